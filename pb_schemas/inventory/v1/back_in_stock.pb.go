@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: pb_schemas/inventory/v1/back_in_stock.proto
+
+package inventoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Back-in-stock subscription, status is one of PENDING, NOTIFIED, EXPIRED
+type BackInStockSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	NotifiedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackInStockSubscription) Reset() {
+	*x = BackInStockSubscription{}
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackInStockSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackInStockSubscription) ProtoMessage() {}
+
+func (x *BackInStockSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackInStockSubscription.ProtoReflect.Descriptor instead.
+func (*BackInStockSubscription) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BackInStockSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BackInStockSubscription) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BackInStockSubscription) GetNotifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return nil
+}
+
+// Request to be emailed once when an out of stock SKU becomes available
+type SubscribeBackInStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBackInStockRequest) Reset() {
+	*x = SubscribeBackInStockRequest{}
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBackInStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBackInStockRequest) ProtoMessage() {}
+
+func (x *SubscribeBackInStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBackInStockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBackInStockRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscribeBackInStockRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SubscribeBackInStockRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type BackInStockSubscriptionResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Subscription  *BackInStockSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Timestamp     *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackInStockSubscriptionResponse) Reset() {
+	*x = BackInStockSubscriptionResponse{}
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackInStockSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackInStockSubscriptionResponse) ProtoMessage() {}
+
+func (x *BackInStockSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackInStockSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*BackInStockSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BackInStockSubscriptionResponse) GetSubscription() *BackInStockSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *BackInStockSubscriptionResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_pb_schemas_inventory_v1_back_in_stock_proto protoreflect.FileDescriptor
+
+const file_pb_schemas_inventory_v1_back_in_stock_proto_rawDesc = "" +
+	"\n" +
+	"+pb_schemas/inventory/v1/back_in_stock.proto\x12\x17pb_schemas.inventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x02\n" +
+	"\x17BackInStockSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vnotified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"notifiedAt\"E\n" +
+	"\x1bSubscribeBackInStockRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"\xb1\x01\n" +
+	"\x1fBackInStockSubscriptionResponse\x12T\n" +
+	"\fsubscription\x18\x01 \x01(\v20.pb_schemas.inventory.v1.BackInStockSubscriptionR\fsubscription\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x9f\x01\n" +
+	"\x12BackInStockService\x12\x88\x01\n" +
+	"\x14SubscribeBackInStock\x124.pb_schemas.inventory.v1.SubscribeBackInStockRequest\x1a8.pb_schemas.inventory.v1.BackInStockSubscriptionResponse\"\x00B3Z1ops-monorepo/protogen/go/inventory/v1;inventoryv1b\x06proto3"
+
+var (
+	file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescOnce sync.Once
+	file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescData []byte
+)
+
+func file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescGZIP() []byte {
+	file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescOnce.Do(func() {
+		file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_back_in_stock_proto_rawDesc), len(file_pb_schemas_inventory_v1_back_in_stock_proto_rawDesc)))
+	})
+	return file_pb_schemas_inventory_v1_back_in_stock_proto_rawDescData
+}
+
+var file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pb_schemas_inventory_v1_back_in_stock_proto_goTypes = []any{
+	(*BackInStockSubscription)(nil),         // 0: pb_schemas.inventory.v1.BackInStockSubscription
+	(*SubscribeBackInStockRequest)(nil),     // 1: pb_schemas.inventory.v1.SubscribeBackInStockRequest
+	(*BackInStockSubscriptionResponse)(nil), // 2: pb_schemas.inventory.v1.BackInStockSubscriptionResponse
+	(*timestamppb.Timestamp)(nil),           // 3: google.protobuf.Timestamp
+}
+var file_pb_schemas_inventory_v1_back_in_stock_proto_depIdxs = []int32{
+	3, // 0: pb_schemas.inventory.v1.BackInStockSubscription.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: pb_schemas.inventory.v1.BackInStockSubscription.expires_at:type_name -> google.protobuf.Timestamp
+	3, // 2: pb_schemas.inventory.v1.BackInStockSubscription.notified_at:type_name -> google.protobuf.Timestamp
+	0, // 3: pb_schemas.inventory.v1.BackInStockSubscriptionResponse.subscription:type_name -> pb_schemas.inventory.v1.BackInStockSubscription
+	3, // 4: pb_schemas.inventory.v1.BackInStockSubscriptionResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 5: pb_schemas.inventory.v1.BackInStockService.SubscribeBackInStock:input_type -> pb_schemas.inventory.v1.SubscribeBackInStockRequest
+	2, // 6: pb_schemas.inventory.v1.BackInStockService.SubscribeBackInStock:output_type -> pb_schemas.inventory.v1.BackInStockSubscriptionResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pb_schemas_inventory_v1_back_in_stock_proto_init() }
+func file_pb_schemas_inventory_v1_back_in_stock_proto_init() {
+	if File_pb_schemas_inventory_v1_back_in_stock_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_back_in_stock_proto_rawDesc), len(file_pb_schemas_inventory_v1_back_in_stock_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pb_schemas_inventory_v1_back_in_stock_proto_goTypes,
+		DependencyIndexes: file_pb_schemas_inventory_v1_back_in_stock_proto_depIdxs,
+		MessageInfos:      file_pb_schemas_inventory_v1_back_in_stock_proto_msgTypes,
+	}.Build()
+	File_pb_schemas_inventory_v1_back_in_stock_proto = out.File
+	file_pb_schemas_inventory_v1_back_in_stock_proto_goTypes = nil
+	file_pb_schemas_inventory_v1_back_in_stock_proto_depIdxs = nil
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pb_schemas/inventory/v1/back_in_stock.proto
+
+package inventoryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BackInStockService_SubscribeBackInStock_FullMethodName = "/pb_schemas.inventory.v1.BackInStockService/SubscribeBackInStock"
+)
+
+// BackInStockServiceClient is the client API for BackInStockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Back In Stock Service
+type BackInStockServiceClient interface {
+	SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*BackInStockSubscriptionResponse, error)
+}
+
+type backInStockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackInStockServiceClient(cc grpc.ClientConnInterface) BackInStockServiceClient {
+	return &backInStockServiceClient{cc}
+}
+
+func (c *backInStockServiceClient) SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*BackInStockSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackInStockSubscriptionResponse)
+	err := c.cc.Invoke(ctx, BackInStockService_SubscribeBackInStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackInStockServiceServer is the server API for BackInStockService service.
+// All implementations should embed UnimplementedBackInStockServiceServer
+// for forward compatibility.
+//
+// Back In Stock Service
+type BackInStockServiceServer interface {
+	SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*BackInStockSubscriptionResponse, error)
+}
+
+// UnimplementedBackInStockServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBackInStockServiceServer struct{}
+
+func (UnimplementedBackInStockServiceServer) SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*BackInStockSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeBackInStock not implemented")
+}
+func (UnimplementedBackInStockServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBackInStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackInStockServiceServer will
+// result in compilation errors.
+type UnsafeBackInStockServiceServer interface {
+	mustEmbedUnimplementedBackInStockServiceServer()
+}
+
+func RegisterBackInStockServiceServer(s grpc.ServiceRegistrar, srv BackInStockServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBackInStockServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BackInStockService_ServiceDesc, srv)
+}
+
+func _BackInStockService_SubscribeBackInStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeBackInStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackInStockServiceServer).SubscribeBackInStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackInStockService_SubscribeBackInStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackInStockServiceServer).SubscribeBackInStock(ctx, req.(*SubscribeBackInStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BackInStockService_ServiceDesc is the grpc.ServiceDesc for BackInStockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackInStockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb_schemas.inventory.v1.BackInStockService",
+	HandlerType: (*BackInStockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubscribeBackInStock",
+			Handler:    _BackInStockService_SubscribeBackInStock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb_schemas/inventory/v1/back_in_stock.proto",
+}
@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: pb_schemas/inventory/v1/backorder.proto
+
+package inventoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Raised once every backorder of an order has been allocated, the order is then fully reserved
+type BackorderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackorderEvent) Reset() {
+	*x = BackorderEvent{}
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackorderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackorderEvent) ProtoMessage() {}
+
+func (x *BackorderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackorderEvent.ProtoReflect.Descriptor instead.
+func (*BackorderEvent) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BackorderEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackorderEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *BackorderEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Request for unacknowledged events, oldest first
+type ListBackorderEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // default 100, max 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackorderEventsRequest) Reset() {
+	*x = ListBackorderEventsRequest{}
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackorderEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackorderEventsRequest) ProtoMessage() {}
+
+func (x *ListBackorderEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackorderEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackorderEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListBackorderEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBackorderEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*BackorderEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackorderEventsResponse) Reset() {
+	*x = ListBackorderEventsResponse{}
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackorderEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackorderEventsResponse) ProtoMessage() {}
+
+func (x *ListBackorderEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackorderEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackorderEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListBackorderEventsResponse) GetEvents() []*BackorderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListBackorderEventsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Acknowledged events are not listed again
+type AcknowledgeBackorderEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeBackorderEventsRequest) Reset() {
+	*x = AcknowledgeBackorderEventsRequest{}
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeBackorderEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeBackorderEventsRequest) ProtoMessage() {}
+
+func (x *AcknowledgeBackorderEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeBackorderEventsRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeBackorderEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcknowledgeBackorderEventsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AcknowledgeBackorderEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acknowledged  int64                  `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeBackorderEventsResponse) Reset() {
+	*x = AcknowledgeBackorderEventsResponse{}
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeBackorderEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeBackorderEventsResponse) ProtoMessage() {}
+
+func (x *AcknowledgeBackorderEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_backorder_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeBackorderEventsResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeBackorderEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcknowledgeBackorderEventsResponse) GetAcknowledged() int64 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+func (x *AcknowledgeBackorderEventsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_pb_schemas_inventory_v1_backorder_proto protoreflect.FileDescriptor
+
+const file_pb_schemas_inventory_v1_backorder_proto_rawDesc = "" +
+	"\n" +
+	"'pb_schemas/inventory/v1/backorder.proto\x12\x17pb_schemas.inventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"v\n" +
+	"\x0eBackorderEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"2\n" +
+	"\x1aListBackorderEventsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\x98\x01\n" +
+	"\x1bListBackorderEventsResponse\x12?\n" +
+	"\x06events\x18\x01 \x03(\v2'.pb_schemas.inventory.v1.BackorderEventR\x06events\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"5\n" +
+	"!AcknowledgeBackorderEventsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"\x82\x01\n" +
+	"\"AcknowledgeBackorderEventsResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\x03R\facknowledged\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xb1\x02\n" +
+	"\x10BackorderService\x12\x82\x01\n" +
+	"\x13ListBackorderEvents\x123.pb_schemas.inventory.v1.ListBackorderEventsRequest\x1a4.pb_schemas.inventory.v1.ListBackorderEventsResponse\"\x00\x12\x97\x01\n" +
+	"\x1aAcknowledgeBackorderEvents\x12:.pb_schemas.inventory.v1.AcknowledgeBackorderEventsRequest\x1a;.pb_schemas.inventory.v1.AcknowledgeBackorderEventsResponse\"\x00B3Z1ops-monorepo/protogen/go/inventory/v1;inventoryv1b\x06proto3"
+
+var (
+	file_pb_schemas_inventory_v1_backorder_proto_rawDescOnce sync.Once
+	file_pb_schemas_inventory_v1_backorder_proto_rawDescData []byte
+)
+
+func file_pb_schemas_inventory_v1_backorder_proto_rawDescGZIP() []byte {
+	file_pb_schemas_inventory_v1_backorder_proto_rawDescOnce.Do(func() {
+		file_pb_schemas_inventory_v1_backorder_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_backorder_proto_rawDesc), len(file_pb_schemas_inventory_v1_backorder_proto_rawDesc)))
+	})
+	return file_pb_schemas_inventory_v1_backorder_proto_rawDescData
+}
+
+var file_pb_schemas_inventory_v1_backorder_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pb_schemas_inventory_v1_backorder_proto_goTypes = []any{
+	(*BackorderEvent)(nil),                     // 0: pb_schemas.inventory.v1.BackorderEvent
+	(*ListBackorderEventsRequest)(nil),         // 1: pb_schemas.inventory.v1.ListBackorderEventsRequest
+	(*ListBackorderEventsResponse)(nil),        // 2: pb_schemas.inventory.v1.ListBackorderEventsResponse
+	(*AcknowledgeBackorderEventsRequest)(nil),  // 3: pb_schemas.inventory.v1.AcknowledgeBackorderEventsRequest
+	(*AcknowledgeBackorderEventsResponse)(nil), // 4: pb_schemas.inventory.v1.AcknowledgeBackorderEventsResponse
+	(*timestamppb.Timestamp)(nil),              // 5: google.protobuf.Timestamp
+}
+var file_pb_schemas_inventory_v1_backorder_proto_depIdxs = []int32{
+	5, // 0: pb_schemas.inventory.v1.BackorderEvent.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: pb_schemas.inventory.v1.ListBackorderEventsResponse.events:type_name -> pb_schemas.inventory.v1.BackorderEvent
+	5, // 2: pb_schemas.inventory.v1.ListBackorderEventsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 3: pb_schemas.inventory.v1.AcknowledgeBackorderEventsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 4: pb_schemas.inventory.v1.BackorderService.ListBackorderEvents:input_type -> pb_schemas.inventory.v1.ListBackorderEventsRequest
+	3, // 5: pb_schemas.inventory.v1.BackorderService.AcknowledgeBackorderEvents:input_type -> pb_schemas.inventory.v1.AcknowledgeBackorderEventsRequest
+	2, // 6: pb_schemas.inventory.v1.BackorderService.ListBackorderEvents:output_type -> pb_schemas.inventory.v1.ListBackorderEventsResponse
+	4, // 7: pb_schemas.inventory.v1.BackorderService.AcknowledgeBackorderEvents:output_type -> pb_schemas.inventory.v1.AcknowledgeBackorderEventsResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pb_schemas_inventory_v1_backorder_proto_init() }
+func file_pb_schemas_inventory_v1_backorder_proto_init() {
+	if File_pb_schemas_inventory_v1_backorder_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_backorder_proto_rawDesc), len(file_pb_schemas_inventory_v1_backorder_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pb_schemas_inventory_v1_backorder_proto_goTypes,
+		DependencyIndexes: file_pb_schemas_inventory_v1_backorder_proto_depIdxs,
+		MessageInfos:      file_pb_schemas_inventory_v1_backorder_proto_msgTypes,
+	}.Build()
+	File_pb_schemas_inventory_v1_backorder_proto = out.File
+	file_pb_schemas_inventory_v1_backorder_proto_goTypes = nil
+	file_pb_schemas_inventory_v1_backorder_proto_depIdxs = nil
+}
@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pb_schemas/inventory/v1/backorder.proto
+
+package inventoryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BackorderService_ListBackorderEvents_FullMethodName        = "/pb_schemas.inventory.v1.BackorderService/ListBackorderEvents"
+	BackorderService_AcknowledgeBackorderEvents_FullMethodName = "/pb_schemas.inventory.v1.BackorderService/AcknowledgeBackorderEvents"
+)
+
+// BackorderServiceClient is the client API for BackorderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Backorder Service
+type BackorderServiceClient interface {
+	ListBackorderEvents(ctx context.Context, in *ListBackorderEventsRequest, opts ...grpc.CallOption) (*ListBackorderEventsResponse, error)
+	AcknowledgeBackorderEvents(ctx context.Context, in *AcknowledgeBackorderEventsRequest, opts ...grpc.CallOption) (*AcknowledgeBackorderEventsResponse, error)
+}
+
+type backorderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBackorderServiceClient(cc grpc.ClientConnInterface) BackorderServiceClient {
+	return &backorderServiceClient{cc}
+}
+
+func (c *backorderServiceClient) ListBackorderEvents(ctx context.Context, in *ListBackorderEventsRequest, opts ...grpc.CallOption) (*ListBackorderEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackorderEventsResponse)
+	err := c.cc.Invoke(ctx, BackorderService_ListBackorderEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backorderServiceClient) AcknowledgeBackorderEvents(ctx context.Context, in *AcknowledgeBackorderEventsRequest, opts ...grpc.CallOption) (*AcknowledgeBackorderEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeBackorderEventsResponse)
+	err := c.cc.Invoke(ctx, BackorderService_AcknowledgeBackorderEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackorderServiceServer is the server API for BackorderService service.
+// All implementations should embed UnimplementedBackorderServiceServer
+// for forward compatibility.
+//
+// Backorder Service
+type BackorderServiceServer interface {
+	ListBackorderEvents(context.Context, *ListBackorderEventsRequest) (*ListBackorderEventsResponse, error)
+	AcknowledgeBackorderEvents(context.Context, *AcknowledgeBackorderEventsRequest) (*AcknowledgeBackorderEventsResponse, error)
+}
+
+// UnimplementedBackorderServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBackorderServiceServer struct{}
+
+func (UnimplementedBackorderServiceServer) ListBackorderEvents(context.Context, *ListBackorderEventsRequest) (*ListBackorderEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackorderEvents not implemented")
+}
+func (UnimplementedBackorderServiceServer) AcknowledgeBackorderEvents(context.Context, *AcknowledgeBackorderEventsRequest) (*AcknowledgeBackorderEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeBackorderEvents not implemented")
+}
+func (UnimplementedBackorderServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBackorderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BackorderServiceServer will
+// result in compilation errors.
+type UnsafeBackorderServiceServer interface {
+	mustEmbedUnimplementedBackorderServiceServer()
+}
+
+func RegisterBackorderServiceServer(s grpc.ServiceRegistrar, srv BackorderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBackorderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BackorderService_ServiceDesc, srv)
+}
+
+func _BackorderService_ListBackorderEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackorderEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackorderServiceServer).ListBackorderEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackorderService_ListBackorderEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackorderServiceServer).ListBackorderEvents(ctx, req.(*ListBackorderEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackorderService_AcknowledgeBackorderEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeBackorderEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackorderServiceServer).AcknowledgeBackorderEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackorderService_AcknowledgeBackorderEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackorderServiceServer).AcknowledgeBackorderEvents(ctx, req.(*AcknowledgeBackorderEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BackorderService_ServiceDesc is the grpc.ServiceDesc for BackorderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BackorderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb_schemas.inventory.v1.BackorderService",
+	HandlerType: (*BackorderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBackorderEvents",
+			Handler:    _BackorderService_ListBackorderEvents_Handler,
+		},
+		{
+			MethodName: "AcknowledgeBackorderEvents",
+			Handler:    _BackorderService_AcknowledgeBackorderEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pb_schemas/inventory/v1/backorder.proto",
+}
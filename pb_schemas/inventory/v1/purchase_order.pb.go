@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: pb_schemas/inventory/v1/purchase_order.proto
+
+package inventoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Purchase order line, reorder_point and avg_daily_demand explain generated lines
+type PurchaseOrderLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku              string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	OrderedQuantity  float64                `protobuf:"fixed64,3,opt,name=ordered_quantity,json=orderedQuantity,proto3" json:"ordered_quantity,omitempty"`
+	ReceivedQuantity float64                `protobuf:"fixed64,4,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	ReorderPoint     float64                `protobuf:"fixed64,5,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	AvgDailyDemand   float64                `protobuf:"fixed64,6,opt,name=avg_daily_demand,json=avgDailyDemand,proto3" json:"avg_daily_demand,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PurchaseOrderLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetOrderedQuantity() float64 {
+	if x != nil {
+		return x.OrderedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() float64 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReorderPoint() float64 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetAvgDailyDemand() float64 {
+	if x != nil {
+		return x.AvgDailyDemand
+	}
+	return 0
+}
+
+// Purchase order, status is one of DRAFT, SUBMITTED, PARTIALLY_RECEIVED, RECEIVED
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Supplier      string                 `protobuf:"bytes,3,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PurchaseOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+// Request to draft a purchase order for every SKU below its reorder point
+type CreateReplenishmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LookbackDays  int32                  `protobuf:"varint,1,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`   // demand window over reservation history, default 30
+	LeadTimeDays  int32                  `protobuf:"varint,2,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"` // days of demand to cover until delivery, default 7
+	Skus          []string               `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`                                        // optional, limits the candidates
+	Supplier      string                 `protobuf:"bytes,4,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplenishmentRequest) Reset() {
+	*x = CreateReplenishmentRequest{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplenishmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplenishmentRequest) ProtoMessage() {}
+
+func (x *CreateReplenishmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplenishmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplenishmentRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateReplenishmentRequest) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *CreateReplenishmentRequest) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *CreateReplenishmentRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *CreateReplenishmentRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+type PurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderRequest) Reset() {
+	*x = PurchaseOrderRequest{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderRequest) ProtoMessage() {}
+
+func (x *PurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PurchaseOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPurchaseOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPurchaseOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPurchaseOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ReceiveLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveLine) Reset() {
+	*x = ReceiveLine{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveLine) ProtoMessage() {}
+
+func (x *ReceiveLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveLine.ProtoReflect.Descriptor instead.
+func (*ReceiveLine) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceiveLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReceiveLine) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReceivePurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lines         []*ReceiveLine         `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLines() []*ReceiveLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type PurchaseOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"` // unset when nothing needs to be reordered
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderResponse) Reset() {
+	*x = PurchaseOrderResponse{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderResponse) ProtoMessage() {}
+
+func (x *PurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+func (x *PurchaseOrderResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ListPurchaseOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PurchaseOrder       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPurchaseOrdersResponse) GetItems() []*PurchaseOrder {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPurchaseOrdersResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_pb_schemas_inventory_v1_purchase_order_proto protoreflect.FileDescriptor
+
+const file_pb_schemas_inventory_v1_purchase_order_proto_rawDesc = "" +
+	"\n" +
+	",pb_schemas/inventory/v1/purchase_order.proto\x12\x17pb_schemas.inventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\x01\n" +
+	"\x11PurchaseOrderLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12)\n" +
+	"\x10ordered_quantity\x18\x03 \x01(\x01R\x0forderedQuantity\x12+\n" +
+	"\x11received_quantity\x18\x04 \x01(\x01R\x10receivedQuantity\x12#\n" +
+	"\rreorder_point\x18\x05 \x01(\x01R\freorderPoint\x12(\n" +
+	"\x10avg_daily_demand\x18\x06 \x01(\x01R\x0eavgDailyDemand\"\x87\x03\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bsupplier\x18\x03 \x01(\tR\bsupplier\x12@\n" +
+	"\x05lines\x18\x04 \x03(\v2*.pb_schemas.inventory.v1.PurchaseOrderLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fsubmitted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12;\n" +
+	"\vreceived_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\"\x97\x01\n" +
+	"\x1aCreateReplenishmentRequest\x12#\n" +
+	"\rlookback_days\x18\x01 \x01(\x05R\flookbackDays\x12$\n" +
+	"\x0elead_time_days\x18\x02 \x01(\x05R\fleadTimeDays\x12\x12\n" +
+	"\x04skus\x18\x03 \x03(\tR\x04skus\x12\x1a\n" +
+	"\bsupplier\x18\x04 \x01(\tR\bsupplier\"&\n" +
+	"\x14PurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"P\n" +
+	"\x19ListPurchaseOrdersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\";\n" +
+	"\vReceiveLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\"i\n" +
+	"\x1bReceivePurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\x05lines\x18\x02 \x03(\v2$.pb_schemas.inventory.v1.ReceiveLineR\x05lines\"\xa0\x01\n" +
+	"\x15PurchaseOrderResponse\x12M\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2&.pb_schemas.inventory.v1.PurchaseOrderR\rpurchaseOrder\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x94\x01\n" +
+	"\x1aListPurchaseOrdersResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.pb_schemas.inventory.v1.PurchaseOrderR\x05items\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x90\x05\n" +
+	"\x14PurchaseOrderService\x12\x89\x01\n" +
+	" CreateReplenishmentPurchaseOrder\x123.pb_schemas.inventory.v1.CreateReplenishmentRequest\x1a..pb_schemas.inventory.v1.PurchaseOrderResponse\"\x00\x12s\n" +
+	"\x10GetPurchaseOrder\x12-.pb_schemas.inventory.v1.PurchaseOrderRequest\x1a..pb_schemas.inventory.v1.PurchaseOrderResponse\"\x00\x12\x7f\n" +
+	"\x12ListPurchaseOrders\x122.pb_schemas.inventory.v1.ListPurchaseOrdersRequest\x1a3.pb_schemas.inventory.v1.ListPurchaseOrdersResponse\"\x00\x12v\n" +
+	"\x13SubmitPurchaseOrder\x12-.pb_schemas.inventory.v1.PurchaseOrderRequest\x1a..pb_schemas.inventory.v1.PurchaseOrderResponse\"\x00\x12~\n" +
+	"\x14ReceivePurchaseOrder\x124.pb_schemas.inventory.v1.ReceivePurchaseOrderRequest\x1a..pb_schemas.inventory.v1.PurchaseOrderResponse\"\x00B3Z1ops-monorepo/protogen/go/inventory/v1;inventoryv1b\x06proto3"
+
+var (
+	file_pb_schemas_inventory_v1_purchase_order_proto_rawDescOnce sync.Once
+	file_pb_schemas_inventory_v1_purchase_order_proto_rawDescData []byte
+)
+
+func file_pb_schemas_inventory_v1_purchase_order_proto_rawDescGZIP() []byte {
+	file_pb_schemas_inventory_v1_purchase_order_proto_rawDescOnce.Do(func() {
+		file_pb_schemas_inventory_v1_purchase_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_purchase_order_proto_rawDesc), len(file_pb_schemas_inventory_v1_purchase_order_proto_rawDesc)))
+	})
+	return file_pb_schemas_inventory_v1_purchase_order_proto_rawDescData
+}
+
+var file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pb_schemas_inventory_v1_purchase_order_proto_goTypes = []any{
+	(*PurchaseOrderLine)(nil),           // 0: pb_schemas.inventory.v1.PurchaseOrderLine
+	(*PurchaseOrder)(nil),               // 1: pb_schemas.inventory.v1.PurchaseOrder
+	(*CreateReplenishmentRequest)(nil),  // 2: pb_schemas.inventory.v1.CreateReplenishmentRequest
+	(*PurchaseOrderRequest)(nil),        // 3: pb_schemas.inventory.v1.PurchaseOrderRequest
+	(*ListPurchaseOrdersRequest)(nil),   // 4: pb_schemas.inventory.v1.ListPurchaseOrdersRequest
+	(*ReceiveLine)(nil),                 // 5: pb_schemas.inventory.v1.ReceiveLine
+	(*ReceivePurchaseOrderRequest)(nil), // 6: pb_schemas.inventory.v1.ReceivePurchaseOrderRequest
+	(*PurchaseOrderResponse)(nil),       // 7: pb_schemas.inventory.v1.PurchaseOrderResponse
+	(*ListPurchaseOrdersResponse)(nil),  // 8: pb_schemas.inventory.v1.ListPurchaseOrdersResponse
+	(*timestamppb.Timestamp)(nil),       // 9: google.protobuf.Timestamp
+}
+var file_pb_schemas_inventory_v1_purchase_order_proto_depIdxs = []int32{
+	0,  // 0: pb_schemas.inventory.v1.PurchaseOrder.lines:type_name -> pb_schemas.inventory.v1.PurchaseOrderLine
+	9,  // 1: pb_schemas.inventory.v1.PurchaseOrder.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: pb_schemas.inventory.v1.PurchaseOrder.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 3: pb_schemas.inventory.v1.PurchaseOrder.submitted_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: pb_schemas.inventory.v1.PurchaseOrder.received_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: pb_schemas.inventory.v1.ReceivePurchaseOrderRequest.lines:type_name -> pb_schemas.inventory.v1.ReceiveLine
+	1,  // 6: pb_schemas.inventory.v1.PurchaseOrderResponse.purchase_order:type_name -> pb_schemas.inventory.v1.PurchaseOrder
+	9,  // 7: pb_schemas.inventory.v1.PurchaseOrderResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 8: pb_schemas.inventory.v1.ListPurchaseOrdersResponse.items:type_name -> pb_schemas.inventory.v1.PurchaseOrder
+	9,  // 9: pb_schemas.inventory.v1.ListPurchaseOrdersResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 10: pb_schemas.inventory.v1.PurchaseOrderService.CreateReplenishmentPurchaseOrder:input_type -> pb_schemas.inventory.v1.CreateReplenishmentRequest
+	3,  // 11: pb_schemas.inventory.v1.PurchaseOrderService.GetPurchaseOrder:input_type -> pb_schemas.inventory.v1.PurchaseOrderRequest
+	4,  // 12: pb_schemas.inventory.v1.PurchaseOrderService.ListPurchaseOrders:input_type -> pb_schemas.inventory.v1.ListPurchaseOrdersRequest
+	3,  // 13: pb_schemas.inventory.v1.PurchaseOrderService.SubmitPurchaseOrder:input_type -> pb_schemas.inventory.v1.PurchaseOrderRequest
+	6,  // 14: pb_schemas.inventory.v1.PurchaseOrderService.ReceivePurchaseOrder:input_type -> pb_schemas.inventory.v1.ReceivePurchaseOrderRequest
+	7,  // 15: pb_schemas.inventory.v1.PurchaseOrderService.CreateReplenishmentPurchaseOrder:output_type -> pb_schemas.inventory.v1.PurchaseOrderResponse
+	7,  // 16: pb_schemas.inventory.v1.PurchaseOrderService.GetPurchaseOrder:output_type -> pb_schemas.inventory.v1.PurchaseOrderResponse
+	8,  // 17: pb_schemas.inventory.v1.PurchaseOrderService.ListPurchaseOrders:output_type -> pb_schemas.inventory.v1.ListPurchaseOrdersResponse
+	7,  // 18: pb_schemas.inventory.v1.PurchaseOrderService.SubmitPurchaseOrder:output_type -> pb_schemas.inventory.v1.PurchaseOrderResponse
+	7,  // 19: pb_schemas.inventory.v1.PurchaseOrderService.ReceivePurchaseOrder:output_type -> pb_schemas.inventory.v1.PurchaseOrderResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_pb_schemas_inventory_v1_purchase_order_proto_init() }
+func file_pb_schemas_inventory_v1_purchase_order_proto_init() {
+	if File_pb_schemas_inventory_v1_purchase_order_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_purchase_order_proto_rawDesc), len(file_pb_schemas_inventory_v1_purchase_order_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pb_schemas_inventory_v1_purchase_order_proto_goTypes,
+		DependencyIndexes: file_pb_schemas_inventory_v1_purchase_order_proto_depIdxs,
+		MessageInfos:      file_pb_schemas_inventory_v1_purchase_order_proto_msgTypes,
+	}.Build()
+	File_pb_schemas_inventory_v1_purchase_order_proto = out.File
+	file_pb_schemas_inventory_v1_purchase_order_proto_goTypes = nil
+	file_pb_schemas_inventory_v1_purchase_order_proto_depIdxs = nil
+}
@@ -22,6 +22,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How ReserveStock handles SKUs that are short
+type ReservationPolicy int32
+
+const (
+	ReservationPolicy_ALL_OR_NOTHING        ReservationPolicy = 0 // every line is reserved in full or the whole order fails
+	ReservationPolicy_PARTIAL               ReservationPolicy = 1 // each line reserves whatever is available
+	ReservationPolicy_FILL_OR_KILL_PER_LINE ReservationPolicy = 2 // each line is reserved in full or not at all
+)
+
+// Enum value maps for ReservationPolicy.
+var (
+	ReservationPolicy_name = map[int32]string{
+		0: "ALL_OR_NOTHING",
+		1: "PARTIAL",
+		2: "FILL_OR_KILL_PER_LINE",
+	}
+	ReservationPolicy_value = map[string]int32{
+		"ALL_OR_NOTHING":        0,
+		"PARTIAL":               1,
+		"FILL_OR_KILL_PER_LINE": 2,
+	}
+)
+
+func (x ReservationPolicy) Enum() *ReservationPolicy {
+	p := new(ReservationPolicy)
+	*p = x
+	return p
+}
+
+func (x ReservationPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_schemas_inventory_v1_stock_proto_enumTypes[0].Descriptor()
+}
+
+func (ReservationPolicy) Type() protoreflect.EnumType {
+	return &file_pb_schemas_inventory_v1_stock_proto_enumTypes[0]
+}
+
+func (x ReservationPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationPolicy.Descriptor instead.
+func (ReservationPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{0}
+}
+
 type ErrorCode int32
 
 const (
@@ -70,11 +120,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_schemas_inventory_v1_stock_proto_enumTypes[0].Descriptor()
+	return file_pb_schemas_inventory_v1_stock_proto_enumTypes[1].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_pb_schemas_inventory_v1_stock_proto_enumTypes[0]
+	return &file_pb_schemas_inventory_v1_stock_proto_enumTypes[1]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -83,7 +133,7 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{0}
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{1}
 }
 
 // Inventory Item Definition
@@ -158,6 +208,9 @@ type InventoryStatus struct {
 	SkuUom            string                 `protobuf:"bytes,6,opt,name=sku_uom,json=skuUom,proto3" json:"sku_uom,omitempty"`
 	SkuPrice          float64                `protobuf:"fixed64,7,opt,name=sku_price,json=skuPrice,proto3" json:"sku_price,omitempty"`
 	SkuCurrency       string                 `protobuf:"bytes,8,opt,name=sku_currency,json=skuCurrency,proto3" json:"sku_currency,omitempty"`
+	IsBundle          bool                   `protobuf:"varint,9,opt,name=is_bundle,json=isBundle,proto3" json:"is_bundle,omitempty"`          // availability is derived from the bundle components
+	CategoryId        string                 `protobuf:"bytes,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`    // category of the product, empty when it has none
+	TaxCategory       string                 `protobuf:"bytes,11,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"` // STANDARD, REDUCED, ZERO or EXEMPT, selects the tax rate of the order
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -248,6 +301,27 @@ func (x *InventoryStatus) GetSkuCurrency() string {
 	return ""
 }
 
+func (x *InventoryStatus) GetIsBundle() bool {
+	if x != nil {
+		return x.IsBundle
+	}
+	return false
+}
+
+func (x *InventoryStatus) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *InventoryStatus) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
 type ReservedItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -302,11 +376,13 @@ func (x *ReservedItem) GetOrderId() string {
 
 // Request to check inventory
 type StandardInventoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Items         []*InventoryItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items             []*InventoryItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	AllowBackorder    bool                   `protobuf:"varint,3,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"`                                                         // ReserveStock queues the shortfall of plain SKUs instead of failing
+	ReservationPolicy ReservationPolicy      `protobuf:"varint,4,opt,name=reservation_policy,json=reservationPolicy,proto3,enum=pb_schemas.inventory.v1.ReservationPolicy" json:"reservation_policy,omitempty"` // ReserveStock only, defaults to ALL_OR_NOTHING
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *StandardInventoryRequest) Reset() {
@@ -353,6 +429,20 @@ func (x *StandardInventoryRequest) GetItems() []*InventoryItem {
 	return nil
 }
 
+func (x *StandardInventoryRequest) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *StandardInventoryRequest) GetReservationPolicy() ReservationPolicy {
+	if x != nil {
+		return x.ReservationPolicy
+	}
+	return ReservationPolicy_ALL_OR_NOTHING
+}
+
 // Successful response
 type InventoryStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -412,6 +502,8 @@ type InventoryReservationResponse struct {
 	SuccessProcessedItems *SuccessProcessedItems `protobuf:"bytes,2,opt,name=success_processed_items,json=successProcessedItems,proto3" json:"success_processed_items,omitempty"`
 	FailedProcessedItems  *FailedProcessedItems  `protobuf:"bytes,3,opt,name=failed_processed_items,json=failedProcessedItems,proto3" json:"failed_processed_items,omitempty"`
 	Timestamp             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Backorders            []*Backorder           `protobuf:"bytes,5,rep,name=backorders,proto3" json:"backorders,omitempty"` // shortfall queued with allow_backorder
+	Lines                 []*ReservedLine        `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`           // per requested SKU, set by ReserveStock
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -474,6 +566,174 @@ func (x *InventoryReservationResponse) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InventoryReservationResponse) GetBackorders() []*Backorder {
+	if x != nil {
+		return x.Backorders
+	}
+	return nil
+}
+
+func (x *InventoryReservationResponse) GetLines() []*ReservedLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Quantity reserved for a requested SKU, less than requested when the line is short
+type ReservedLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	RequestedQuantity float64                `protobuf:"fixed64,2,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"`
+	ReservedQuantity  float64                `protobuf:"fixed64,3,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReservedLine) Reset() {
+	*x = ReservedLine{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservedLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedLine) ProtoMessage() {}
+
+func (x *ReservedLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedLine.ProtoReflect.Descriptor instead.
+func (*ReservedLine) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReservedLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReservedLine) GetRequestedQuantity() float64 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *ReservedLine) GetReservedQuantity() float64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+// Quantity of an order waiting for stock, status is one of PENDING, ALLOCATED, CANCELLED
+type Backorder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AllocatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=allocated_at,json=allocatedAt,proto3" json:"allocated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Backorder) Reset() {
+	*x = Backorder{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backorder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backorder) ProtoMessage() {}
+
+func (x *Backorder) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backorder.ProtoReflect.Descriptor instead.
+func (*Backorder) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Backorder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Backorder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Backorder) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Backorder) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Backorder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Backorder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Backorder) GetAllocatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AllocatedAt
+	}
+	return nil
+}
+
 type ReservationHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -484,13 +744,15 @@ type ReservationHistory struct {
 	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
 	ReservedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
 	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	LineType      string                 `protobuf:"bytes,9,opt,name=line_type,json=lineType,proto3" json:"line_type,omitempty"`     // STOCK, BUNDLE or COMPONENT
+	BundleSku     string                 `protobuf:"bytes,10,opt,name=bundle_sku,json=bundleSku,proto3" json:"bundle_sku,omitempty"` // set on COMPONENT lines, the bundle they were reserved for
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReservationHistory) Reset() {
 	*x = ReservationHistory{}
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[6]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +764,7 @@ func (x *ReservationHistory) String() string {
 func (*ReservationHistory) ProtoMessage() {}
 
 func (x *ReservationHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[6]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +777,7 @@ func (x *ReservationHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReservationHistory.ProtoReflect.Descriptor instead.
 func (*ReservationHistory) Descriptor() ([]byte, []int) {
-	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{6}
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReservationHistory) GetId() string {
@@ -574,6 +836,20 @@ func (x *ReservationHistory) GetReleasedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ReservationHistory) GetLineType() string {
+	if x != nil {
+		return x.LineType
+	}
+	return ""
+}
+
+func (x *ReservationHistory) GetBundleSku() string {
+	if x != nil {
+		return x.BundleSku
+	}
+	return ""
+}
+
 type SuccessProcessedItems struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*ReservationHistory  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -583,7 +859,7 @@ type SuccessProcessedItems struct {
 
 func (x *SuccessProcessedItems) Reset() {
 	*x = SuccessProcessedItems{}
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[7]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +871,7 @@ func (x *SuccessProcessedItems) String() string {
 func (*SuccessProcessedItems) ProtoMessage() {}
 
 func (x *SuccessProcessedItems) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[7]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +884,7 @@ func (x *SuccessProcessedItems) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SuccessProcessedItems.ProtoReflect.Descriptor instead.
 func (*SuccessProcessedItems) Descriptor() ([]byte, []int) {
-	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{7}
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SuccessProcessedItems) GetItems() []*ReservationHistory {
@@ -627,7 +903,7 @@ type FailedProcessedItems struct {
 
 func (x *FailedProcessedItems) Reset() {
 	*x = FailedProcessedItems{}
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[8]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +915,7 @@ func (x *FailedProcessedItems) String() string {
 func (*FailedProcessedItems) ProtoMessage() {}
 
 func (x *FailedProcessedItems) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[8]
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +928,7 @@ func (x *FailedProcessedItems) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FailedProcessedItems.ProtoReflect.Descriptor instead.
 func (*FailedProcessedItems) Descriptor() ([]byte, []int) {
-	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{8}
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FailedProcessedItems) GetItems() []*InventoryStatus {
@@ -662,29 +938,30 @@ func (x *FailedProcessedItems) GetItems() []*InventoryStatus {
 	return nil
 }
 
-type ErrorDetails struct {
+// Changes the reserved quantities of an order, every change is applied or none of them
+type AmendReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ErrorCode     ErrorCode              `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=pb_schemas.inventory.v1.ErrorCode" json:"error_code,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Changes       []*ReservationChange   `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ErrorDetails) Reset() {
-	*x = ErrorDetails{}
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[9]
+func (x *AmendReservationRequest) Reset() {
+	*x = AmendReservationRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ErrorDetails) String() string {
+func (x *AmendReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorDetails) ProtoMessage() {}
+func (*AmendReservationRequest) ProtoMessage() {}
 
-func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[9]
+func (x *AmendReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,57 +972,2008 @@ func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorDetails.ProtoReflect.Descriptor instead.
-func (*ErrorDetails) Descriptor() ([]byte, []int) {
-	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AmendReservationRequest.ProtoReflect.Descriptor instead.
+func (*AmendReservationRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ErrorDetails) GetErrorCode() ErrorCode {
+func (x *AmendReservationRequest) GetOrderId() string {
 	if x != nil {
-		return x.ErrorCode
+		return x.OrderId
 	}
-	return ErrorCode_UNDEFINED
+	return ""
 }
 
-func (x *ErrorDetails) GetErrorMessage() string {
+func (x *AmendReservationRequest) GetChanges() []*ReservationChange {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-var File_pb_schemas_inventory_v1_stock_proto protoreflect.FileDescriptor
+// Positive quantity_delta reserves more of the sku, negative releases part of its reservation
+type ReservationChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	QuantityDelta float64                `protobuf:"fixed64,2,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_pb_schemas_inventory_v1_stock_proto_rawDesc = "" +
-	"\n" +
-	"#pb_schemas/inventory/v1/stock.proto\x12\x17pb_schemas.inventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
-	"\rInventoryItem\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12%\n" +
-	"\x0freq_qty_per_uom\x18\x02 \x01(\x01R\freqQtyPerUom\x12\x10\n" +
-	"\x03uom\x18\x03 \x01(\tR\x03uom\"\xae\x02\n" +
-	"\x0fInventoryStatus\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku\x12-\n" +
-	"\x12requested_quantity\x18\x02 \x01(\x01R\x11requestedQuantity\x12-\n" +
-	"\x12available_quantity\x18\x03 \x01(\x01R\x11availableQuantity\x12+\n" +
-	"\x11reserved_quantity\x18\x04 \x01(\x01R\x10reservedQuantity\x12%\n" +
+func (x *ReservationChange) Reset() {
+	*x = ReservationChange{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationChange) ProtoMessage() {}
+
+func (x *ReservationChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationChange.ProtoReflect.Descriptor instead.
+func (*ReservationChange) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReservationChange) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReservationChange) GetQuantityDelta() float64 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
+
+// Request to list reservation history, every filter is optional
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ReservedFrom  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reserved_from,json=reservedFrom,proto3" json:"reserved_from,omitempty"`
+	ReservedTo    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reserved_to,json=reservedTo,proto3" json:"reserved_to,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReservationsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReservationsRequest) GetReservedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReservedFrom
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetReservedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReservedTo
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Aggregated quantities of the filtered reservations for a single SKU
+type ReservationSkuTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sku              string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ReservedQuantity float64                `protobuf:"fixed64,2,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	ReleasedQuantity float64                `protobuf:"fixed64,3,opt,name=released_quantity,json=releasedQuantity,proto3" json:"released_quantity,omitempty"`
+	ReservationCount int64                  `protobuf:"varint,4,opt,name=reservation_count,json=reservationCount,proto3" json:"reservation_count,omitempty"`
+	ConsumedQuantity float64                `protobuf:"fixed64,5,opt,name=consumed_quantity,json=consumedQuantity,proto3" json:"consumed_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReservationSkuTotal) Reset() {
+	*x = ReservationSkuTotal{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationSkuTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationSkuTotal) ProtoMessage() {}
+
+func (x *ReservationSkuTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationSkuTotal.ProtoReflect.Descriptor instead.
+func (*ReservationSkuTotal) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReservationSkuTotal) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReservationSkuTotal) GetReservedQuantity() float64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *ReservationSkuTotal) GetReleasedQuantity() float64 {
+	if x != nil {
+		return x.ReleasedQuantity
+	}
+	return 0
+}
+
+func (x *ReservationSkuTotal) GetReservationCount() int64 {
+	if x != nil {
+		return x.ReservationCount
+	}
+	return 0
+}
+
+func (x *ReservationSkuTotal) GetConsumedQuantity() float64 {
+	if x != nil {
+		return x.ConsumedQuantity
+	}
+	return 0
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReservationHistory  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Totals        []*ReservationSkuTotal `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListReservationsResponse) GetItems() []*ReservationHistory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListReservationsResponse) GetTotals() []*ReservationSkuTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *ListReservationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListReservationsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// One line of a bundle bill of materials
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // component quantity per single bundle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BundleComponent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Defines or replaces the bill of materials of a bundle SKU
+type DefineBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleSku     string                 `protobuf:"bytes,1,opt,name=bundle_sku,json=bundleSku,proto3" json:"bundle_sku,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineBundleRequest) Reset() {
+	*x = DefineBundleRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineBundleRequest) ProtoMessage() {}
+
+func (x *DefineBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineBundleRequest.ProtoReflect.Descriptor instead.
+func (*DefineBundleRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DefineBundleRequest) GetBundleSku() string {
+	if x != nil {
+		return x.BundleSku
+	}
+	return ""
+}
+
+func (x *DefineBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type BundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleSku     string                 `protobuf:"bytes,1,opt,name=bundle_sku,json=bundleSku,proto3" json:"bundle_sku,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleResponse) Reset() {
+	*x = BundleResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleResponse) ProtoMessage() {}
+
+func (x *BundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleResponse.ProtoReflect.Descriptor instead.
+func (*BundleResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BundleResponse) GetBundleSku() string {
+	if x != nil {
+		return x.BundleSku
+	}
+	return ""
+}
+
+func (x *BundleResponse) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *BundleResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Request to rebuild stock positions at a point in time
+type GetStockAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []string               `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAsOfRequest) Reset() {
+	*x = GetStockAsOfRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAsOfRequest) ProtoMessage() {}
+
+func (x *GetStockAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStockAsOfRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *GetStockAsOfRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type StockPosition struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	CurrentQuantity   float64                `protobuf:"fixed64,2,opt,name=current_quantity,json=currentQuantity,proto3" json:"current_quantity,omitempty"`
+	ReservedQuantity  float64                `protobuf:"fixed64,3,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity float64                `protobuf:"fixed64,4,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	SnapshotAsOf      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=snapshot_as_of,json=snapshotAsOf,proto3" json:"snapshot_as_of,omitempty"` // daily snapshot used as the base, unset when none
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StockPosition) Reset() {
+	*x = StockPosition{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockPosition) ProtoMessage() {}
+
+func (x *StockPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockPosition.ProtoReflect.Descriptor instead.
+func (*StockPosition) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StockPosition) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *StockPosition) GetCurrentQuantity() float64 {
+	if x != nil {
+		return x.CurrentQuantity
+	}
+	return 0
+}
+
+func (x *StockPosition) GetReservedQuantity() float64 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *StockPosition) GetAvailableQuantity() float64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *StockPosition) GetSnapshotAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnapshotAsOf
+	}
+	return nil
+}
+
+type GetStockAsOfResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockPosition       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAsOfResponse) Reset() {
+	*x = GetStockAsOfResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAsOfResponse) ProtoMessage() {}
+
+func (x *GetStockAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetStockAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStockAsOfResponse) GetItems() []*StockPosition {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetStockAsOfResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// Predicate on skus.variant_attributes, a sku matches when the key holds any of the values
+type AttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // empty only requires the key to be present
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AttributeFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Request to search SKUs, every filter is optional and filters are combined with AND
+type SearchSkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // the category and all of its descendants
+	Attributes    []*AttributeFilter     `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Discontinued  *bool                  `protobuf:"varint,4,opt,name=discontinued,proto3,oneof" json:"discontinued,omitempty"`
+	AvailableOnly bool                   `protobuf:"varint,5,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"` // only SKUs with available quantity above zero
+	FacetKeys     []string               `protobuf:"bytes,6,rep,name=facet_keys,json=facetKeys,proto3" json:"facet_keys,omitempty"`              // attribute keys to count, every key when empty
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSkusRequest) Reset() {
+	*x = SearchSkusRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSkusRequest) ProtoMessage() {}
+
+func (x *SearchSkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSkusRequest.ProtoReflect.Descriptor instead.
+func (*SearchSkusRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchSkusRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchSkusRequest) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SearchSkusRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *SearchSkusRequest) GetDiscontinued() bool {
+	if x != nil && x.Discontinued != nil {
+		return *x.Discontinued
+	}
+	return false
+}
+
+func (x *SearchSkusRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+func (x *SearchSkusRequest) GetFacetKeys() []string {
+	if x != nil {
+		return x.FacetKeys
+	}
+	return nil
+}
+
+func (x *SearchSkusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchSkusRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SkuSearchItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	CategoryId        string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Attributes        map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsActive          bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Discontinued      bool                   `protobuf:"varint,7,opt,name=discontinued,proto3" json:"discontinued,omitempty"`
+	AvailableQuantity float64                `protobuf:"fixed64,8,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	IsBundle          bool                   `protobuf:"varint,9,opt,name=is_bundle,json=isBundle,proto3" json:"is_bundle,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SkuSearchItem) Reset() {
+	*x = SkuSearchItem{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuSearchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuSearchItem) ProtoMessage() {}
+
+func (x *SkuSearchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuSearchItem.ProtoReflect.Descriptor instead.
+func (*SkuSearchItem) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SkuSearchItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SkuSearchItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SkuSearchItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *SkuSearchItem) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SkuSearchItem) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SkuSearchItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SkuSearchItem) GetDiscontinued() bool {
+	if x != nil {
+		return x.Discontinued
+	}
+	return false
+}
+
+func (x *SkuSearchItem) GetAvailableQuantity() float64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *SkuSearchItem) GetIsBundle() bool {
+	if x != nil {
+		return x.IsBundle
+	}
+	return false
+}
+
+type AttributeFacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFacetValue) Reset() {
+	*x = AttributeFacetValue{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFacetValue) ProtoMessage() {}
+
+func (x *AttributeFacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFacetValue.ProtoReflect.Descriptor instead.
+func (*AttributeFacetValue) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AttributeFacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AttributeFacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Number of matching SKUs per value of a single attribute key
+type AttributeFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []*AttributeFacetValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFacet) Reset() {
+	*x = AttributeFacet{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFacet) ProtoMessage() {}
+
+func (x *AttributeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFacet.ProtoReflect.Descriptor instead.
+func (*AttributeFacet) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AttributeFacet) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeFacet) GetValues() []*AttributeFacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchSkusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SkuSearchItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Facets        []*AttributeFacet      `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"` // over the whole filtered set, not only the current page
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSkusResponse) Reset() {
+	*x = SearchSkusResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSkusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSkusResponse) ProtoMessage() {}
+
+func (x *SearchSkusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSkusResponse.ProtoReflect.Descriptor instead.
+func (*SearchSkusResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchSkusResponse) GetItems() []*SkuSearchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchSkusResponse) GetFacets() []*AttributeFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchSkusResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchSkusResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type SubstituteRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"` // lower is suggested first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubstituteRule) Reset() {
+	*x = SubstituteRule{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstituteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstituteRule) ProtoMessage() {}
+
+func (x *SubstituteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstituteRule.ProtoReflect.Descriptor instead.
+func (*SubstituteRule) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubstituteRule) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SubstituteRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// Defines or replaces the substitution rules of a SKU
+type DefineSubstitutesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Substitutes   []*SubstituteRule      `protobuf:"bytes,2,rep,name=substitutes,proto3" json:"substitutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefineSubstitutesRequest) Reset() {
+	*x = DefineSubstitutesRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineSubstitutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineSubstitutesRequest) ProtoMessage() {}
+
+func (x *DefineSubstitutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineSubstitutesRequest.ProtoReflect.Descriptor instead.
+func (*DefineSubstitutesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DefineSubstitutesRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *DefineSubstitutesRequest) GetSubstitutes() []*SubstituteRule {
+	if x != nil {
+		return x.Substitutes
+	}
+	return nil
+}
+
+type SubstitutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Substitutes   []*SubstituteRule      `protobuf:"bytes,2,rep,name=substitutes,proto3" json:"substitutes,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubstitutesResponse) Reset() {
+	*x = SubstitutesResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstitutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstitutesResponse) ProtoMessage() {}
+
+func (x *SubstitutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstitutesResponse.ProtoReflect.Descriptor instead.
+func (*SubstitutesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubstitutesResponse) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SubstitutesResponse) GetSubstitutes() []*SubstituteRule {
+	if x != nil {
+		return x.Substitutes
+	}
+	return nil
+}
+
+func (x *SubstitutesResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Request for in stock alternatives of SKUs that cannot be fulfilled
+type SuggestAlternativesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InventoryItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`  // req_qty_per_uom is the quantity an alternative must cover
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // alternatives per SKU, defaults to 3
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAlternativesRequest) Reset() {
+	*x = SuggestAlternativesRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAlternativesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesRequest) ProtoMessage() {}
+
+func (x *SuggestAlternativesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SuggestAlternativesRequest) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SuggestAlternativesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AlternativeSku struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Sku                string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductId          string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName        string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	AvailableQuantity  float64                `protobuf:"fixed64,4,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Reason             string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                    // SUBSTITUTE_RULE, SAME_PRODUCT or SAME_CATEGORY
+	MatchingAttributes int32                  `protobuf:"varint,6,opt,name=matching_attributes,json=matchingAttributes,proto3" json:"matching_attributes,omitempty"` // variant attributes shared with the requested SKU
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AlternativeSku) Reset() {
+	*x = AlternativeSku{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlternativeSku) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternativeSku) ProtoMessage() {}
+
+func (x *AlternativeSku) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternativeSku.ProtoReflect.Descriptor instead.
+func (*AlternativeSku) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AlternativeSku) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AlternativeSku) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AlternativeSku) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *AlternativeSku) GetAvailableQuantity() float64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *AlternativeSku) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AlternativeSku) GetMatchingAttributes() int32 {
+	if x != nil {
+		return x.MatchingAttributes
+	}
+	return 0
+}
+
+type SkuAlternatives struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	RequestedQuantity float64                `protobuf:"fixed64,4,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"`
+	Alternatives      []*AlternativeSku      `protobuf:"bytes,5,rep,name=alternatives,proto3" json:"alternatives,omitempty"` // best match first
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SkuAlternatives) Reset() {
+	*x = SkuAlternatives{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuAlternatives) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuAlternatives) ProtoMessage() {}
+
+func (x *SkuAlternatives) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuAlternatives.ProtoReflect.Descriptor instead.
+func (*SkuAlternatives) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SkuAlternatives) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SkuAlternatives) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SkuAlternatives) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *SkuAlternatives) GetRequestedQuantity() float64 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *SkuAlternatives) GetAlternatives() []*AlternativeSku {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type SuggestAlternativesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SkuAlternatives     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // unknown SKUs are left out
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAlternativesResponse) Reset() {
+	*x = SuggestAlternativesResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAlternativesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesResponse) ProtoMessage() {}
+
+func (x *SuggestAlternativesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SuggestAlternativesResponse) GetItems() []*SkuAlternatives {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SuggestAlternativesResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Puts the goods of a customer return back into stock, bundles are restocked as their components.
+// retried calls with the same return_id restock the goods once
+type RestockReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReturnId      string                 `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*InventoryItem       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // req_qty_per_uom is the returned quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockReturnRequest) Reset() {
+	*x = RestockReturnRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockReturnRequest) ProtoMessage() {}
+
+func (x *RestockReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockReturnRequest.ProtoReflect.Descriptor instead.
+func (*RestockReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestockReturnRequest) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *RestockReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RestockReturnRequest) GetItems() []*InventoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RestockedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockedItem) Reset() {
+	*x = RestockedItem{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockedItem) ProtoMessage() {}
+
+func (x *RestockedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockedItem.ProtoReflect.Descriptor instead.
+func (*RestockedItem) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestockedItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *RestockedItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RestockReturnResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReturnId         string                 `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	Items            []*RestockedItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	AlreadyRestocked bool                   `protobuf:"varint,3,opt,name=already_restocked,json=alreadyRestocked,proto3" json:"already_restocked,omitempty"` // an earlier call with the same return_id restocked the goods
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestockReturnResponse) Reset() {
+	*x = RestockReturnResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockReturnResponse) ProtoMessage() {}
+
+func (x *RestockReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockReturnResponse.ProtoReflect.Descriptor instead.
+func (*RestockReturnResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestockReturnResponse) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *RestockReturnResponse) GetItems() []*RestockedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RestockReturnResponse) GetAlreadyRestocked() bool {
+	if x != nil {
+		return x.AlreadyRestocked
+	}
+	return false
+}
+
+func (x *RestockReturnResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Consumes reserved stock of an order when its goods leave the warehouse, current and reserved stock
+// both go down by the quantity. commit_id, the shipment id, makes a retried call commit once
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CommitId      string                 `protobuf:"bytes,2,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	Items         []*CommitItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // empty commits everything the order still holds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CommitReservationRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CommitReservationRequest) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *CommitReservationRequest) GetItems() []*CommitItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CommitItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitItem) Reset() {
+	*x = CommitItem{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitItem) ProtoMessage() {}
+
+func (x *CommitItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitItem.ProtoReflect.Descriptor instead.
+func (*CommitItem) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CommitItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CommitItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CommitReservationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CommitId         string                 `protobuf:"bytes,2,opt,name=commit_id,json=commitId,proto3" json:"commit_id,omitempty"`
+	Items            []*CommitItem          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                                                // quantities consumed per sku
+	AlreadyCommitted bool                   `protobuf:"varint,4,opt,name=already_committed,json=alreadyCommitted,proto3" json:"already_committed,omitempty"` // an earlier call with the same commit_id committed the stock
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CommitReservationResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CommitReservationResponse) GetCommitId() string {
+	if x != nil {
+		return x.CommitId
+	}
+	return ""
+}
+
+func (x *CommitReservationResponse) GetItems() []*CommitItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CommitReservationResponse) GetAlreadyCommitted() bool {
+	if x != nil {
+		return x.AlreadyCommitted
+	}
+	return false
+}
+
+func (x *CommitReservationResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ErrorDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrorCode     ErrorCode              `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=pb_schemas.inventory.v1.ErrorCode" json:"error_code,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorDetails) Reset() {
+	*x = ErrorDetails{}
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetails) ProtoMessage() {}
+
+func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_schemas_inventory_v1_stock_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetails.ProtoReflect.Descriptor instead.
+func (*ErrorDetails) Descriptor() ([]byte, []int) {
+	return file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ErrorDetails) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+func (x *ErrorDetails) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+var File_pb_schemas_inventory_v1_stock_proto protoreflect.FileDescriptor
+
+const file_pb_schemas_inventory_v1_stock_proto_rawDesc = "" +
+	"\n" +
+	"#pb_schemas/inventory/v1/stock.proto\x12\x17pb_schemas.inventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\rInventoryItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12%\n" +
+	"\x0freq_qty_per_uom\x18\x02 \x01(\x01R\freqQtyPerUom\x12\x10\n" +
+	"\x03uom\x18\x03 \x01(\tR\x03uom\"\x8f\x03\n" +
+	"\x0fInventoryStatus\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12-\n" +
+	"\x12requested_quantity\x18\x02 \x01(\x01R\x11requestedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x03 \x01(\x01R\x11availableQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\x04 \x01(\x01R\x10reservedQuantity\x12%\n" +
 	"\x0etotal_quantity\x18\x05 \x01(\x01R\rtotalQuantity\x12\x17\n" +
 	"\asku_uom\x18\x06 \x01(\tR\x06skuUom\x12\x1b\n" +
 	"\tsku_price\x18\a \x01(\x01R\bskuPrice\x12!\n" +
-	"\fsku_currency\x18\b \x01(\tR\vskuCurrency\"9\n" +
+	"\fsku_currency\x18\b \x01(\tR\vskuCurrency\x12\x1b\n" +
+	"\tis_bundle\x18\t \x01(\bR\bisBundle\x12\x1f\n" +
+	"\vcategory_id\x18\n" +
+	" \x01(\tR\n" +
+	"categoryId\x12!\n" +
+	"\ftax_category\x18\v \x01(\tR\vtaxCategory\"9\n" +
 	"\fReservedItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\tR\aorderId\"s\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"\xf7\x01\n" +
 	"\x18StandardInventoryRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12<\n" +
-	"\x05items\x18\x02 \x03(\v2&.pb_schemas.inventory.v1.InventoryItemR\x05items\"\x93\x01\n" +
+	"\x05items\x18\x02 \x03(\v2&.pb_schemas.inventory.v1.InventoryItemR\x05items\x12'\n" +
+	"\x0fallow_backorder\x18\x03 \x01(\bR\x0eallowBackorder\x12Y\n" +
+	"\x12reservation_policy\x18\x04 \x01(\x0e2*.pb_schemas.inventory.v1.ReservationPolicyR\x11reservationPolicy\"\x93\x01\n" +
 	"\x17InventoryStatusResponse\x12>\n" +
 	"\x05items\x18\x01 \x03(\v2(.pb_schemas.inventory.v1.InventoryStatusR\x05items\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc0\x02\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc1\x03\n" +
 	"\x1cInventoryReservationResponse\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12f\n" +
 	"\x17success_processed_items\x18\x02 \x01(\v2..pb_schemas.inventory.v1.SuccessProcessedItemsR\x15successProcessedItems\x12c\n" +
 	"\x16failed_processed_items\x18\x03 \x01(\v2-.pb_schemas.inventory.v1.FailedProcessedItemsR\x14failedProcessedItems\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x91\x02\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12B\n" +
+	"\n" +
+	"backorders\x18\x05 \x03(\v2\".pb_schemas.inventory.v1.BackorderR\n" +
+	"backorders\x12;\n" +
+	"\x05lines\x18\x06 \x03(\v2%.pb_schemas.inventory.v1.ReservedLineR\x05lines\"|\n" +
+	"\fReservedLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12-\n" +
+	"\x12requested_quantity\x18\x02 \x01(\x01R\x11requestedQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\x03 \x01(\x01R\x10reservedQuantity\"\xf6\x01\n" +
+	"\tBackorder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fallocated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vallocatedAt\"\xcd\x02\n" +
 	"\x12ReservationHistory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x10\n" +
@@ -756,15 +2984,183 @@ const file_pb_schemas_inventory_v1_stock_proto_rawDesc = "" +
 	"\vreserved_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"reservedAt\x12;\n" +
 	"\vreleased_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"releasedAt\"Z\n" +
+	"releasedAt\x12\x1b\n" +
+	"\tline_type\x18\t \x01(\tR\blineType\x12\x1d\n" +
+	"\n" +
+	"bundle_sku\x18\n" +
+	" \x01(\tR\tbundleSku\"Z\n" +
 	"\x15SuccessProcessedItems\x12A\n" +
 	"\x05items\x18\x01 \x03(\v2+.pb_schemas.inventory.v1.ReservationHistoryR\x05items\"V\n" +
 	"\x14FailedProcessedItems\x12>\n" +
-	"\x05items\x18\x01 \x03(\v2(.pb_schemas.inventory.v1.InventoryStatusR\x05items\"v\n" +
+	"\x05items\x18\x01 \x03(\v2(.pb_schemas.inventory.v1.InventoryStatusR\x05items\"z\n" +
+	"\x17AmendReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12D\n" +
+	"\achanges\x18\x02 \x03(\v2*.pb_schemas.inventory.v1.ReservationChangeR\achanges\"L\n" +
+	"\x11ReservationChange\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12%\n" +
+	"\x0equantity_delta\x18\x02 \x01(\x01R\rquantityDelta\"\x91\x02\n" +
+	"\x17ListReservationsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12?\n" +
+	"\rreserved_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\freservedFrom\x12;\n" +
+	"\vreserved_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reservedTo\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\"\xdb\x01\n" +
+	"\x13ReservationSkuTotal\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12+\n" +
+	"\x11reserved_quantity\x18\x02 \x01(\x01R\x10reservedQuantity\x12+\n" +
+	"\x11released_quantity\x18\x03 \x01(\x01R\x10releasedQuantity\x12+\n" +
+	"\x11reservation_count\x18\x04 \x01(\x03R\x10reservationCount\x12+\n" +
+	"\x11consumed_quantity\x18\x05 \x01(\x01R\x10consumedQuantity\"\xfe\x01\n" +
+	"\x18ListReservationsResponse\x12A\n" +
+	"\x05items\x18\x01 \x03(\v2+.pb_schemas.inventory.v1.ReservationHistoryR\x05items\x12D\n" +
+	"\x06totals\x18\x02 \x03(\v2,.pb_schemas.inventory.v1.ReservationSkuTotalR\x06totals\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"?\n" +
+	"\x0fBundleComponent\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\"~\n" +
+	"\x13DefineBundleRequest\x12\x1d\n" +
+	"\n" +
+	"bundle_sku\x18\x01 \x01(\tR\tbundleSku\x12H\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2(.pb_schemas.inventory.v1.BundleComponentR\n" +
+	"components\"\xb3\x01\n" +
+	"\x0eBundleResponse\x12\x1d\n" +
+	"\n" +
+	"bundle_sku\x18\x01 \x01(\tR\tbundleSku\x12H\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2(.pb_schemas.inventory.v1.BundleComponentR\n" +
+	"components\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"Z\n" +
+	"\x13GetStockAsOfRequest\x12\x12\n" +
+	"\x04skus\x18\x01 \x03(\tR\x04skus\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xea\x01\n" +
+	"\rStockPosition\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12)\n" +
+	"\x10current_quantity\x18\x02 \x01(\x01R\x0fcurrentQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\x03 \x01(\x01R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\x04 \x01(\x01R\x11availableQuantity\x12@\n" +
+	"\x0esnapshot_as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fsnapshotAsOf\"\x85\x01\n" +
+	"\x14GetStockAsOfResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.pb_schemas.inventory.v1.StockPositionR\x05items\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\";\n" +
+	"\x0fAttributeFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"\xe3\x02\n" +
+	"\x11SearchSkusRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12H\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2(.pb_schemas.inventory.v1.AttributeFilterR\n" +
+	"attributes\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x00R\bisActive\x88\x01\x01\x12'\n" +
+	"\fdiscontinued\x18\x04 \x01(\bH\x01R\fdiscontinued\x88\x01\x01\x12%\n" +
+	"\x0eavailable_only\x18\x05 \x01(\bR\ravailableOnly\x12\x1d\n" +
+	"\n" +
+	"facet_keys\x18\x06 \x03(\tR\tfacetKeys\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursorB\f\n" +
+	"\n" +
+	"_is_activeB\x0f\n" +
+	"\r_discontinued\"\xa8\x03\n" +
+	"\rSkuSearchItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x12V\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v26.pb_schemas.inventory.v1.SkuSearchItem.AttributesEntryR\n" +
+	"attributes\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12\"\n" +
+	"\fdiscontinued\x18\a \x01(\bR\fdiscontinued\x12-\n" +
+	"\x12available_quantity\x18\b \x01(\x01R\x11availableQuantity\x12\x1b\n" +
+	"\tis_bundle\x18\t \x01(\bR\bisBundle\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x13AttributeFacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"h\n" +
+	"\x0eAttributeFacet\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x06values\x18\x02 \x03(\v2,.pb_schemas.inventory.v1.AttributeFacetValueR\x06values\"\xee\x01\n" +
+	"\x12SearchSkusResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.pb_schemas.inventory.v1.SkuSearchItemR\x05items\x12?\n" +
+	"\x06facets\x18\x02 \x03(\v2'.pb_schemas.inventory.v1.AttributeFacetR\x06facets\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\">\n" +
+	"\x0eSubstituteRule\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"w\n" +
+	"\x18DefineSubstitutesRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12I\n" +
+	"\vsubstitutes\x18\x02 \x03(\v2'.pb_schemas.inventory.v1.SubstituteRuleR\vsubstitutes\"\xac\x01\n" +
+	"\x13SubstitutesResponse\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12I\n" +
+	"\vsubstitutes\x18\x02 \x03(\v2'.pb_schemas.inventory.v1.SubstituteRuleR\vsubstitutes\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"p\n" +
+	"\x1aSuggestAlternativesRequest\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.pb_schemas.inventory.v1.InventoryItemR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xdc\x01\n" +
+	"\x0eAlternativeSku\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12-\n" +
+	"\x12available_quantity\x18\x04 \x01(\x01R\x11availableQuantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12/\n" +
+	"\x13matching_attributes\x18\x06 \x01(\x05R\x12matchingAttributes\"\xe1\x01\n" +
+	"\x0fSkuAlternatives\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12-\n" +
+	"\x12requested_quantity\x18\x04 \x01(\x01R\x11requestedQuantity\x12K\n" +
+	"\falternatives\x18\x05 \x03(\v2'.pb_schemas.inventory.v1.AlternativeSkuR\falternatives\"\x97\x01\n" +
+	"\x1bSuggestAlternativesResponse\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.pb_schemas.inventory.v1.SkuAlternativesR\x05items\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8c\x01\n" +
+	"\x14RestockReturnRequest\x12\x1b\n" +
+	"\treturn_id\x18\x01 \x01(\tR\breturnId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12<\n" +
+	"\x05items\x18\x03 \x03(\v2&.pb_schemas.inventory.v1.InventoryItemR\x05items\"=\n" +
+	"\rRestockedItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\"\xd9\x01\n" +
+	"\x15RestockReturnResponse\x12\x1b\n" +
+	"\treturn_id\x18\x01 \x01(\tR\breturnId\x12<\n" +
+	"\x05items\x18\x02 \x03(\v2&.pb_schemas.inventory.v1.RestockedItemR\x05items\x12+\n" +
+	"\x11already_restocked\x18\x03 \x01(\bR\x10alreadyRestocked\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8d\x01\n" +
+	"\x18CommitReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tcommit_id\x18\x02 \x01(\tR\bcommitId\x129\n" +
+	"\x05items\x18\x03 \x03(\v2#.pb_schemas.inventory.v1.CommitItemR\x05items\":\n" +
+	"\n" +
+	"CommitItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\"\xf5\x01\n" +
+	"\x19CommitReservationResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tcommit_id\x18\x02 \x01(\tR\bcommitId\x129\n" +
+	"\x05items\x18\x03 \x03(\v2#.pb_schemas.inventory.v1.CommitItemR\x05items\x12+\n" +
+	"\x11already_committed\x18\x04 \x01(\bR\x10alreadyCommitted\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"v\n" +
 	"\fErrorDetails\x12A\n" +
 	"\n" +
 	"error_code\x18\x01 \x01(\x0e2\".pb_schemas.inventory.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage*\xd7\x01\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage*O\n" +
+	"\x11ReservationPolicy\x12\x12\n" +
+	"\x0eALL_OR_NOTHING\x10\x00\x12\v\n" +
+	"\aPARTIAL\x10\x01\x12\x19\n" +
+	"\x15FILL_OR_KILL_PER_LINE\x10\x02*\xd7\x01\n" +
 	"\tErrorCode\x12\r\n" +
 	"\tUNDEFINED\x10\x00\x12\x11\n" +
 	"\rSKU_NOT_FOUND\x10\x01\x12\x1a\n" +
@@ -773,12 +3169,22 @@ const file_pb_schemas_inventory_v1_stock_proto_rawDesc = "" +
 	"\x14DB_ERROR_TRANSACTION\x10\x04\x12\x12\n" +
 	"\x0eINTERNAL_ERROR\x10\x05\x12$\n" +
 	" INSUFFICIENT_QUANTITY_TO_RESERVE\x10\x06\x12$\n" +
-	" INSUFFICIENT_QUANTITY_TO_RELEASE\x10\a2\xff\x02\n" +
+	" INSUFFICIENT_QUANTITY_TO_RELEASE\x10\a2\xa7\v\n" +
 	"\x10InventoryService\x12s\n" +
 	"\n" +
 	"CheckStock\x121.pb_schemas.inventory.v1.StandardInventoryRequest\x1a0.pb_schemas.inventory.v1.InventoryStatusResponse\"\x00\x12z\n" +
 	"\fReserveStock\x121.pb_schemas.inventory.v1.StandardInventoryRequest\x1a5.pb_schemas.inventory.v1.InventoryReservationResponse\"\x00\x12z\n" +
-	"\fReleaseStock\x121.pb_schemas.inventory.v1.StandardInventoryRequest\x1a5.pb_schemas.inventory.v1.InventoryReservationResponse\"\x00B3Z1ops-monorepo/protogen/go/inventory/v1;inventoryv1b\x06proto3"
+	"\fReleaseStock\x121.pb_schemas.inventory.v1.StandardInventoryRequest\x1a5.pb_schemas.inventory.v1.InventoryReservationResponse\"\x00\x12}\n" +
+	"\x10AmendReservation\x120.pb_schemas.inventory.v1.AmendReservationRequest\x1a5.pb_schemas.inventory.v1.InventoryReservationResponse\"\x00\x12y\n" +
+	"\x10ListReservations\x120.pb_schemas.inventory.v1.ListReservationsRequest\x1a1.pb_schemas.inventory.v1.ListReservationsResponse\"\x00\x12g\n" +
+	"\fDefineBundle\x12,.pb_schemas.inventory.v1.DefineBundleRequest\x1a'.pb_schemas.inventory.v1.BundleResponse\"\x00\x12m\n" +
+	"\fGetStockAsOf\x12,.pb_schemas.inventory.v1.GetStockAsOfRequest\x1a-.pb_schemas.inventory.v1.GetStockAsOfResponse\"\x00\x12g\n" +
+	"\n" +
+	"SearchSkus\x12*.pb_schemas.inventory.v1.SearchSkusRequest\x1a+.pb_schemas.inventory.v1.SearchSkusResponse\"\x00\x12v\n" +
+	"\x11DefineSubstitutes\x121.pb_schemas.inventory.v1.DefineSubstitutesRequest\x1a,.pb_schemas.inventory.v1.SubstitutesResponse\"\x00\x12\x82\x01\n" +
+	"\x13SuggestAlternatives\x123.pb_schemas.inventory.v1.SuggestAlternativesRequest\x1a4.pb_schemas.inventory.v1.SuggestAlternativesResponse\"\x00\x12p\n" +
+	"\rRestockReturn\x12-.pb_schemas.inventory.v1.RestockReturnRequest\x1a..pb_schemas.inventory.v1.RestockReturnResponse\"\x00\x12|\n" +
+	"\x11CommitReservation\x121.pb_schemas.inventory.v1.CommitReservationRequest\x1a2.pb_schemas.inventory.v1.CommitReservationResponse\"\x00B3Z1ops-monorepo/protogen/go/inventory/v1;inventoryv1b\x06proto3"
 
 var (
 	file_pb_schemas_inventory_v1_stock_proto_rawDescOnce sync.Once
@@ -792,45 +3198,134 @@ func file_pb_schemas_inventory_v1_stock_proto_rawDescGZIP() []byte {
 	return file_pb_schemas_inventory_v1_stock_proto_rawDescData
 }
 
-var file_pb_schemas_inventory_v1_stock_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pb_schemas_inventory_v1_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_pb_schemas_inventory_v1_stock_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pb_schemas_inventory_v1_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_pb_schemas_inventory_v1_stock_proto_goTypes = []any{
-	(ErrorCode)(0),                       // 0: pb_schemas.inventory.v1.ErrorCode
-	(*InventoryItem)(nil),                // 1: pb_schemas.inventory.v1.InventoryItem
-	(*InventoryStatus)(nil),              // 2: pb_schemas.inventory.v1.InventoryStatus
-	(*ReservedItem)(nil),                 // 3: pb_schemas.inventory.v1.ReservedItem
-	(*StandardInventoryRequest)(nil),     // 4: pb_schemas.inventory.v1.StandardInventoryRequest
-	(*InventoryStatusResponse)(nil),      // 5: pb_schemas.inventory.v1.InventoryStatusResponse
-	(*InventoryReservationResponse)(nil), // 6: pb_schemas.inventory.v1.InventoryReservationResponse
-	(*ReservationHistory)(nil),           // 7: pb_schemas.inventory.v1.ReservationHistory
-	(*SuccessProcessedItems)(nil),        // 8: pb_schemas.inventory.v1.SuccessProcessedItems
-	(*FailedProcessedItems)(nil),         // 9: pb_schemas.inventory.v1.FailedProcessedItems
-	(*ErrorDetails)(nil),                 // 10: pb_schemas.inventory.v1.ErrorDetails
-	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+	(ReservationPolicy)(0),               // 0: pb_schemas.inventory.v1.ReservationPolicy
+	(ErrorCode)(0),                       // 1: pb_schemas.inventory.v1.ErrorCode
+	(*InventoryItem)(nil),                // 2: pb_schemas.inventory.v1.InventoryItem
+	(*InventoryStatus)(nil),              // 3: pb_schemas.inventory.v1.InventoryStatus
+	(*ReservedItem)(nil),                 // 4: pb_schemas.inventory.v1.ReservedItem
+	(*StandardInventoryRequest)(nil),     // 5: pb_schemas.inventory.v1.StandardInventoryRequest
+	(*InventoryStatusResponse)(nil),      // 6: pb_schemas.inventory.v1.InventoryStatusResponse
+	(*InventoryReservationResponse)(nil), // 7: pb_schemas.inventory.v1.InventoryReservationResponse
+	(*ReservedLine)(nil),                 // 8: pb_schemas.inventory.v1.ReservedLine
+	(*Backorder)(nil),                    // 9: pb_schemas.inventory.v1.Backorder
+	(*ReservationHistory)(nil),           // 10: pb_schemas.inventory.v1.ReservationHistory
+	(*SuccessProcessedItems)(nil),        // 11: pb_schemas.inventory.v1.SuccessProcessedItems
+	(*FailedProcessedItems)(nil),         // 12: pb_schemas.inventory.v1.FailedProcessedItems
+	(*AmendReservationRequest)(nil),      // 13: pb_schemas.inventory.v1.AmendReservationRequest
+	(*ReservationChange)(nil),            // 14: pb_schemas.inventory.v1.ReservationChange
+	(*ListReservationsRequest)(nil),      // 15: pb_schemas.inventory.v1.ListReservationsRequest
+	(*ReservationSkuTotal)(nil),          // 16: pb_schemas.inventory.v1.ReservationSkuTotal
+	(*ListReservationsResponse)(nil),     // 17: pb_schemas.inventory.v1.ListReservationsResponse
+	(*BundleComponent)(nil),              // 18: pb_schemas.inventory.v1.BundleComponent
+	(*DefineBundleRequest)(nil),          // 19: pb_schemas.inventory.v1.DefineBundleRequest
+	(*BundleResponse)(nil),               // 20: pb_schemas.inventory.v1.BundleResponse
+	(*GetStockAsOfRequest)(nil),          // 21: pb_schemas.inventory.v1.GetStockAsOfRequest
+	(*StockPosition)(nil),                // 22: pb_schemas.inventory.v1.StockPosition
+	(*GetStockAsOfResponse)(nil),         // 23: pb_schemas.inventory.v1.GetStockAsOfResponse
+	(*AttributeFilter)(nil),              // 24: pb_schemas.inventory.v1.AttributeFilter
+	(*SearchSkusRequest)(nil),            // 25: pb_schemas.inventory.v1.SearchSkusRequest
+	(*SkuSearchItem)(nil),                // 26: pb_schemas.inventory.v1.SkuSearchItem
+	(*AttributeFacetValue)(nil),          // 27: pb_schemas.inventory.v1.AttributeFacetValue
+	(*AttributeFacet)(nil),               // 28: pb_schemas.inventory.v1.AttributeFacet
+	(*SearchSkusResponse)(nil),           // 29: pb_schemas.inventory.v1.SearchSkusResponse
+	(*SubstituteRule)(nil),               // 30: pb_schemas.inventory.v1.SubstituteRule
+	(*DefineSubstitutesRequest)(nil),     // 31: pb_schemas.inventory.v1.DefineSubstitutesRequest
+	(*SubstitutesResponse)(nil),          // 32: pb_schemas.inventory.v1.SubstitutesResponse
+	(*SuggestAlternativesRequest)(nil),   // 33: pb_schemas.inventory.v1.SuggestAlternativesRequest
+	(*AlternativeSku)(nil),               // 34: pb_schemas.inventory.v1.AlternativeSku
+	(*SkuAlternatives)(nil),              // 35: pb_schemas.inventory.v1.SkuAlternatives
+	(*SuggestAlternativesResponse)(nil),  // 36: pb_schemas.inventory.v1.SuggestAlternativesResponse
+	(*RestockReturnRequest)(nil),         // 37: pb_schemas.inventory.v1.RestockReturnRequest
+	(*RestockedItem)(nil),                // 38: pb_schemas.inventory.v1.RestockedItem
+	(*RestockReturnResponse)(nil),        // 39: pb_schemas.inventory.v1.RestockReturnResponse
+	(*CommitReservationRequest)(nil),     // 40: pb_schemas.inventory.v1.CommitReservationRequest
+	(*CommitItem)(nil),                   // 41: pb_schemas.inventory.v1.CommitItem
+	(*CommitReservationResponse)(nil),    // 42: pb_schemas.inventory.v1.CommitReservationResponse
+	(*ErrorDetails)(nil),                 // 43: pb_schemas.inventory.v1.ErrorDetails
+	nil,                                  // 44: pb_schemas.inventory.v1.SkuSearchItem.AttributesEntry
+	(*timestamppb.Timestamp)(nil),        // 45: google.protobuf.Timestamp
 }
 var file_pb_schemas_inventory_v1_stock_proto_depIdxs = []int32{
-	1,  // 0: pb_schemas.inventory.v1.StandardInventoryRequest.items:type_name -> pb_schemas.inventory.v1.InventoryItem
-	2,  // 1: pb_schemas.inventory.v1.InventoryStatusResponse.items:type_name -> pb_schemas.inventory.v1.InventoryStatus
-	11, // 2: pb_schemas.inventory.v1.InventoryStatusResponse.timestamp:type_name -> google.protobuf.Timestamp
-	8,  // 3: pb_schemas.inventory.v1.InventoryReservationResponse.success_processed_items:type_name -> pb_schemas.inventory.v1.SuccessProcessedItems
-	9,  // 4: pb_schemas.inventory.v1.InventoryReservationResponse.failed_processed_items:type_name -> pb_schemas.inventory.v1.FailedProcessedItems
-	11, // 5: pb_schemas.inventory.v1.InventoryReservationResponse.timestamp:type_name -> google.protobuf.Timestamp
-	11, // 6: pb_schemas.inventory.v1.ReservationHistory.reserved_at:type_name -> google.protobuf.Timestamp
-	11, // 7: pb_schemas.inventory.v1.ReservationHistory.released_at:type_name -> google.protobuf.Timestamp
-	7,  // 8: pb_schemas.inventory.v1.SuccessProcessedItems.items:type_name -> pb_schemas.inventory.v1.ReservationHistory
-	2,  // 9: pb_schemas.inventory.v1.FailedProcessedItems.items:type_name -> pb_schemas.inventory.v1.InventoryStatus
-	0,  // 10: pb_schemas.inventory.v1.ErrorDetails.error_code:type_name -> pb_schemas.inventory.v1.ErrorCode
-	4,  // 11: pb_schemas.inventory.v1.InventoryService.CheckStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
-	4,  // 12: pb_schemas.inventory.v1.InventoryService.ReserveStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
-	4,  // 13: pb_schemas.inventory.v1.InventoryService.ReleaseStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
-	5,  // 14: pb_schemas.inventory.v1.InventoryService.CheckStock:output_type -> pb_schemas.inventory.v1.InventoryStatusResponse
-	6,  // 15: pb_schemas.inventory.v1.InventoryService.ReserveStock:output_type -> pb_schemas.inventory.v1.InventoryReservationResponse
-	6,  // 16: pb_schemas.inventory.v1.InventoryService.ReleaseStock:output_type -> pb_schemas.inventory.v1.InventoryReservationResponse
-	14, // [14:17] is the sub-list for method output_type
-	11, // [11:14] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: pb_schemas.inventory.v1.StandardInventoryRequest.items:type_name -> pb_schemas.inventory.v1.InventoryItem
+	0,  // 1: pb_schemas.inventory.v1.StandardInventoryRequest.reservation_policy:type_name -> pb_schemas.inventory.v1.ReservationPolicy
+	3,  // 2: pb_schemas.inventory.v1.InventoryStatusResponse.items:type_name -> pb_schemas.inventory.v1.InventoryStatus
+	45, // 3: pb_schemas.inventory.v1.InventoryStatusResponse.timestamp:type_name -> google.protobuf.Timestamp
+	11, // 4: pb_schemas.inventory.v1.InventoryReservationResponse.success_processed_items:type_name -> pb_schemas.inventory.v1.SuccessProcessedItems
+	12, // 5: pb_schemas.inventory.v1.InventoryReservationResponse.failed_processed_items:type_name -> pb_schemas.inventory.v1.FailedProcessedItems
+	45, // 6: pb_schemas.inventory.v1.InventoryReservationResponse.timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 7: pb_schemas.inventory.v1.InventoryReservationResponse.backorders:type_name -> pb_schemas.inventory.v1.Backorder
+	8,  // 8: pb_schemas.inventory.v1.InventoryReservationResponse.lines:type_name -> pb_schemas.inventory.v1.ReservedLine
+	45, // 9: pb_schemas.inventory.v1.Backorder.created_at:type_name -> google.protobuf.Timestamp
+	45, // 10: pb_schemas.inventory.v1.Backorder.allocated_at:type_name -> google.protobuf.Timestamp
+	45, // 11: pb_schemas.inventory.v1.ReservationHistory.reserved_at:type_name -> google.protobuf.Timestamp
+	45, // 12: pb_schemas.inventory.v1.ReservationHistory.released_at:type_name -> google.protobuf.Timestamp
+	10, // 13: pb_schemas.inventory.v1.SuccessProcessedItems.items:type_name -> pb_schemas.inventory.v1.ReservationHistory
+	3,  // 14: pb_schemas.inventory.v1.FailedProcessedItems.items:type_name -> pb_schemas.inventory.v1.InventoryStatus
+	14, // 15: pb_schemas.inventory.v1.AmendReservationRequest.changes:type_name -> pb_schemas.inventory.v1.ReservationChange
+	45, // 16: pb_schemas.inventory.v1.ListReservationsRequest.reserved_from:type_name -> google.protobuf.Timestamp
+	45, // 17: pb_schemas.inventory.v1.ListReservationsRequest.reserved_to:type_name -> google.protobuf.Timestamp
+	10, // 18: pb_schemas.inventory.v1.ListReservationsResponse.items:type_name -> pb_schemas.inventory.v1.ReservationHistory
+	16, // 19: pb_schemas.inventory.v1.ListReservationsResponse.totals:type_name -> pb_schemas.inventory.v1.ReservationSkuTotal
+	45, // 20: pb_schemas.inventory.v1.ListReservationsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 21: pb_schemas.inventory.v1.DefineBundleRequest.components:type_name -> pb_schemas.inventory.v1.BundleComponent
+	18, // 22: pb_schemas.inventory.v1.BundleResponse.components:type_name -> pb_schemas.inventory.v1.BundleComponent
+	45, // 23: pb_schemas.inventory.v1.BundleResponse.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 24: pb_schemas.inventory.v1.GetStockAsOfRequest.as_of:type_name -> google.protobuf.Timestamp
+	45, // 25: pb_schemas.inventory.v1.StockPosition.snapshot_as_of:type_name -> google.protobuf.Timestamp
+	22, // 26: pb_schemas.inventory.v1.GetStockAsOfResponse.items:type_name -> pb_schemas.inventory.v1.StockPosition
+	45, // 27: pb_schemas.inventory.v1.GetStockAsOfResponse.as_of:type_name -> google.protobuf.Timestamp
+	24, // 28: pb_schemas.inventory.v1.SearchSkusRequest.attributes:type_name -> pb_schemas.inventory.v1.AttributeFilter
+	44, // 29: pb_schemas.inventory.v1.SkuSearchItem.attributes:type_name -> pb_schemas.inventory.v1.SkuSearchItem.AttributesEntry
+	27, // 30: pb_schemas.inventory.v1.AttributeFacet.values:type_name -> pb_schemas.inventory.v1.AttributeFacetValue
+	26, // 31: pb_schemas.inventory.v1.SearchSkusResponse.items:type_name -> pb_schemas.inventory.v1.SkuSearchItem
+	28, // 32: pb_schemas.inventory.v1.SearchSkusResponse.facets:type_name -> pb_schemas.inventory.v1.AttributeFacet
+	45, // 33: pb_schemas.inventory.v1.SearchSkusResponse.timestamp:type_name -> google.protobuf.Timestamp
+	30, // 34: pb_schemas.inventory.v1.DefineSubstitutesRequest.substitutes:type_name -> pb_schemas.inventory.v1.SubstituteRule
+	30, // 35: pb_schemas.inventory.v1.SubstitutesResponse.substitutes:type_name -> pb_schemas.inventory.v1.SubstituteRule
+	45, // 36: pb_schemas.inventory.v1.SubstitutesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 37: pb_schemas.inventory.v1.SuggestAlternativesRequest.items:type_name -> pb_schemas.inventory.v1.InventoryItem
+	34, // 38: pb_schemas.inventory.v1.SkuAlternatives.alternatives:type_name -> pb_schemas.inventory.v1.AlternativeSku
+	35, // 39: pb_schemas.inventory.v1.SuggestAlternativesResponse.items:type_name -> pb_schemas.inventory.v1.SkuAlternatives
+	45, // 40: pb_schemas.inventory.v1.SuggestAlternativesResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 41: pb_schemas.inventory.v1.RestockReturnRequest.items:type_name -> pb_schemas.inventory.v1.InventoryItem
+	38, // 42: pb_schemas.inventory.v1.RestockReturnResponse.items:type_name -> pb_schemas.inventory.v1.RestockedItem
+	45, // 43: pb_schemas.inventory.v1.RestockReturnResponse.timestamp:type_name -> google.protobuf.Timestamp
+	41, // 44: pb_schemas.inventory.v1.CommitReservationRequest.items:type_name -> pb_schemas.inventory.v1.CommitItem
+	41, // 45: pb_schemas.inventory.v1.CommitReservationResponse.items:type_name -> pb_schemas.inventory.v1.CommitItem
+	45, // 46: pb_schemas.inventory.v1.CommitReservationResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 47: pb_schemas.inventory.v1.ErrorDetails.error_code:type_name -> pb_schemas.inventory.v1.ErrorCode
+	5,  // 48: pb_schemas.inventory.v1.InventoryService.CheckStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
+	5,  // 49: pb_schemas.inventory.v1.InventoryService.ReserveStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
+	5,  // 50: pb_schemas.inventory.v1.InventoryService.ReleaseStock:input_type -> pb_schemas.inventory.v1.StandardInventoryRequest
+	13, // 51: pb_schemas.inventory.v1.InventoryService.AmendReservation:input_type -> pb_schemas.inventory.v1.AmendReservationRequest
+	15, // 52: pb_schemas.inventory.v1.InventoryService.ListReservations:input_type -> pb_schemas.inventory.v1.ListReservationsRequest
+	19, // 53: pb_schemas.inventory.v1.InventoryService.DefineBundle:input_type -> pb_schemas.inventory.v1.DefineBundleRequest
+	21, // 54: pb_schemas.inventory.v1.InventoryService.GetStockAsOf:input_type -> pb_schemas.inventory.v1.GetStockAsOfRequest
+	25, // 55: pb_schemas.inventory.v1.InventoryService.SearchSkus:input_type -> pb_schemas.inventory.v1.SearchSkusRequest
+	31, // 56: pb_schemas.inventory.v1.InventoryService.DefineSubstitutes:input_type -> pb_schemas.inventory.v1.DefineSubstitutesRequest
+	33, // 57: pb_schemas.inventory.v1.InventoryService.SuggestAlternatives:input_type -> pb_schemas.inventory.v1.SuggestAlternativesRequest
+	37, // 58: pb_schemas.inventory.v1.InventoryService.RestockReturn:input_type -> pb_schemas.inventory.v1.RestockReturnRequest
+	40, // 59: pb_schemas.inventory.v1.InventoryService.CommitReservation:input_type -> pb_schemas.inventory.v1.CommitReservationRequest
+	6,  // 60: pb_schemas.inventory.v1.InventoryService.CheckStock:output_type -> pb_schemas.inventory.v1.InventoryStatusResponse
+	7,  // 61: pb_schemas.inventory.v1.InventoryService.ReserveStock:output_type -> pb_schemas.inventory.v1.InventoryReservationResponse
+	7,  // 62: pb_schemas.inventory.v1.InventoryService.ReleaseStock:output_type -> pb_schemas.inventory.v1.InventoryReservationResponse
+	7,  // 63: pb_schemas.inventory.v1.InventoryService.AmendReservation:output_type -> pb_schemas.inventory.v1.InventoryReservationResponse
+	17, // 64: pb_schemas.inventory.v1.InventoryService.ListReservations:output_type -> pb_schemas.inventory.v1.ListReservationsResponse
+	20, // 65: pb_schemas.inventory.v1.InventoryService.DefineBundle:output_type -> pb_schemas.inventory.v1.BundleResponse
+	23, // 66: pb_schemas.inventory.v1.InventoryService.GetStockAsOf:output_type -> pb_schemas.inventory.v1.GetStockAsOfResponse
+	29, // 67: pb_schemas.inventory.v1.InventoryService.SearchSkus:output_type -> pb_schemas.inventory.v1.SearchSkusResponse
+	32, // 68: pb_schemas.inventory.v1.InventoryService.DefineSubstitutes:output_type -> pb_schemas.inventory.v1.SubstitutesResponse
+	36, // 69: pb_schemas.inventory.v1.InventoryService.SuggestAlternatives:output_type -> pb_schemas.inventory.v1.SuggestAlternativesResponse
+	39, // 70: pb_schemas.inventory.v1.InventoryService.RestockReturn:output_type -> pb_schemas.inventory.v1.RestockReturnResponse
+	42, // 71: pb_schemas.inventory.v1.InventoryService.CommitReservation:output_type -> pb_schemas.inventory.v1.CommitReservationResponse
+	60, // [60:72] is the sub-list for method output_type
+	48, // [48:60] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_pb_schemas_inventory_v1_stock_proto_init() }
@@ -838,13 +3333,14 @@ func file_pb_schemas_inventory_v1_stock_proto_init() {
 	if File_pb_schemas_inventory_v1_stock_proto != nil {
 		return
 	}
+	file_pb_schemas_inventory_v1_stock_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_schemas_inventory_v1_stock_proto_rawDesc), len(file_pb_schemas_inventory_v1_stock_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_CheckStock_FullMethodName   = "/pb_schemas.inventory.v1.InventoryService/CheckStock"
-	InventoryService_ReserveStock_FullMethodName = "/pb_schemas.inventory.v1.InventoryService/ReserveStock"
-	InventoryService_ReleaseStock_FullMethodName = "/pb_schemas.inventory.v1.InventoryService/ReleaseStock"
+	InventoryService_CheckStock_FullMethodName          = "/pb_schemas.inventory.v1.InventoryService/CheckStock"
+	InventoryService_ReserveStock_FullMethodName        = "/pb_schemas.inventory.v1.InventoryService/ReserveStock"
+	InventoryService_ReleaseStock_FullMethodName        = "/pb_schemas.inventory.v1.InventoryService/ReleaseStock"
+	InventoryService_AmendReservation_FullMethodName    = "/pb_schemas.inventory.v1.InventoryService/AmendReservation"
+	InventoryService_ListReservations_FullMethodName    = "/pb_schemas.inventory.v1.InventoryService/ListReservations"
+	InventoryService_DefineBundle_FullMethodName        = "/pb_schemas.inventory.v1.InventoryService/DefineBundle"
+	InventoryService_GetStockAsOf_FullMethodName        = "/pb_schemas.inventory.v1.InventoryService/GetStockAsOf"
+	InventoryService_SearchSkus_FullMethodName          = "/pb_schemas.inventory.v1.InventoryService/SearchSkus"
+	InventoryService_DefineSubstitutes_FullMethodName   = "/pb_schemas.inventory.v1.InventoryService/DefineSubstitutes"
+	InventoryService_SuggestAlternatives_FullMethodName = "/pb_schemas.inventory.v1.InventoryService/SuggestAlternatives"
+	InventoryService_RestockReturn_FullMethodName       = "/pb_schemas.inventory.v1.InventoryService/RestockReturn"
+	InventoryService_CommitReservation_FullMethodName   = "/pb_schemas.inventory.v1.InventoryService/CommitReservation"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -33,6 +42,15 @@ type InventoryServiceClient interface {
 	CheckStock(ctx context.Context, in *StandardInventoryRequest, opts ...grpc.CallOption) (*InventoryStatusResponse, error)
 	ReserveStock(ctx context.Context, in *StandardInventoryRequest, opts ...grpc.CallOption) (*InventoryReservationResponse, error)
 	ReleaseStock(ctx context.Context, in *StandardInventoryRequest, opts ...grpc.CallOption) (*InventoryReservationResponse, error)
+	AmendReservation(ctx context.Context, in *AmendReservationRequest, opts ...grpc.CallOption) (*InventoryReservationResponse, error)
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	DefineBundle(ctx context.Context, in *DefineBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	GetStockAsOf(ctx context.Context, in *GetStockAsOfRequest, opts ...grpc.CallOption) (*GetStockAsOfResponse, error)
+	SearchSkus(ctx context.Context, in *SearchSkusRequest, opts ...grpc.CallOption) (*SearchSkusResponse, error)
+	DefineSubstitutes(ctx context.Context, in *DefineSubstitutesRequest, opts ...grpc.CallOption) (*SubstitutesResponse, error)
+	SuggestAlternatives(ctx context.Context, in *SuggestAlternativesRequest, opts ...grpc.CallOption) (*SuggestAlternativesResponse, error)
+	RestockReturn(ctx context.Context, in *RestockReturnRequest, opts ...grpc.CallOption) (*RestockReturnResponse, error)
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -73,6 +91,96 @@ func (c *inventoryServiceClient) ReleaseStock(ctx context.Context, in *StandardI
 	return out, nil
 }
 
+func (c *inventoryServiceClient) AmendReservation(ctx context.Context, in *AmendReservationRequest, opts ...grpc.CallOption) (*InventoryReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AmendReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DefineBundle(ctx context.Context, in *DefineBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DefineBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockAsOf(ctx context.Context, in *GetStockAsOfRequest, opts ...grpc.CallOption) (*GetStockAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockAsOfResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SearchSkus(ctx context.Context, in *SearchSkusRequest, opts ...grpc.CallOption) (*SearchSkusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSkusResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SearchSkus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DefineSubstitutes(ctx context.Context, in *DefineSubstitutesRequest, opts ...grpc.CallOption) (*SubstitutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubstitutesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DefineSubstitutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SuggestAlternatives(ctx context.Context, in *SuggestAlternativesRequest, opts ...grpc.CallOption) (*SuggestAlternativesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestAlternativesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SuggestAlternatives_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestockReturn(ctx context.Context, in *RestockReturnRequest, opts ...grpc.CallOption) (*RestockReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestockReturnResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestockReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations should embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -82,6 +190,15 @@ type InventoryServiceServer interface {
 	CheckStock(context.Context, *StandardInventoryRequest) (*InventoryStatusResponse, error)
 	ReserveStock(context.Context, *StandardInventoryRequest) (*InventoryReservationResponse, error)
 	ReleaseStock(context.Context, *StandardInventoryRequest) (*InventoryReservationResponse, error)
+	AmendReservation(context.Context, *AmendReservationRequest) (*InventoryReservationResponse, error)
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	DefineBundle(context.Context, *DefineBundleRequest) (*BundleResponse, error)
+	GetStockAsOf(context.Context, *GetStockAsOfRequest) (*GetStockAsOfResponse, error)
+	SearchSkus(context.Context, *SearchSkusRequest) (*SearchSkusResponse, error)
+	DefineSubstitutes(context.Context, *DefineSubstitutesRequest) (*SubstitutesResponse, error)
+	SuggestAlternatives(context.Context, *SuggestAlternativesRequest) (*SuggestAlternativesResponse, error)
+	RestockReturn(context.Context, *RestockReturnRequest) (*RestockReturnResponse, error)
+	CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error)
 }
 
 // UnimplementedInventoryServiceServer should be embedded to have
@@ -100,6 +217,33 @@ func (UnimplementedInventoryServiceServer) ReserveStock(context.Context, *Standa
 func (UnimplementedInventoryServiceServer) ReleaseStock(context.Context, *StandardInventoryRequest) (*InventoryReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) AmendReservation(context.Context, *AmendReservationRequest) (*InventoryReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (UnimplementedInventoryServiceServer) DefineBundle(context.Context, *DefineBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineBundle not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockAsOf(context.Context, *GetStockAsOfRequest) (*GetStockAsOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAsOf not implemented")
+}
+func (UnimplementedInventoryServiceServer) SearchSkus(context.Context, *SearchSkusRequest) (*SearchSkusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSkus not implemented")
+}
+func (UnimplementedInventoryServiceServer) DefineSubstitutes(context.Context, *DefineSubstitutesRequest) (*SubstitutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineSubstitutes not implemented")
+}
+func (UnimplementedInventoryServiceServer) SuggestAlternatives(context.Context, *SuggestAlternativesRequest) (*SuggestAlternativesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestAlternatives not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestockReturn(context.Context, *RestockReturnRequest) (*RestockReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockReturn not implemented")
+}
+func (UnimplementedInventoryServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue() {}
 
 // UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +318,168 @@ func _InventoryService_ReleaseStock_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_AmendReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AmendReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AmendReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AmendReservation(ctx, req.(*AmendReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DefineBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DefineBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DefineBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DefineBundle(ctx, req.(*DefineBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockAsOf(ctx, req.(*GetStockAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SearchSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SearchSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SearchSkus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SearchSkus(ctx, req.(*SearchSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DefineSubstitutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineSubstitutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DefineSubstitutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DefineSubstitutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DefineSubstitutes(ctx, req.(*DefineSubstitutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SuggestAlternatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestAlternativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SuggestAlternatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SuggestAlternatives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SuggestAlternatives(ctx, req.(*SuggestAlternativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestockReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestockReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestockReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestockReturn(ctx, req.(*RestockReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +499,42 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseStock",
 			Handler:    _InventoryService_ReleaseStock_Handler,
 		},
+		{
+			MethodName: "AmendReservation",
+			Handler:    _InventoryService_AmendReservation_Handler,
+		},
+		{
+			MethodName: "ListReservations",
+			Handler:    _InventoryService_ListReservations_Handler,
+		},
+		{
+			MethodName: "DefineBundle",
+			Handler:    _InventoryService_DefineBundle_Handler,
+		},
+		{
+			MethodName: "GetStockAsOf",
+			Handler:    _InventoryService_GetStockAsOf_Handler,
+		},
+		{
+			MethodName: "SearchSkus",
+			Handler:    _InventoryService_SearchSkus_Handler,
+		},
+		{
+			MethodName: "DefineSubstitutes",
+			Handler:    _InventoryService_DefineSubstitutes_Handler,
+		},
+		{
+			MethodName: "SuggestAlternatives",
+			Handler:    _InventoryService_SuggestAlternatives_Handler,
+		},
+		{
+			MethodName: "RestockReturn",
+			Handler:    _InventoryService_RestockReturn_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _InventoryService_CommitReservation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb_schemas/inventory/v1/stock.proto",
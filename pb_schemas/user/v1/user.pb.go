@@ -70,6 +70,7 @@ type ValidateTokenResponse struct {
 	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	UserEmail     string                 `protobuf:"bytes,2,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
 	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,18 +126,26 @@ func (x *ValidateTokenResponse) GetRoles() []string {
 	return nil
 }
 
+func (x *ValidateTokenResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 var File_pb_schemas_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_pb_schemas_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"\x1dpb_schemas/user/v1/user.proto\x12\x12pb_schemas.user.v1\",\n" +
 	"\x14ValidateTokenRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"b\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"{\n" +
 	"\x15ValidateTokenResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1d\n" +
 	"\n" +
 	"user_email\x18\x02 \x01(\tR\tuserEmail\x12\x14\n" +
-	"\x05roles\x18\x03 \x03(\tR\x05roles2s\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId2s\n" +
 	"\vUserService\x12d\n" +
 	"\rValidateToken\x12(.pb_schemas.user.v1.ValidateTokenRequest\x1a).pb_schemas.user.v1.ValidateTokenResponseB)Z'ops-monorepo/protogen/go/user/v1;userv1b\x06proto3"
 
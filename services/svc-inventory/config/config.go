@@ -3,16 +3,23 @@ package config
 import (
 	"log"
 	"ops-monorepo/shared-libs/env"
+	"time"
 )
 
 type (
 	Config struct {
-		Env       string   `json:"env"`
-		AppName   string   `json:"app_name"`
-		DebugMode bool     `json:"debug_mode"`
-		Port      string   `json:"port"`
-		Database  Database `json:"database"`
-		Redis     Redis    `json:"redis"`
+		Env          string       `json:"env"`
+		AppName      string       `json:"app_name"`
+		DebugMode    bool         `json:"debug_mode"`
+		Port         string       `json:"port"`
+		Database     Database     `json:"database"`
+		Redis        Redis        `json:"redis"`
+		Cache        Cache        `json:"cache"`
+		Snapshot     Snapshot     `json:"snapshot"`
+		BackInStock  BackInStock  `json:"back_in_stock"`
+		Reservation  Reservation  `json:"reservation"`
+		Backorder    Backorder    `json:"backorder"`
+		GrpcServices GrpcServices `json:"grpc_services"`
 	}
 	Database struct {
 		InitSeeds bool   `json:"init_seeds"`
@@ -21,6 +28,36 @@ type (
 	Redis struct {
 		Uri string `json:"uri"`
 	}
+	Cache struct {
+		Enabled     bool          `json:"enabled"`
+		MetadataTTL time.Duration `json:"metadata_ttl"`
+		QuantityTTL time.Duration `json:"quantity_ttl"`
+	}
+	Snapshot struct {
+		JobEnabled  bool          `json:"job_enabled"`
+		JobInterval time.Duration `json:"job_interval"`
+	}
+	BackInStock struct {
+		JobEnabled      bool          `json:"job_enabled"`
+		JobInterval     time.Duration `json:"job_interval"`
+		BatchSize       int           `json:"batch_size"`
+		RatePerSecond   int           `json:"rate_per_second"`
+		HoldWindow      time.Duration `json:"hold_window"`
+		SubscriptionTTL time.Duration `json:"subscription_ttl"`
+	}
+	Reservation struct {
+		Mode           string        `json:"mode"`
+		MaxRetries     int           `json:"max_retries"`
+		RetryBaseDelay time.Duration `json:"retry_base_delay"`
+		RetryMaxDelay  time.Duration `json:"retry_max_delay"`
+	}
+	Backorder struct {
+		JobEnabled  bool          `json:"job_enabled"`
+		JobInterval time.Duration `json:"job_interval"`
+	}
+	GrpcServices struct {
+		ServiceNotificationGrpcUrl string `json:"service_notification_grpc_url"`
+	}
 )
 
 func LoadConfig(path string) (*Config, error) {
@@ -39,6 +76,46 @@ func LoadConfig(path string) (*Config, error) {
 			InitSeeds: env.Get("INIT_SEEDS", "false").Bool(),
 			Uri:       env.Get("DB_URI", "").String(),
 		},
+
+		Redis: Redis{
+			Uri: env.Get("REDIS_URI", "").String(),
+		},
+
+		Cache: Cache{
+			Enabled:     env.Get("CACHE_ENABLED", "false").Bool(),
+			MetadataTTL: env.Get("CACHE_METADATA_TTL", "10m").DurationInSecond(),
+			QuantityTTL: env.Get("CACHE_QUANTITY_TTL", "5s").DurationInSecond(),
+		},
+
+		Snapshot: Snapshot{
+			JobEnabled:  env.Get("SNAPSHOT_JOB_ENABLED", "false").Bool(),
+			JobInterval: env.Get("SNAPSHOT_JOB_INTERVAL", "1h").DurationInSecond(),
+		},
+
+		BackInStock: BackInStock{
+			JobEnabled:      env.Get("BACK_IN_STOCK_JOB_ENABLED", "false").Bool(),
+			JobInterval:     env.Get("BACK_IN_STOCK_JOB_INTERVAL", "1m").DurationInSecond(),
+			BatchSize:       env.Get("BACK_IN_STOCK_BATCH_SIZE", "100").IntDefault(100),
+			RatePerSecond:   env.Get("BACK_IN_STOCK_RATE_PER_SECOND", "5").IntDefault(5),
+			HoldWindow:      env.Get("BACK_IN_STOCK_HOLD_WINDOW", "1h").DurationInSecond(),
+			SubscriptionTTL: env.Get("BACK_IN_STOCK_SUBSCRIPTION_TTL", "720h").DurationInSecond(),
+		},
+
+		Reservation: Reservation{
+			Mode:           env.Get("RESERVATION_MODE", "pessimistic").String(),
+			MaxRetries:     env.Get("RESERVATION_MAX_RETRIES", "5").IntDefault(5),
+			RetryBaseDelay: env.Get("RESERVATION_RETRY_BASE_DELAY", "2ms").DurationInSecond(),
+			RetryMaxDelay:  env.Get("RESERVATION_RETRY_MAX_DELAY", "50ms").DurationInSecond(),
+		},
+
+		Backorder: Backorder{
+			JobEnabled:  env.Get("BACKORDER_JOB_ENABLED", "false").Bool(),
+			JobInterval: env.Get("BACKORDER_JOB_INTERVAL", "1m").DurationInSecond(),
+		},
+
+		GrpcServices: GrpcServices{
+			ServiceNotificationGrpcUrl: env.Get("SERVICE_NOTIFICATION_GRPC_URL", "").String(),
+		},
 	}
 
 	return cfg, nil
@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/config"
+)
+
+// RunBulkCommand executes `svc-inventory bulk ...` instead of starting the grpc server
+func RunBulkCommand(cfg *config.Config, args []string) error {
+
+	dep := InitDependencies(cfg)
+
+	return dep.Impl.bulkImpl.command.Run(context.Background(), args)
+}
+
+// RunSnapshotCommand executes `svc-inventory snapshot ...` instead of starting the grpc server
+func RunSnapshotCommand(cfg *config.Config, args []string) error {
+
+	dep := InitDependencies(cfg)
+
+	return dep.Impl.snapshotImpl.command.Run(context.Background(), args)
+}
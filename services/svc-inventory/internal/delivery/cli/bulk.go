@@ -0,0 +1,406 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// number of rejected rows printed to the console, the error file always has all of them
+const maxPrintedRejections = 20
+
+var ErrRowsRejected = errors.New("some rows were rejected, see the error file")
+
+const bulkUsage = `usage:
+  svc-inventory bulk import -entity <products|skus|prices|stock> -file <path> [-format csv|ndjson] [-dry-run] [-batch-size 1000] [-errors <path>]
+  svc-inventory bulk export -entity <products|skus|prices|stock> -file <path> [-format csv|ndjson]`
+
+type (
+	IBulkCommand interface {
+		Run(ctx context.Context, args []string) error
+	}
+
+	bulkCommand struct {
+		logger  logger.Logger
+		usecase usecase.IBulkUsecase
+		out     io.Writer
+	}
+)
+
+func NewBulkCommand(log logger.Logger, uc usecase.IBulkUsecase, out io.Writer) IBulkCommand {
+	return &bulkCommand{
+		logger:  log,
+		usecase: uc,
+		out:     out,
+	}
+}
+
+func (b *bulkCommand) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(bulkUsage)
+	}
+
+	switch args[0] {
+	case "import":
+		return b.runImport(ctx, args[1:])
+	case "export":
+		return b.runExport(ctx, args[1:])
+	}
+
+	return fmt.Errorf("unknown bulk command %q\n%s", args[0], bulkUsage)
+}
+
+func (b *bulkCommand) runImport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("bulk import", flag.ContinueOnError)
+	entity := fs.String("entity", "", "products, skus, prices or stock")
+	file := fs.String("file", "", "input file")
+	format := fs.String("format", "", "csv or ndjson, detected from the file extension when empty")
+	dryRun := fs.Bool("dry-run", false, "validate only, nothing is written")
+	batchSize := fs.Int("batch-size", 1000, "rows per transaction")
+	errorFile := fs.String("errors", "", "rejected rows output, defaults to <file>.rejected.<format>")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if err := validateEntity(*entity); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+	fileFormat, err := resolveFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(*file)
+	if err != nil {
+		return fmt.Errorf("failed to open input file: %w", err)
+	}
+	defer in.Close()
+
+	var rows []model.BulkInputRow
+	if fileFormat == model.BulkFormatCSV {
+		rows, err = readCSV(in)
+	} else {
+		rows, err = readNDJSON(in)
+	}
+	if err != nil {
+		return err
+	}
+
+	report, err := b.usecase.Import(ctx, *entity, rows, model.BulkImportOptions{
+		DryRun:    *dryRun,
+		BatchSize: *batchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(report.Rejected) > 0 {
+		path := *errorFile
+		if path == "" {
+			path = strings.TrimSuffix(*file, filepath.Ext(*file)) + ".rejected." + fileFormat
+		}
+		if err := writeRejected(path, fileFormat, *entity, report.Rejected); err != nil {
+			return err
+		}
+		fmt.Fprintf(b.out, "rejected rows written to %s\n", path)
+	}
+
+	printReport(b.out, report)
+
+	if report.RejectedRows > 0 {
+		return ErrRowsRejected
+	}
+	return nil
+}
+
+func (b *bulkCommand) runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("bulk export", flag.ContinueOnError)
+	entity := fs.String("entity", "", "products, skus, prices or stock")
+	file := fs.String("file", "", "output file")
+	format := fs.String("format", "", "csv or ndjson, detected from the file extension when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if err := validateEntity(*entity); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+	fileFormat, err := resolveFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(*file)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer out.Close()
+
+	w := newRowWriter(out, fileFormat, model.BulkColumns[*entity])
+	count := 0
+	err = b.usecase.Export(ctx, *entity, func(row model.BulkRow) error {
+		count++
+		return w.Write(row)
+	})
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(b.out, "exported %d %s rows to %s\n", count, *entity, *file)
+	return nil
+}
+
+func validateEntity(entity string) error {
+	if _, ok := model.BulkColumns[entity]; !ok {
+		return fmt.Errorf("-entity must be one of products, skus, prices, stock\n%s", bulkUsage)
+	}
+	return nil
+}
+
+func resolveFormat(format, file string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+		if format == "jsonl" {
+			format = model.BulkFormatNDJSON
+		}
+	}
+	if format != model.BulkFormatCSV && format != model.BulkFormatNDJSON {
+		return "", fmt.Errorf("unsupported format %q, use csv or ndjson", format)
+	}
+	return format, nil
+}
+
+// reads a csv file with a header row, empty cells are treated as missing values
+func readCSV(r io.Reader) ([]model.BulkInputRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+
+	var rows []model.BulkInputRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := model.BulkRow{}
+		for i, column := range header {
+			if i < len(record) && record[i] != "" {
+				row[column] = record[i]
+			}
+		}
+		rows = append(rows, model.BulkInputRow{Line: line, Row: row})
+	}
+
+	return rows, nil
+}
+
+// reads one json object per line, blank lines are skipped
+func readNDJSON(r io.Reader) ([]model.BulkInputRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+
+	var rows []model.BulkInputRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.UseNumber()
+
+		row := model.BulkRow{}
+		if err := dec.Decode(&row); err != nil {
+			// keep the line so it still shows up in the report and error file
+			rows = append(rows, model.BulkInputRow{Line: line, Row: model.BulkRow{}, Error: "invalid json object"})
+			continue
+		}
+		rows = append(rows, model.BulkInputRow{Line: line, Row: row})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ndjson: %w", err)
+	}
+	return rows, nil
+}
+
+type rowWriter interface {
+	Write(row model.BulkRow) error
+	Flush() error
+}
+
+type csvRowWriter struct {
+	w       *csv.Writer
+	columns []string
+	header  bool
+}
+
+func (c *csvRowWriter) Write(row model.BulkRow) error {
+	if !c.header {
+		c.header = true
+		if err := c.w.Write(c.columns); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		record[i] = formatCSVValue(row[column])
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvRowWriter) Flush() error {
+	if !c.header {
+		c.header = true
+		c.w.Write(c.columns)
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonRowWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (n *ndjsonRowWriter) Write(row model.BulkRow) error {
+	return n.enc.Encode(row)
+}
+
+func (n *ndjsonRowWriter) Flush() error {
+	return n.w.Flush()
+}
+
+func newRowWriter(w io.Writer, format string, columns []string) rowWriter {
+	if format == model.BulkFormatCSV {
+		return &csvRowWriter{w: csv.NewWriter(w), columns: columns}
+	}
+	bw := bufio.NewWriter(w)
+	return &ndjsonRowWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func formatCSVValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case *string:
+		if val == nil {
+			return ""
+		}
+		return *val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case *float64:
+		if val == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*val, 'f', -1, 64)
+	case time.Time:
+		return val.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if val == nil {
+			return ""
+		}
+		return val.UTC().Format(time.RFC3339)
+	case json.RawMessage:
+		return string(val)
+	case json.Number:
+		return val.String()
+	default:
+		b, _ := json.Marshal(val)
+		return string(b)
+	}
+}
+
+// writes rejected rows in the input format with the line number and reason
+func writeRejected(path, format, entity string, rejected []model.RejectedRow) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create error file: %w", err)
+	}
+	defer f.Close()
+
+	if format == model.BulkFormatNDJSON {
+		bw := bufio.NewWriter(f)
+		enc := json.NewEncoder(bw)
+		for _, r := range rejected {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return bw.Flush()
+	}
+
+	columns := append([]string{"line", "reason"}, model.BulkColumns[entity]...)
+	w := csv.NewWriter(f)
+	w.Write(columns)
+	for _, r := range rejected {
+		record := []string{strconv.Itoa(r.Line), r.Reason}
+		for _, column := range model.BulkColumns[entity] {
+			record = append(record, formatCSVValue(r.Row[column]))
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func printReport(out io.Writer, report *model.BulkImportReport) {
+	mode := "import"
+	if report.DryRun {
+		mode = "dry run"
+	}
+
+	fmt.Fprintf(out, "%s %s: %d rows, %d accepted, %d rejected, %d batches\n",
+		report.Entity, mode, report.TotalRows, report.AcceptedRows, report.RejectedRows, report.Batches)
+
+	for i, r := range report.Rejected {
+		if i == maxPrintedRejections {
+			fmt.Fprintf(out, "  ... %d more\n", len(report.Rejected)-maxPrintedRejections)
+			break
+		}
+		fmt.Fprintf(out, "  line %d: %s\n", r.Line, r.Reason)
+	}
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"os"
+	"time"
+)
+
+const snapshotUsage = `usage:
+  svc-inventory snapshot run [-date YYYY-MM-DD]
+  svc-inventory snapshot export -date YYYY-MM-DD -file <path> [-format csv|ndjson]`
+
+type (
+	ISnapshotCommand interface {
+		Run(ctx context.Context, args []string) error
+	}
+
+	snapshotCommand struct {
+		logger  logger.Logger
+		usecase usecase.ISnapshotUsecase
+		out     io.Writer
+	}
+)
+
+func NewSnapshotCommand(log logger.Logger, uc usecase.ISnapshotUsecase, out io.Writer) ISnapshotCommand {
+	return &snapshotCommand{
+		logger:  log,
+		usecase: uc,
+		out:     out,
+	}
+}
+
+func (s *snapshotCommand) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(snapshotUsage)
+	}
+
+	switch args[0] {
+	case "run":
+		return s.runSnapshot(ctx, args[1:])
+	case "export":
+		return s.runExport(ctx, args[1:])
+	}
+
+	return fmt.Errorf("unknown snapshot command %q\n%s", args[0], snapshotUsage)
+}
+
+// without -date every ended day missing a snapshot is written
+func (s *snapshotCommand) runSnapshot(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("snapshot run", flag.ContinueOnError)
+	date := fs.String("date", "", "day to snapshot (UTC), defaults to every missing day")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *date == "" {
+		written, err := s.usecase.WriteDueSnapshots(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(s.out, "%d snapshots written\n", len(written))
+		return nil
+	}
+
+	day, err := time.Parse(time.DateOnly, *date)
+	if err != nil {
+		return fmt.Errorf("-date must be YYYY-MM-DD: %w", err)
+	}
+
+	rows, err := s.usecase.WriteSnapshot(ctx, day)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		fmt.Fprintf(s.out, "snapshot of %s already exists or there is no stock movement yet\n", *date)
+		return nil
+	}
+	fmt.Fprintf(s.out, "snapshot of %s written with %d rows\n", *date, rows)
+	return nil
+}
+
+func (s *snapshotCommand) runExport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("snapshot export", flag.ContinueOnError)
+	date := fs.String("date", "", "snapshot day (UTC)")
+	file := fs.String("file", "", "output file")
+	format := fs.String("format", "", "csv or ndjson, detected from the file extension when empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	day, err := time.Parse(time.DateOnly, *date)
+	if err != nil {
+		return fmt.Errorf("-date must be YYYY-MM-DD\n%s", snapshotUsage)
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+	fileFormat, err := resolveFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(*file)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer out.Close()
+
+	w := newRowWriter(out, fileFormat, usecase.SnapshotColumns)
+	count := 0
+	err = s.usecase.ExportSnapshot(ctx, day, func(row model.BulkRow) error {
+		count++
+		return w.Write(row)
+	})
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if count == 0 {
+		fmt.Fprintf(s.out, "no snapshot found for %s, run `snapshot run -date %s` first\n", *date, *date)
+		return nil
+	}
+	fmt.Fprintf(s.out, "exported %d snapshot rows of %s to %s\n", count, *date, *file)
+	return nil
+}
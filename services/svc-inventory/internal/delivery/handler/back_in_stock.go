@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"net/mail"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	IBackInStockHandler interface {
+		inventoryv1.BackInStockServiceServer
+	}
+
+	backInStockHandler struct {
+		inventoryv1.UnimplementedBackInStockServiceServer // embed the unimplemented server
+		logger                                            logger.Logger
+		grpcErr                                           *grpcErr.GRPCErrorHandler
+		usecase                                           usecase.IBackInStockUsecase
+	}
+)
+
+func NewBackInStockHandler(
+	log logger.Logger,
+	uc usecase.IBackInStockUsecase,
+	grpcErr *grpcErr.GRPCErrorHandler,
+
+) IBackInStockHandler {
+	return &backInStockHandler{
+		logger:  log,
+		usecase: uc,
+		grpcErr: grpcErr,
+	}
+}
+
+func (h *backInStockHandler) SubscribeBackInStock(ctx context.Context, req *inventoryv1.SubscribeBackInStockRequest) (*inventoryv1.BackInStockSubscriptionResponse, error) {
+	fieldErrors := map[string]string{}
+	if req.Sku == "" {
+		fieldErrors["sku"] = "this properties cannot empty"
+	}
+	if req.Email == "" {
+		fieldErrors["email"] = "this properties cannot empty"
+	} else if _, err := mail.ParseAddress(req.Email); err != nil {
+		fieldErrors["email"] = "must be a valid email address"
+	}
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	sub, err := h.usecase.Subscribe(ctx, req.Sku, req.Email)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return &inventoryv1.BackInStockSubscriptionResponse{
+		Subscription: toProtoBackInStockSubscription(*sub),
+		Timestamp:    timestamppb.New(time.Now()),
+	}, nil
+}
+
+func toProtoBackInStockSubscription(sub model.BackInStockSubscription) *inventoryv1.BackInStockSubscription {
+	item := &inventoryv1.BackInStockSubscription{
+		Id:        sub.Id,
+		Sku:       sub.Sku,
+		Email:     sub.Email,
+		Status:    sub.Status,
+		CreatedAt: timestamppb.New(sub.CreatedAt),
+		ExpiresAt: timestamppb.New(sub.ExpiresAt),
+	}
+	if sub.NotifiedAt != nil {
+		item.NotifiedAt = timestamppb.New(*sub.NotifiedAt)
+	}
+	return item
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	IBackorderHandler interface {
+		inventoryv1.BackorderServiceServer
+	}
+
+	backorderHandler struct {
+		inventoryv1.UnimplementedBackorderServiceServer // embed the unimplemented server
+		logger                                          logger.Logger
+		grpcErr                                         *grpcErr.GRPCErrorHandler
+		usecase                                         usecase.IBackorderUsecase
+	}
+)
+
+func NewBackorderHandler(
+	log logger.Logger,
+	uc usecase.IBackorderUsecase,
+	grpcErr *grpcErr.GRPCErrorHandler,
+
+) IBackorderHandler {
+	return &backorderHandler{
+		logger:  log,
+		usecase: uc,
+		grpcErr: grpcErr,
+	}
+}
+
+func (h *backorderHandler) ListBackorderEvents(ctx context.Context, req *inventoryv1.ListBackorderEventsRequest) (*inventoryv1.ListBackorderEventsResponse, error) {
+	if req.Limit < 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"limit": "must not be negative",
+		}))
+	}
+
+	events, err := h.usecase.ListEvents(ctx, int(req.Limit))
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	resp := &inventoryv1.ListBackorderEventsResponse{
+		Timestamp: timestamppb.New(time.Now()),
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &inventoryv1.BackorderEvent{
+			Id:        e.Id,
+			OrderId:   e.OrderId,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *backorderHandler) AcknowledgeBackorderEvents(ctx context.Context, req *inventoryv1.AcknowledgeBackorderEventsRequest) (*inventoryv1.AcknowledgeBackorderEventsResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"ids": "this properties cannot empty",
+		}))
+	}
+
+	acknowledged, err := h.usecase.AcknowledgeEvents(ctx, req.Ids)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return &inventoryv1.AcknowledgeBackorderEventsResponse{
+		Acknowledged: acknowledged,
+		Timestamp:    timestamppb.New(time.Now()),
+	}, nil
+}
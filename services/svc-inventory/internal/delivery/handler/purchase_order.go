@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	IPurchaseOrderHandler interface {
+		inventoryv1.PurchaseOrderServiceServer
+	}
+
+	purchaseOrderHandler struct {
+		inventoryv1.UnimplementedPurchaseOrderServiceServer // embed the unimplemented server
+		logger                                              logger.Logger
+		grpcErr                                             *grpcErr.GRPCErrorHandler
+		usecase                                             usecase.IPurchaseOrderUsecase
+	}
+)
+
+func NewPurchaseOrderHandler(
+	log logger.Logger,
+	uc usecase.IPurchaseOrderUsecase,
+	grpcErr *grpcErr.GRPCErrorHandler,
+
+) IPurchaseOrderHandler {
+	return &purchaseOrderHandler{
+		logger:  log,
+		usecase: uc,
+		grpcErr: grpcErr,
+	}
+}
+
+func (h *purchaseOrderHandler) CreateReplenishmentPurchaseOrder(ctx context.Context, req *inventoryv1.CreateReplenishmentRequest) (*inventoryv1.PurchaseOrderResponse, error) {
+	fieldErrors := map[string]string{}
+	if req.LookbackDays < 0 {
+		fieldErrors["lookback_days"] = "must not be negative"
+	}
+	if req.LeadTimeDays < 0 {
+		fieldErrors["lead_time_days"] = "must not be negative"
+	}
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	params := model.ReplenishmentParams{
+		LookbackDays: int(req.LookbackDays),
+		LeadTimeDays: int(req.LeadTimeDays),
+		Skus:         req.Skus,
+	}
+	if req.Supplier != "" {
+		params.Supplier = &req.Supplier
+	}
+
+	po, err := h.usecase.CreateReplenishmentPurchaseOrder(ctx, params)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoPurchaseOrderResp(po), nil
+}
+
+func (h *purchaseOrderHandler) GetPurchaseOrder(ctx context.Context, req *inventoryv1.PurchaseOrderRequest) (*inventoryv1.PurchaseOrderResponse, error) {
+	if req.Id == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"id": "this properties cannot empty",
+		}))
+	}
+
+	po, err := h.usecase.GetPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoPurchaseOrderResp(po), nil
+}
+
+func (h *purchaseOrderHandler) ListPurchaseOrders(ctx context.Context, req *inventoryv1.ListPurchaseOrdersRequest) (*inventoryv1.ListPurchaseOrdersResponse, error) {
+	orders, err := h.usecase.ListPurchaseOrders(ctx, req.Status, int(req.PageSize))
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	resp := &inventoryv1.ListPurchaseOrdersResponse{
+		Timestamp: timestamppb.New(time.Now()),
+	}
+	for _, po := range orders {
+		resp.Items = append(resp.Items, toProtoPurchaseOrder(po))
+	}
+
+	return resp, nil
+}
+
+func (h *purchaseOrderHandler) SubmitPurchaseOrder(ctx context.Context, req *inventoryv1.PurchaseOrderRequest) (*inventoryv1.PurchaseOrderResponse, error) {
+	if req.Id == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"id": "this properties cannot empty",
+		}))
+	}
+
+	po, err := h.usecase.SubmitPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoPurchaseOrderResp(po), nil
+}
+
+func (h *purchaseOrderHandler) ReceivePurchaseOrder(ctx context.Context, req *inventoryv1.ReceivePurchaseOrderRequest) (*inventoryv1.PurchaseOrderResponse, error) {
+	fieldErrors := map[string]string{}
+	if req.Id == "" {
+		fieldErrors["id"] = "this properties cannot empty"
+	}
+	if len(req.Lines) == 0 {
+		fieldErrors["lines"] = "this properties cannot empty"
+	}
+
+	// the same sku twice is summed into one receipt
+	quantities := map[string]float64{}
+	for _, line := range req.Lines {
+		if line.Sku == "" {
+			fieldErrors["lines"] = "sku cannot empty"
+			break
+		}
+		if line.Quantity <= 0 {
+			fieldErrors["lines"] = "quantity must be greater than zero for sku " + line.Sku
+			break
+		}
+		quantities[line.Sku] += line.Quantity
+	}
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	po, err := h.usecase.ReceivePurchaseOrder(ctx, req.Id, quantities)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoPurchaseOrderResp(po), nil
+}
+
+func toProtoPurchaseOrderResp(po *model.PurchaseOrder) *inventoryv1.PurchaseOrderResponse {
+	resp := &inventoryv1.PurchaseOrderResponse{
+		Timestamp: timestamppb.New(time.Now()),
+	}
+	if po != nil {
+		resp.PurchaseOrder = toProtoPurchaseOrder(*po)
+	}
+	return resp
+}
+
+func toProtoPurchaseOrder(po model.PurchaseOrder) *inventoryv1.PurchaseOrder {
+	item := &inventoryv1.PurchaseOrder{
+		Id:        po.Id,
+		Status:    po.Status,
+		CreatedAt: timestamppb.New(po.CreatedAt),
+		UpdatedAt: timestamppb.New(po.UpdatedAt),
+	}
+	if po.Supplier != nil {
+		item.Supplier = *po.Supplier
+	}
+	if po.SubmittedAt != nil {
+		item.SubmittedAt = timestamppb.New(*po.SubmittedAt)
+	}
+	if po.ReceivedAt != nil {
+		item.ReceivedAt = timestamppb.New(*po.ReceivedAt)
+	}
+
+	for _, line := range po.Lines {
+		pLine := &inventoryv1.PurchaseOrderLine{
+			Id:               line.Id,
+			Sku:              line.Sku,
+			OrderedQuantity:  line.OrderedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+		}
+		if line.ReorderPoint != nil {
+			pLine.ReorderPoint = *line.ReorderPoint
+		}
+		if line.AvgDailyDemand != nil {
+			pLine.AvgDailyDemand = *line.AvgDailyDemand
+		}
+		item.Lines = append(item.Lines, pLine)
+	}
+
+	return item
+}
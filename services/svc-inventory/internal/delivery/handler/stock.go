@@ -7,6 +7,7 @@ import (
 	grpcErr "ops-monorepo/shared-libs/grpc/errors"
 	"ops-monorepo/shared-libs/logger"
 	inventoryv1 "pb_schemas/inventory/v1"
+	"sort"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -76,6 +77,9 @@ func toProtoSuccessInventoryStatusResp(stocks []model.StockStatus, mapSkuReqQuan
 			SkuUom:            stock.SKU_UOM,
 			SkuPrice:          stock.SKUPrice,
 			SkuCurrency:       stock.SKUCurrency,
+			IsBundle:          stock.IsBundle,
+			CategoryId:        stock.CategoryId,
+			TaxCategory:       stock.TaxCategory,
 		}
 
 		items = append(items, pStock)
@@ -94,6 +98,12 @@ func (h *inventoryHandler) ReserveStock(ctx context.Context, req *inventoryv1.St
 		})
 	}
 
+	if _, ok := inventoryv1.ReservationPolicy_name[int32(req.ReservationPolicy)]; !ok {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"reservation_policy": "unknown reservation policy",
+		}))
+	}
+
 	mapSkuRequestedQuantityPerUom := map[string]float64{}
 	if req.Items != nil {
 		for _, item := range req.Items {
@@ -101,23 +111,52 @@ func (h *inventoryHandler) ReserveStock(ctx context.Context, req *inventoryv1.St
 		}
 	}
 
-	reservationHistory, failedReserve, err := h.usecase.ReserveStock(ctx, req.OrderId, mapSkuRequestedQuantityPerUom)
-	if err == nil && failedReserve != nil {
+	result, failedReserve, err := h.usecase.ReserveStock(ctx, req.OrderId, mapSkuRequestedQuantityPerUom, model.ReserveOptions{
+		Policy:         req.ReservationPolicy.String(),
+		AllowBackorder: req.AllowBackorder,
+	})
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+	if result == nil {
 		// give insufficient error response
 		return toProtoSuccessInventoryReservationResp(nil, failedReserve, req.OrderId), nil
 	}
-	if err != nil && failedReserve == nil {
-		// give general error reponse
-		return nil, h.grpcErr.HandleError(err)
+
+	// the per line policies report short lines next to the reserved ones
+	resp := toProtoSuccessInventoryReservationResp(result.History, failedReserve, req.OrderId)
+	for _, b := range result.Backorders {
+		resp.Backorders = append(resp.Backorders, toProtoBackorder(b))
 	}
+	for _, l := range result.Lines {
+		resp.Lines = append(resp.Lines, &inventoryv1.ReservedLine{
+			Sku:               l.Sku,
+			RequestedQuantity: l.RequestedQuantity,
+			ReservedQuantity:  l.ReservedQuantity,
+		})
+	}
+	return resp, nil
+}
 
-	return toProtoSuccessInventoryReservationResp(reservationHistory, nil, req.OrderId), nil
+func toProtoBackorder(b model.Backorder) *inventoryv1.Backorder {
+	item := &inventoryv1.Backorder{
+		Id:        b.Id,
+		OrderId:   b.OrderId,
+		Sku:       b.Sku,
+		Quantity:  b.Quantity,
+		Status:    b.Status,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+	if b.AllocatedAt != nil {
+		item.AllocatedAt = timestamppb.New(*b.AllocatedAt)
+	}
+	return item
 }
 
 func toProtoSuccessInventoryReservationResp(reservationHistory []model.ReservationHistory, stockStatus []model.StockStatus, orderId string) *inventoryv1.InventoryReservationResponse {
 
 	var (
-		resp             *inventoryv1.InventoryReservationResponse
+		resp             = &inventoryv1.InventoryReservationResponse{}
 		unprocessedStock inventoryv1.FailedProcessedItems
 		processedStock   inventoryv1.SuccessProcessedItems
 	)
@@ -135,6 +174,7 @@ func toProtoSuccessInventoryReservationResp(reservationHistory []model.Reservati
 				SkuUom:            ss.SKU_UOM,
 				SkuPrice:          ss.SKUPrice,
 				SkuCurrency:       ss.SKUCurrency,
+				IsBundle:          ss.IsBundle,
 			}
 			unprocessedStock.Items = append(unprocessedStock.Items, item)
 		}
@@ -143,18 +183,7 @@ func toProtoSuccessInventoryReservationResp(reservationHistory []model.Reservati
 	if reservationHistory != nil && len(reservationHistory) > 0 {
 		for _, r := range reservationHistory {
 
-			item := &inventoryv1.ReservationHistory{
-				Id:         r.Id,
-				OrderId:    r.OrderId,
-				Sku:        r.Sku,
-				Quantity:   r.Quantity,
-				Uom:        r.Uom,
-				Status:     r.Status,
-				ReservedAt: timestamppb.New(r.ReservedAt),
-				ReleasedAt: timestamppb.New(*r.ReleasedAt),
-			}
-
-			processedStock.Items = append(processedStock.Items, item)
+			processedStock.Items = append(processedStock.Items, toProtoReservationHistory(r))
 		}
 	}
 
@@ -163,3 +192,529 @@ func toProtoSuccessInventoryReservationResp(reservationHistory []model.Reservati
 
 	return resp
 }
+
+func (h *inventoryHandler) ReleaseStock(ctx context.Context, req *inventoryv1.StandardInventoryRequest) (*inventoryv1.InventoryReservationResponse, error) {
+	if req.OrderId == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"order_id": "this properties cannot empty",
+		}))
+	}
+
+	// items are optional, without them every reservation of the order is released
+	skus := []string{}
+	for _, item := range req.Items {
+		skus = append(skus, item.Sku)
+	}
+
+	reservationHistory, failedRelease, err := h.usecase.ReleaseStock(ctx, req.OrderId, skus)
+	if err == nil && failedRelease != nil {
+		// give insufficient error response
+		return toProtoSuccessInventoryReservationResp(nil, failedRelease, req.OrderId), nil
+	}
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoSuccessInventoryReservationResp(reservationHistory, nil, req.OrderId), nil
+}
+
+func (h *inventoryHandler) AmendReservation(ctx context.Context, req *inventoryv1.AmendReservationRequest) (*inventoryv1.InventoryReservationResponse, error) {
+	if req.OrderId == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"order_id": "this properties cannot empty",
+		}))
+	}
+	if len(req.Changes) == 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"changes": "this properties cannot empty",
+		}))
+	}
+
+	deltas := map[string]float64{}
+	for _, change := range req.Changes {
+		if change.Sku == "" {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"sku": "this properties cannot empty",
+			}))
+		}
+		deltas[change.Sku] += change.QuantityDelta
+	}
+	for sku, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, sku)
+		}
+	}
+	if len(deltas) == 0 {
+		return toProtoSuccessInventoryReservationResp(nil, nil, req.OrderId), nil
+	}
+
+	reservationHistory, failedReserve, err := h.usecase.AmendReservation(ctx, req.OrderId, deltas)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+	if failedReserve != nil {
+		// give insufficient error response
+		return toProtoSuccessInventoryReservationResp(nil, failedReserve, req.OrderId), nil
+	}
+
+	return toProtoSuccessInventoryReservationResp(reservationHistory, nil, req.OrderId), nil
+}
+
+func toProtoReservationHistory(r model.ReservationHistory) *inventoryv1.ReservationHistory {
+	item := &inventoryv1.ReservationHistory{
+		Id:         r.Id,
+		OrderId:    r.OrderId,
+		Sku:        r.Sku,
+		Quantity:   r.Quantity,
+		Uom:        r.Uom,
+		Status:     r.Status,
+		ReservedAt: timestamppb.New(r.ReservedAt),
+		LineType:   r.LineType,
+	}
+
+	// released_at is only set once the reservation has been released
+	if r.ReleasedAt != nil {
+		item.ReleasedAt = timestamppb.New(*r.ReleasedAt)
+	}
+
+	// bundle_sku links component lines to the bundle they were reserved for
+	if r.BundleSku != nil {
+		item.BundleSku = *r.BundleSku
+	}
+
+	return item
+}
+
+func (h *inventoryHandler) ListReservations(ctx context.Context, req *inventoryv1.ListReservationsRequest) (*inventoryv1.ListReservationsResponse, error) {
+	if req.Status != "" && req.Status != model.ReservedStatus && req.Status != model.ReleasedStatus && req.Status != model.ConsumedStatus {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"status": "must be one of RESERVED, RELEASED, CONSUMED",
+		}))
+	}
+
+	filter := model.ReservationFilter{
+		OrderId: req.OrderId,
+		Sku:     req.Sku,
+		Status:  req.Status,
+	}
+	if req.ReservedFrom != nil {
+		from := req.ReservedFrom.AsTime()
+		filter.ReservedFrom = &from
+	}
+	if req.ReservedTo != nil {
+		to := req.ReservedTo.AsTime()
+		filter.ReservedTo = &to
+	}
+	if filter.ReservedFrom != nil && filter.ReservedTo != nil && !filter.ReservedFrom.Before(*filter.ReservedTo) {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"reserved_from": "must be before reserved_to",
+		}))
+	}
+
+	reservations, totals, nextCursor, err := h.usecase.ListReservations(ctx, filter, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoListReservationsResp(reservations, totals, nextCursor), nil
+}
+
+func toProtoListReservationsResp(reservations []model.ReservationHistory, totals []model.ReservationSkuTotal, nextCursor string) *inventoryv1.ListReservationsResponse {
+
+	resp := &inventoryv1.ListReservationsResponse{
+		NextCursor: nextCursor,
+		Timestamp:  timestamppb.New(time.Now()),
+	}
+
+	for _, r := range reservations {
+		resp.Items = append(resp.Items, toProtoReservationHistory(r))
+	}
+
+	for _, t := range totals {
+		resp.Totals = append(resp.Totals, &inventoryv1.ReservationSkuTotal{
+			Sku:              t.Sku,
+			ReservedQuantity: t.ReservedQuantity,
+			ReleasedQuantity: t.ReleasedQuantity,
+			ConsumedQuantity: t.ConsumedQuantity,
+			ReservationCount: t.ReservationCount,
+		})
+	}
+
+	return resp
+}
+
+func (h *inventoryHandler) DefineBundle(ctx context.Context, req *inventoryv1.DefineBundleRequest) (*inventoryv1.BundleResponse, error) {
+	fieldErrors := map[string]string{}
+	if req.BundleSku == "" {
+		fieldErrors["bundle_sku"] = "this properties cannot empty"
+	}
+	if len(req.Components) == 0 {
+		fieldErrors["components"] = "this properties cannot empty"
+	}
+
+	components := []model.BundleComponent{}
+	seen := map[string]bool{}
+	for _, c := range req.Components {
+		switch {
+		case c.Sku == "":
+			fieldErrors["components"] = "component sku cannot empty"
+		case c.Sku == req.BundleSku:
+			fieldErrors["components"] = "a bundle cannot contain itself"
+		case c.Quantity <= 0:
+			fieldErrors["components"] = "quantity of " + c.Sku + " must be greater than 0"
+		case seen[c.Sku]:
+			fieldErrors["components"] = "duplicate component " + c.Sku
+		}
+		seen[c.Sku] = true
+
+		components = append(components, model.BundleComponent{
+			BundleSku:    req.BundleSku,
+			ComponentSku: c.Sku,
+			Quantity:     c.Quantity,
+		})
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	saved, err := h.usecase.DefineBundle(ctx, req.BundleSku, components)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoBundleResp(req.BundleSku, saved), nil
+}
+
+func toProtoBundleResp(bundleSku string, components []model.BundleComponent) *inventoryv1.BundleResponse {
+
+	resp := &inventoryv1.BundleResponse{
+		BundleSku: bundleSku,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+
+	for _, c := range components {
+		resp.Components = append(resp.Components, &inventoryv1.BundleComponent{
+			Sku:      c.ComponentSku,
+			Quantity: c.Quantity,
+		})
+	}
+
+	return resp
+}
+
+func (h *inventoryHandler) GetStockAsOf(ctx context.Context, req *inventoryv1.GetStockAsOfRequest) (*inventoryv1.GetStockAsOfResponse, error) {
+	fieldErrors := map[string]string{}
+	if len(req.Skus) == 0 {
+		fieldErrors["skus"] = "this properties cannot empty"
+	}
+	if req.AsOf == nil {
+		fieldErrors["as_of"] = "this properties cannot empty"
+	} else if req.AsOf.AsTime().After(time.Now()) {
+		fieldErrors["as_of"] = "must not be in the future"
+	}
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	asOf := req.AsOf.AsTime()
+	positions, err := h.usecase.GetStockAsOf(ctx, req.Skus, asOf)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoGetStockAsOfResp(positions, asOf), nil
+}
+
+func toProtoGetStockAsOfResp(positions []model.StockPosition, asOf time.Time) *inventoryv1.GetStockAsOfResponse {
+
+	resp := &inventoryv1.GetStockAsOfResponse{
+		AsOf: timestamppb.New(asOf),
+	}
+
+	for _, p := range positions {
+		item := &inventoryv1.StockPosition{
+			Sku:               p.Sku,
+			CurrentQuantity:   p.CurrentQuantity,
+			ReservedQuantity:  p.ReservedQuantity,
+			AvailableQuantity: p.AvailableQuantity,
+		}
+		if p.SnapshotAsOf != nil {
+			item.SnapshotAsOf = timestamppb.New(*p.SnapshotAsOf)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp
+}
+
+func (h *inventoryHandler) SearchSkus(ctx context.Context, req *inventoryv1.SearchSkusRequest) (*inventoryv1.SearchSkusResponse, error) {
+	filter := model.SkuSearchFilter{
+		CategoryId:    req.CategoryId,
+		IsActive:      req.IsActive,
+		Discontinued:  req.Discontinued,
+		AvailableOnly: req.AvailableOnly,
+	}
+
+	for _, attr := range req.Attributes {
+		if attr.Key == "" {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"attributes": "key cannot empty",
+			}))
+		}
+		filter.Attributes = append(filter.Attributes, model.AttributeFilter{
+			Key:    attr.Key,
+			Values: attr.Values,
+		})
+	}
+
+	items, facets, nextCursor, err := h.usecase.SearchSkus(ctx, filter, req.FacetKeys, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	return toProtoSearchSkusResp(items, facets, nextCursor), nil
+}
+
+func toProtoSearchSkusResp(items []model.SkuSearchResult, facets []model.AttributeFacet, nextCursor string) *inventoryv1.SearchSkusResponse {
+
+	resp := &inventoryv1.SearchSkusResponse{
+		NextCursor: nextCursor,
+		Timestamp:  timestamppb.New(time.Now()),
+	}
+
+	for _, item := range items {
+		pItem := &inventoryv1.SkuSearchItem{
+			Sku:               item.Sku,
+			ProductId:         item.ProductId,
+			ProductName:       item.ProductName,
+			Attributes:        item.Attributes,
+			IsActive:          item.IsActive,
+			Discontinued:      item.Discontinued,
+			AvailableQuantity: item.AvailableQuantity,
+			IsBundle:          item.IsBundle,
+		}
+		if item.CategoryId != nil {
+			pItem.CategoryId = *item.CategoryId
+		}
+		resp.Items = append(resp.Items, pItem)
+	}
+
+	for _, facet := range facets {
+		pFacet := &inventoryv1.AttributeFacet{Key: facet.Key}
+		for _, v := range facet.Values {
+			pFacet.Values = append(pFacet.Values, &inventoryv1.AttributeFacetValue{
+				Value: v.Value,
+				Count: v.Count,
+			})
+		}
+		resp.Facets = append(resp.Facets, pFacet)
+	}
+
+	return resp
+}
+
+func (h *inventoryHandler) DefineSubstitutes(ctx context.Context, req *inventoryv1.DefineSubstitutesRequest) (*inventoryv1.SubstitutesResponse, error) {
+	fieldErrors := map[string]string{}
+	if req.Sku == "" {
+		fieldErrors["sku"] = "this properties cannot empty"
+	}
+
+	rules := []model.SubstituteRule{}
+	seen := map[string]bool{}
+	for _, s := range req.Substitutes {
+		switch {
+		case s.Sku == "":
+			fieldErrors["substitutes"] = "substitute sku cannot empty"
+		case s.Sku == req.Sku:
+			fieldErrors["substitutes"] = "a sku cannot substitute itself"
+		case seen[s.Sku]:
+			fieldErrors["substitutes"] = "duplicate substitute " + s.Sku
+		}
+		seen[s.Sku] = true
+
+		rules = append(rules, model.SubstituteRule{
+			Sku:           req.Sku,
+			SubstituteSku: s.Sku,
+			Priority:      s.Priority,
+		})
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", fieldErrors))
+	}
+
+	saved, err := h.usecase.DefineSubstitutes(ctx, req.Sku, rules)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	resp := &inventoryv1.SubstitutesResponse{
+		Sku:       req.Sku,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+	for _, rule := range saved {
+		resp.Substitutes = append(resp.Substitutes, &inventoryv1.SubstituteRule{
+			Sku:      rule.SubstituteSku,
+			Priority: rule.Priority,
+		})
+	}
+
+	return resp, nil
+}
+
+func (h *inventoryHandler) SuggestAlternatives(ctx context.Context, req *inventoryv1.SuggestAlternativesRequest) (*inventoryv1.SuggestAlternativesResponse, error) {
+	if len(req.Items) == 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"items": "this properties cannot empty",
+		}))
+	}
+
+	// the same sku twice is summed, alternatives must cover the total
+	quantities := map[string]float64{}
+	for _, item := range req.Items {
+		if item.Sku == "" {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"items": "sku cannot empty",
+			}))
+		}
+		quantities[item.Sku] += item.ReqQtyPerUom
+	}
+
+	items, err := h.usecase.SuggestAlternatives(ctx, quantities, int(req.Limit))
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	resp := &inventoryv1.SuggestAlternativesResponse{
+		Timestamp: timestamppb.New(time.Now()),
+	}
+	for _, item := range items {
+		pItem := &inventoryv1.SkuAlternatives{
+			Sku:               item.Sku,
+			ProductId:         item.ProductId,
+			ProductName:       item.ProductName,
+			RequestedQuantity: item.RequestedQuantity,
+		}
+		for _, alt := range item.Alternatives {
+			pItem.Alternatives = append(pItem.Alternatives, &inventoryv1.AlternativeSku{
+				Sku:                alt.Sku,
+				ProductId:          alt.ProductId,
+				ProductName:        alt.ProductName,
+				AvailableQuantity:  alt.AvailableQuantity,
+				Reason:             alt.Reason,
+				MatchingAttributes: alt.MatchingAttributes,
+			})
+		}
+		resp.Items = append(resp.Items, pItem)
+	}
+
+	return resp, nil
+}
+
+func (h *inventoryHandler) RestockReturn(ctx context.Context, req *inventoryv1.RestockReturnRequest) (*inventoryv1.RestockReturnResponse, error) {
+	if req.ReturnId == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"return_id": "this properties cannot empty",
+		}))
+	}
+	if len(req.Items) == 0 {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"items": "this properties cannot empty",
+		}))
+	}
+
+	quantities := map[string]float64{}
+	for _, item := range req.Items {
+		if item.Sku == "" {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"items": "sku cannot empty",
+			}))
+		}
+		if item.ReqQtyPerUom <= 0 {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"items": "quantity of " + item.Sku + " must be greater than zero",
+			}))
+		}
+		quantities[item.Sku] += item.ReqQtyPerUom
+	}
+
+	restocked, alreadyRestocked, err := h.usecase.RestockReturn(ctx, req.ReturnId, req.OrderId, quantities)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	skus := make([]string, 0, len(restocked))
+	for sku := range restocked {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	resp := &inventoryv1.RestockReturnResponse{
+		ReturnId:         req.ReturnId,
+		AlreadyRestocked: alreadyRestocked,
+		Timestamp:        timestamppb.New(time.Now()),
+	}
+	for _, sku := range skus {
+		resp.Items = append(resp.Items, &inventoryv1.RestockedItem{
+			Sku:      sku,
+			Quantity: restocked[sku],
+		})
+	}
+
+	return resp, nil
+}
+
+func (h *inventoryHandler) CommitReservation(ctx context.Context, req *inventoryv1.CommitReservationRequest) (*inventoryv1.CommitReservationResponse, error) {
+	if req.OrderId == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"order_id": "this properties cannot empty",
+		}))
+	}
+	if req.CommitId == "" {
+		return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+			"commit_id": "this properties cannot empty",
+		}))
+	}
+
+	quantities := map[string]float64{}
+	for _, item := range req.Items {
+		if item.Sku == "" {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"items": "sku cannot empty",
+			}))
+		}
+		if item.Quantity <= 0 {
+			return nil, h.grpcErr.HandleError(grpcErr.NewValidationError("validation error", map[string]string{
+				"items": "quantity of " + item.Sku + " must be greater than zero",
+			}))
+		}
+		quantities[item.Sku] += item.Quantity
+	}
+
+	consumed, alreadyCommitted, err := h.usecase.CommitReservation(ctx, req.OrderId, req.CommitId, quantities)
+	if err != nil {
+		return nil, h.grpcErr.HandleError(err)
+	}
+
+	skus := make([]string, 0, len(consumed))
+	for sku := range consumed {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	resp := &inventoryv1.CommitReservationResponse{
+		OrderId:          req.OrderId,
+		CommitId:         req.CommitId,
+		AlreadyCommitted: alreadyCommitted,
+		Timestamp:        timestamppb.New(time.Now()),
+	}
+	for _, sku := range skus {
+		resp.Items = append(resp.Items, &inventoryv1.CommitItem{
+			Sku:      sku,
+			Quantity: consumed[sku],
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultBackInStockInterval = time.Minute
+
+type (
+	IBackInStockJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	backInStockJob struct {
+		logger   logger.Logger
+		usecase  usecase.IBackInStockUsecase
+		interval time.Duration
+	}
+)
+
+// NewBackInStockJob emails subscribers of skus that became available every interval,
+// a restock is picked up by the next run after it commits
+func NewBackInStockJob(log logger.Logger, uc usecase.IBackInStockUsecase, interval time.Duration) IBackInStockJob {
+	if interval <= 0 {
+		interval = defaultBackInStockInterval
+	}
+
+	return &backInStockJob{
+		logger:   log,
+		usecase:  uc,
+		interval: interval,
+	}
+}
+
+func (j *backInStockJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *backInStockJob) run(ctx context.Context) {
+	sent, err := j.usecase.NotifyRestocked(ctx)
+	if err != nil {
+		j.logger.Errorf("back in stock job failed", "error", err.Error())
+	}
+	if sent > 0 {
+		j.logger.Infof("back in stock emails sent", "count", sent)
+	}
+}
@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultBackorderInterval = time.Minute
+
+type (
+	IBackorderJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	backorderJob struct {
+		logger   logger.Logger
+		usecase  usecase.IBackorderUsecase
+		interval time.Duration
+	}
+)
+
+// NewBackorderJob allocates pending backorders every interval, receipts and stock imports
+// allocate right away so the job covers adjustments and released reservations
+func NewBackorderJob(log logger.Logger, uc usecase.IBackorderUsecase, interval time.Duration) IBackorderJob {
+	if interval <= 0 {
+		interval = defaultBackorderInterval
+	}
+
+	return &backorderJob{
+		logger:   log,
+		usecase:  uc,
+		interval: interval,
+	}
+}
+
+func (j *backorderJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *backorderJob) run(ctx context.Context) {
+	if _, err := j.usecase.AllocatePending(ctx); err != nil {
+		j.logger.Errorf("backorder job failed", "error", err.Error())
+	}
+}
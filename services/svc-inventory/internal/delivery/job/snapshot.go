@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultSnapshotInterval = time.Hour
+
+type (
+	ISnapshotJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	snapshotJob struct {
+		logger   logger.Logger
+		usecase  usecase.ISnapshotUsecase
+		interval time.Duration
+	}
+)
+
+// NewSnapshotJob checks every interval for ended days without a snapshot and writes them,
+// missed days are caught up on the next run so restarts and downtime do not leave gaps
+func NewSnapshotJob(log logger.Logger, uc usecase.ISnapshotUsecase, interval time.Duration) ISnapshotJob {
+	if interval <= 0 {
+		interval = defaultSnapshotInterval
+	}
+
+	return &snapshotJob{
+		logger:   log,
+		usecase:  uc,
+		interval: interval,
+	}
+}
+
+func (j *snapshotJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *snapshotJob) run(ctx context.Context) {
+	if _, err := j.usecase.WriteDueSnapshots(ctx, time.Now()); err != nil {
+		j.logger.Errorf("stock snapshot job failed", "error", err.Error())
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"log"
 
 	"ops-monorepo/services/svc-inventory/config"
+	"ops-monorepo/services/svc-inventory/internal/delivery/cli"
 	"ops-monorepo/services/svc-inventory/internal/delivery/handler"
+	"ops-monorepo/services/svc-inventory/internal/delivery/job"
 	"ops-monorepo/services/svc-inventory/internal/repository"
 	"ops-monorepo/services/svc-inventory/internal/usecase"
 	"ops-monorepo/services/svc-inventory/seeds"
@@ -12,9 +14,13 @@ import (
 	"ops-monorepo/shared-libs/jwt"
 	"ops-monorepo/shared-libs/logger"
 	"os"
+	notificationv1 "pb_schemas/notification/v1"
 	"time"
 
+	gg "ops-monorepo/shared-libs/grpc/client"
+
 	pg "ops-monorepo/shared-libs/storage/postgres"
+	rd "ops-monorepo/shared-libs/storage/redis"
 )
 
 type Dependencies struct {
@@ -27,6 +33,11 @@ type Dependencies struct {
 
 type Impl struct {
 	inventoryImpl
+	bulkImpl
+	snapshotImpl
+	purchaseOrderImpl
+	backInStockImpl
+	backorderImpl
 }
 
 type inventoryImpl struct {
@@ -35,6 +46,39 @@ type inventoryImpl struct {
 	repository repository.IInventorySQLRepository
 }
 
+type bulkImpl struct {
+	command    cli.IBulkCommand
+	usecase    usecase.IBulkUsecase
+	repository repository.IBulkSQLRepository
+}
+
+type snapshotImpl struct {
+	job        job.ISnapshotJob
+	command    cli.ISnapshotCommand
+	usecase    usecase.ISnapshotUsecase
+	repository repository.IStockSnapshotSQLRepository
+}
+
+type purchaseOrderImpl struct {
+	handler    handler.IPurchaseOrderHandler
+	usecase    usecase.IPurchaseOrderUsecase
+	repository repository.IPurchaseOrderSQLRepository
+}
+
+type backInStockImpl struct {
+	job        job.IBackInStockJob
+	handler    handler.IBackInStockHandler
+	usecase    usecase.IBackInStockUsecase
+	repository repository.IBackInStockSQLRepository
+}
+
+type backorderImpl struct {
+	job        job.IBackorderJob
+	handler    handler.IBackorderHandler
+	usecase    usecase.IBackorderUsecase
+	repository repository.IBackorderSQLRepository
+}
+
 func InitDependencies(cfg *config.Config) Dependencies {
 
 	if cfg == nil {
@@ -87,9 +131,108 @@ func InitDependencies(cfg *config.Config) Dependencies {
 
 	// inventory
 	dep.Impl.inventoryImpl.repository = repository.NewInventoryRepository(db)
-	dep.Impl.inventoryImpl.usecase = usecase.NewInventoryUsecase(zl, dep.Impl.repository)
-	dep.Impl.inventoryImpl.handler = handler.NewInventoryHandler(zl, dep.Impl.usecase, dep.GrpcErrHandler)
+
+	// optional check stock cache, inventory keeps working on postgres only when redis is unavailable
+	if cfg.Cache.Enabled {
+		rdb, err := rd.NewRedis(&rd.RedisCfg{Addr: cfg.Redis.Uri})
+		if err != nil {
+			zl.Warnf("inventory cache enabled but redis unavailable, continuing without cache: %v", err)
+		} else {
+			dep.Impl.inventoryImpl.repository = repository.NewCachedInventoryRepository(
+				dep.Impl.inventoryImpl.repository,
+				rdb,
+				repository.CacheConfig{
+					MetadataTTL: cfg.Cache.MetadataTTL,
+					QuantityTTL: cfg.Cache.QuantityTTL,
+				},
+				zl,
+			)
+			zl.Info("inventory cache ok..")
+		}
+	}
+
+	// keeps the check stock cache in sync after writes outside of the inventory repository
+	var cacheInvalidator usecase.SkuCacheInvalidator
+	if inv, ok := dep.Impl.inventoryImpl.repository.(usecase.SkuCacheInvalidator); ok {
+		cacheInvalidator = inv
+	}
+
+	// backorders, receipts, returns and stock imports allocate right away, the job sweeps up the rest
+	dep.Impl.backorderImpl.repository = repository.NewBackorderRepository(db)
+	dep.Impl.backorderImpl.usecase = usecase.NewBackorderUsecase(zl, dep.Impl.backorderImpl.repository, cacheInvalidator)
+	dep.Impl.backorderImpl.handler = handler.NewBackorderHandler(zl, dep.Impl.backorderImpl.usecase, dep.GrpcErrHandler)
+	if cfg.Backorder.JobEnabled {
+		dep.Impl.backorderImpl.job = job.NewBackorderJob(zl, dep.Impl.backorderImpl.usecase, cfg.Backorder.JobInterval)
+	}
+	zl.Info("backorder ok..")
+
+	// reservation mode per deployment, optimistic suits flash sales on a few hot skus
+	if cfg.Reservation.Mode != usecase.ReservationModePessimistic && cfg.Reservation.Mode != usecase.ReservationModeOptimistic {
+		zl.Warnf("unknown RESERVATION_MODE %q, using %s", cfg.Reservation.Mode, usecase.ReservationModePessimistic)
+	}
+	dep.Impl.inventoryImpl.usecase = usecase.NewInventoryUsecase(zl, dep.Impl.inventoryImpl.repository, usecase.ReservationConfig{
+		Mode:           cfg.Reservation.Mode,
+		MaxRetries:     cfg.Reservation.MaxRetries,
+		RetryBaseDelay: cfg.Reservation.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Reservation.RetryMaxDelay,
+	}, dep.Impl.backorderImpl.usecase)
+	dep.Impl.inventoryImpl.handler = handler.NewInventoryHandler(zl, dep.Impl.inventoryImpl.usecase, dep.GrpcErrHandler)
 	zl.Info("inventory ok..")
 
+	// bulk import and export
+	dep.Impl.bulkImpl.repository = repository.NewBulkRepository(db)
+	dep.Impl.bulkImpl.usecase = usecase.NewBulkUsecase(zl, dep.Impl.bulkImpl.repository, cacheInvalidator, dep.Impl.backorderImpl.usecase)
+	dep.Impl.bulkImpl.command = cli.NewBulkCommand(zl, dep.Impl.bulkImpl.usecase, os.Stdout)
+	zl.Info("bulk ok..")
+
+	// daily stock snapshots, the job only runs in the grpc server when enabled
+	dep.Impl.snapshotImpl.repository = repository.NewStockSnapshotRepository(db)
+	dep.Impl.snapshotImpl.usecase = usecase.NewSnapshotUsecase(zl, dep.Impl.snapshotImpl.repository)
+	dep.Impl.snapshotImpl.command = cli.NewSnapshotCommand(zl, dep.Impl.snapshotImpl.usecase, os.Stdout)
+	if cfg.Snapshot.JobEnabled {
+		dep.Impl.snapshotImpl.job = job.NewSnapshotJob(zl, dep.Impl.snapshotImpl.usecase, cfg.Snapshot.JobInterval)
+	}
+	zl.Info("snapshot ok..")
+
+	// purchase orders, receiving stock keeps the check stock cache in sync when enabled
+	dep.Impl.purchaseOrderImpl.repository = repository.NewPurchaseOrderRepository(db)
+	dep.Impl.purchaseOrderImpl.usecase = usecase.NewPurchaseOrderUsecase(zl, dep.Impl.purchaseOrderImpl.repository, cacheInvalidator, dep.Impl.backorderImpl.usecase)
+	dep.Impl.purchaseOrderImpl.handler = handler.NewPurchaseOrderHandler(zl, dep.Impl.purchaseOrderImpl.usecase, dep.GrpcErrHandler)
+	zl.Info("purchase order ok..")
+
+	// back in stock subscriptions, the notifier job needs the notification service
+	var notificationClient notificationv1.NotificationServiceClient
+	if cfg.GrpcServices.ServiceNotificationGrpcUrl != "" {
+		conn, err := gg.NewClientRegistry().GetConnection(cfg.GrpcServices.ServiceNotificationGrpcUrl)
+		if err != nil {
+			zl.Warnf("cannot establish connection with notification service, back in stock emails disabled: %v", err)
+		} else {
+			notificationClient = notificationv1.NewNotificationServiceClient(conn)
+			zl.Info("notification grpc client ok..")
+		}
+	}
+	dep.Impl.backInStockImpl.repository = repository.NewBackInStockRepository(db)
+	dep.Impl.backInStockImpl.usecase = usecase.NewBackInStockUsecase(
+		zl,
+		dep.Impl.backInStockImpl.repository,
+		dep.Impl.inventoryImpl.repository,
+		notificationClient,
+		usecase.BackInStockConfig{
+			SubscriptionTTL: cfg.BackInStock.SubscriptionTTL,
+			BatchSize:       cfg.BackInStock.BatchSize,
+			RatePerSecond:   cfg.BackInStock.RatePerSecond,
+			HoldWindow:      cfg.BackInStock.HoldWindow,
+		},
+	)
+	dep.Impl.backInStockImpl.handler = handler.NewBackInStockHandler(zl, dep.Impl.backInStockImpl.usecase, dep.GrpcErrHandler)
+	if cfg.BackInStock.JobEnabled {
+		if notificationClient == nil {
+			zl.Warnf("back in stock job enabled but SERVICE_NOTIFICATION_GRPC_URL is not set, job not started")
+		} else {
+			dep.Impl.backInStockImpl.job = job.NewBackInStockJob(zl, dep.Impl.backInStockImpl.usecase, cfg.BackInStock.JobInterval)
+		}
+	}
+	zl.Info("back in stock ok..")
+
 	return dep
 }
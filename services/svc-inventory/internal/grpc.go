@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"ops-monorepo/services/svc-inventory/config"
 	"ops-monorepo/shared-libs/logger"
 	inventoryv1 "pb_schemas/inventory/v1"
@@ -9,9 +10,13 @@ import (
 )
 
 type grpcServer struct {
-	Server    *grpc.Server
-	inventory *inventoryImpl
-	Log       logger.Logger
+	Server        *grpc.Server
+	inventory     *inventoryImpl
+	snapshot      *snapshotImpl
+	purchaseOrder *purchaseOrderImpl
+	backInStock   *backInStockImpl
+	backorder     *backorderImpl
+	Log           logger.Logger
 }
 
 func NewGrpcServer(cfg *config.Config) *grpcServer {
@@ -21,9 +26,13 @@ func NewGrpcServer(cfg *config.Config) *grpcServer {
 	s := grpc.NewServer()
 
 	return &grpcServer{
-		Server:    s,
-		inventory: &dep.Impl.inventoryImpl,
-		Log:       dep.log,
+		Server:        s,
+		inventory:     &dep.Impl.inventoryImpl,
+		snapshot:      &dep.Impl.snapshotImpl,
+		purchaseOrder: &dep.Impl.purchaseOrderImpl,
+		backInStock:   &dep.Impl.backInStockImpl,
+		backorder:     &dep.Impl.backorderImpl,
+		Log:           dep.log,
 	}
 }
 
@@ -31,4 +40,32 @@ func (s *grpcServer) Register() {
 
 	// inventory implementation
 	inventoryv1.RegisterInventoryServiceServer(s.Server, s.inventory.handler)
+
+	// purchase order implementation
+	inventoryv1.RegisterPurchaseOrderServiceServer(s.Server, s.purchaseOrder.handler)
+
+	// back in stock implementation
+	inventoryv1.RegisterBackInStockServiceServer(s.Server, s.backInStock.handler)
+
+	// backorder implementation
+	inventoryv1.RegisterBackorderServiceServer(s.Server, s.backorder.handler)
+}
+
+// starts background jobs enabled in the config
+func (s *grpcServer) StartJobs(ctx context.Context) {
+
+	if s.snapshot.job != nil {
+		s.snapshot.job.Start(ctx)
+		s.Log.Info("stock snapshot job started")
+	}
+
+	if s.backInStock.job != nil {
+		s.backInStock.job.Start(ctx)
+		s.Log.Info("back in stock job started")
+	}
+
+	if s.backorder.job != nil {
+		s.backorder.job.Start(ctx)
+		s.Log.Info("backorder job started")
+	}
 }
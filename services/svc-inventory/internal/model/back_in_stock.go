@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+const (
+	BackInStockPending  = "PENDING"
+	BackInStockNotified = "NOTIFIED"
+	BackInStockExpired  = "EXPIRED"
+)
+
+type BackInStockSubscription struct {
+	Id         string     `json:"id"`
+	Sku        string     `json:"sku"`
+	Email      string     `json:"email"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	NotifiedAt *time.Time `json:"notified_at"`
+
+	// product name for the email, only set on claimed subscriptions
+	ProductName string `json:"product_name,omitempty"`
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+const (
+	BackorderPending   = "PENDING"
+	BackorderAllocated = "ALLOCATED"
+	BackorderCancelled = "CANCELLED"
+)
+
+// Backorder is the quantity of an order line that could not be reserved and waits for stock
+type Backorder struct {
+	Id          string     `json:"id"`
+	OrderId     string     `json:"order_id"`
+	Sku         string     `json:"sku"`
+	Quantity    float64    `json:"quantity"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	AllocatedAt *time.Time `json:"allocated_at"`
+}
+
+// BackorderEvent tells svc-order that every backorder of an order has been allocated
+type BackorderEvent struct {
+	Id        int64     `json:"id"`
+	OrderId   string    `json:"order_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// entities supported by bulk import and export, import them in this order
+const (
+	BulkEntityProducts = "products"
+	BulkEntitySkus     = "skus"
+	BulkEntityPrices   = "prices"
+	BulkEntityStock    = "stock"
+)
+
+const (
+	BulkFormatCSV    = "csv"
+	BulkFormatNDJSON = "ndjson"
+)
+
+// column order used for csv headers and as the list of accepted fields
+var BulkColumns = map[string][]string{
+	BulkEntityProducts: {"id", "name", "description", "category_id", "discontinued"},
+	BulkEntitySkus:     {"sku", "product_id", "default_uom", "variant_attributes", "is_active"},
+	BulkEntityPrices:   {"sku", "uom_code", "currency", "unit_price", "valid_from", "valid_to", "is_active"},
+	BulkEntityStock:    {"sku", "current_stock", "min_stock_level", "max_stock_level"},
+}
+
+type (
+	// BulkRow is a single decoded input row keyed by column name,
+	// values are strings for csv and json values for ndjson
+	BulkRow map[string]interface{}
+
+	// BulkInputRow keeps the source line of a row for the rejection report
+	BulkInputRow struct {
+		Line  int
+		Row   BulkRow
+		Error string // set when the row could not be decoded
+	}
+
+	ProductRecord struct {
+		Id           string
+		Name         string
+		Description  *string
+		CategoryId   *string
+		Discontinued bool
+	}
+
+	SkuRecord struct {
+		Sku               string
+		ProductId         string
+		DefaultUom        string
+		VariantAttributes json.RawMessage
+		IsActive          bool
+	}
+
+	PriceRecord struct {
+		Sku       string
+		UomCode   string
+		Currency  string
+		UnitPrice float64
+		ValidFrom time.Time
+		ValidTo   *time.Time
+		IsActive  bool
+	}
+
+	StockRecord struct {
+		Sku           string
+		CurrentStock  float64
+		MinStockLevel float64
+		MaxStockLevel *float64
+	}
+
+	BulkImportOptions struct {
+		DryRun    bool
+		BatchSize int
+	}
+
+	RejectedRow struct {
+		Line   int     `json:"line"`
+		Reason string  `json:"reason"`
+		Row    BulkRow `json:"row"`
+	}
+
+	BulkImportReport struct {
+		Entity       string        `json:"entity"`
+		DryRun       bool          `json:"dry_run"`
+		TotalRows    int           `json:"total_rows"`
+		AcceptedRows int           `json:"accepted_rows"`
+		RejectedRows int           `json:"rejected_rows"`
+		Batches      int           `json:"batches"`
+		Rejected     []RejectedRow `json:"rejected"`
+	}
+)
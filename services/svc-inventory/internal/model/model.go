@@ -5,8 +5,45 @@ import "time"
 const (
 	ReservedStatus = "RESERVED"
 	ReleasedStatus = "RELEASED"
+	// the goods left the warehouse, current and reserved stock both went down
+	ConsumedStatus = "CONSUMED"
 )
 
+// reservation history line types, a bundle reservation writes one BUNDLE line
+// and one COMPONENT line per bill of materials entry
+const (
+	ReservationLineStock     = "STOCK"
+	ReservationLineBundle    = "BUNDLE"
+	ReservationLineComponent = "COMPONENT"
+)
+
+// reservation policies, how ReserveStock handles skus that are short
+const (
+	ReservationPolicyAllOrNothing      = "ALL_OR_NOTHING"
+	ReservationPolicyPartial           = "PARTIAL"
+	ReservationPolicyFillOrKillPerLine = "FILL_OR_KILL_PER_LINE"
+)
+
+// ReserveOptions tunes a ReserveStock call, backorders need the ALL_OR_NOTHING policy
+type ReserveOptions struct {
+	Policy         string
+	AllowBackorder bool
+}
+
+// ReservedLine is the quantity reserved for a requested sku, less than requested when short
+type ReservedLine struct {
+	Sku               string  `json:"sku"`
+	RequestedQuantity float64 `json:"requested_quantity"`
+	ReservedQuantity  float64 `json:"reserved_quantity"`
+}
+
+// ReservationResult is what ReserveStock reserved and queued for an order
+type ReservationResult struct {
+	History    []ReservationHistory `json:"history"`
+	Lines      []ReservedLine       `json:"lines"`
+	Backorders []Backorder          `json:"backorders"`
+}
+
 // StockStatus represents the inventory status of a single SKU
 type StockStatus struct {
 	SKU               string  `json:"sku"`
@@ -16,6 +53,9 @@ type StockStatus struct {
 	SKU_UOM           string  `json:"sku_uom"`
 	SKUPrice          float64 `json:"sku_price"`
 	SKUCurrency       string  `json:"sku_currency"`
+	IsBundle          bool    `json:"is_bundle"`
+	CategoryId        string  `json:"category_id"`
+	TaxCategory       string  `json:"tax_category"`
 }
 
 type ReservationHistory struct {
@@ -27,4 +67,137 @@ type ReservationHistory struct {
 	Status     string     `json:"status"`
 	ReservedAt time.Time  `json:"reserved_at"`
 	ReleasedAt *time.Time `json:"released_at"`
+	LineType   string     `json:"line_type"`
+	BundleSku  *string    `json:"bundle_sku"`
+}
+
+// BundleComponent is a single bill of materials line, quantity is per one bundle
+type BundleComponent struct {
+	BundleSku    string  `json:"bundle_sku"`
+	ComponentSku string  `json:"component_sku"`
+	Quantity     float64 `json:"quantity"`
+}
+
+// ReservationFilter narrows down reservation history listing, zero values are ignored
+type ReservationFilter struct {
+	OrderId      string
+	Sku          string
+	Status       string
+	ReservedFrom *time.Time
+	ReservedTo   *time.Time
+
+	// keyset cursor, points to the last row of the previous page
+	CursorReservedAt *time.Time
+	CursorId         string
+}
+
+// ReservationSkuTotal aggregates reservation history quantities for a single SKU
+type ReservationSkuTotal struct {
+	Sku              string  `json:"sku"`
+	ReservedQuantity float64 `json:"reserved_quantity"`
+	ReleasedQuantity float64 `json:"released_quantity"`
+	ConsumedQuantity float64 `json:"consumed_quantity"`
+	ReservationCount int64   `json:"reservation_count"`
+}
+
+// stock movement types, every change to current_stock or reserved_stock writes one movement
+const (
+	MovementOpening    = "OPENING"
+	MovementReceipt    = "RECEIPT"
+	MovementAdjustment = "ADJUSTMENT"
+	MovementReserve    = "RESERVE"
+	MovementRelease    = "RELEASE"
+	MovementReturn     = "RETURN"
+	MovementConsume    = "CONSUME"
+)
+
+// StockPosition is the stock of a SKU rebuilt from the movement log at a point in time
+type StockPosition struct {
+	Sku               string     `json:"sku"`
+	CurrentQuantity   float64    `json:"current_quantity"`
+	ReservedQuantity  float64    `json:"reserved_quantity"`
+	AvailableQuantity float64    `json:"available_quantity"`
+	SnapshotAsOf      *time.Time `json:"snapshot_as_of"` // base snapshot the movements were applied to, nil when none
+}
+
+// StockSnapshot is one row of the daily snapshot table
+type StockSnapshot struct {
+	SnapshotDate  time.Time `json:"snapshot_date"`
+	Sku           string    `json:"sku"`
+	AsOf          time.Time `json:"as_of"`
+	CurrentStock  float64   `json:"current_stock"`
+	ReservedStock float64   `json:"reserved_stock"`
+}
+
+// AttributeFilter matches skus whose variant attribute key holds any of the values,
+// no values only requires the key to be present
+type AttributeFilter struct {
+	Key    string
+	Values []string
+}
+
+// SkuSearchFilter narrows down SKU search, zero values are ignored
+type SkuSearchFilter struct {
+	CategoryId    string
+	Attributes    []AttributeFilter
+	IsActive      *bool
+	Discontinued  *bool
+	AvailableOnly bool
+
+	// keyset cursor, the last sku of the previous page
+	CursorSku string
+}
+
+type SkuSearchResult struct {
+	Sku               string            `json:"sku"`
+	ProductId         string            `json:"product_id"`
+	ProductName       string            `json:"product_name"`
+	CategoryId        *string           `json:"category_id"`
+	Attributes        map[string]string `json:"attributes"`
+	IsActive          bool              `json:"is_active"`
+	Discontinued      bool              `json:"discontinued"`
+	AvailableQuantity float64           `json:"available_quantity"`
+	IsBundle          bool              `json:"is_bundle"`
+}
+
+// AttributeFacet counts matching skus per value of a single attribute key
+type AttributeFacet struct {
+	Key    string                `json:"key"`
+	Values []AttributeFacetValue `json:"values"`
+}
+
+type AttributeFacetValue struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+const (
+	AlternativeReasonRule         = "SUBSTITUTE_RULE"
+	AlternativeReasonSameProduct  = "SAME_PRODUCT"
+	AlternativeReasonSameCategory = "SAME_CATEGORY"
+)
+
+// SubstituteRule offers SubstituteSku when Sku cannot be fulfilled, lower priority is suggested first
+type SubstituteRule struct {
+	Sku           string `json:"sku"`
+	SubstituteSku string `json:"substitute_sku"`
+	Priority      int32  `json:"priority"`
+}
+
+// AlternativeSku is an in stock sku that can replace a requested one
+type AlternativeSku struct {
+	Sku                string  `json:"sku"`
+	ProductId          string  `json:"product_id"`
+	ProductName        string  `json:"product_name"`
+	AvailableQuantity  float64 `json:"available_quantity"`
+	Reason             string  `json:"reason"`
+	MatchingAttributes int32   `json:"matching_attributes"`
+}
+
+type SkuAlternatives struct {
+	Sku               string           `json:"sku"`
+	ProductId         string           `json:"product_id"`
+	ProductName       string           `json:"product_name"`
+	RequestedQuantity float64          `json:"requested_quantity"`
+	Alternatives      []AlternativeSku `json:"alternatives"`
 }
@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+const (
+	PurchaseOrderDraft             = "DRAFT"
+	PurchaseOrderSubmitted         = "SUBMITTED"
+	PurchaseOrderPartiallyReceived = "PARTIALLY_RECEIVED"
+	PurchaseOrderReceived          = "RECEIVED"
+)
+
+type PurchaseOrder struct {
+	Id          string              `json:"id"`
+	Status      string              `json:"status"`
+	Supplier    *string             `json:"supplier"`
+	Lines       []PurchaseOrderLine `json:"lines"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	SubmittedAt *time.Time          `json:"submitted_at"`
+	ReceivedAt  *time.Time          `json:"received_at"`
+}
+
+type PurchaseOrderLine struct {
+	Id               string   `json:"id"`
+	PurchaseOrderId  string   `json:"purchase_order_id"`
+	Sku              string   `json:"sku"`
+	OrderedQuantity  float64  `json:"ordered_quantity"`
+	ReceivedQuantity float64  `json:"received_quantity"`
+	ReorderPoint     *float64 `json:"reorder_point"`
+	AvgDailyDemand   *float64 `json:"avg_daily_demand"`
+}
+
+// ReplenishmentCandidate is a sku whose stock position fell below its reorder point.
+// position = current - reserved + on order, reorder point = min_stock_level + demand over the lead time
+type ReplenishmentCandidate struct {
+	Sku            string   `json:"sku"`
+	CurrentStock   float64  `json:"current_stock"`
+	ReservedStock  float64  `json:"reserved_stock"`
+	OnOrder        float64  `json:"on_order"`
+	MinStockLevel  float64  `json:"min_stock_level"`
+	MaxStockLevel  *float64 `json:"max_stock_level"`
+	AvgDailyDemand float64  `json:"avg_daily_demand"`
+	ReorderPoint   float64  `json:"reorder_point"`
+}
+
+func IsPurchaseOrderStatus(status string) bool {
+	switch status {
+	case PurchaseOrderDraft, PurchaseOrderSubmitted, PurchaseOrderPartiallyReceived, PurchaseOrderReceived:
+		return true
+	}
+	return false
+}
+
+// ReplenishmentParams drives CreateReplenishmentPurchaseOrder, zero values fall back to the defaults
+type ReplenishmentParams struct {
+	LookbackDays int
+	LeadTimeDays int
+	Skus         []string
+	Supplier     *string
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"sort"
+)
+
+// ErrReleaseExceedsReserved means an amendment releases more of a sku than the order holds
+var ErrReleaseExceedsReserved = errors.New("release exceeds the reserved quantity of the order")
+
+// AmendOrderReservations applies the quantity deltas of plain skus to the reservations of an order
+// in one transaction. positive deltas reserve more stock, negative deltas release the newest
+// reservation lines first and split the last one when only part of it is released
+func (r *InventorySQLRepository) AmendOrderReservations(ctx context.Context, orderId string, deltas map[string]float64) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	skus := make([]string, 0, len(deltas))
+	for sku := range deltas {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	// lock stock rows in sku order so concurrent amendments cannot deadlock
+	rows, err := tx.Query(ctx,
+		`SELECT sku, (current_stock - reserved_stock)
+		FROM inventory_service.sku_inventory
+		WHERE sku = ANY($1)
+		ORDER BY sku
+		FOR UPDATE`,
+		skus,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check available quantity: %w", err)
+	}
+
+	available := map[string]float64{}
+	for rows.Next() {
+		var sku string
+		var quantity float64
+		if err := rows.Scan(&sku, &quantity); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan sku inventory row: %w", err)
+		}
+		available[sku] = quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	for _, sku := range skus {
+		delta := deltas[sku]
+		if _, ok := available[sku]; !ok {
+			return fmt.Errorf("sku %s has no inventory", sku)
+		}
+
+		if delta > 0 {
+			if available[sku] < delta {
+				return fmt.Errorf("insufficient available quantity for SKU %s: requested %.2f, available %.2f",
+					sku, delta, available[sku])
+			}
+			if err := reserveLockedStock(ctx, tx, orderId, sku, delta); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if delta < 0 {
+			if err := releaseLockedStock(ctx, tx, orderId, sku, -delta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// releases quantity of the RESERVED stock lines of an order for a sku whose inventory row is locked by tx
+func releaseLockedStock(ctx context.Context, tx sql.PgxTx, orderId, sku string, quantity float64) error {
+	query := `
+		SELECT id, quantity
+		FROM inventory_service.reservation_history
+		WHERE order_id = $1 AND sku = $2 AND status = $3 AND line_type = $4
+		ORDER BY reserved_at DESC, id DESC
+		FOR UPDATE
+	`
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), orderId, sku, model.ReservedStatus, model.ReservationLineStock)
+	if err != nil {
+		return fmt.Errorf("failed to query reservation history: %w", err)
+	}
+
+	type line struct {
+		id       string
+		quantity float64
+	}
+	var (
+		lines    []line
+		reserved float64
+	)
+	for rows.Next() {
+		var l line
+		if err := rows.Scan(&l.id, &l.quantity); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan reservation history row: %w", err)
+		}
+		lines = append(lines, l)
+		reserved += l.quantity
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	if reserved < quantity {
+		return fmt.Errorf("%w: sku %s holds %.2f, requested to release %.2f", ErrReleaseExceedsReserved, sku, reserved, quantity)
+	}
+
+	remaining := quantity
+	for _, l := range lines {
+		if remaining <= 0 {
+			break
+		}
+
+		_, err = tx.Exec(ctx,
+			"UPDATE inventory_service.reservation_history SET status = $1, released_at = NOW() WHERE id = $2",
+			model.ReleasedStatus, l.id,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update reservation history: %w", err)
+		}
+
+		// the part that stays reserved keeps the age of the original line
+		if l.quantity > remaining {
+			_, err = tx.Exec(ctx,
+				`INSERT INTO inventory_service.reservation_history
+				(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type)
+				SELECT gen_random_uuid(), order_id, sku, $1, uom, $2, reserved_at, NULL, line_type
+				FROM inventory_service.reservation_history WHERE id = $3`,
+				l.quantity-remaining, model.ReservedStatus, l.id,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert reservation history: %w", err)
+			}
+		}
+		remaining -= l.quantity
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE inventory_service.sku_inventory SET reserved_stock = reserved_stock - $1 WHERE sku = $2",
+		quantity, sku,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release inventory: %w", err)
+	}
+
+	return insertStockMovement(ctx, tx, sku, model.MovementRelease, 0, -quantity, orderId)
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+)
+
+type IBackInStockSQLRepository interface {
+	CreateSubscription(ctx context.Context, sku, email string, expiresAt time.Time) (*model.BackInStockSubscription, error)
+	ExpireSubscriptions(ctx context.Context) (int64, error)
+	ClaimNotifications(ctx context.Context, limit int, holdSince time.Time) ([]model.BackInStockSubscription, error)
+	RevertNotification(ctx context.Context, id string) error
+}
+
+type BackInStockSQLRepository struct {
+	Pgx *sql.PostgresPgx
+}
+
+func NewBackInStockRepository(pgx *sql.PostgresPgx) IBackInStockSQLRepository {
+	return &BackInStockSQLRepository{
+		Pgx: pgx,
+	}
+}
+
+// subscribing again while a subscription is pending keeps its place in the queue and extends its expiry
+func (r *BackInStockSQLRepository) CreateSubscription(ctx context.Context, sku, email string, expiresAt time.Time) (*model.BackInStockSubscription, error) {
+	query := `
+		INSERT INTO inventory_service.back_in_stock_subscriptions (id, sku, email, status, created_at, expires_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, NOW(), $4)
+		ON CONFLICT (sku, email) WHERE status = 'PENDING' DO UPDATE SET
+			expires_at = GREATEST(inventory_service.back_in_stock_subscriptions.expires_at, EXCLUDED.expires_at)
+		RETURNING id, sku, email, status, created_at, expires_at, notified_at
+	`
+
+	var sub model.BackInStockSubscription
+	err := r.Pgx.Pool().QueryRow(ctx, rg.ReplaceWhitesWithSingleSpace(query), sku, email, model.BackInStockPending, expiresAt).Scan(
+		&sub.Id,
+		&sub.Sku,
+		&sub.Email,
+		&sub.Status,
+		&sub.CreatedAt,
+		&sub.ExpiresAt,
+		&sub.NotifiedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create back in stock subscription: %w", err)
+	}
+
+	return &sub, nil
+}
+
+func (r *BackInStockSQLRepository) ExpireSubscriptions(ctx context.Context) (int64, error) {
+	tag, err := r.Pgx.Pool().Exec(ctx,
+		`UPDATE inventory_service.back_in_stock_subscriptions
+		SET status = $1
+		WHERE status = $2 AND expires_at <= NOW()`,
+		model.BackInStockExpired, model.BackInStockPending,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire back in stock subscriptions: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// marks pending subscriptions of available skus as notified and returns them, oldest first.
+// each sku gets one subscriber per available unit in subscription order, minus subscribers
+// notified since holdSince who are still expected to buy, so limited stock goes first come first served.
+// the caller sends the emails and reverts the ones that failed
+func (r *BackInStockSQLRepository) ClaimNotifications(ctx context.Context, limit int, holdSince time.Time) ([]model.BackInStockSubscription, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// serialize claimers so two replicas running the job do not notify the same stock twice
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('inventory_service.back_in_stock_subscriptions'))"); err != nil {
+		return nil, fmt.Errorf("failed to lock back in stock subscriptions: %w", err)
+	}
+
+	query := `
+		WITH ` + bundleStockCTE + `, held AS (
+			SELECT sku, COUNT(*) AS quantity
+			FROM inventory_service.back_in_stock_subscriptions
+			WHERE status = $3 AND notified_at > $2
+			GROUP BY sku
+		), ranked AS (
+			SELECT
+				b.id,
+				b.created_at,
+				ROW_NUMBER() OVER (PARTITION BY b.sku ORDER BY b.created_at, b.id) AS position,
+				FLOOR(COALESCE(bs.available_quantity, si.current_stock - si.reserved_stock, 0)) - COALESCE(h.quantity, 0) AS slots
+			FROM inventory_service.back_in_stock_subscriptions b
+			LEFT JOIN inventory_service.sku_inventory si ON si.sku = b.sku
+			LEFT JOIN bundle_stock bs ON bs.bundle_sku = b.sku
+			LEFT JOIN held h ON h.sku = b.sku
+			WHERE b.status = $4 AND b.expires_at > NOW()
+		), claimed AS (
+			SELECT id
+			FROM ranked
+			WHERE position <= slots
+			ORDER BY created_at, id
+			LIMIT $1
+		)
+		UPDATE inventory_service.back_in_stock_subscriptions b
+		SET status = $3, notified_at = NOW()
+		FROM claimed c, inventory_service.skus s
+		JOIN inventory_service.products p ON p.id = s.product_id
+		WHERE b.id = c.id AND s.sku = b.sku
+		RETURNING b.id, b.sku, b.email, b.status, b.created_at, b.expires_at, b.notified_at, p.name
+	`
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), limit, holdSince, model.BackInStockNotified, model.BackInStockPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim back in stock notifications: %w", err)
+	}
+
+	var claimed []model.BackInStockSubscription
+	for rows.Next() {
+		var sub model.BackInStockSubscription
+		err := rows.Scan(
+			&sub.Id,
+			&sub.Sku,
+			&sub.Email,
+			&sub.Status,
+			&sub.CreatedAt,
+			&sub.ExpiresAt,
+			&sub.NotifiedAt,
+			&sub.ProductName,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan back in stock subscription row: %w", err)
+		}
+		claimed = append(claimed, sub)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return claimed, nil
+}
+
+// puts a claimed subscription back in the queue after its email failed, unless the
+// customer subscribed again in the meantime
+func (r *BackInStockSQLRepository) RevertNotification(ctx context.Context, id string) error {
+	_, err := r.Pgx.Pool().Exec(ctx,
+		`UPDATE inventory_service.back_in_stock_subscriptions b
+		SET status = $2, notified_at = NULL
+		WHERE b.id = $1 AND b.status = $3
+			AND NOT EXISTS (
+				SELECT 1 FROM inventory_service.back_in_stock_subscriptions o
+				WHERE o.sku = b.sku AND o.email = b.email AND o.status = $2
+			)`,
+		id, model.BackInStockPending, model.BackInStockNotified,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revert back in stock notification: %w", err)
+	}
+	return nil
+}
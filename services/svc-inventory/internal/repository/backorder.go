@@ -0,0 +1,362 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"sort"
+)
+
+type IBackorderSQLRepository interface {
+	GetSkusWithPendingBackorders(ctx context.Context) ([]string, error)
+	AllocateBackorders(ctx context.Context, skus []string) ([]model.Backorder, error)
+	ListBackorderEvents(ctx context.Context, limit int) ([]model.BackorderEvent, error)
+	AcknowledgeBackorderEvents(ctx context.Context, ids []int64) (int64, error)
+}
+
+type BackorderSQLRepository struct {
+	Pgx *sql.PostgresPgx
+}
+
+func NewBackorderRepository(pgx *sql.PostgresPgx) IBackorderSQLRepository {
+	return &BackorderSQLRepository{
+		Pgx: pgx,
+	}
+}
+
+// serializes allocation so an order's last allocated backorder always sees the others committed
+const backorderAllocationLock = "SELECT pg_advisory_xact_lock(hashtext('inventory_service.backorders'))"
+
+// reserves what is available of a single sku and queues the shortfall as a PENDING backorder.
+// when the sku already has pending backorders the whole quantity is queued behind them, so
+// stock arriving later goes to the oldest demand first. returns nil when nothing was queued
+func (r *InventorySQLRepository) ReserveStockWithBackorder(ctx context.Context, orderId, sku string, quantity float64) (*model.Backorder, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var available float64
+	err = tx.QueryRow(ctx,
+		"SELECT (current_stock - reserved_stock) FROM inventory_service.sku_inventory WHERE sku = $1 FOR UPDATE",
+		sku,
+	).Scan(&available)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check available quantity: %w", err)
+	}
+
+	var queued bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM inventory_service.backorders WHERE sku = $1 AND status = $2)",
+		sku, model.BackorderPending,
+	).Scan(&queued)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending backorders: %w", err)
+	}
+
+	reserve := math.Min(math.Max(available, 0), quantity)
+	if queued {
+		reserve = 0
+	}
+
+	if reserve > 0 {
+		if err := reserveLockedStock(ctx, tx, orderId, sku, reserve); err != nil {
+			return nil, err
+		}
+	}
+
+	var backorder *model.Backorder
+	if shortfall := quantity - reserve; shortfall > 0 {
+		b := model.Backorder{}
+		err = tx.QueryRow(ctx,
+			`INSERT INTO inventory_service.backorders (id, order_id, sku, quantity, status, created_at)
+			VALUES (gen_random_uuid(), $1, $2, $3, $4, NOW())
+			RETURNING id, order_id, sku, quantity, status, created_at, allocated_at`,
+			orderId, sku, shortfall, model.BackorderPending,
+		).Scan(&b.Id, &b.OrderId, &b.Sku, &b.Quantity, &b.Status, &b.CreatedAt, &b.AllocatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert backorder: %w", err)
+		}
+		backorder = &b
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return backorder, nil
+}
+
+// reserves quantity of a sku whose inventory row is locked by tx, with its ledger and history lines
+func reserveLockedStock(ctx context.Context, tx sql.PgxTx, orderId, sku string, quantity float64) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE inventory_service.sku_inventory SET reserved_stock = reserved_stock + $1 WHERE sku = $2",
+		quantity, sku,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reserve inventory: %w", err)
+	}
+
+	if err := insertStockMovement(ctx, tx, sku, model.MovementReserve, 0, quantity, orderId); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO inventory_service.reservation_history
+		(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type)
+		SELECT gen_random_uuid(), $1, sku, $2, default_uom, $3, NOW(), NULL, $4
+		FROM inventory_service.skus WHERE sku = $5`,
+		orderId, quantity, model.ReservedStatus, model.ReservationLineStock, sku,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert reservation history: %w", err)
+	}
+	return nil
+}
+
+// cancels the pending backorders of an order, limited to skus when given, and returns their skus
+func cancelPendingBackorders(ctx context.Context, tx sql.PgxTx, orderId string, skus []string) ([]string, error) {
+	query := `
+		UPDATE inventory_service.backorders
+		SET status = $1
+		WHERE order_id = $2 AND status = $3
+	`
+	args := []interface{}{model.BackorderCancelled, orderId, model.BackorderPending}
+	if len(skus) > 0 {
+		query += " AND sku = ANY($4)"
+		args = append(args, skus)
+	}
+	query += " RETURNING sku"
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel backorders: %w", err)
+	}
+	defer rows.Close()
+
+	var cancelled []string
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, fmt.Errorf("failed to scan backorder row: %w", err)
+		}
+		cancelled = append(cancelled, sku)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return cancelled, nil
+}
+
+func (r *BackorderSQLRepository) GetSkusWithPendingBackorders(ctx context.Context) ([]string, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT DISTINCT sku FROM inventory_service.backorders WHERE status = $1 ORDER BY sku",
+		model.BackorderPending,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backordered skus: %w", err)
+	}
+	defer rows.Close()
+
+	var skus []string
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, fmt.Errorf("failed to scan backordered sku row: %w", err)
+		}
+		skus = append(skus, sku)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return skus, nil
+}
+
+// reserves available stock for pending backorders of skus in one transaction, oldest first per sku.
+// a backorder is only allocated in full and a sku stops at the first one its stock cannot cover,
+// so later and smaller demand never overtakes older demand. writes a backorder event for every
+// order left without pending backorders and returns the allocated backorders
+func (r *BackorderSQLRepository) AllocateBackorders(ctx context.Context, skus []string) ([]model.Backorder, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, backorderAllocationLock); err != nil {
+		return nil, fmt.Errorf("failed to lock backorders: %w", err)
+	}
+
+	// lock stock rows in sku order to keep lock order stable
+	sorted := append([]string(nil), skus...)
+	sort.Strings(sorted)
+
+	var allocated []model.Backorder
+	for _, sku := range sorted {
+		backorders, err := allocateSkuBackorders(ctx, tx, sku)
+		if err != nil {
+			return nil, err
+		}
+		allocated = append(allocated, backorders...)
+	}
+
+	if len(allocated) == 0 {
+		return nil, nil
+	}
+
+	orderIds := make([]string, 0, len(allocated))
+	for _, b := range allocated {
+		orderIds = append(orderIds, b.OrderId)
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO inventory_service.backorder_events (order_id, created_at)
+		SELECT DISTINCT o.order_id, NOW()
+		FROM unnest($1::uuid[]) AS o(order_id)
+		WHERE NOT EXISTS (
+			SELECT 1 FROM inventory_service.backorders b
+			WHERE b.order_id = o.order_id AND b.status = $2
+		)`,
+		orderIds, model.BackorderPending,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert backorder events: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return allocated, nil
+}
+
+func allocateSkuBackorders(ctx context.Context, tx sql.PgxTx, sku string) ([]model.Backorder, error) {
+	var available float64
+	err := tx.QueryRow(ctx,
+		"SELECT (current_stock - reserved_stock) FROM inventory_service.sku_inventory WHERE sku = $1 FOR UPDATE",
+		sku,
+	).Scan(&available)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check available quantity of %s: %w", sku, err)
+	}
+	if available <= 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx,
+		`SELECT id, order_id, sku, quantity, status, created_at, allocated_at
+		FROM inventory_service.backorders
+		WHERE sku = $1 AND status = $2
+		ORDER BY created_at, id
+		FOR UPDATE`,
+		sku, model.BackorderPending,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backorders: %w", err)
+	}
+
+	var queue []model.Backorder
+	for rows.Next() {
+		var b model.Backorder
+		if err := rows.Scan(&b.Id, &b.OrderId, &b.Sku, &b.Quantity, &b.Status, &b.CreatedAt, &b.AllocatedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan backorder row: %w", err)
+		}
+		queue = append(queue, b)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	var allocated []model.Backorder
+	for _, b := range fifoAllocation(queue, available) {
+		if err := reserveLockedStock(ctx, tx, b.OrderId, sku, b.Quantity); err != nil {
+			return nil, err
+		}
+
+		err = tx.QueryRow(ctx,
+			`UPDATE inventory_service.backorders
+			SET status = $1, allocated_at = NOW()
+			WHERE id = $2
+			RETURNING status, allocated_at`,
+			model.BackorderAllocated, b.Id,
+		).Scan(&b.Status, &b.AllocatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to allocate backorder: %w", err)
+		}
+		allocated = append(allocated, b)
+	}
+
+	return allocated, nil
+}
+
+// takes backorders from the head of the queue while the available quantity covers them whole.
+// a backorder that doesn't fit blocks the ones behind it, so smaller later demand can't jump the queue
+func fifoAllocation(queue []model.Backorder, available float64) []model.Backorder {
+	n := 0
+	for _, b := range queue {
+		if b.Quantity > available {
+			break
+		}
+		available -= b.Quantity
+		n++
+	}
+	return queue[:n]
+}
+
+// lists unacknowledged events oldest first. an order backordered again after its event was
+// written is skipped until its new backorders are allocated too
+func (r *BackorderSQLRepository) ListBackorderEvents(ctx context.Context, limit int) ([]model.BackorderEvent, error) {
+	query := `
+		SELECT e.id, e.order_id::text, e.created_at
+		FROM inventory_service.backorder_events e
+		WHERE e.acknowledged_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1 FROM inventory_service.backorders b
+				WHERE b.order_id = e.order_id AND b.status = $1
+			)
+		ORDER BY e.id
+		LIMIT $2
+	`
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), model.BackorderPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backorder events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.BackorderEvent
+	for rows.Next() {
+		var e model.BackorderEvent
+		if err := rows.Scan(&e.Id, &e.OrderId, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan backorder event row: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *BackorderSQLRepository) AcknowledgeBackorderEvents(ctx context.Context, ids []int64) (int64, error) {
+	tag, err := r.Pgx.Pool().Exec(ctx,
+		`UPDATE inventory_service.backorder_events
+		SET acknowledged_at = NOW()
+		WHERE id = ANY($1) AND acknowledged_at IS NULL`,
+		ids,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge backorder events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
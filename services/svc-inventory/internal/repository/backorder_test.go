@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ops-monorepo/services/svc-inventory/internal/model"
+)
+
+func TestFifoAllocation(t *testing.T) {
+	// the queue as allocateSkuBackorders reads it, ordered by created_at and id
+	queue := []model.Backorder{
+		{Id: "bo-1", OrderId: "order-1", Sku: "MUG-BLUE", Quantity: 3, Status: model.BackorderPending},
+		{Id: "bo-2", OrderId: "order-2", Sku: "MUG-BLUE", Quantity: 5, Status: model.BackorderPending},
+		{Id: "bo-3", OrderId: "order-3", Sku: "MUG-BLUE", Quantity: 1, Status: model.BackorderPending},
+	}
+
+	testCases := []struct {
+		Name      string
+		Available float64
+		Expected  []string
+	}{
+		{
+			Name:      "oldest backorder is allocated first",
+			Available: 3,
+			Expected:  []string{"bo-1"},
+		},
+		{
+			Name:      "every backorder fits",
+			Available: 9,
+			Expected:  []string{"bo-1", "bo-2", "bo-3"},
+		},
+		{
+			Name:      "backorder that doesn't fit blocks the smaller ones behind it",
+			Available: 7,
+			Expected:  []string{"bo-1"},
+		},
+		{
+			Name:      "backorders are not split",
+			Available: 2,
+			Expected:  []string{},
+		},
+		{
+			Name:      "leftover after a partial run stays on the sku",
+			Available: 8.5,
+			Expected:  []string{"bo-1", "bo-2"},
+		},
+		{
+			Name:      "nothing available",
+			Available: 0,
+			Expected:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ids := []string{}
+			for _, b := range fifoAllocation(queue, tc.Available) {
+				ids = append(ids, b.Id)
+			}
+			assert.Equal(t, tc.Expected, ids)
+		})
+	}
+
+	t.Run("empty queue", func(t *testing.T) {
+		assert.Empty(t, fifoAllocation(nil, 10))
+	})
+}
@@ -0,0 +1,337 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type IBulkSQLRepository interface {
+	// upsert a batch in a single transaction using COPY into a temp table
+	UpsertProducts(ctx context.Context, records []model.ProductRecord) error
+	UpsertSkus(ctx context.Context, records []model.SkuRecord) error
+	UpsertPrices(ctx context.Context, records []model.PriceRecord) error
+	UpsertStock(ctx context.Context, records []model.StockRecord) error
+
+	// reference lookups used by validation
+	FindExistingProductIds(ctx context.Context, ids []string) (map[string]bool, error)
+	FindExistingCategoryIds(ctx context.Context, ids []string) (map[string]bool, error)
+	FindExistingSkus(ctx context.Context, skus []string) (map[string]bool, error)
+	FindExistingUoms(ctx context.Context, codes []string) (map[string]bool, error)
+	GetReservedStockBySkus(ctx context.Context, skus []string) (map[string]float64, error)
+
+	// stream every row of an entity to fn
+	ExportProducts(ctx context.Context, fn func(model.ProductRecord) error) error
+	ExportSkus(ctx context.Context, fn func(model.SkuRecord) error) error
+	ExportPrices(ctx context.Context, fn func(model.PriceRecord) error) error
+	ExportStock(ctx context.Context, fn func(model.StockRecord) error) error
+}
+
+type BulkSQLRepository struct {
+	Pgx *sql.PostgresPgx
+}
+
+func NewBulkRepository(pgx *sql.PostgresPgx) IBulkSQLRepository {
+	return &BulkSQLRepository{
+		Pgx: pgx,
+	}
+}
+
+// copies rows into a temp table shaped like the target and merges them with the given upsert statement
+func (r *BulkSQLRepository) copyUpsert(ctx context.Context, table string, columns []string, rows [][]interface{}, upsertQuery string) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tmpTable := "bulk_" + table
+	_, err = tx.Exec(ctx, fmt.Sprintf(
+		"CREATE TEMP TABLE %s (LIKE inventory_service.%s INCLUDING DEFAULTS) ON COMMIT DROP", tmpTable, table,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create temp table: %w", err)
+	}
+
+	if _, err = tx.CopyFrom(ctx, pgx.Identifier{tmpTable}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to copy %s rows: %w", table, err)
+	}
+
+	if _, err = tx.Exec(ctx, rg.ReplaceWhitesWithSingleSpace(upsertQuery)); err != nil {
+		return fmt.Errorf("failed to upsert %s rows: %w", table, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *BulkSQLRepository) UpsertProducts(ctx context.Context, records []model.ProductRecord) error {
+	rows := make([][]interface{}, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, []interface{}{rec.Id, rec.Name, rec.Description, rec.CategoryId, rec.Discontinued})
+	}
+
+	query := `
+		INSERT INTO inventory_service.products (id, name, description, category_id, discontinued)
+		SELECT id, name, description, category_id, discontinued FROM bulk_products
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			category_id = EXCLUDED.category_id,
+			discontinued = EXCLUDED.discontinued,
+			updated_at = NOW()
+	`
+
+	return r.copyUpsert(ctx, "products", []string{"id", "name", "description", "category_id", "discontinued"}, rows, query)
+}
+
+func (r *BulkSQLRepository) UpsertSkus(ctx context.Context, records []model.SkuRecord) error {
+	rows := make([][]interface{}, 0, len(records))
+	for _, rec := range records {
+		var attributes []byte
+		if len(rec.VariantAttributes) > 0 {
+			attributes = rec.VariantAttributes
+		}
+		rows = append(rows, []interface{}{rec.Sku, rec.ProductId, attributes, rec.DefaultUom, rec.IsActive})
+	}
+
+	query := `
+		INSERT INTO inventory_service.skus (sku, product_id, variant_attributes, default_uom, is_active)
+		SELECT sku, product_id, variant_attributes, default_uom, is_active FROM bulk_skus
+		ON CONFLICT (sku) DO UPDATE SET
+			product_id = EXCLUDED.product_id,
+			variant_attributes = EXCLUDED.variant_attributes,
+			default_uom = EXCLUDED.default_uom,
+			is_active = EXCLUDED.is_active,
+			updated_at = NOW()
+	`
+
+	return r.copyUpsert(ctx, "skus", []string{"sku", "product_id", "variant_attributes", "default_uom", "is_active"}, rows, query)
+}
+
+func (r *BulkSQLRepository) UpsertPrices(ctx context.Context, records []model.PriceRecord) error {
+	rows := make([][]interface{}, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, []interface{}{rec.Sku, rec.UomCode, rec.Currency, rec.UnitPrice, rec.ValidFrom, rec.ValidTo, rec.IsActive})
+	}
+
+	query := `
+		INSERT INTO inventory_service.sku_prices (sku, uom_code, currency, unit_price, valid_from, valid_to, is_active)
+		SELECT sku, uom_code, currency, unit_price, valid_from, valid_to, is_active FROM bulk_sku_prices
+		ON CONFLICT (sku, currency, valid_from) DO UPDATE SET
+			uom_code = EXCLUDED.uom_code,
+			unit_price = EXCLUDED.unit_price,
+			valid_to = EXCLUDED.valid_to,
+			is_active = EXCLUDED.is_active
+	`
+
+	return r.copyUpsert(ctx, "sku_prices", []string{"sku", "uom_code", "currency", "unit_price", "valid_from", "valid_to", "is_active"}, rows, query)
+}
+
+// reserved_stock is owned by reservations and is never overwritten by an import.
+// every changed current_stock is written to the movement log, new rows as an opening balance
+func (r *BulkSQLRepository) UpsertStock(ctx context.Context, records []model.StockRecord) error {
+	rows := make([][]interface{}, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, []interface{}{rec.Sku, rec.CurrentStock, rec.MinStockLevel, rec.MaxStockLevel})
+	}
+
+	query := fmt.Sprintf(`
+		WITH previous AS (
+			SELECT si.sku, si.current_stock
+			FROM inventory_service.sku_inventory si
+			JOIN bulk_sku_inventory b ON b.sku = si.sku
+			FOR UPDATE OF si
+		), upserted AS (
+			INSERT INTO inventory_service.sku_inventory (sku, current_stock, min_stock_level, max_stock_level)
+			SELECT sku, current_stock, min_stock_level, max_stock_level FROM bulk_sku_inventory
+			ON CONFLICT (sku) DO UPDATE SET
+				current_stock = EXCLUDED.current_stock,
+				min_stock_level = EXCLUDED.min_stock_level,
+				max_stock_level = EXCLUDED.max_stock_level,
+				last_stock_update = NOW()
+			RETURNING sku, current_stock
+		)
+		INSERT INTO inventory_service.stock_movements (sku, movement_type, current_delta, reserved_delta, reference)
+		SELECT
+			u.sku,
+			CASE WHEN p.sku IS NULL THEN '%s' ELSE '%s' END,
+			u.current_stock - COALESCE(p.current_stock, 0),
+			0,
+			'bulk import'
+		FROM upserted u
+		LEFT JOIN previous p ON p.sku = u.sku
+		WHERE u.current_stock <> COALESCE(p.current_stock, 0)
+	`, model.MovementOpening, model.MovementAdjustment)
+
+	return r.copyUpsert(ctx, "sku_inventory", []string{"sku", "current_stock", "min_stock_level", "max_stock_level"}, rows, query)
+}
+
+// returns the subset of keys present in the given column
+func (r *BulkSQLRepository) findExisting(ctx context.Context, query string, keys []string) (map[string]bool, error) {
+	found := make(map[string]bool, len(keys))
+	if len(keys) == 0 {
+		return found, nil
+	}
+
+	rows, err := r.Pgx.Pool().Query(ctx, query, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing keys: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("failed to scan existing key: %w", err)
+		}
+		found[key] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return found, nil
+}
+
+func (r *BulkSQLRepository) FindExistingProductIds(ctx context.Context, ids []string) (map[string]bool, error) {
+	return r.findExisting(ctx, "SELECT id::text FROM inventory_service.products WHERE id::text = ANY($1)", ids)
+}
+
+func (r *BulkSQLRepository) FindExistingCategoryIds(ctx context.Context, ids []string) (map[string]bool, error) {
+	return r.findExisting(ctx, "SELECT id::text FROM inventory_service.product_categories WHERE id::text = ANY($1)", ids)
+}
+
+func (r *BulkSQLRepository) FindExistingSkus(ctx context.Context, skus []string) (map[string]bool, error) {
+	return r.findExisting(ctx, "SELECT sku FROM inventory_service.skus WHERE sku = ANY($1)", skus)
+}
+
+func (r *BulkSQLRepository) FindExistingUoms(ctx context.Context, codes []string) (map[string]bool, error) {
+	return r.findExisting(ctx, "SELECT code FROM inventory_service.uom WHERE code = ANY($1)", codes)
+}
+
+func (r *BulkSQLRepository) GetReservedStockBySkus(ctx context.Context, skus []string) (map[string]float64, error) {
+	reserved := make(map[string]float64, len(skus))
+	if len(skus) == 0 {
+		return reserved, nil
+	}
+
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT sku, reserved_stock FROM inventory_service.sku_inventory WHERE sku = ANY($1)",
+		skus,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reserved stock: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sku string
+		var qty float64
+		if err := rows.Scan(&sku, &qty); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved stock: %w", err)
+		}
+		reserved[sku] = qty
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return reserved, nil
+}
+
+func (r *BulkSQLRepository) ExportProducts(ctx context.Context, fn func(model.ProductRecord) error) error {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT id::text, name, description, category_id::text, discontinued FROM inventory_service.products ORDER BY id",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query products: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec model.ProductRecord
+		if err := rows.Scan(&rec.Id, &rec.Name, &rec.Description, &rec.CategoryId, &rec.Discontinued); err != nil {
+			return fmt.Errorf("failed to scan product row: %w", err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *BulkSQLRepository) ExportSkus(ctx context.Context, fn func(model.SkuRecord) error) error {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT sku, product_id::text, default_uom, variant_attributes, is_active FROM inventory_service.skus ORDER BY sku",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query skus: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec model.SkuRecord
+		var attributes []byte
+		if err := rows.Scan(&rec.Sku, &rec.ProductId, &rec.DefaultUom, &attributes, &rec.IsActive); err != nil {
+			return fmt.Errorf("failed to scan sku row: %w", err)
+		}
+		rec.VariantAttributes = attributes
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *BulkSQLRepository) ExportPrices(ctx context.Context, fn func(model.PriceRecord) error) error {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		`SELECT sku, uom_code, currency, unit_price, valid_from, valid_to, is_active
+		FROM inventory_service.sku_prices ORDER BY sku, currency, valid_from`,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query prices: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec model.PriceRecord
+		if err := rows.Scan(&rec.Sku, &rec.UomCode, &rec.Currency, &rec.UnitPrice, &rec.ValidFrom, &rec.ValidTo, &rec.IsActive); err != nil {
+			return fmt.Errorf("failed to scan price row: %w", err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *BulkSQLRepository) ExportStock(ctx context.Context, fn func(model.StockRecord) error) error {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT sku, current_stock, min_stock_level, max_stock_level FROM inventory_service.sku_inventory ORDER BY sku",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query stock: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec model.StockRecord
+		if err := rows.Scan(&rec.Sku, &rec.CurrentStock, &rec.MinStockLevel, &rec.MaxStockLevel); err != nil {
+			return fmt.Errorf("failed to scan stock row: %w", err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
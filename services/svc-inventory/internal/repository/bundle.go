@@ -0,0 +1,366 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	"sort"
+)
+
+// bundles hold no stock, their availability is the number of complete bundles the components can build
+const bundleStockCTE = `
+	bundle_stock AS (
+		SELECT
+			bc.bundle_sku,
+			MIN(FLOOR(GREATEST(COALESCE(ci.current_stock - ci.reserved_stock, 0), 0) / bc.quantity)) AS available_quantity
+		FROM inventory_service.sku_bundle_components bc
+		LEFT JOIN inventory_service.sku_inventory ci ON ci.sku = bc.component_sku
+		GROUP BY bc.bundle_sku
+	)`
+
+// returns the bill of materials of every given sku that is a bundle, skus without components are skipped
+func (r *InventorySQLRepository) GetBundleComponents(ctx context.Context, bundleSkus []string) ([]model.BundleComponent, error) {
+	query := `
+		SELECT bundle_sku, component_sku, quantity
+		FROM inventory_service.sku_bundle_components
+		WHERE bundle_sku = ANY($1)
+		ORDER BY bundle_sku, component_sku
+	`
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), bundleSkus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bundle components: %w", err)
+	}
+	defer rows.Close()
+
+	var components []model.BundleComponent
+	for rows.Next() {
+		var c model.BundleComponent
+		if err := rows.Scan(&c.BundleSku, &c.ComponentSku, &c.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan bundle component row: %w", err)
+		}
+		components = append(components, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return components, nil
+}
+
+// returns the bundles having the given sku in their bill of materials
+func (r *InventorySQLRepository) GetBundlesContainingSku(ctx context.Context, sku string) ([]string, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT bundle_sku FROM inventory_service.sku_bundle_components WHERE component_sku = $1 ORDER BY bundle_sku",
+		sku,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bundles by component: %w", err)
+	}
+	defer rows.Close()
+
+	var bundles []string
+	for rows.Next() {
+		var bundle string
+		if err := rows.Scan(&bundle); err != nil {
+			return nil, fmt.Errorf("failed to scan bundle row: %w", err)
+		}
+		bundles = append(bundles, bundle)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return bundles, nil
+}
+
+// returns the subset of skus that exist in the catalog
+func (r *InventorySQLRepository) FindExistingSkus(ctx context.Context, skus []string) (map[string]bool, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		"SELECT sku FROM inventory_service.skus WHERE sku = ANY($1)",
+		skus,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query skus: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool, len(skus))
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, fmt.Errorf("failed to scan sku row: %w", err)
+		}
+		existing[sku] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return existing, nil
+}
+
+// replaces the whole bill of materials of a bundle in a single transaction
+func (r *InventorySQLRepository) ReplaceBundleComponents(ctx context.Context, bundleSku string, components []model.BundleComponent) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		"DELETE FROM inventory_service.sku_bundle_components WHERE bundle_sku = $1",
+		bundleSku,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete bundle components: %w", err)
+	}
+
+	for _, c := range components {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.sku_bundle_components (bundle_sku, component_sku, quantity)
+			VALUES ($1, $2, $3)`,
+			bundleSku, c.ComponentSku, c.Quantity,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert bundle component %s: %w", c.ComponentSku, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// reserves quantity bundles by reserving every component in one transaction,
+// writes a BUNDLE history line for the bundle and a COMPONENT line per component
+func (r *InventorySQLRepository) ReserveBundle(ctx context.Context, orderId, bundleSku string, quantity float64) ([]model.BundleComponent, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// lock component stock rows in sku order so concurrent bundles sharing components cannot deadlock
+	query := `
+		SELECT
+			bc.component_sku,
+			bc.quantity,
+			s.default_uom,
+			(si.current_stock - si.reserved_stock) AS available_quantity
+		FROM inventory_service.sku_bundle_components bc
+		JOIN inventory_service.skus s ON s.sku = bc.component_sku
+		JOIN inventory_service.sku_inventory si ON si.sku = bc.component_sku
+		WHERE bc.bundle_sku = $1
+		ORDER BY bc.component_sku
+		FOR UPDATE OF si
+	`
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), bundleSku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bundle components: %w", err)
+	}
+
+	type componentStock struct {
+		component model.BundleComponent
+		uom       string
+		available float64
+	}
+
+	var stocks []componentStock
+	for rows.Next() {
+		cs := componentStock{component: model.BundleComponent{BundleSku: bundleSku}}
+		if err := rows.Scan(&cs.component.ComponentSku, &cs.component.Quantity, &cs.uom, &cs.available); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan bundle component row: %w", err)
+		}
+		stocks = append(stocks, cs)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	if len(stocks) == 0 {
+		return nil, fmt.Errorf("sku %s is not a bundle or its components have no inventory", bundleSku)
+	}
+
+	// validate every component before touching any of them
+	for _, cs := range stocks {
+		required := cs.component.Quantity * quantity
+		if cs.available < required {
+			return nil, fmt.Errorf("insufficient available quantity for SKU %s: requested %.2f, available %.2f",
+				cs.component.ComponentSku, required, cs.available)
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO inventory_service.reservation_history
+		(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type, bundle_sku)
+		SELECT gen_random_uuid(), $1, sku, $2, default_uom, $3, NOW(), NULL, $4, NULL
+		FROM inventory_service.skus WHERE sku = $5`,
+		orderId, quantity, model.ReservedStatus, model.ReservationLineBundle, bundleSku,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert reservation history: %w", err)
+	}
+
+	components := make([]model.BundleComponent, 0, len(stocks))
+	for _, cs := range stocks {
+		required := cs.component.Quantity * quantity
+
+		_, err = tx.Exec(ctx,
+			"UPDATE inventory_service.sku_inventory SET reserved_stock = reserved_stock + $1 WHERE sku = $2",
+			required, cs.component.ComponentSku,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve inventory: %w", err)
+		}
+
+		if err := insertStockMovement(ctx, tx, cs.component.ComponentSku, model.MovementReserve, 0, required, orderId); err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.reservation_history
+			(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type, bundle_sku)
+			VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, NOW(), NULL, $6, $7)`,
+			orderId, cs.component.ComponentSku, required, cs.uom, model.ReservedStatus, model.ReservationLineComponent, bundleSku,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert reservation history: %w", err)
+		}
+
+		components = append(components, cs.component)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// releases the RESERVED lines of an order in one transaction and marks them RELEASED,
+// pending backorders of the order are cancelled in the same transaction.
+// when skus is not empty only the stock and bundle lines of those skus, and the components of those
+// bundles, are released.
+// returns every sku whose lines were released or whose backorders were cancelled.
+func (r *InventorySQLRepository) ReleaseOrderReservations(ctx context.Context, orderId string, skus []string) ([]string, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cancelledSkus, err := cancelPendingBackorders(ctx, tx, orderId, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, sku, quantity, line_type
+		FROM inventory_service.reservation_history
+		WHERE order_id = $1 AND status = $2
+	`
+	args := []interface{}{orderId, model.ReservedStatus}
+	if len(skus) > 0 {
+		// a component line goes with its bundle, a plain sku that is also a component of a bundle on the
+		// order only releases its own line
+		query += " AND ((line_type <> $4 AND sku = ANY($3)) OR (line_type = $4 AND bundle_sku = ANY($3)))"
+		args = append(args, skus, model.ReservationLineComponent)
+	}
+	query += " FOR UPDATE"
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reservation history: %w", err)
+	}
+
+	var (
+		ids           []string
+		releasedSkus  []string
+		seen          = map[string]bool{}
+		quantityBySku = map[string]float64{}
+	)
+	for _, sku := range cancelledSkus {
+		if !seen[sku] {
+			seen[sku] = true
+			releasedSkus = append(releasedSkus, sku)
+		}
+	}
+	for rows.Next() {
+		var id, sku, lineType string
+		var quantity float64
+		if err := rows.Scan(&id, &sku, &quantity, &lineType); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan reservation history row: %w", err)
+		}
+
+		ids = append(ids, id)
+		if !seen[sku] {
+			seen[sku] = true
+			releasedSkus = append(releasedSkus, sku)
+		}
+
+		// bundle lines hold no stock, their components are released through their own lines
+		if lineType != model.ReservationLineBundle {
+			quantityBySku[sku] += quantity
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	if len(ids) == 0 {
+		if len(cancelledSkus) == 0 {
+			return nil, nil
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return releasedSkus, nil
+	}
+
+	// update stock rows in sku order to keep lock order stable
+	stockSkus := make([]string, 0, len(quantityBySku))
+	for sku := range quantityBySku {
+		stockSkus = append(stockSkus, sku)
+	}
+	sort.Strings(stockSkus)
+
+	for _, sku := range stockSkus {
+		quantity := quantityBySku[sku]
+		tag, err := tx.Exec(ctx,
+			`UPDATE inventory_service.sku_inventory
+			SET reserved_stock = reserved_stock - $1
+			WHERE sku = $2 AND reserved_stock >= $1`,
+			quantity, sku,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release inventory: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, fmt.Errorf("insufficient reserved quantity for SKU %s: requested to release %.2f", sku, quantity)
+		}
+
+		if err := insertStockMovement(ctx, tx, sku, model.MovementRelease, 0, -quantity, orderId); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		"UPDATE inventory_service.reservation_history SET status = $1, released_at = NOW() WHERE id = ANY($2)",
+		model.ReleasedStatus, ids,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update reservation history: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return releasedSkus, nil
+}
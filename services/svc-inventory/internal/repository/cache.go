@@ -0,0 +1,477 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/shared-libs/logger"
+	storage "ops-monorepo/shared-libs/storage/redis"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/robaho/fixed"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	cacheKeyPrefix = "inventory:sku"
+
+	defaultCacheMetadataTTL = 10 * time.Minute
+	defaultCacheQuantityTTL = 5 * time.Second
+	defaultCacheOpTimeout   = 100 * time.Millisecond
+
+	// generations must outlive any quantity filled before they were bumped
+	minCacheGenerationTTL = time.Hour
+)
+
+type CacheConfig struct {
+	// sku uom, price and currency rarely change
+	MetadataTTL time.Duration
+	// current and reserved stock change on every order, keep it short
+	QuantityTTL time.Duration
+	// upper bound of a single redis call before falling back to postgres
+	OpTimeout time.Duration
+}
+
+// cached sku metadata, stored separately from the fast changing quantities
+type skuMetadataCache struct {
+	Uom         string  `json:"uom"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"`
+	IsBundle    bool    `json:"is_bundle"`
+	Category    string  `json:"category_id"`
+	TaxCategory string  `json:"tax_category"`
+}
+
+// Generation is the sku generation read before the quantities were loaded, the entry is stale
+// once an invalidation bumped the generation past it
+type skuQuantityCache struct {
+	Total      float64 `json:"total"`
+	Reserved   float64 `json:"reserved"`
+	Generation int64   `json:"gen"`
+}
+
+type checkStockResult struct {
+	data        []model.StockStatus
+	missingSkus []string
+}
+
+// cachedInventoryRepository is a read-through cache in front of the sql repository.
+// every redis failure falls back to postgres so results stay correct when redis is down.
+type cachedInventoryRepository struct {
+	IInventorySQLRepository
+	rdb           *redis.Client
+	cfg           CacheConfig
+	generationTTL time.Duration
+	logger        logger.Logger
+	group         singleflight.Group
+}
+
+func NewCachedInventoryRepository(repo IInventorySQLRepository, rdb *storage.Redis, cfg CacheConfig, log logger.Logger) IInventorySQLRepository {
+	if cfg.MetadataTTL <= 0 {
+		cfg.MetadataTTL = defaultCacheMetadataTTL
+	}
+	if cfg.QuantityTTL <= 0 {
+		cfg.QuantityTTL = defaultCacheQuantityTTL
+	}
+	if cfg.OpTimeout <= 0 {
+		cfg.OpTimeout = defaultCacheOpTimeout
+	}
+
+	generationTTL := minCacheGenerationTTL
+	if 2*cfg.QuantityTTL > generationTTL {
+		generationTTL = 2 * cfg.QuantityTTL
+	}
+
+	return &cachedInventoryRepository{
+		IInventorySQLRepository: repo,
+		rdb:                     rdb.Client(),
+		cfg:                     cfg,
+		generationTTL:           generationTTL,
+		logger:                  log,
+	}
+}
+
+func metadataKey(sku string) string {
+	return fmt.Sprintf("%s:%s:meta", cacheKeyPrefix, sku)
+}
+
+func quantityKey(sku string) string {
+	return fmt.Sprintf("%s:%s:qty", cacheKeyPrefix, sku)
+}
+
+func generationKey(sku string) string {
+	return fmt.Sprintf("%s:%s:gen", cacheKeyPrefix, sku)
+}
+
+// checks the availability of multiple SKUs, reading from cache first
+func (c *cachedInventoryRepository) CheckStockWithMultipleSkus(ctx context.Context, skus []string) (data []model.StockStatus, missingSkus []string, err error) {
+	if len(skus) == 0 {
+		return c.IInventorySQLRepository.CheckStockWithMultipleSkus(ctx, skus)
+	}
+
+	cached, uncached, generations := c.getCachedStock(ctx, skus)
+	if len(uncached) == 0 {
+		return orderStockBySkus(skus, cached), []string{}, nil
+	}
+
+	loaded, loadErr := c.loadStock(ctx, uncached, generations)
+	if loadErr != nil && len(loaded.missingSkus) == 0 {
+		// database failure, not a missing sku
+		return nil, []string{}, loadErr
+	}
+
+	for _, item := range loaded.data {
+		cached[item.SKU] = item
+	}
+
+	return orderStockBySkus(skus, cached), loaded.missingSkus, loadErr
+}
+
+// loads uncached skus from postgres, concurrent callers asking for the same skus share one query.
+// generations were read before the query, quantities are cached under them so a fill racing an
+// invalidation is never served
+func (c *cachedInventoryRepository) loadStock(ctx context.Context, skus []string, generations map[string]int64) (*checkStockResult, error) {
+	sorted := append([]string{}, skus...)
+	sort.Strings(sorted)
+	flightKey := strings.Join(sorted, ",")
+
+	// detach from the caller so one cancelled request does not fail everyone waiting on the same key
+	loadCtx := context.WithoutCancel(ctx)
+
+	v, err, _ := c.group.Do(flightKey, func() (interface{}, error) {
+		data, missingSkus, err := c.IInventorySQLRepository.CheckStockWithMultipleSkus(loadCtx, sorted)
+		if data != nil {
+			c.setCachedStock(loadCtx, data, generations)
+		}
+		return &checkStockResult{data: data, missingSkus: missingSkus}, err
+	})
+
+	result, ok := v.(*checkStockResult)
+	if !ok {
+		result = &checkStockResult{}
+	}
+	return result, err
+}
+
+// returns cached stock for skus having both metadata and a current quantity, the list of skus to load
+// and the generation of every sku. generations are nil when redis could not be read
+func (c *cachedInventoryRepository) getCachedStock(ctx context.Context, skus []string) (map[string]model.StockStatus, []string, map[string]int64) {
+	result := make(map[string]model.StockStatus, len(skus))
+
+	keys := make([]string, 0, len(skus)*3)
+	for _, sku := range skus {
+		keys = append(keys, metadataKey(sku), quantityKey(sku), generationKey(sku))
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, c.cfg.OpTimeout)
+	defer cancel()
+
+	values, err := c.rdb.MGet(opCtx, keys...).Result()
+	if err != nil {
+		c.logger.Warnf("inventory cache read failed, falling back to database: %v", err)
+		return result, skus, nil
+	}
+
+	var uncached []string
+	generations := make(map[string]int64, len(skus))
+	for i, sku := range skus {
+		generations[sku] = decodeGeneration(values[i*3+2])
+
+		var meta skuMetadataCache
+		qty, fresh := decodeQuantity(values[i*3+1], generations[sku])
+		if !decodeCacheValue(values[i*3], &meta) || !fresh {
+			uncached = append(uncached, sku)
+			continue
+		}
+
+		result[sku] = model.StockStatus{
+			SKU:               sku,
+			TotalQuantity:     qty.Total,
+			ReservedQuantity:  qty.Reserved,
+			AvailableQuantity: qty.Total - qty.Reserved,
+			SKU_UOM:           meta.Uom,
+			SKUPrice:          meta.Price,
+			SKUCurrency:       meta.Currency,
+			IsBundle:          meta.IsBundle,
+			CategoryId:        meta.Category,
+			TaxCategory:       meta.TaxCategory,
+		}
+	}
+
+	return result, uncached, generations
+}
+
+// quantities are only cached for skus with a known generation
+func (c *cachedInventoryRepository) setCachedStock(ctx context.Context, stocks []model.StockStatus, generations map[string]int64) {
+	opCtx, cancel := context.WithTimeout(ctx, c.cfg.OpTimeout)
+	defer cancel()
+
+	pipe := c.rdb.Pipeline()
+	for _, s := range stocks {
+		meta, _ := json.Marshal(skuMetadataCache{Uom: s.SKU_UOM, Price: s.SKUPrice, Currency: s.SKUCurrency, IsBundle: s.IsBundle, Category: s.CategoryId, TaxCategory: s.TaxCategory})
+		pipe.Set(opCtx, metadataKey(s.SKU), meta, withJitter(c.cfg.MetadataTTL))
+
+		generation, ok := generations[s.SKU]
+		if !ok {
+			continue
+		}
+		qty, _ := json.Marshal(skuQuantityCache{Total: s.TotalQuantity, Reserved: s.ReservedQuantity, Generation: generation})
+		pipe.Set(opCtx, quantityKey(s.SKU), qty, withJitter(c.cfg.QuantityTTL))
+	}
+
+	if _, err := pipe.Exec(opCtx); err != nil {
+		c.logger.Warnf("inventory cache write failed: %v", err)
+	}
+}
+
+// drops cached quantities once a stock change is committed, metadata is kept. the quantities of bundles
+// built from the skus go too, and bumped generations keep a fill loaded before the change out of the cache
+func (c *cachedInventoryRepository) invalidateQuantities(ctx context.Context, skus ...string) {
+	ctx = context.WithoutCancel(ctx)
+	skus = c.withBundles(ctx, skus)
+
+	opCtx, cancel := context.WithTimeout(ctx, c.cfg.OpTimeout)
+	defer cancel()
+
+	pipe := c.rdb.Pipeline()
+	for _, sku := range skus {
+		pipe.Incr(opCtx, generationKey(sku))
+		pipe.Expire(opCtx, generationKey(sku), c.generationTTL)
+		pipe.Del(opCtx, quantityKey(sku))
+	}
+
+	// on failure the entry still expires after QuantityTTL
+	if _, err := pipe.Exec(opCtx); err != nil {
+		c.logger.Warnf("inventory cache invalidation failed for %v: %v", skus, err)
+	}
+}
+
+// adds the bundles having any of skus in their bill of materials, a bundle's availability follows its components
+func (c *cachedInventoryRepository) withBundles(ctx context.Context, skus []string) []string {
+	seen := make(map[string]bool, len(skus))
+	result := make([]string, 0, len(skus))
+	for _, sku := range skus {
+		if !seen[sku] {
+			seen[sku] = true
+			result = append(result, sku)
+		}
+	}
+
+	for _, sku := range skus {
+		bundles, err := c.IInventorySQLRepository.GetBundlesContainingSku(ctx, sku)
+		if err != nil {
+			// their entries still expire after QuantityTTL
+			c.logger.Warnf("inventory cache failed to look up bundles containing %s: %v", sku, err)
+			continue
+		}
+		for _, bundle := range bundles {
+			if !seen[bundle] {
+				seen[bundle] = true
+				result = append(result, bundle)
+			}
+		}
+	}
+	return result
+}
+
+// drops both metadata and quantities, used when prices or sku definitions change
+func (c *cachedInventoryRepository) InvalidateSkus(ctx context.Context, skus ...string) {
+	keys := make([]string, 0, len(skus))
+	for _, sku := range skus {
+		keys = append(keys, metadataKey(sku))
+	}
+
+	opCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.OpTimeout)
+	defer cancel()
+
+	if err := c.rdb.Del(opCtx, keys...).Err(); err != nil {
+		c.logger.Warnf("inventory cache invalidation failed for %v: %v", skus, err)
+	}
+
+	c.invalidateQuantities(ctx, skus...)
+}
+
+func (c *cachedInventoryRepository) ReserveStock(ctx context.Context, orderId, sku string, quantity float64) error {
+	if err := c.IInventorySQLRepository.ReserveStock(ctx, orderId, sku, quantity); err != nil {
+		return err
+	}
+	c.invalidateQuantities(ctx, sku)
+	return nil
+}
+
+func (c *cachedInventoryRepository) ReserveStockOptimistic(ctx context.Context, orderId, sku string, quantity float64) error {
+	if err := c.IInventorySQLRepository.ReserveStockOptimistic(ctx, orderId, sku, quantity); err != nil {
+		return err
+	}
+	c.invalidateQuantities(ctx, sku)
+	return nil
+}
+
+func (c *cachedInventoryRepository) ReserveStockWithBackorder(ctx context.Context, orderId, sku string, quantity float64) (*model.Backorder, error) {
+	backorder, err := c.IInventorySQLRepository.ReserveStockWithBackorder(ctx, orderId, sku, quantity)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateQuantities(ctx, sku)
+	return backorder, nil
+}
+
+func (c *cachedInventoryRepository) ReserveAvailableStock(ctx context.Context, orderId, sku string, quantity float64) (float64, error) {
+	reserved, err := c.IInventorySQLRepository.ReserveAvailableStock(ctx, orderId, sku, quantity)
+	if err != nil {
+		return 0, err
+	}
+	if reserved > 0 {
+		c.invalidateQuantities(ctx, sku)
+	}
+	return reserved, nil
+}
+
+func (c *cachedInventoryRepository) ReleaseStock(ctx context.Context, sku string, quantity float64) error {
+	if err := c.IInventorySQLRepository.ReleaseStock(ctx, sku, quantity); err != nil {
+		return err
+	}
+	c.invalidateQuantities(ctx, sku)
+	return nil
+}
+
+func (c *cachedInventoryRepository) IncrementInventory(ctx context.Context, sku string, adjustment fixed.Fixed) error {
+	if err := c.IInventorySQLRepository.IncrementInventory(ctx, sku, adjustment); err != nil {
+		return err
+	}
+	c.invalidateQuantities(ctx, sku)
+	return nil
+}
+
+func (c *cachedInventoryRepository) ReserveBundle(ctx context.Context, orderId, bundleSku string, quantity float64) ([]model.BundleComponent, error) {
+	components, err := c.IInventorySQLRepository.ReserveBundle(ctx, orderId, bundleSku, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := []string{bundleSku}
+	for _, component := range components {
+		skus = append(skus, component.ComponentSku)
+	}
+	c.invalidateQuantities(ctx, skus...)
+	return components, nil
+}
+
+func (c *cachedInventoryRepository) ReleaseOrderReservations(ctx context.Context, orderId string, skus []string) ([]string, error) {
+	releasedSkus, err := c.IInventorySQLRepository.ReleaseOrderReservations(ctx, orderId, skus)
+	if err != nil {
+		return nil, err
+	}
+	if len(releasedSkus) > 0 {
+		c.invalidateQuantities(ctx, releasedSkus...)
+	}
+	return releasedSkus, nil
+}
+
+func (c *cachedInventoryRepository) AmendOrderReservations(ctx context.Context, orderId string, deltas map[string]float64) error {
+	if err := c.IInventorySQLRepository.AmendOrderReservations(ctx, orderId, deltas); err != nil {
+		return err
+	}
+
+	skus := make([]string, 0, len(deltas))
+	for sku := range deltas {
+		skus = append(skus, sku)
+	}
+	c.invalidateQuantities(ctx, skus...)
+	return nil
+}
+
+func (c *cachedInventoryRepository) RestockReturn(ctx context.Context, returnId string, quantities map[string]float64) (bool, error) {
+	restocked, err := c.IInventorySQLRepository.RestockReturn(ctx, returnId, quantities)
+	if err != nil || !restocked {
+		return restocked, err
+	}
+
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	c.invalidateQuantities(ctx, skus...)
+	return true, nil
+}
+
+func (c *cachedInventoryRepository) CommitReservation(ctx context.Context, orderId, commitId string, quantities map[string]float64) (map[string]float64, bool, error) {
+	consumed, committed, err := c.IInventorySQLRepository.CommitReservation(ctx, orderId, commitId, quantities)
+	if err != nil || !committed {
+		return consumed, committed, err
+	}
+
+	skus := make([]string, 0, len(consumed)+len(quantities))
+	for sku := range consumed {
+		skus = append(skus, sku)
+	}
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	c.invalidateQuantities(ctx, skus...)
+	return consumed, true, nil
+}
+
+// a new bill of materials changes the bundle quantities and whether the sku is a bundle at all
+func (c *cachedInventoryRepository) ReplaceBundleComponents(ctx context.Context, bundleSku string, components []model.BundleComponent) error {
+	if err := c.IInventorySQLRepository.ReplaceBundleComponents(ctx, bundleSku, components); err != nil {
+		return err
+	}
+	c.InvalidateSkus(ctx, bundleSku)
+	return nil
+}
+
+// a cached quantity is only fresh when it was loaded under the current generation of its sku
+func decodeQuantity(value interface{}, generation int64) (skuQuantityCache, bool) {
+	var qty skuQuantityCache
+	if !decodeCacheValue(value, &qty) {
+		return skuQuantityCache{}, false
+	}
+	return qty, qty.Generation == generation
+}
+
+// a sku never invalidated has generation 0
+func decodeGeneration(value interface{}) int64 {
+	raw, ok := value.(string)
+	if !ok {
+		return 0
+	}
+	generation, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+func decodeCacheValue(value interface{}, dst interface{}) bool {
+	raw, ok := value.(string)
+	if !ok || raw == "" {
+		return false
+	}
+	return json.Unmarshal([]byte(raw), dst) == nil
+}
+
+// spreads expirations so hot skus cached together do not expire together
+func withJitter(ttl time.Duration) time.Duration {
+	jitter := ttl / 10
+	if jitter <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(int64(jitter)))
+}
+
+func orderStockBySkus(skus []string, stocks map[string]model.StockStatus) []model.StockStatus {
+	var results []model.StockStatus
+	for _, sku := range skus {
+		if s, ok := stocks[sku]; ok {
+			results = append(results, s)
+		}
+	}
+	return results
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeQuantity(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         interface{}
+		Generation    int64
+		ExpectedFresh bool
+	}{
+		{
+			Name:          "loaded under the current generation",
+			Value:         `{"total":10,"reserved":4,"gen":3}`,
+			Generation:    3,
+			ExpectedFresh: true,
+		},
+		{
+			Name:          "sku never invalidated",
+			Value:         `{"total":10,"reserved":4,"gen":0}`,
+			Generation:    0,
+			ExpectedFresh: true,
+		},
+		{
+			// the miss read generation 3, an order reserved and bumped it before the fill landed
+			Name:       "filled after a concurrent invalidation",
+			Value:      `{"total":10,"reserved":4,"gen":3}`,
+			Generation: 4,
+		},
+		{
+			Name:       "entry written before generations",
+			Value:      `{"total":10,"reserved":4}`,
+			Generation: 1,
+		},
+		{
+			Name:       "missing entry",
+			Value:      nil,
+			Generation: 0,
+		},
+		{
+			Name:       "corrupt entry",
+			Value:      "{",
+			Generation: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			qty, fresh := decodeQuantity(tc.Value, tc.Generation)
+
+			assert.Equal(t, tc.ExpectedFresh, fresh)
+			if tc.ExpectedFresh {
+				assert.Equal(t, skuQuantityCache{Total: 10, Reserved: 4, Generation: tc.Generation}, qty)
+			}
+		})
+	}
+}
+
+func TestDecodeGeneration(t *testing.T) {
+	assert.Equal(t, int64(0), decodeGeneration(nil))
+	assert.Equal(t, int64(0), decodeGeneration("not a number"))
+	assert.Equal(t, int64(7), decodeGeneration("7"))
+}
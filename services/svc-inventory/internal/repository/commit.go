@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"sort"
+)
+
+// ErrCommitExceedsReserved means a commit consumes more of a sku than the order still holds
+var ErrCommitExceedsReserved = errors.New("commit exceeds the reserved quantity of the order")
+
+// quantities below this are rounding left overs of float arithmetic
+const commitTolerance = 1e-6
+
+type reservedLine struct {
+	id        string
+	sku       string
+	quantity  float64
+	lineType  string
+	bundleSku string
+}
+
+// CommitReservation consumes RESERVED lines of an order in one transaction: the lines become CONSUMED and
+// current_stock and reserved_stock of their skus go down by the quantity, with a CONSUME movement referencing
+// commitId. quantities lists the order skus to consume, oldest lines first, a line consumed in part is split
+// and the rest stays reserved with its original age. a bundle consumes the same share of each of its
+// component lines. an empty quantities consumes everything the order holds. a commit id that already has
+// CONSUME movements is not committed again, committed is then false and consumed holds what it consumed
+func (r *InventorySQLRepository) CommitReservation(ctx context.Context, orderId, commitId string, quantities map[string]float64) (consumed map[string]float64, committed bool, err error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('inventory_service.commit:' || $1))", commitId); err != nil {
+		return nil, false, fmt.Errorf("failed to lock commit: %w", err)
+	}
+
+	consumed, err = committedQuantities(ctx, tx, commitId)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(consumed) > 0 {
+		return consumed, false, nil
+	}
+
+	lines, err := lockReservedLines(ctx, tx, orderId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	plan, err := planCommit(lines, quantities)
+	if err != nil {
+		return nil, false, err
+	}
+
+	consumed = map[string]float64{}
+	for _, l := range lines {
+		quantity, ok := plan[l.id]
+		if !ok {
+			continue
+		}
+		if err := consumeLine(ctx, tx, l, quantity); err != nil {
+			return nil, false, err
+		}
+		// bundle lines hold no stock, their components are consumed through their own lines
+		if l.lineType != model.ReservationLineBundle {
+			consumed[l.sku] += quantity
+		}
+	}
+
+	// update stock rows in sku order to keep lock order stable
+	skus := make([]string, 0, len(consumed))
+	for sku := range consumed {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	for _, sku := range skus {
+		quantity := consumed[sku]
+		tag, err := tx.Exec(ctx,
+			`UPDATE inventory_service.sku_inventory
+			SET current_stock = current_stock - $1, reserved_stock = reserved_stock - $1, last_stock_update = NOW()
+			WHERE sku = $2 AND reserved_stock >= $1 AND current_stock >= $1`,
+			quantity, sku,
+		)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to consume inventory: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return nil, false, fmt.Errorf("insufficient reserved quantity for SKU %s: requested to consume %.2f", sku, quantity)
+		}
+
+		if err := insertStockMovement(ctx, tx, sku, model.MovementConsume, -quantity, -quantity, commitId); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return consumed, true, nil
+}
+
+// quantities consumed by an earlier commit with the same id, per sku
+func committedQuantities(ctx context.Context, tx sql.PgxTx, commitId string) (map[string]float64, error) {
+	rows, err := tx.Query(ctx,
+		`SELECT sku, -SUM(current_delta)
+		FROM inventory_service.stock_movements
+		WHERE movement_type = $1 AND reference = $2
+		GROUP BY sku`,
+		model.MovementConsume, commitId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check commit movements: %w", err)
+	}
+	defer rows.Close()
+
+	consumed := map[string]float64{}
+	for rows.Next() {
+		var sku string
+		var quantity float64
+		if err := rows.Scan(&sku, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan commit movement row: %w", err)
+		}
+		consumed[sku] = quantity
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+	return consumed, nil
+}
+
+// RESERVED lines of an order, oldest first, locked until tx ends
+func lockReservedLines(ctx context.Context, tx sql.PgxTx, orderId string) ([]reservedLine, error) {
+	query := `
+		SELECT id, sku, quantity, line_type, COALESCE(bundle_sku, '')
+		FROM inventory_service.reservation_history
+		WHERE order_id = $1 AND status = $2
+		ORDER BY reserved_at, id
+		FOR UPDATE
+	`
+
+	rows, err := tx.Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), orderId, model.ReservedStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reservation history: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []reservedLine
+	for rows.Next() {
+		var l reservedLine
+		if err := rows.Scan(&l.id, &l.sku, &l.quantity, &l.lineType, &l.bundleSku); err != nil {
+			return nil, fmt.Errorf("failed to scan reservation history row: %w", err)
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+	return lines, nil
+}
+
+// quantity to consume per line id. a plain sku consumes its STOCK lines, a bundle its BUNDLE lines and
+// the same share of every component line reserved for it
+func planCommit(lines []reservedLine, quantities map[string]float64) (map[string]float64, error) {
+	plan := map[string]float64{}
+	if len(quantities) == 0 {
+		for _, l := range lines {
+			plan[l.id] = l.quantity
+		}
+		return plan, nil
+	}
+
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	for _, sku := range skus {
+		quantity := quantities[sku]
+
+		var stock, bundle []reservedLine
+		components := map[string][]reservedLine{}
+		for _, l := range lines {
+			switch {
+			case l.lineType == model.ReservationLineStock && l.sku == sku:
+				stock = append(stock, l)
+			case l.lineType == model.ReservationLineBundle && l.sku == sku:
+				bundle = append(bundle, l)
+			case l.lineType == model.ReservationLineComponent && l.bundleSku == sku:
+				components[l.sku] = append(components[l.sku], l)
+			}
+		}
+
+		if len(bundle) == 0 {
+			if err := planOldestFirst(plan, sku, stock, quantity); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		reserved := sumLines(bundle)
+		if err := planOldestFirst(plan, sku, bundle, quantity); err != nil {
+			return nil, err
+		}
+		for component, componentLines := range components {
+			share := sumLines(componentLines) * quantity / reserved
+			if err := planOldestFirst(plan, component, componentLines, share); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return plan, nil
+}
+
+func planOldestFirst(plan map[string]float64, sku string, lines []reservedLine, quantity float64) error {
+	reserved := sumLines(lines)
+	if reserved+commitTolerance < quantity {
+		return fmt.Errorf("%w: sku %s holds %.2f, requested to consume %.2f", ErrCommitExceedsReserved, sku, reserved, quantity)
+	}
+
+	remaining := quantity
+	for _, l := range lines {
+		if remaining <= commitTolerance {
+			break
+		}
+		take := l.quantity
+		if take > remaining {
+			take = remaining
+		}
+		plan[l.id] = take
+		remaining -= take
+	}
+	return nil
+}
+
+func sumLines(lines []reservedLine) float64 {
+	var total float64
+	for _, l := range lines {
+		total += l.quantity
+	}
+	return total
+}
+
+// marks a line CONSUMED with the consumed quantity, the rest of a line consumed in part is reserved again
+// as a new line keeping the age of the original
+func consumeLine(ctx context.Context, tx sql.PgxTx, l reservedLine, quantity float64) error {
+	_, err := tx.Exec(ctx,
+		"UPDATE inventory_service.reservation_history SET status = $1, quantity = $2, released_at = NOW() WHERE id = $3",
+		model.ConsumedStatus, quantity, l.id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update reservation history: %w", err)
+	}
+
+	if l.quantity-quantity <= commitTolerance {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO inventory_service.reservation_history
+		(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type, bundle_sku)
+		SELECT gen_random_uuid(), order_id, sku, $1, uom, $2, reserved_at, NULL, line_type, bundle_sku
+		FROM inventory_service.reservation_history WHERE id = $3`,
+		l.quantity-quantity, model.ReservedStatus, l.id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to split reservation history: %w", err)
+	}
+	return nil
+}
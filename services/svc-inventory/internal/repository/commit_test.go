@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ops-monorepo/services/svc-inventory/internal/model"
+)
+
+// lines of an order holding 5 MUG-BLUE in two lines and one GIFT-BOX of a mug and two tea bags, oldest first
+var orderLines = []reservedLine{
+	{id: "line-1", sku: "MUG-BLUE", quantity: 3, lineType: model.ReservationLineStock},
+	{id: "line-2", sku: "MUG-BLUE", quantity: 2, lineType: model.ReservationLineStock},
+	{id: "line-3", sku: "GIFT-BOX", quantity: 2, lineType: model.ReservationLineBundle},
+	{id: "line-4", sku: "MUG-BLUE", quantity: 2, lineType: model.ReservationLineComponent, bundleSku: "GIFT-BOX"},
+	{id: "line-5", sku: "TEA-BAG", quantity: 4, lineType: model.ReservationLineComponent, bundleSku: "GIFT-BOX"},
+}
+
+// what stays RESERVED after a plan is consumed, a line consumed in part keeps the rest under a new id
+func remainingLines(lines []reservedLine, plan map[string]float64) []reservedLine {
+	var remaining []reservedLine
+	for _, l := range lines {
+		quantity, ok := plan[l.id]
+		if !ok {
+			remaining = append(remaining, l)
+			continue
+		}
+		if l.quantity-quantity > commitTolerance {
+			rest := l
+			rest.id = l.id + "-rest"
+			rest.quantity = l.quantity - quantity
+			remaining = append(remaining, rest)
+		}
+	}
+	return remaining
+}
+
+func TestPlanCommit(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Quantities map[string]float64
+		Expected   map[string]float64
+		Err        error
+	}{
+		{
+			Name:     "empty quantities consume the whole order",
+			Expected: map[string]float64{"line-1": 3, "line-2": 2, "line-3": 2, "line-4": 2, "line-5": 4},
+		},
+		{
+			Name:       "oldest line is consumed first",
+			Quantities: map[string]float64{"MUG-BLUE": 3},
+			Expected:   map[string]float64{"line-1": 3},
+		},
+		{
+			Name:       "line is consumed in part",
+			Quantities: map[string]float64{"MUG-BLUE": 4},
+			Expected:   map[string]float64{"line-1": 3, "line-2": 1},
+		},
+		{
+			Name:       "component lines of a bundle are not consumed by their plain sku",
+			Quantities: map[string]float64{"MUG-BLUE": 5},
+			Expected:   map[string]float64{"line-1": 3, "line-2": 2},
+		},
+		{
+			Name:       "bundle consumes the same share of its components",
+			Quantities: map[string]float64{"GIFT-BOX": 1},
+			Expected:   map[string]float64{"line-3": 1, "line-4": 1, "line-5": 2},
+		},
+		{
+			Name:       "more than is reserved",
+			Quantities: map[string]float64{"MUG-BLUE": 6},
+			Err:        ErrCommitExceedsReserved,
+		},
+		{
+			Name:       "more bundles than are reserved",
+			Quantities: map[string]float64{"GIFT-BOX": 3},
+			Err:        ErrCommitExceedsReserved,
+		},
+		{
+			Name:       "sku the order doesn't hold",
+			Quantities: map[string]float64{"TEA-BAG": 1},
+			Err:        ErrCommitExceedsReserved,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			plan, err := planCommit(orderLines, tc.Quantities)
+
+			if tc.Err != nil {
+				assert.ErrorIs(t, err, tc.Err)
+				assert.Nil(t, plan)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, plan)
+		})
+	}
+}
+
+func TestPlanCommit_PartialThenRest(t *testing.T) {
+	first, err := planCommit(orderLines, map[string]float64{"MUG-BLUE": 4, "GIFT-BOX": 0.5})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"line-1": 3, "line-2": 1, "line-3": 0.5, "line-4": 0.5, "line-5": 1}, first)
+
+	remaining := remainingLines(orderLines, first)
+
+	t.Run("rest is consumed", func(t *testing.T) {
+		rest, err := planCommit(remaining, map[string]float64{"MUG-BLUE": 1, "GIFT-BOX": 1.5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"line-2-rest": 1, "line-3-rest": 1.5, "line-4-rest": 1.5, "line-5-rest": 3}, rest)
+		assert.Empty(t, remainingLines(remaining, rest))
+	})
+
+	t.Run("empty quantities consume the rest", func(t *testing.T) {
+		rest, err := planCommit(remaining, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"line-2-rest": 1, "line-3-rest": 1.5, "line-4-rest": 1.5, "line-5-rest": 3}, rest)
+	})
+
+	t.Run("rest can't consume what the first commit took", func(t *testing.T) {
+		rest, err := planCommit(remaining, map[string]float64{"MUG-BLUE": 2})
+
+		assert.ErrorIs(t, err, ErrCommitExceedsReserved)
+		assert.Nil(t, rest)
+	})
+}
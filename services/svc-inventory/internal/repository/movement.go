@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+)
+
+// appends a movement in the same transaction as the stock change it describes
+func insertStockMovement(ctx context.Context, tx sql.PgxTx, sku, movementType string, currentDelta, reservedDelta interface{}, reference string) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO inventory_service.stock_movements (sku, movement_type, current_delta, reserved_delta, reference)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))`,
+		sku, movementType, currentDelta, reservedDelta, reference,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert stock movement: %w", err)
+	}
+	return nil
+}
+
+// rebuilds current and reserved stock at asOf from the latest snapshot taken at or before asOf
+// plus the movements since, skus without any movement are returned with zero quantities.
+// a snapshot holds every movement before its as_of, so movements at as_of or later are applied
+func (r *InventorySQLRepository) GetStockAsOf(ctx context.Context, skus []string, asOf time.Time) ([]model.StockPosition, error) {
+	query := `
+		WITH base AS (
+			SELECT DISTINCT ON (sku)
+				sku, as_of, current_stock, reserved_stock
+			FROM inventory_service.stock_snapshots
+			WHERE sku = ANY($1) AND as_of <= $2
+			ORDER BY sku, as_of DESC
+		)
+		SELECT
+			s.sku,
+			COALESCE(b.current_stock, 0) + COALESCE(SUM(m.current_delta), 0) AS current_stock,
+			COALESCE(b.reserved_stock, 0) + COALESCE(SUM(m.reserved_delta), 0) AS reserved_stock,
+			b.as_of
+		FROM inventory_service.skus s
+		LEFT JOIN base b ON b.sku = s.sku
+		LEFT JOIN inventory_service.stock_movements m
+			ON m.sku = s.sku
+			AND m.occurred_at <= $2
+			AND (b.as_of IS NULL OR m.occurred_at >= b.as_of)
+		WHERE s.sku = ANY($1)
+		GROUP BY s.sku, b.as_of, b.current_stock, b.reserved_stock
+		ORDER BY s.sku
+	`
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), skus, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock as of: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []model.StockPosition
+	for rows.Next() {
+		var p model.StockPosition
+		if err := rows.Scan(&p.Sku, &p.CurrentQuantity, &p.ReservedQuantity, &p.SnapshotAsOf); err != nil {
+			return nil, fmt.Errorf("failed to scan stock position row: %w", err)
+		}
+		p.AvailableQuantity = p.CurrentQuantity - p.ReservedQuantity
+		positions = append(positions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return positions, nil
+}
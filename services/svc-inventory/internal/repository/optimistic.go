@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+)
+
+// ErrVersionConflict means the inventory row changed between reading and updating it, the caller may retry
+var ErrVersionConflict = errors.New("inventory changed concurrently")
+
+// reserves inventory for a single sku without locking the row while availability is checked.
+// the update only applies when the row still has the version that was read, a trigger bumps
+// the version on every update of sku_inventory. returns ErrVersionConflict when it lost the race
+func (r *InventorySQLRepository) ReserveStockOptimistic(ctx context.Context, orderId, sku string, quantity float64) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		available float64
+		version   int64
+	)
+	err = tx.QueryRow(ctx,
+		"SELECT (current_stock - reserved_stock), version FROM inventory_service.sku_inventory WHERE sku = $1",
+		sku,
+	).Scan(&available, &version)
+
+	if err != nil {
+		return fmt.Errorf("failed to check available quantity: %w", err)
+	}
+
+	if available < quantity {
+		return fmt.Errorf("insufficient available quantity for SKU %s: requested %.2f, available %.2f",
+			sku, quantity, available)
+	}
+
+	tag, err := tx.Exec(ctx,
+		"UPDATE inventory_service.sku_inventory SET reserved_stock = reserved_stock + $1 WHERE sku = $2 AND version = $3",
+		quantity, sku, version,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reserve inventory: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrVersionConflict
+	}
+
+	if err := insertStockMovement(ctx, tx, sku, model.MovementReserve, 0, quantity, orderId); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO inventory_service.reservation_history
+		(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type)
+		VALUES (gen_random_uuid(), $1, $2, $3, 'EA', $4, NOW(), NULL, $5)`,
+		orderId, sku, quantity, model.ReservedStatus, model.ReservationLineStock,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert reservation history: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// reserves what is available of a single sku, at most quantity, and returns the reserved quantity.
+// the inventory row is locked while availability is checked, nothing is written when none is available
+func (r *InventorySQLRepository) ReserveAvailableStock(ctx context.Context, orderId, sku string, quantity float64) (float64, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var available float64
+	err = tx.QueryRow(ctx,
+		"SELECT (current_stock - reserved_stock) FROM inventory_service.sku_inventory WHERE sku = $1 FOR UPDATE",
+		sku,
+	).Scan(&available)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check available quantity: %w", err)
+	}
+
+	reserve := math.Min(math.Max(available, 0), quantity)
+	if reserve <= 0 {
+		return 0, nil
+	}
+
+	if err := reserveLockedStock(ctx, tx, orderId, sku, reserve); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return reserve, nil
+}
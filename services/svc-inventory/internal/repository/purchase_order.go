@@ -0,0 +1,382 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"sort"
+	"time"
+)
+
+var (
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrPurchaseOrderStatus   = errors.New("purchase order status does not allow this operation")
+	ErrInvalidReceipt        = errors.New("receipt does not match an open purchase order line")
+)
+
+type IPurchaseOrderSQLRepository interface {
+	GetReplenishmentCandidates(ctx context.Context, skus []string, demandSince time.Time, lookbackDays, leadTimeDays int) ([]model.ReplenishmentCandidate, error)
+
+	CreatePurchaseOrder(ctx context.Context, po model.PurchaseOrder) (string, error)
+	GetPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, status string, limit int) ([]model.PurchaseOrder, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, id, fromStatus, toStatus string) error
+	ReceivePurchaseOrder(ctx context.Context, id string, quantities map[string]float64) error
+}
+
+type PurchaseOrderSQLRepository struct {
+	Pgx *sql.PostgresPgx
+}
+
+func NewPurchaseOrderRepository(pgx *sql.PostgresPgx) IPurchaseOrderSQLRepository {
+	return &PurchaseOrderSQLRepository{
+		Pgx: pgx,
+	}
+}
+
+// returns active skus whose position (current - reserved + open purchase orders) is below
+// min_stock_level plus the average daily demand of the lookback window times the lead time.
+// demand is every reserved quantity in reservation_history since demandSince, bundle lines excluded
+// since their components carry the demand. bundles hold no stock and are never candidates.
+func (r *PurchaseOrderSQLRepository) GetReplenishmentCandidates(ctx context.Context, skus []string, demandSince time.Time, lookbackDays, leadTimeDays int) ([]model.ReplenishmentCandidate, error) {
+	query := fmt.Sprintf(`
+		WITH demand AS (
+			SELECT sku, SUM(quantity) AS quantity
+			FROM inventory_service.reservation_history
+			WHERE reserved_at >= $1 AND line_type <> '%s'
+			GROUP BY sku
+		), on_order AS (
+			SELECT l.sku, SUM(l.ordered_quantity - l.received_quantity) AS quantity
+			FROM inventory_service.purchase_order_lines l
+			JOIN inventory_service.purchase_orders po ON po.id = l.purchase_order_id
+			WHERE po.status IN ('%s', '%s', '%s')
+			GROUP BY l.sku
+		), positions AS (
+			SELECT
+				si.sku,
+				si.current_stock,
+				si.reserved_stock,
+				COALESCE(oo.quantity, 0) AS on_order,
+				si.min_stock_level,
+				si.max_stock_level,
+				COALESCE(d.quantity, 0) / $2::numeric AS avg_daily_demand
+			FROM inventory_service.sku_inventory si
+			JOIN inventory_service.skus s ON s.sku = si.sku
+			JOIN inventory_service.products p ON p.id = s.product_id
+			LEFT JOIN demand d ON d.sku = si.sku
+			LEFT JOIN on_order oo ON oo.sku = si.sku
+			WHERE s.is_active AND NOT p.discontinued
+				AND NOT EXISTS (SELECT 1 FROM inventory_service.sku_bundle_components bc WHERE bc.bundle_sku = si.sku)
+				AND (cardinality($4::text[]) = 0 OR si.sku = ANY($4))
+		)
+		SELECT
+			sku,
+			current_stock,
+			reserved_stock,
+			on_order,
+			min_stock_level,
+			max_stock_level,
+			avg_daily_demand,
+			min_stock_level + avg_daily_demand * $3 AS reorder_point
+		FROM positions
+		WHERE current_stock - reserved_stock + on_order < min_stock_level + avg_daily_demand * $3
+		ORDER BY sku
+	`, model.ReservationLineBundle, model.PurchaseOrderDraft, model.PurchaseOrderSubmitted, model.PurchaseOrderPartiallyReceived)
+
+	if skus == nil {
+		skus = []string{}
+	}
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), demandSince, lookbackDays, leadTimeDays, skus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query replenishment candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []model.ReplenishmentCandidate
+	for rows.Next() {
+		var c model.ReplenishmentCandidate
+		err := rows.Scan(
+			&c.Sku,
+			&c.CurrentStock,
+			&c.ReservedStock,
+			&c.OnOrder,
+			&c.MinStockLevel,
+			&c.MaxStockLevel,
+			&c.AvgDailyDemand,
+			&c.ReorderPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan replenishment candidate row: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// inserts the purchase order and its lines in a single transaction and returns the new id
+func (r *PurchaseOrderSQLRepository) CreatePurchaseOrder(ctx context.Context, po model.PurchaseOrder) (string, error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id string
+	err = tx.QueryRow(ctx,
+		`INSERT INTO inventory_service.purchase_orders (id, status, supplier, created_at, updated_at)
+		VALUES (gen_random_uuid(), $1, $2, NOW(), NOW())
+		RETURNING id`,
+		po.Status, po.Supplier,
+	).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert purchase order: %w", err)
+	}
+
+	for _, line := range po.Lines {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.purchase_order_lines
+			(id, purchase_order_id, sku, ordered_quantity, received_quantity, reorder_point, avg_daily_demand)
+			VALUES (gen_random_uuid(), $1, $2, $3, 0, $4, $5)`,
+			id, line.Sku, line.OrderedQuantity, line.ReorderPoint, line.AvgDailyDemand,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to insert purchase order line %s: %w", line.Sku, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PurchaseOrderSQLRepository) GetPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error) {
+	var po model.PurchaseOrder
+	err := r.Pgx.Pool().QueryRow(ctx,
+		`SELECT id, status, supplier, created_at, updated_at, submitted_at, received_at
+		FROM inventory_service.purchase_orders WHERE id = $1`,
+		id,
+	).Scan(&po.Id, &po.Status, &po.Supplier, &po.CreatedAt, &po.UpdatedAt, &po.SubmittedAt, &po.ReceivedAt)
+	if err != nil {
+		if errors.Is(err, sql.PgxErrNoRows) {
+			return nil, ErrPurchaseOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get purchase order: %w", err)
+	}
+
+	lines, err := r.getPurchaseOrderLines(ctx, []string{po.Id})
+	if err != nil {
+		return nil, err
+	}
+	po.Lines = lines[po.Id]
+
+	return &po, nil
+}
+
+// lists purchase orders newest first, every status when status is empty
+func (r *PurchaseOrderSQLRepository) ListPurchaseOrders(ctx context.Context, status string, limit int) ([]model.PurchaseOrder, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		`SELECT id, status, supplier, created_at, updated_at, submitted_at, received_at
+		FROM inventory_service.purchase_orders
+		WHERE ($1::text = '' OR status = $1)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2`,
+		status, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		orders []model.PurchaseOrder
+		ids    []string
+	)
+	for rows.Next() {
+		var po model.PurchaseOrder
+		if err := rows.Scan(&po.Id, &po.Status, &po.Supplier, &po.CreatedAt, &po.UpdatedAt, &po.SubmittedAt, &po.ReceivedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order row: %w", err)
+		}
+		orders = append(orders, po)
+		ids = append(ids, po.Id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	if len(orders) == 0 {
+		return orders, nil
+	}
+
+	lines, err := r.getPurchaseOrderLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range orders {
+		orders[i].Lines = lines[orders[i].Id]
+	}
+
+	return orders, nil
+}
+
+func (r *PurchaseOrderSQLRepository) getPurchaseOrderLines(ctx context.Context, ids []string) (map[string][]model.PurchaseOrderLine, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		`SELECT id, purchase_order_id, sku, ordered_quantity, received_quantity, reorder_point, avg_daily_demand
+		FROM inventory_service.purchase_order_lines
+		WHERE purchase_order_id = ANY($1)
+		ORDER BY sku`,
+		ids,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purchase order lines: %w", err)
+	}
+	defer rows.Close()
+
+	lines := map[string][]model.PurchaseOrderLine{}
+	for rows.Next() {
+		var line model.PurchaseOrderLine
+		err := rows.Scan(
+			&line.Id,
+			&line.PurchaseOrderId,
+			&line.Sku,
+			&line.OrderedQuantity,
+			&line.ReceivedQuantity,
+			&line.ReorderPoint,
+			&line.AvgDailyDemand,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order line row: %w", err)
+		}
+		lines[line.PurchaseOrderId] = append(lines[line.PurchaseOrderId], line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return lines, nil
+}
+
+// moves the purchase order to toStatus only while it is still in fromStatus
+func (r *PurchaseOrderSQLRepository) UpdatePurchaseOrderStatus(ctx context.Context, id, fromStatus, toStatus string) error {
+	tag, err := r.Pgx.Pool().Exec(ctx,
+		`UPDATE inventory_service.purchase_orders
+		SET status = $3,
+			updated_at = NOW(),
+			submitted_at = CASE WHEN $4 THEN NOW() ELSE submitted_at END
+		WHERE id = $1 AND status = $2`,
+		id, fromStatus, toStatus, toStatus == model.PurchaseOrderSubmitted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		if err := r.Pgx.Pool().QueryRow(ctx,
+			"SELECT EXISTS (SELECT 1 FROM inventory_service.purchase_orders WHERE id = $1)", id,
+		).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check purchase order: %w", err)
+		}
+		if !exists {
+			return ErrPurchaseOrderNotFound
+		}
+		return ErrPurchaseOrderStatus
+	}
+
+	return nil
+}
+
+// books received quantities against the open lines, increases current_stock through the
+// stock movement ledger and moves the order to PARTIALLY_RECEIVED or RECEIVED, all in one transaction
+func (r *PurchaseOrderSQLRepository) ReceivePurchaseOrder(ctx context.Context, id string, quantities map[string]float64) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx,
+		"SELECT status FROM inventory_service.purchase_orders WHERE id = $1 FOR UPDATE",
+		id,
+	).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.PgxErrNoRows) {
+			return ErrPurchaseOrderNotFound
+		}
+		return fmt.Errorf("failed to get purchase order: %w", err)
+	}
+	if status != model.PurchaseOrderSubmitted && status != model.PurchaseOrderPartiallyReceived {
+		return ErrPurchaseOrderStatus
+	}
+
+	// stable order keeps stock row locks consistent with other writers
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	for _, sku := range skus {
+		quantity := quantities[sku]
+
+		tag, err := tx.Exec(ctx,
+			`UPDATE inventory_service.purchase_order_lines
+			SET received_quantity = received_quantity + $1
+			WHERE purchase_order_id = $2 AND sku = $3 AND received_quantity + $1 <= ordered_quantity`,
+			quantity, id, sku,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update purchase order line: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: %s is not on the purchase order or exceeds the open quantity", ErrInvalidReceipt, sku)
+		}
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.sku_inventory (sku, current_stock, last_stock_update)
+			VALUES ($1, $2, NOW())
+			ON CONFLICT (sku) DO UPDATE SET
+				current_stock = inventory_service.sku_inventory.current_stock + EXCLUDED.current_stock,
+				last_stock_update = NOW()`,
+			sku, quantity,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+
+		if err := insertStockMovement(ctx, tx, sku, model.MovementReceipt, quantity, 0, id); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE inventory_service.purchase_orders po
+		SET status = CASE WHEN fully.received THEN $2 ELSE $3 END,
+			received_at = CASE WHEN fully.received THEN NOW() ELSE NULL END,
+			updated_at = NOW()
+		FROM (
+			SELECT bool_and(received_quantity >= ordered_quantity) AS received
+			FROM inventory_service.purchase_order_lines
+			WHERE purchase_order_id = $1
+		) fully
+		WHERE po.id = $1`,
+		id, model.PurchaseOrderReceived, model.PurchaseOrderPartiallyReceived,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"sort"
+)
+
+// increases current_stock by the returned quantities and writes a RETURN movement per sku referencing
+// the return, in one transaction. a return that already has RETURN movements is not restocked again
+// and restocked is false, the lock on the return id keeps concurrent retries from both restocking
+func (r *InventorySQLRepository) RestockReturn(ctx context.Context, returnId string, quantities map[string]float64) (restocked bool, err error) {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('inventory_service.return:' || $1))", returnId); err != nil {
+		return false, fmt.Errorf("failed to lock return: %w", err)
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM inventory_service.stock_movements WHERE movement_type = $1 AND reference = $2)",
+		model.MovementReturn, returnId,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check return movements: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	// stable order keeps stock row locks consistent with other writers
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	for _, sku := range skus {
+		quantity := quantities[sku]
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.sku_inventory (sku, current_stock, last_stock_update)
+			VALUES ($1, $2, NOW())
+			ON CONFLICT (sku) DO UPDATE SET
+				current_stock = inventory_service.sku_inventory.current_stock + EXCLUDED.current_stock,
+				last_stock_update = NOW()`,
+			sku, quantity,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to update inventory: %w", err)
+		}
+
+		if err := insertStockMovement(ctx, tx, sku, model.MovementReturn, quantity, 0, returnId); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
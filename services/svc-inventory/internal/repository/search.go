@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	"strconv"
+	"strings"
+)
+
+// builds the matched CTE shared by the search and facet queries. the category subtree is
+// walked with a recursive CTE, UNION stops on a cycle in parent_id. attribute predicates are
+// jsonb containment and key existence so they can use the GIN index on variant_attributes
+func skuSearchCTE(filter model.SkuSearchFilter, withCursor bool) (string, []interface{}, error) {
+	conditions := []string{"1 = 1"}
+	args := []interface{}{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	categoryTree := ""
+	if filter.CategoryId != "" {
+		args = append(args, filter.CategoryId)
+		categoryTree = fmt.Sprintf(`
+			category_tree AS (
+				SELECT id FROM inventory_service.product_categories WHERE id = $%d
+				UNION
+				SELECT c.id
+				FROM inventory_service.product_categories c
+				JOIN category_tree t ON c.parent_id = t.id
+			),`, len(args))
+		conditions = append(conditions, "p.category_id IN (SELECT id FROM category_tree)")
+	}
+
+	for _, attr := range filter.Attributes {
+		if len(attr.Values) == 0 {
+			addCondition("s.variant_attributes ? $%d", attr.Key)
+			continue
+		}
+
+		var alternatives []string
+		for _, value := range attr.Values {
+			for _, candidate := range attributeValueCandidates(value) {
+				doc, err := json.Marshal(map[string]interface{}{attr.Key: candidate})
+				if err != nil {
+					return "", nil, fmt.Errorf("failed to encode attribute filter %s: %w", attr.Key, err)
+				}
+				args = append(args, string(doc))
+				alternatives = append(alternatives, fmt.Sprintf("s.variant_attributes @> $%d::jsonb", len(args)))
+			}
+		}
+		conditions = append(conditions, "("+strings.Join(alternatives, " OR ")+")")
+	}
+
+	if filter.IsActive != nil {
+		addCondition("s.is_active = $%d", *filter.IsActive)
+	}
+	if filter.Discontinued != nil {
+		addCondition("p.discontinued = $%d", *filter.Discontinued)
+	}
+	if filter.AvailableOnly {
+		conditions = append(conditions, "COALESCE(bs.available_quantity, si.current_stock - si.reserved_stock, 0) > 0")
+	}
+	if withCursor && filter.CursorSku != "" {
+		addCondition("s.sku > $%d", filter.CursorSku)
+	}
+
+	cte := fmt.Sprintf(`
+		WITH RECURSIVE %s
+		%s, matched AS (
+			SELECT
+				s.sku,
+				s.product_id::text AS product_id,
+				p.name AS product_name,
+				p.category_id::text AS category_id,
+				s.variant_attributes,
+				s.is_active,
+				p.discontinued,
+				COALESCE(bs.available_quantity, si.current_stock - si.reserved_stock, 0) AS available_quantity,
+				bs.bundle_sku IS NOT NULL AS is_bundle
+			FROM inventory_service.skus s
+			JOIN inventory_service.products p ON p.id = s.product_id
+			LEFT JOIN inventory_service.sku_inventory si ON si.sku = s.sku
+			LEFT JOIN bundle_stock bs ON bs.bundle_sku = s.sku
+			WHERE %s
+		)
+	`, categoryTree, bundleStockCTE, strings.Join(conditions, " AND "))
+
+	return cte, args, nil
+}
+
+// attribute values arrive as strings, numbers and booleans stored in variant_attributes match their literal too
+func attributeValueCandidates(value string) []interface{} {
+	candidates := []interface{}{value}
+	if n, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(n) && !math.IsInf(n, 0) {
+		candidates = append(candidates, n)
+	}
+	if value == "true" || value == "false" {
+		candidates = append(candidates, value == "true")
+	}
+	return candidates
+}
+
+// searches skus ordered by sku using keyset pagination on sku
+func (r *InventorySQLRepository) SearchSkus(ctx context.Context, filter model.SkuSearchFilter, limit int) ([]model.SkuSearchResult, error) {
+	cte, args, err := skuSearchCTE(filter, true)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`%s
+		SELECT
+			m.sku,
+			m.product_id,
+			m.product_name,
+			m.category_id,
+			COALESCE((SELECT jsonb_object_agg(kv.key, kv.value) FROM jsonb_each_text(m.variant_attributes) kv), '{}'::jsonb),
+			m.is_active,
+			m.discontinued,
+			m.available_quantity,
+			m.is_bundle
+		FROM matched m
+		ORDER BY m.sku
+		LIMIT $%d
+	`, cte, len(args))
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search skus: %w", err)
+	}
+	defer rows.Close()
+
+	var results []model.SkuSearchResult
+	for rows.Next() {
+		var item model.SkuSearchResult
+		err := rows.Scan(
+			&item.Sku,
+			&item.ProductId,
+			&item.ProductName,
+			&item.CategoryId,
+			&item.Attributes,
+			&item.IsActive,
+			&item.Discontinued,
+			&item.AvailableQuantity,
+			&item.IsBundle,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sku search row: %w", err)
+		}
+		results = append(results, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return results, nil
+}
+
+// counts the filtered skus per attribute value, limited to keys when given
+func (r *InventorySQLRepository) GetSkuSearchFacets(ctx context.Context, filter model.SkuSearchFilter, keys []string) ([]model.AttributeFacet, error) {
+	cte, args, err := skuSearchCTE(filter, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if keys == nil {
+		keys = []string{}
+	}
+	args = append(args, keys)
+
+	query := fmt.Sprintf(`%s
+		SELECT kv.key, kv.value, COUNT(*)
+		FROM matched m
+		CROSS JOIN LATERAL jsonb_each_text(m.variant_attributes) kv
+		WHERE cardinality($%d::text[]) = 0 OR kv.key = ANY($%d)
+		GROUP BY kv.key, kv.value
+		ORDER BY kv.key, COUNT(*) DESC, kv.value
+	`, cte, len(args), len(args))
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sku search facets: %w", err)
+	}
+	defer rows.Close()
+
+	var facets []model.AttributeFacet
+	for rows.Next() {
+		var (
+			key   string
+			value model.AttributeFacetValue
+		)
+		if err := rows.Scan(&key, &value.Value, &value.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan sku search facet row: %w", err)
+		}
+
+		// rows arrive grouped by key
+		if len(facets) == 0 || facets[len(facets)-1].Key != key {
+			facets = append(facets, model.AttributeFacet{Key: key})
+		}
+		facets[len(facets)-1].Values = append(facets[len(facets)-1].Values, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return facets, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+)
+
+type IStockSnapshotSQLRepository interface {
+	GetLatestSnapshotDate(ctx context.Context) (*time.Time, error)
+	GetFirstMovementTime(ctx context.Context) (*time.Time, error)
+	WriteDailySnapshot(ctx context.Context, day time.Time) (rows int64, err error)
+	ExportSnapshot(ctx context.Context, day time.Time, fn func(model.StockSnapshot) error) error
+}
+
+type StockSnapshotSQLRepository struct {
+	Pgx *sql.PostgresPgx
+}
+
+func NewStockSnapshotRepository(pgx *sql.PostgresPgx) IStockSnapshotSQLRepository {
+	return &StockSnapshotSQLRepository{
+		Pgx: pgx,
+	}
+}
+
+func (r *StockSnapshotSQLRepository) GetLatestSnapshotDate(ctx context.Context) (*time.Time, error) {
+	var day *time.Time
+	err := r.Pgx.Pool().QueryRow(ctx,
+		"SELECT MAX(snapshot_date) FROM inventory_service.stock_snapshots",
+	).Scan(&day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest snapshot date: %w", err)
+	}
+	return day, nil
+}
+
+func (r *StockSnapshotSQLRepository) GetFirstMovementTime(ctx context.Context) (*time.Time, error) {
+	var occurredAt *time.Time
+	err := r.Pgx.Pool().QueryRow(ctx,
+		"SELECT MIN(occurred_at) FROM inventory_service.stock_movements",
+	).Scan(&occurredAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query first stock movement: %w", err)
+	}
+	return occurredAt, nil
+}
+
+// writes the positions at the end of day (UTC) as the previous snapshot plus the movements of the day.
+// returns 0 rows without error when the snapshot already exists.
+func (r *StockSnapshotSQLRepository) WriteDailySnapshot(ctx context.Context, day time.Time) (int64, error) {
+	day = day.UTC().Truncate(24 * time.Hour)
+	asOf := day.AddDate(0, 0, 1)
+
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// serialize writers so two replicas running the job do not write the same day twice
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('inventory_service.stock_snapshots'))"); err != nil {
+		return 0, fmt.Errorf("failed to lock snapshots: %w", err)
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM inventory_service.stock_snapshots WHERE snapshot_date = $1)",
+		day,
+	).Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check snapshot: %w", err)
+	}
+	if exists {
+		return 0, nil
+	}
+
+	var previousDay, previousAsOf *time.Time
+	err = tx.QueryRow(ctx,
+		"SELECT snapshot_date, as_of FROM inventory_service.stock_snapshots WHERE snapshot_date < $1 ORDER BY snapshot_date DESC LIMIT 1",
+		day,
+	).Scan(&previousDay, &previousAsOf)
+	if err != nil && !errors.Is(err, sql.PgxErrNoRows) {
+		return 0, fmt.Errorf("failed to query previous snapshot: %w", err)
+	}
+
+	query := `
+		INSERT INTO inventory_service.stock_snapshots (snapshot_date, sku, as_of, current_stock, reserved_stock)
+		SELECT $1, sku, $2, SUM(current_stock), SUM(reserved_stock)
+		FROM (
+			SELECT sku, current_stock, reserved_stock
+			FROM inventory_service.stock_snapshots
+			WHERE snapshot_date = $3
+			UNION ALL
+			SELECT sku, current_delta, reserved_delta
+			FROM inventory_service.stock_movements
+			WHERE ($4::timestamptz IS NULL OR occurred_at >= $4) AND occurred_at < $2
+		) positions
+		GROUP BY sku
+	`
+
+	tag, err := tx.Exec(ctx, rg.ReplaceWhitesWithSingleSpace(query), day, asOf, previousDay, previousAsOf)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *StockSnapshotSQLRepository) ExportSnapshot(ctx context.Context, day time.Time, fn func(model.StockSnapshot) error) error {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		`SELECT snapshot_date, sku, as_of, current_stock, reserved_stock
+		FROM inventory_service.stock_snapshots WHERE snapshot_date = $1 ORDER BY sku`,
+		day.UTC().Truncate(24*time.Hour),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to query snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rec model.StockSnapshot
+		if err := rows.Scan(&rec.SnapshotDate, &rec.Sku, &rec.AsOf, &rec.CurrentStock, &rec.ReservedStock); err != nil {
+			return fmt.Errorf("failed to scan snapshot row: %w", err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
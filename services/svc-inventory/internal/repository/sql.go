@@ -7,6 +7,8 @@ import (
 	"ops-monorepo/services/svc-inventory/internal/model"
 	rg "ops-monorepo/shared-libs/regexp"
 	sql "ops-monorepo/shared-libs/storage/postgres"
+	"strings"
+	"time"
 
 	"github.com/robaho/fixed"
 )
@@ -20,9 +22,34 @@ type IInventorySQLRepository interface {
 	GetStockStatus(ctx context.Context, sku string) (*model.StockStatus, error)
 
 	ReserveStock(ctx context.Context, orderId, sku string, quantity float64) error
+	ReserveStockOptimistic(ctx context.Context, orderId, sku string, quantity float64) error
+	ReserveStockWithBackorder(ctx context.Context, orderId, sku string, quantity float64) (*model.Backorder, error)
+	ReserveAvailableStock(ctx context.Context, orderId, sku string, quantity float64) (float64, error)
 	ReleaseStock(ctx context.Context, sku string, quantity float64) error
+	IncrementInventory(ctx context.Context, sku string, adjustment fixed.Fixed) error
 
 	GetReservationHistoryByOrderIdAndstatus(ctx context.Context, orderId string, status string) ([]model.ReservationHistory, error)
+	ListReservationHistory(ctx context.Context, filter model.ReservationFilter, limit int) ([]model.ReservationHistory, error)
+	GetReservationTotalsBySku(ctx context.Context, filter model.ReservationFilter) ([]model.ReservationSkuTotal, error)
+	ReleaseOrderReservations(ctx context.Context, orderId string, skus []string) (releasedSkus []string, err error)
+	AmendOrderReservations(ctx context.Context, orderId string, deltas map[string]float64) error
+	RestockReturn(ctx context.Context, returnId string, quantities map[string]float64) (restocked bool, err error)
+	CommitReservation(ctx context.Context, orderId, commitId string, quantities map[string]float64) (consumed map[string]float64, committed bool, err error)
+
+	FindExistingSkus(ctx context.Context, skus []string) (map[string]bool, error)
+	GetBundleComponents(ctx context.Context, bundleSkus []string) ([]model.BundleComponent, error)
+	GetBundlesContainingSku(ctx context.Context, sku string) ([]string, error)
+	ReplaceBundleComponents(ctx context.Context, bundleSku string, components []model.BundleComponent) error
+	ReserveBundle(ctx context.Context, orderId, bundleSku string, quantity float64) ([]model.BundleComponent, error)
+
+	GetStockAsOf(ctx context.Context, skus []string, asOf time.Time) ([]model.StockPosition, error)
+
+	SearchSkus(ctx context.Context, filter model.SkuSearchFilter, limit int) ([]model.SkuSearchResult, error)
+	GetSkuSearchFacets(ctx context.Context, filter model.SkuSearchFilter, keys []string) ([]model.AttributeFacet, error)
+
+	GetSubstitutes(ctx context.Context, sku string) ([]model.SubstituteRule, error)
+	ReplaceSubstitutes(ctx context.Context, sku string, rules []model.SubstituteRule) error
+	SuggestAlternatives(ctx context.Context, quantities map[string]float64, limit int) ([]model.SkuAlternatives, error)
 }
 
 type InventorySQLRepository struct {
@@ -53,23 +80,47 @@ func (r *InventorySQLRepository) CheckStockWithMultipleSkus(ctx context.Context,
 		return nil, []string{}, errors.New("no SKUs provided")
 	}
 
+	// bundles hold no stock, their quantities are the number of complete bundles
+	// the components can build, a missing component inventory row counts as zero
 	query := `
+		WITH bundle_stock AS (
+			SELECT 
+				bc.bundle_sku,
+				MIN(FLOOR(COALESCE(ci.current_stock, 0) / bc.quantity)) AS total_quantity,
+				MIN(FLOOR(GREATEST(COALESCE(ci.current_stock - ci.reserved_stock, 0), 0) / bc.quantity)) AS available_quantity
+			FROM 
+				inventory_service.sku_bundle_components bc
+			LEFT JOIN 
+				inventory_service.sku_inventory ci ON ci.sku = bc.component_sku
+			WHERE 
+				bc.bundle_sku = ANY($1)
+			GROUP BY 
+				bc.bundle_sku
+		)
 		SELECT 
 			s.sku,
-			si.current_stock,
-			si.reserved_stock,
-			(si.current_stock - si.reserved_stock) as available_quantity,
+			COALESCE(bs.total_quantity, si.current_stock),
+			COALESCE(bs.total_quantity - bs.available_quantity, si.reserved_stock),
+			COALESCE(bs.available_quantity, si.current_stock - si.reserved_stock) as available_quantity,
 			s.default_uom,
 			sp.unit_price,
-			sp.currency
+			sp.currency,
+			bs.bundle_sku IS NOT NULL AS is_bundle,
+			COALESCE(p.category_id::text, '') AS category_id,
+			p.tax_category
 		FROM 
 			inventory_service.skus s
 		JOIN 
+			inventory_service.products p ON p.id = s.product_id
+		LEFT JOIN 
 			inventory_service.sku_inventory si ON s.sku = si.sku
+		LEFT JOIN 
+			bundle_stock bs ON s.sku = bs.bundle_sku
 		JOIN 
 			inventory_service.sku_prices sp ON s.sku = sp.sku
 		WHERE 
 			s.sku = ANY($1)
+			AND (si.sku IS NOT NULL OR bs.bundle_sku IS NOT NULL)
 			AND sp.is_active = true
 			AND (sp.valid_to IS NULL OR sp.valid_to > NOW())
 	`
@@ -93,6 +144,9 @@ func (r *InventorySQLRepository) CheckStockWithMultipleSkus(ctx context.Context,
 			&item.SKU_UOM,
 			&item.SKUPrice,
 			&item.SKUCurrency,
+			&item.IsBundle,
+			&item.CategoryId,
+			&item.TaxCategory,
 		)
 		if err != nil {
 			return nil, []string{}, fmt.Errorf("failed to scan inventory row: %w", err)
@@ -175,10 +229,11 @@ func (r *InventorySQLRepository) ReserveStock(ctx context.Context, orderId, sku
 	}
 	defer tx.Rollback(ctx)
 
-	// get available quantity first
+	// get available quantity first, the row stays locked until commit so concurrent
+	// reservations of the same sku queue up behind this one
 	var available float64
 	err = tx.QueryRow(ctx,
-		"SELECT (current_stock - reserved_stock) FROM inventory_service.sku_inventory WHERE sku = $1",
+		"SELECT (current_stock - reserved_stock) FROM inventory_service.sku_inventory WHERE sku = $1 FOR UPDATE",
 		sku,
 	).Scan(&available)
 
@@ -201,12 +256,16 @@ func (r *InventorySQLRepository) ReserveStock(ctx context.Context, orderId, sku
 		return fmt.Errorf("failed to reserve inventory: %w", err)
 	}
 
+	if err := insertStockMovement(ctx, tx, sku, model.MovementReserve, 0, quantity, orderId); err != nil {
+		return err
+	}
+
 	// insert reservation history
 	_, err = tx.Exec(ctx,
 		`INSERT INTO inventory_service.reservation_history 
-		(id, order_id, sku, quantity, uom, status, reserved_at, released_at) 
-		VALUES (gen_random_uuid(), $1, $2, $3, 'EA', $4, NOW(), NULL)`,
-		orderId, sku, quantity, model.ReservedStatus,
+		(id, order_id, sku, quantity, uom, status, reserved_at, released_at, line_type) 
+		VALUES (gen_random_uuid(), $1, $2, $3, 'EA', $4, NOW(), NULL, $5)`,
+		orderId, sku, quantity, model.ReservedStatus, model.ReservationLineStock,
 	)
 
 	if err != nil {
@@ -228,7 +287,9 @@ func (r *InventorySQLRepository) GetReservationHistoryByOrderIdAndstatus(ctx con
 			uom,
 			status,
 			reserved_at,
-			released_at
+			released_at,
+			line_type,
+			bundle_sku
 		FROM inventory_service.reservation_history 
 		WHERE order_id = $1 AND status = $2
 		ORDER BY reserved_at DESC
@@ -252,6 +313,8 @@ func (r *InventorySQLRepository) GetReservationHistoryByOrderIdAndstatus(ctx con
 			&history.Status,
 			&history.ReservedAt,
 			&history.ReleasedAt,
+			&history.LineType,
+			&history.BundleSku,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan reservation history row: %w", err)
@@ -266,6 +329,143 @@ func (r *InventorySQLRepository) GetReservationHistoryByOrderIdAndstatus(ctx con
 	return histories, nil
 }
 
+// builds the where clause shared by reservation history listing and totals
+func reservationFilterClause(filter model.ReservationFilter, withCursor bool) (string, []interface{}) {
+	conditions := []string{"1 = 1"}
+	args := []interface{}{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.OrderId != "" {
+		addCondition("order_id = $%d", filter.OrderId)
+	}
+	if filter.Sku != "" {
+		addCondition("sku = $%d", filter.Sku)
+	}
+	if filter.Status != "" {
+		addCondition("status = $%d", filter.Status)
+	}
+	if filter.ReservedFrom != nil {
+		addCondition("reserved_at >= $%d", *filter.ReservedFrom)
+	}
+	if filter.ReservedTo != nil {
+		addCondition("reserved_at < $%d", *filter.ReservedTo)
+	}
+	if withCursor && filter.CursorReservedAt != nil && filter.CursorId != "" {
+		args = append(args, *filter.CursorReservedAt, filter.CursorId)
+		conditions = append(conditions, fmt.Sprintf("(reserved_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// lists reservation history newest first using keyset pagination on (reserved_at, id)
+func (r *InventorySQLRepository) ListReservationHistory(ctx context.Context, filter model.ReservationFilter, limit int) ([]model.ReservationHistory, error) {
+	where, args := reservationFilterClause(filter, true)
+	args = append(args, limit)
+
+	query := fmt.Sprintf(`
+		SELECT 
+			id,
+			order_id,
+			sku,
+			quantity,
+			uom,
+			status,
+			reserved_at,
+			released_at,
+			line_type,
+			bundle_sku
+		FROM inventory_service.reservation_history 
+		WHERE %s
+		ORDER BY reserved_at DESC, id DESC
+		LIMIT $%d
+	`, where, len(args))
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reservation history: %w", err)
+	}
+	defer rows.Close()
+
+	var histories []model.ReservationHistory
+	for rows.Next() {
+		var history model.ReservationHistory
+		err := rows.Scan(
+			&history.Id,
+			&history.OrderId,
+			&history.Sku,
+			&history.Quantity,
+			&history.Uom,
+			&history.Status,
+			&history.ReservedAt,
+			&history.ReleasedAt,
+			&history.LineType,
+			&history.BundleSku,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reservation history row: %w", err)
+		}
+		histories = append(histories, history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return histories, nil
+}
+
+// sums the quantities of every reservation matching the filter, grouped by sku
+func (r *InventorySQLRepository) GetReservationTotalsBySku(ctx context.Context, filter model.ReservationFilter) ([]model.ReservationSkuTotal, error) {
+	where, args := reservationFilterClause(filter, false)
+	args = append(args, model.ReservedStatus, model.ReleasedStatus, model.ConsumedStatus)
+
+	query := fmt.Sprintf(`
+		SELECT 
+			sku,
+			COALESCE(SUM(quantity) FILTER (WHERE status = $%d), 0) AS reserved_quantity,
+			COALESCE(SUM(quantity) FILTER (WHERE status = $%d), 0) AS released_quantity,
+			COALESCE(SUM(quantity) FILTER (WHERE status = $%d), 0) AS consumed_quantity,
+			COUNT(*) AS reservation_count
+		FROM inventory_service.reservation_history 
+		WHERE %s
+		GROUP BY sku
+		ORDER BY sku
+	`, len(args)-2, len(args)-1, len(args), where)
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reservation totals: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []model.ReservationSkuTotal
+	for rows.Next() {
+		var total model.ReservationSkuTotal
+		err := rows.Scan(
+			&total.Sku,
+			&total.ReservedQuantity,
+			&total.ReleasedQuantity,
+			&total.ConsumedQuantity,
+			&total.ReservationCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reservation totals row: %w", err)
+		}
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return totals, nil
+}
+
 // ReleaseInventory releases reserved inventory for a SKU
 func (r *InventorySQLRepository) ReleaseStock(ctx context.Context, sku string, quantity float64) error {
 	tx, err := r.Pgx.Pool().Begin(ctx)
@@ -300,6 +500,10 @@ func (r *InventorySQLRepository) ReleaseStock(ctx context.Context, sku string, q
 		return fmt.Errorf("failed to release inventory: %w", err)
 	}
 
+	if err := insertStockMovement(ctx, tx, sku, model.MovementRelease, 0, -quantity, ""); err != nil {
+		return err
+	}
+
 	// update reservation history status by order_id to RELEASED
 
 	return tx.Commit(ctx)
@@ -307,7 +511,13 @@ func (r *InventorySQLRepository) ReleaseStock(ctx context.Context, sku string, q
 
 // updates the inventory levels for a SKU
 func (r *InventorySQLRepository) IncrementInventory(ctx context.Context, sku string, adjustment fixed.Fixed) error {
-	_, err := r.Pgx.Pool().Exec(ctx,
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
 		`UPDATE inventory_service.sku_inventory 
 		SET current_stock = current_stock + $1, 
 			last_stock_update = NOW() 
@@ -319,5 +529,13 @@ func (r *InventorySQLRepository) IncrementInventory(ctx context.Context, sku str
 		return fmt.Errorf("failed to update inventory: %w", err)
 	}
 
-	return nil
+	movementType := model.MovementReceipt
+	if adjustment.LessThan(fixed.ZERO) {
+		movementType = model.MovementAdjustment
+	}
+	if err := insertStockMovement(ctx, tx, sku, movementType, adjustment, 0, ""); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
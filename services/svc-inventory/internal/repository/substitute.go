@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	rg "ops-monorepo/shared-libs/regexp"
+)
+
+// returns the substitution rules of sku, best first
+func (r *InventorySQLRepository) GetSubstitutes(ctx context.Context, sku string) ([]model.SubstituteRule, error) {
+	rows, err := r.Pgx.Pool().Query(ctx,
+		`SELECT sku, substitute_sku, priority
+		FROM inventory_service.sku_substitutes
+		WHERE sku = $1
+		ORDER BY priority, substitute_sku`,
+		sku,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sku substitutes: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []model.SubstituteRule
+	for rows.Next() {
+		var rule model.SubstituteRule
+		if err := rows.Scan(&rule.Sku, &rule.SubstituteSku, &rule.Priority); err != nil {
+			return nil, fmt.Errorf("failed to scan sku substitute row: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return rules, nil
+}
+
+// replaces every substitution rule of sku in a single transaction, an empty list removes them
+func (r *InventorySQLRepository) ReplaceSubstitutes(ctx context.Context, sku string, rules []model.SubstituteRule) error {
+	tx, err := r.Pgx.Pool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		"DELETE FROM inventory_service.sku_substitutes WHERE sku = $1",
+		sku,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete sku substitutes: %w", err)
+	}
+
+	for _, rule := range rules {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO inventory_service.sku_substitutes (sku, substitute_sku, priority, created_at)
+			VALUES ($1, $2, $3, NOW())`,
+			sku, rule.SubstituteSku, rule.Priority,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert sku substitute %s: %w", rule.SubstituteSku, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// returns up to limit active, in stock alternatives per requested sku that can cover the requested quantity.
+// curated substitution rules come first by priority, then other variants of the same product, then skus of
+// the same category. within a group skus sharing more variant attributes with the requested sku rank higher.
+// requested skus missing from the catalog are left out, known ones are returned even without alternatives
+func (r *InventorySQLRepository) SuggestAlternatives(ctx context.Context, quantities map[string]float64, limit int) ([]model.SkuAlternatives, error) {
+	skus := make([]string, 0, len(quantities))
+	requested := make([]float64, 0, len(quantities))
+	for sku, quantity := range quantities {
+		skus = append(skus, sku)
+		requested = append(requested, quantity)
+	}
+
+	query := `
+		WITH requested AS (
+			SELECT
+				r.sku,
+				r.quantity,
+				s.product_id,
+				p.name AS product_name,
+				p.category_id,
+				s.variant_attributes
+			FROM unnest($1::text[], $2::float8[]) AS r(sku, quantity)
+			JOIN inventory_service.skus s ON s.sku = r.sku
+			JOIN inventory_service.products p ON p.id = s.product_id
+		), ` + bundleStockCTE + `, candidates AS (
+			SELECT
+				r.sku AS requested_sku,
+				c.sku,
+				c.product_id::text AS product_id,
+				cp.name AS product_name,
+				COALESCE(bs.available_quantity, ci.current_stock - ci.reserved_stock, 0) AS available_quantity,
+				CASE
+					WHEN sr.sku IS NOT NULL THEN $4
+					WHEN c.product_id = r.product_id THEN $5
+					ELSE $6
+				END AS reason,
+				CASE
+					WHEN sr.sku IS NOT NULL THEN 0
+					WHEN c.product_id = r.product_id THEN 1
+					ELSE 2
+				END AS reason_rank,
+				COALESCE(sr.priority, 0) AS priority,
+				(
+					SELECT COUNT(*)
+					FROM jsonb_each(COALESCE(r.variant_attributes, '{}'::jsonb)) a
+					WHERE c.variant_attributes @> jsonb_build_object(a.key, a.value)
+				)::int AS matching_attributes,
+				r.quantity
+			FROM requested r
+			JOIN inventory_service.skus c ON c.sku <> r.sku AND c.is_active
+			JOIN inventory_service.products cp ON cp.id = c.product_id AND NOT cp.discontinued
+			LEFT JOIN inventory_service.sku_substitutes sr ON sr.sku = r.sku AND sr.substitute_sku = c.sku
+			LEFT JOIN inventory_service.sku_inventory ci ON ci.sku = c.sku
+			LEFT JOIN bundle_stock bs ON bs.bundle_sku = c.sku
+			WHERE sr.sku IS NOT NULL OR c.product_id = r.product_id OR cp.category_id = r.category_id
+		), ranked AS (
+			SELECT
+				c.*,
+				ROW_NUMBER() OVER (
+					PARTITION BY c.requested_sku
+					ORDER BY c.reason_rank, c.priority, c.matching_attributes DESC, c.available_quantity DESC, c.sku
+				) AS position
+			FROM candidates c
+			WHERE c.available_quantity > 0 AND c.available_quantity >= c.quantity
+		)
+		SELECT
+			r.sku,
+			r.product_id::text,
+			r.product_name,
+			r.quantity,
+			a.sku,
+			a.product_id,
+			a.product_name,
+			a.available_quantity,
+			a.reason,
+			a.matching_attributes
+		FROM requested r
+		LEFT JOIN ranked a ON a.requested_sku = r.sku AND a.position <= $3
+		ORDER BY r.sku, a.position
+	`
+
+	rows, err := r.Pgx.Pool().Query(ctx, rg.ReplaceWhitesWithSingleSpace(query),
+		skus, requested, limit,
+		model.AlternativeReasonRule, model.AlternativeReasonSameProduct, model.AlternativeReasonSameCategory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sku alternatives: %w", err)
+	}
+	defer rows.Close()
+
+	var results []model.SkuAlternatives
+	for rows.Next() {
+		var (
+			item model.SkuAlternatives
+			alt  struct {
+				sku, productId, productName, reason *string
+				availableQuantity                   *float64
+				matchingAttributes                  *int32
+			}
+		)
+		err := rows.Scan(
+			&item.Sku,
+			&item.ProductId,
+			&item.ProductName,
+			&item.RequestedQuantity,
+			&alt.sku,
+			&alt.productId,
+			&alt.productName,
+			&alt.availableQuantity,
+			&alt.reason,
+			&alt.matchingAttributes,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sku alternative row: %w", err)
+		}
+
+		// rows arrive grouped by requested sku
+		if len(results) == 0 || results[len(results)-1].Sku != item.Sku {
+			results = append(results, item)
+		}
+		if alt.sku == nil {
+			continue
+		}
+
+		last := &results[len(results)-1]
+		last.Alternatives = append(last.Alternatives, model.AlternativeSku{
+			Sku:                *alt.sku,
+			ProductId:          *alt.productId,
+			ProductName:        *alt.productName,
+			AvailableQuantity:  *alt.availableQuantity,
+			Reason:             *alt.reason,
+			MatchingAttributes: *alt.matchingAttributes,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"sort"
+)
+
+// AmendReservation reserves the increases and releases the decreases of an amended order in one
+// transaction. when an increase is short nothing is changed and failedToReserve holds the stock
+// status of every increased sku. bundles cannot be amended, their orders are cancelled and placed again
+func (uc *inventoryUsecase) AmendReservation(ctx context.Context, orderId string, deltas map[string]float64) (reservationHistory []model.ReservationHistory, failedToReserve []model.StockStatus, err error) {
+
+	var skusArr []string
+	for sku := range deltas {
+		skusArr = append(skusArr, sku)
+	}
+	sort.Strings(skusArr)
+
+	bundles, err := uc.bundleSkus(ctx, skusArr)
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundleComponents", "error", err.Error())
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+	for _, sku := range skusArr {
+		if bundles[sku] {
+			return nil, nil, grpcErr.NewValidationError("validation error", map[string]string{
+				sku: "bundle reservations cannot be amended",
+			})
+		}
+	}
+
+	err = uc.repoSQL.AmendOrderReservations(ctx, orderId, deltas)
+	if errors.Is(err, repository.ErrReleaseExceedsReserved) {
+		return nil, nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"changes": err.Error(),
+		})
+	}
+	if err != nil && isInsufficientStock(err) {
+		var increased []string
+		for _, sku := range skusArr {
+			if deltas[sku] > 0 {
+				increased = append(increased, sku)
+			}
+		}
+
+		failedToReserve, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, increased)
+		if err != nil {
+			return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetStockStatus", map[string]interface{}{"error": err.Error()})
+		}
+
+		// return failed stock status without app error
+		uc.logger.Infof("insufficient quantity to amend reservation", "failed_to_reserve", failedToReserve)
+		return nil, failedToReserve, nil
+	}
+	if err != nil {
+		uc.logger.Errorf("something wrong with db: failed in AmendOrderReservations", "error", err.Error())
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in AmendOrderReservations", map[string]interface{}{"error": err.Error()})
+	}
+
+	reservationHistory, err = uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
+	if err != nil {
+		uc.logger.Errorf("failed in GetReservationHistoryByOrderId", "error", err.Error())
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetReservationHistoryByOrderIdAndstatus", map[string]interface{}{"error": err.Error()})
+	}
+
+	return reservationHistory, nil, nil
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+	notificationv1 "pb_schemas/notification/v1"
+	"strings"
+	"time"
+)
+
+type IBackInStockUsecase interface {
+	Subscribe(ctx context.Context, sku, email string) (*model.BackInStockSubscription, error)
+	// sends the due back in stock emails and returns how many were sent
+	NotifyRestocked(ctx context.Context) (int, error)
+}
+
+// BackInStockConfig bounds the notifier, zero values fall back to the defaults
+type BackInStockConfig struct {
+	SubscriptionTTL time.Duration // pending subscriptions expire after this
+	BatchSize       int           // emails claimed per run
+	RatePerSecond   int           // emails sent per second
+	HoldWindow      time.Duration // a notified subscriber keeps a unit of stock for this long
+}
+
+const (
+	defaultBackInStockTTL        = 30 * 24 * time.Hour
+	defaultBackInStockBatchSize  = 100
+	defaultBackInStockRate       = 5
+	defaultBackInStockHoldWindow = time.Hour
+)
+
+type backInStockUsecase struct {
+	logger       logger.Logger
+	repoSub      repository.IBackInStockSQLRepository
+	repoSQL      repository.IInventorySQLRepository
+	notification notificationv1.NotificationServiceClient
+	cfg          BackInStockConfig
+}
+
+func NewBackInStockUsecase(
+	log logger.Logger,
+	repoSub repository.IBackInStockSQLRepository,
+	repoSQL repository.IInventorySQLRepository,
+	notification notificationv1.NotificationServiceClient,
+	cfg BackInStockConfig,
+) IBackInStockUsecase {
+	if cfg.SubscriptionTTL <= 0 {
+		cfg.SubscriptionTTL = defaultBackInStockTTL
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBackInStockBatchSize
+	}
+	if cfg.RatePerSecond <= 0 {
+		cfg.RatePerSecond = defaultBackInStockRate
+	}
+	if cfg.HoldWindow <= 0 {
+		cfg.HoldWindow = defaultBackInStockHoldWindow
+	}
+
+	return &backInStockUsecase{
+		logger:       log,
+		repoSub:      repoSub,
+		repoSQL:      repoSQL,
+		notification: notification,
+		cfg:          cfg,
+	}
+}
+
+// Subscribe queues email for a single notification once sku is available again,
+// only skus that are currently out of stock can be subscribed to
+func (uc *backInStockUsecase) Subscribe(ctx context.Context, sku, email string) (*model.BackInStockSubscription, error) {
+
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	stocks, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, []string{sku})
+	if err != nil {
+		uc.logger.Errorf("failed in CheckStockWithMultipleSkus", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CheckStockWithMultipleSkus", map[string]interface{}{"error": err.Error()})
+	}
+	if len(stocks) == 0 {
+		return nil, grpcErr.NewValidationError("sku not found", map[string]string{"sku": "sku not found"})
+	}
+	if stocks[0].AvailableQuantity > 0 {
+		return nil, grpcErr.NewValidationError("sku is in stock", map[string]string{"sku": "sku is in stock"})
+	}
+
+	sub, err := uc.repoSub.CreateSubscription(ctx, sku, email, time.Now().Add(uc.cfg.SubscriptionTTL))
+	if err != nil {
+		uc.logger.Errorf("failed in CreateSubscription", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CreateSubscription", map[string]interface{}{"error": err.Error()})
+	}
+
+	return sub, nil
+}
+
+// NotifyRestocked expires old subscriptions, claims the ones whose sku is available again and
+// emails them at the configured rate. failed emails go back to the queue for the next run
+func (uc *backInStockUsecase) NotifyRestocked(ctx context.Context) (int, error) {
+
+	expired, err := uc.repoSub.ExpireSubscriptions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if expired > 0 {
+		uc.logger.Infof("back in stock subscriptions expired", "count", expired)
+	}
+
+	claimed, err := uc.repoSub.ClaimNotifications(ctx, uc.cfg.BatchSize, time.Now().Add(-uc.cfg.HoldWindow))
+	if err != nil {
+		return 0, err
+	}
+	if len(claimed) == 0 {
+		return 0, nil
+	}
+
+	pace := time.NewTicker(time.Second / time.Duration(uc.cfg.RatePerSecond))
+	defer pace.Stop()
+
+	sent := 0
+	for i, sub := range claimed {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				uc.revert(claimed[i:])
+				return sent, ctx.Err()
+			case <-pace.C:
+			}
+		}
+
+		if err := uc.send(ctx, sub); err != nil {
+			uc.logger.Errorf("failed to send back in stock email", "subscription_id", sub.Id, "error", err.Error())
+			uc.revert(claimed[i : i+1])
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (uc *backInStockUsecase) send(ctx context.Context, sub model.BackInStockSubscription) error {
+	name := sub.ProductName
+	if name == "" {
+		name = sub.Sku
+	}
+
+	resp, err := uc.notification.SendEmail(ctx, &notificationv1.SendEmailRequest{
+		To:      sub.Email,
+		Subject: fmt.Sprintf("%s is back in stock", name),
+		Body: fmt.Sprintf(
+			"Good news, %s (SKU %s) is available again.\n\nStock is limited and is not held for you, so order soon if you still want it.\n\nThis was a one-time notification, subscribe again if you miss it.",
+			name, sub.Sku,
+		),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetSuccess() {
+		return fmt.Errorf("notification service: %s", resp.GetMessage())
+	}
+	return nil
+}
+
+// reverting runs detached from ctx so a cancelled run still hands back its claims
+func (uc *backInStockUsecase) revert(subs []model.BackInStockSubscription) {
+	for _, sub := range subs {
+		if err := uc.repoSub.RevertNotification(context.Background(), sub.Id); err != nil {
+			uc.logger.Errorf("failed in RevertNotification", "subscription_id", sub.Id, "error", err.Error())
+		}
+	}
+}
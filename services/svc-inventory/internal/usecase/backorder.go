@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+)
+
+const (
+	defaultBackorderEventsLimit = 100
+	maxBackorderEventsLimit     = 500
+)
+
+type IBackorderUsecase interface {
+	BackorderAllocator
+	// allocates the backorders of every sku with a queue and returns how many were allocated
+	AllocatePending(ctx context.Context) (int, error)
+	ListEvents(ctx context.Context, limit int) ([]model.BackorderEvent, error)
+	AcknowledgeEvents(ctx context.Context, ids []int64) (int64, error)
+}
+
+// BackorderAllocator hands stock that just arrived to the oldest backorders of its skus
+type BackorderAllocator interface {
+	AllocateBackorders(ctx context.Context, skus []string) ([]model.Backorder, error)
+}
+
+type backorderUsecase struct {
+	logger      logger.Logger
+	repoBO      repository.IBackorderSQLRepository
+	invalidator SkuCacheInvalidator
+}
+
+func NewBackorderUsecase(log logger.Logger, repo repository.IBackorderSQLRepository, invalidator SkuCacheInvalidator) IBackorderUsecase {
+	return &backorderUsecase{
+		logger:      log,
+		repoBO:      repo,
+		invalidator: invalidator,
+	}
+}
+
+// AllocateBackorders reserves available stock of skus for their pending backorders, oldest first
+func (uc *backorderUsecase) AllocateBackorders(ctx context.Context, skus []string) ([]model.Backorder, error) {
+	if len(skus) == 0 {
+		return nil, nil
+	}
+
+	allocated, err := uc.repoBO.AllocateBackorders(ctx, skus)
+	if err != nil {
+		uc.logger.Errorf("failed in AllocateBackorders", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in AllocateBackorders", map[string]interface{}{"error": err.Error()})
+	}
+
+	if len(allocated) > 0 {
+		if uc.invalidator != nil {
+			var allocatedSkus []string
+			for _, b := range allocated {
+				allocatedSkus = append(allocatedSkus, b.Sku)
+			}
+			uc.invalidator.InvalidateSkus(ctx, allocatedSkus...)
+		}
+		uc.logger.Infof("backorders allocated", "count", len(allocated))
+	}
+
+	return allocated, nil
+}
+
+// AllocatePending picks up stock that arrived outside of receipts and imports,
+// such as adjustments and released reservations
+func (uc *backorderUsecase) AllocatePending(ctx context.Context) (int, error) {
+
+	skus, err := uc.repoBO.GetSkusWithPendingBackorders(ctx)
+	if err != nil {
+		uc.logger.Errorf("failed in GetSkusWithPendingBackorders", "error", err.Error())
+		return 0, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetSkusWithPendingBackorders", map[string]interface{}{"error": err.Error()})
+	}
+
+	allocated, err := uc.AllocateBackorders(ctx, skus)
+	if err != nil {
+		return 0, err
+	}
+	return len(allocated), nil
+}
+
+// ListEvents returns orders whose backorders have all been allocated, oldest first
+func (uc *backorderUsecase) ListEvents(ctx context.Context, limit int) ([]model.BackorderEvent, error) {
+
+	if limit <= 0 {
+		limit = defaultBackorderEventsLimit
+	}
+	if limit > maxBackorderEventsLimit {
+		limit = maxBackorderEventsLimit
+	}
+
+	events, err := uc.repoBO.ListBackorderEvents(ctx, limit)
+	if err != nil {
+		uc.logger.Errorf("failed in ListBackorderEvents", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in ListBackorderEvents", map[string]interface{}{"error": err.Error()})
+	}
+
+	return events, nil
+}
+
+// AcknowledgeEvents hides processed events from later listings
+func (uc *backorderUsecase) AcknowledgeEvents(ctx context.Context, ids []int64) (int64, error) {
+
+	acknowledged, err := uc.repoBO.AcknowledgeBackorderEvents(ctx, ids)
+	if err != nil {
+		uc.logger.Errorf("failed in AcknowledgeBackorderEvents", "error", err.Error())
+		return 0, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in AcknowledgeBackorderEvents", map[string]interface{}{"error": err.Error()})
+	}
+
+	return acknowledged, nil
+}
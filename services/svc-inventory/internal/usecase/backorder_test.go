@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-inventory/internal/model"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+)
+
+func TestBackorderUsecase_AllocateBackorders(t *testing.T) {
+	// order-1 backordered mugs before order-2, order-2 also waits on tea bags
+	mugOrder1 := model.Backorder{Id: "bo-1", OrderId: "order-1", Sku: "MUG-BLUE", Quantity: 3, Status: model.BackorderAllocated}
+	mugOrder2 := model.Backorder{Id: "bo-2", OrderId: "order-2", Sku: "MUG-BLUE", Quantity: 2, Status: model.BackorderAllocated}
+	teaOrder2 := model.Backorder{Id: "bo-3", OrderId: "order-2", Sku: "TEA-BAG", Quantity: 4, Status: model.BackorderAllocated}
+
+	testCases := []struct {
+		Name     string
+		Skus     []string
+		Mock     func(dep backorderDeps)
+		Expected []model.Backorder
+		Err      bool
+	}{
+		{
+			Name: "backorders are allocated oldest first",
+			Skus: []string{"MUG-BLUE"},
+			Mock: func(dep backorderDeps) {
+				dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE"}).
+					Return([]model.Backorder{mugOrder1, mugOrder2}, nil)
+				dep.invalidator.EXPECT().InvalidateSkus(mock.Anything, []string{"MUG-BLUE", "MUG-BLUE"})
+				dep.logger.EXPECT().Infof("backorders allocated", mock.Anything)
+			},
+			Expected: []model.Backorder{mugOrder1, mugOrder2},
+		},
+		{
+			Name: "partial allocation returns only the backorders the stock covered",
+			Skus: []string{"MUG-BLUE", "TEA-BAG"},
+			Mock: func(dep backorderDeps) {
+				// order-2 got its mugs but its tea bags are still short
+				dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE", "TEA-BAG"}).
+					Return([]model.Backorder{mugOrder1, mugOrder2}, nil)
+				dep.invalidator.EXPECT().InvalidateSkus(mock.Anything, []string{"MUG-BLUE", "MUG-BLUE"})
+				dep.logger.EXPECT().Infof("backorders allocated", mock.Anything)
+			},
+			Expected: []model.Backorder{mugOrder1, mugOrder2},
+		},
+		{
+			Name: "last backorder of an order completes it",
+			Skus: []string{"TEA-BAG"},
+			Mock: func(dep backorderDeps) {
+				dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"TEA-BAG"}).
+					Return([]model.Backorder{teaOrder2}, nil)
+				dep.invalidator.EXPECT().InvalidateSkus(mock.Anything, []string{"TEA-BAG"})
+				dep.logger.EXPECT().Infof("backorders allocated", mock.Anything)
+			},
+			Expected: []model.Backorder{teaOrder2},
+		},
+		{
+			Name: "nothing allocated keeps the cache",
+			Skus: []string{"MUG-BLUE"},
+			Mock: func(dep backorderDeps) {
+				dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE"}).
+					Return(nil, nil)
+			},
+		},
+		{
+			Name: "no skus",
+			Mock: func(dep backorderDeps) {},
+		},
+		{
+			Name: "database error",
+			Skus: []string{"MUG-BLUE"},
+			Mock: func(dep backorderDeps) {
+				dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE"}).
+					Return(nil, errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed in AllocateBackorders", mock.Anything)
+			},
+			Err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dep := newBackorderDeps(t)
+			tc.Mock(dep)
+
+			allocated, err := dep.usecase().AllocateBackorders(context.Background(), tc.Skus)
+
+			if tc.Err {
+				var appErr *grpcErr.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, grpcErr.DbError, appErr.Type)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, allocated)
+		})
+	}
+}
+
+func TestBackorderUsecase_AllocatePending(t *testing.T) {
+	dep := newBackorderDeps(t)
+
+	allocated := []model.Backorder{
+		{Id: "bo-1", OrderId: "order-1", Sku: "MUG-BLUE", Quantity: 3, Status: model.BackorderAllocated},
+	}
+	dep.repoBO.EXPECT().GetSkusWithPendingBackorders(mock.Anything).
+		Return([]string{"MUG-BLUE", "TEA-BAG"}, nil)
+	dep.repoBO.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE", "TEA-BAG"}).
+		Return(allocated, nil)
+	dep.invalidator.EXPECT().InvalidateSkus(mock.Anything, []string{"MUG-BLUE"})
+	dep.logger.EXPECT().Infof("backorders allocated", mock.Anything)
+
+	count, err := dep.usecase().AllocatePending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestBackorderUsecase_ListEvents(t *testing.T) {
+	// an event is written once every backorder of an order is allocated, svc-order
+	// moves the order from BACKORDERED to CONFIRMED when it reads it
+	events := []model.BackorderEvent{
+		{Id: 1, OrderId: "order-1"},
+		{Id: 2, OrderId: "order-2"},
+	}
+
+	testCases := []struct {
+		Name          string
+		Limit         int
+		ExpectedLimit int
+	}{
+		{Name: "limit is passed through", Limit: 10, ExpectedLimit: 10},
+		{Name: "default limit", Limit: 0, ExpectedLimit: defaultBackorderEventsLimit},
+		{Name: "limit is capped", Limit: 10000, ExpectedLimit: maxBackorderEventsLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dep := newBackorderDeps(t)
+			dep.repoBO.EXPECT().ListBackorderEvents(mock.Anything, tc.ExpectedLimit).Return(events, nil)
+
+			result, err := dep.usecase().ListEvents(context.Background(), tc.Limit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, events, result)
+		})
+	}
+}
+
+func TestPurchaseOrderUsecase_ReceivePurchaseOrder(t *testing.T) {
+	const purchaseOrderId = "2b9d8f0e-6c1a-4e3b-8a7d-5f4c3b2a1e0d"
+	received := &model.PurchaseOrder{Id: purchaseOrderId, Status: model.PurchaseOrderReceived}
+	quantities := map[string]float64{"MUG-BLUE": 10}
+
+	testCases := []struct {
+		Name string
+		Mock func(dep purchaseOrderDeps)
+	}{
+		{
+			Name: "received stock goes to the backorders first",
+			Mock: func(dep purchaseOrderDeps) {
+				dep.allocator.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE"}).
+					Return([]model.Backorder{{Id: "bo-1", OrderId: "order-1", Sku: "MUG-BLUE", Quantity: 3}}, nil)
+			},
+		},
+		{
+			Name: "failed allocation is left to the backorder job",
+			Mock: func(dep purchaseOrderDeps) {
+				dep.allocator.EXPECT().AllocateBackorders(mock.Anything, []string{"MUG-BLUE"}).
+					Return(nil, errors.New("connection refused"))
+				dep.logger.EXPECT().Warnf("failed to allocate backorders after receiving purchase order %s: %v", mock.Anything)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dep := newPurchaseOrderDeps(t)
+			dep.repoPO.EXPECT().ReceivePurchaseOrder(mock.Anything, purchaseOrderId, quantities).Return(nil)
+			dep.invalidator.EXPECT().InvalidateSkus(mock.Anything, []string{"MUG-BLUE"})
+			tc.Mock(dep)
+			dep.repoPO.EXPECT().GetPurchaseOrder(mock.Anything, purchaseOrderId).Return(received, nil)
+
+			result, err := dep.usecase().ReceivePurchaseOrder(context.Background(), purchaseOrderId, quantities)
+
+			assert.NoError(t, err)
+			assert.Equal(t, received, result)
+		})
+	}
+}
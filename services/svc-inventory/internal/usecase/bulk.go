@@ -0,0 +1,634 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	"ops-monorepo/shared-libs/logger"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultBulkBatchSize = 1000
+
+var (
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+type IBulkUsecase interface {
+	Import(ctx context.Context, entity string, rows []model.BulkInputRow, opts model.BulkImportOptions) (*model.BulkImportReport, error)
+	Export(ctx context.Context, entity string, emit func(model.BulkRow) error) error
+}
+
+// SkuCacheInvalidator drops cached sku data after an import changed it
+type SkuCacheInvalidator interface {
+	InvalidateSkus(ctx context.Context, skus ...string)
+}
+
+type bulkUsecase struct {
+	logger      logger.Logger
+	repoBulk    repository.IBulkSQLRepository
+	invalidator SkuCacheInvalidator
+	allocator   BackorderAllocator
+}
+
+func NewBulkUsecase(log logger.Logger, repo repository.IBulkSQLRepository, invalidator SkuCacheInvalidator, allocator BackorderAllocator) IBulkUsecase {
+	return &bulkUsecase{
+		logger:      log,
+		repoBulk:    repo,
+		invalidator: invalidator,
+		allocator:   allocator,
+	}
+}
+
+// validated row waiting to be written, keeps its input line for the report
+type pendingRow struct {
+	line   int
+	row    model.BulkRow
+	key    string
+	record interface{}
+}
+
+// Import validates every row, rejects invalid ones with a reason and upserts the rest in batches.
+// in dry run mode nothing is written.
+func (uc *bulkUsecase) Import(ctx context.Context, entity string, rows []model.BulkInputRow, opts model.BulkImportOptions) (*model.BulkImportReport, error) {
+	if _, ok := model.BulkColumns[entity]; !ok {
+		return nil, fmt.Errorf("unknown entity %q", entity)
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBulkBatchSize
+	}
+
+	report := &model.BulkImportReport{
+		Entity:    entity,
+		DryRun:    opts.DryRun,
+		TotalRows: len(rows),
+		Rejected:  []model.RejectedRow{},
+	}
+
+	reject := func(line int, row model.BulkRow, reason string) {
+		report.Rejected = append(report.Rejected, model.RejectedRow{Line: line, Row: row, Reason: reason})
+	}
+
+	// parse and check each row on its own
+	seen := map[string]int{}
+	var pending []pendingRow
+	for _, input := range rows {
+		line, row := input.Line, input.Row
+		if input.Error != "" {
+			reject(line, row, input.Error)
+			continue
+		}
+
+		record, key, err := parseBulkRow(entity, row)
+		if err != nil {
+			reject(line, row, err.Error())
+			continue
+		}
+		if firstLine, dup := seen[key]; dup {
+			reject(line, row, fmt.Sprintf("duplicate of line %d", firstLine))
+			continue
+		}
+		seen[key] = line
+		pending = append(pending, pendingRow{line: line, row: row, key: key, record: record})
+	}
+
+	// check references against the database
+	pending, err := uc.checkReferences(ctx, entity, pending, reject)
+	if err != nil {
+		uc.logger.Errorf("failed to check bulk import references", "error", err.Error())
+		return nil, err
+	}
+
+	if opts.DryRun {
+		report.AcceptedRows = len(pending)
+		report.RejectedRows = len(report.Rejected)
+		return report, nil
+	}
+
+	// write in batches, one transaction per batch
+	for start := 0; start < len(pending); start += opts.BatchSize {
+		end := start + opts.BatchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+		report.Batches++
+
+		if err := uc.upsertBatch(ctx, entity, batch); err != nil {
+			uc.logger.Errorf("failed to upsert bulk batch", "entity", entity, "error", err.Error())
+			for _, p := range batch {
+				reject(p.line, p.row, fmt.Sprintf("batch %d rolled back: %v", report.Batches, err))
+			}
+			continue
+		}
+
+		report.AcceptedRows += len(batch)
+		uc.invalidateBatch(ctx, entity, batch)
+		uc.allocateBatch(ctx, entity, batch)
+	}
+
+	report.RejectedRows = len(report.Rejected)
+	return report, nil
+}
+
+func (uc *bulkUsecase) upsertBatch(ctx context.Context, entity string, batch []pendingRow) error {
+	switch entity {
+	case model.BulkEntityProducts:
+		records := make([]model.ProductRecord, 0, len(batch))
+		for _, p := range batch {
+			records = append(records, p.record.(model.ProductRecord))
+		}
+		return uc.repoBulk.UpsertProducts(ctx, records)
+	case model.BulkEntitySkus:
+		records := make([]model.SkuRecord, 0, len(batch))
+		for _, p := range batch {
+			records = append(records, p.record.(model.SkuRecord))
+		}
+		return uc.repoBulk.UpsertSkus(ctx, records)
+	case model.BulkEntityPrices:
+		records := make([]model.PriceRecord, 0, len(batch))
+		for _, p := range batch {
+			records = append(records, p.record.(model.PriceRecord))
+		}
+		return uc.repoBulk.UpsertPrices(ctx, records)
+	case model.BulkEntityStock:
+		records := make([]model.StockRecord, 0, len(batch))
+		for _, p := range batch {
+			records = append(records, p.record.(model.StockRecord))
+		}
+		return uc.repoBulk.UpsertStock(ctx, records)
+	}
+	return fmt.Errorf("unknown entity %q", entity)
+}
+
+// prices and stock are served from the check stock cache, drop them once written
+func (uc *bulkUsecase) invalidateBatch(ctx context.Context, entity string, batch []pendingRow) {
+	if uc.invalidator == nil {
+		return
+	}
+
+	var skus []string
+	for _, p := range batch {
+		switch rec := p.record.(type) {
+		case model.SkuRecord:
+			skus = append(skus, rec.Sku)
+		case model.PriceRecord:
+			skus = append(skus, rec.Sku)
+		case model.StockRecord:
+			skus = append(skus, rec.Sku)
+		}
+	}
+	if len(skus) > 0 {
+		uc.invalidator.InvalidateSkus(ctx, skus...)
+	}
+}
+
+// imported stock goes to waiting backorders first, a failure is left to the backorder job
+func (uc *bulkUsecase) allocateBatch(ctx context.Context, entity string, batch []pendingRow) {
+	if uc.allocator == nil || entity != model.BulkEntityStock {
+		return
+	}
+
+	skus := make([]string, 0, len(batch))
+	for _, p := range batch {
+		if rec, ok := p.record.(model.StockRecord); ok {
+			skus = append(skus, rec.Sku)
+		}
+	}
+	if _, err := uc.allocator.AllocateBackorders(ctx, skus); err != nil {
+		uc.logger.Warnf("failed to allocate backorders after stock import: %v", err)
+	}
+}
+
+// rejects rows pointing to products, categories, skus or uoms that do not exist
+func (uc *bulkUsecase) checkReferences(ctx context.Context, entity string, pending []pendingRow, reject func(int, model.BulkRow, string)) ([]pendingRow, error) {
+	collect := func(get func(interface{}) string) []string {
+		var keys []string
+		for _, p := range pending {
+			if k := get(p.record); k != "" {
+				keys = append(keys, k)
+			}
+		}
+		return keys
+	}
+
+	var checks []func(p pendingRow) string
+
+	switch entity {
+	case model.BulkEntityProducts:
+		categories, err := uc.repoBulk.FindExistingCategoryIds(ctx, collect(func(r interface{}) string {
+			if c := r.(model.ProductRecord).CategoryId; c != nil {
+				return *c
+			}
+			return ""
+		}))
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, func(p pendingRow) string {
+			if c := p.record.(model.ProductRecord).CategoryId; c != nil && !categories[*c] {
+				return fmt.Sprintf("category_id %s does not exist", *c)
+			}
+			return ""
+		})
+
+	case model.BulkEntitySkus:
+		products, err := uc.repoBulk.FindExistingProductIds(ctx, collect(func(r interface{}) string { return r.(model.SkuRecord).ProductId }))
+		if err != nil {
+			return nil, err
+		}
+		uoms, err := uc.repoBulk.FindExistingUoms(ctx, collect(func(r interface{}) string { return r.(model.SkuRecord).DefaultUom }))
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, func(p pendingRow) string {
+			rec := p.record.(model.SkuRecord)
+			if !products[rec.ProductId] {
+				return fmt.Sprintf("product_id %s does not exist", rec.ProductId)
+			}
+			if !uoms[rec.DefaultUom] {
+				return fmt.Sprintf("default_uom %s does not exist", rec.DefaultUom)
+			}
+			return ""
+		})
+
+	case model.BulkEntityPrices:
+		skus, err := uc.repoBulk.FindExistingSkus(ctx, collect(func(r interface{}) string { return r.(model.PriceRecord).Sku }))
+		if err != nil {
+			return nil, err
+		}
+		uoms, err := uc.repoBulk.FindExistingUoms(ctx, collect(func(r interface{}) string { return r.(model.PriceRecord).UomCode }))
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, func(p pendingRow) string {
+			rec := p.record.(model.PriceRecord)
+			if !skus[rec.Sku] {
+				return fmt.Sprintf("sku %s does not exist", rec.Sku)
+			}
+			if !uoms[rec.UomCode] {
+				return fmt.Sprintf("uom_code %s does not exist", rec.UomCode)
+			}
+			return ""
+		})
+
+	case model.BulkEntityStock:
+		keys := collect(func(r interface{}) string { return r.(model.StockRecord).Sku })
+		skus, err := uc.repoBulk.FindExistingSkus(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		reserved, err := uc.repoBulk.GetReservedStockBySkus(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, func(p pendingRow) string {
+			rec := p.record.(model.StockRecord)
+			if !skus[rec.Sku] {
+				return fmt.Sprintf("sku %s does not exist", rec.Sku)
+			}
+			if rec.CurrentStock < reserved[rec.Sku] {
+				return fmt.Sprintf("current_stock %.3f is below reserved stock %.3f", rec.CurrentStock, reserved[rec.Sku])
+			}
+			return ""
+		})
+	}
+
+	var valid []pendingRow
+	for _, p := range pending {
+		rejected := false
+		for _, check := range checks {
+			if reason := check(p); reason != "" {
+				reject(p.line, p.row, reason)
+				rejected = true
+				break
+			}
+		}
+		if !rejected {
+			valid = append(valid, p)
+		}
+	}
+
+	return valid, nil
+}
+
+// parseBulkRow converts a raw row into a typed record and returns its natural key
+func parseBulkRow(entity string, row model.BulkRow) (interface{}, string, error) {
+	for column := range row {
+		if !containsColumn(model.BulkColumns[entity], column) {
+			return nil, "", fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	switch entity {
+	case model.BulkEntityProducts:
+		var rec model.ProductRecord
+		var err error
+		if rec.Id, err = requiredString(row, "id"); err != nil {
+			return nil, "", err
+		}
+		if !uuidPattern.MatchString(rec.Id) {
+			return nil, "", errors.New("id must be a uuid")
+		}
+		if rec.Name, err = requiredString(row, "name"); err != nil {
+			return nil, "", err
+		}
+		if len(rec.Name) > 255 {
+			return nil, "", errors.New("name must be at most 255 characters")
+		}
+		rec.Description = optionalString(row, "description")
+		rec.CategoryId = optionalString(row, "category_id")
+		if rec.CategoryId != nil {
+			if !uuidPattern.MatchString(*rec.CategoryId) {
+				return nil, "", errors.New("category_id must be a uuid")
+			}
+			categoryId := strings.ToLower(*rec.CategoryId)
+			rec.CategoryId = &categoryId
+		}
+		if rec.Discontinued, err = optionalBool(row, "discontinued", false); err != nil {
+			return nil, "", err
+		}
+		rec.Id = strings.ToLower(rec.Id)
+		return rec, rec.Id, nil
+
+	case model.BulkEntitySkus:
+		var rec model.SkuRecord
+		var err error
+		if rec.Sku, err = requiredSku(row); err != nil {
+			return nil, "", err
+		}
+		if rec.ProductId, err = requiredString(row, "product_id"); err != nil {
+			return nil, "", err
+		}
+		if !uuidPattern.MatchString(rec.ProductId) {
+			return nil, "", errors.New("product_id must be a uuid")
+		}
+		rec.ProductId = strings.ToLower(rec.ProductId)
+		if rec.DefaultUom, err = requiredString(row, "default_uom"); err != nil {
+			return nil, "", err
+		}
+		if rec.VariantAttributes, err = optionalJSONObject(row, "variant_attributes"); err != nil {
+			return nil, "", err
+		}
+		if rec.IsActive, err = optionalBool(row, "is_active", true); err != nil {
+			return nil, "", err
+		}
+		return rec, rec.Sku, nil
+
+	case model.BulkEntityPrices:
+		var rec model.PriceRecord
+		var err error
+		if rec.Sku, err = requiredSku(row); err != nil {
+			return nil, "", err
+		}
+		if rec.UomCode, err = requiredString(row, "uom_code"); err != nil {
+			return nil, "", err
+		}
+		if rec.Currency, err = requiredString(row, "currency"); err != nil {
+			return nil, "", err
+		}
+		if !currencyPattern.MatchString(rec.Currency) {
+			return nil, "", errors.New("currency must be a 3 letter uppercase code")
+		}
+		if rec.UnitPrice, err = requiredNumber(row, "unit_price"); err != nil {
+			return nil, "", err
+		}
+		if rec.UnitPrice < 0 || rec.UnitPrice >= 1e8 {
+			return nil, "", errors.New("unit_price must be between 0 and 99999999.99")
+		}
+		validFrom, err := optionalTime(row, "valid_from")
+		if err != nil {
+			return nil, "", err
+		}
+		if validFrom == nil {
+			return nil, "", errors.New("valid_from is required")
+		}
+		rec.ValidFrom = *validFrom
+		if rec.ValidTo, err = optionalTime(row, "valid_to"); err != nil {
+			return nil, "", err
+		}
+		if rec.ValidTo != nil && !rec.ValidTo.After(rec.ValidFrom) {
+			return nil, "", errors.New("valid_to must be after valid_from")
+		}
+		if rec.IsActive, err = optionalBool(row, "is_active", true); err != nil {
+			return nil, "", err
+		}
+		key := fmt.Sprintf("%s|%s|%s", rec.Sku, rec.Currency, rec.ValidFrom.UTC().Format(time.RFC3339Nano))
+		return rec, key, nil
+
+	case model.BulkEntityStock:
+		var rec model.StockRecord
+		var err error
+		if rec.Sku, err = requiredSku(row); err != nil {
+			return nil, "", err
+		}
+		if rec.CurrentStock, err = requiredNumber(row, "current_stock"); err != nil {
+			return nil, "", err
+		}
+		if rec.CurrentStock < 0 {
+			return nil, "", errors.New("current_stock cannot be negative")
+		}
+		minLevel, err := optionalNumber(row, "min_stock_level")
+		if err != nil {
+			return nil, "", err
+		}
+		if minLevel != nil {
+			if *minLevel < 0 {
+				return nil, "", errors.New("min_stock_level cannot be negative")
+			}
+			rec.MinStockLevel = *minLevel
+		}
+		if rec.MaxStockLevel, err = optionalNumber(row, "max_stock_level"); err != nil {
+			return nil, "", err
+		}
+		if rec.MaxStockLevel != nil && *rec.MaxStockLevel < rec.MinStockLevel {
+			return nil, "", errors.New("max_stock_level must not be below min_stock_level")
+		}
+		return rec, rec.Sku, nil
+	}
+
+	return nil, "", fmt.Errorf("unknown entity %q", entity)
+}
+
+// Export streams every row of an entity as a BulkRow using the import column names
+func (uc *bulkUsecase) Export(ctx context.Context, entity string, emit func(model.BulkRow) error) error {
+	switch entity {
+	case model.BulkEntityProducts:
+		return uc.repoBulk.ExportProducts(ctx, func(rec model.ProductRecord) error {
+			return emit(model.BulkRow{
+				"id":           rec.Id,
+				"name":         rec.Name,
+				"description":  rec.Description,
+				"category_id":  rec.CategoryId,
+				"discontinued": rec.Discontinued,
+			})
+		})
+	case model.BulkEntitySkus:
+		return uc.repoBulk.ExportSkus(ctx, func(rec model.SkuRecord) error {
+			var attributes interface{}
+			if len(rec.VariantAttributes) > 0 {
+				attributes = rec.VariantAttributes
+			}
+			return emit(model.BulkRow{
+				"sku":                rec.Sku,
+				"product_id":         rec.ProductId,
+				"default_uom":        rec.DefaultUom,
+				"variant_attributes": attributes,
+				"is_active":          rec.IsActive,
+			})
+		})
+	case model.BulkEntityPrices:
+		return uc.repoBulk.ExportPrices(ctx, func(rec model.PriceRecord) error {
+			return emit(model.BulkRow{
+				"sku":        rec.Sku,
+				"uom_code":   rec.UomCode,
+				"currency":   rec.Currency,
+				"unit_price": rec.UnitPrice,
+				"valid_from": rec.ValidFrom,
+				"valid_to":   rec.ValidTo,
+				"is_active":  rec.IsActive,
+			})
+		})
+	case model.BulkEntityStock:
+		return uc.repoBulk.ExportStock(ctx, func(rec model.StockRecord) error {
+			return emit(model.BulkRow{
+				"sku":             rec.Sku,
+				"current_stock":   rec.CurrentStock,
+				"min_stock_level": rec.MinStockLevel,
+				"max_stock_level": rec.MaxStockLevel,
+			})
+		})
+	}
+
+	return fmt.Errorf("unknown entity %q", entity)
+}
+
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the trimmed textual value of a column, csv cells and json scalars alike
+func rawValue(row model.BulkRow, column string) (string, bool) {
+	v, ok := row[column]
+	if !ok || v == nil {
+		return "", false
+	}
+
+	var s string
+	switch val := v.(type) {
+	case string:
+		s = val
+	case json.Number:
+		s = val.String()
+	case bool:
+		s = strconv.FormatBool(val)
+	case float64:
+		s = strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(val)
+		s = string(b)
+	}
+
+	s = strings.TrimSpace(s)
+	return s, s != ""
+}
+
+func requiredString(row model.BulkRow, column string) (string, error) {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return "", fmt.Errorf("%s is required", column)
+	}
+	return s, nil
+}
+
+func requiredSku(row model.BulkRow) (string, error) {
+	sku, err := requiredString(row, "sku")
+	if err != nil {
+		return "", err
+	}
+	if len(sku) > 50 {
+		return "", errors.New("sku must be at most 50 characters")
+	}
+	return sku, nil
+}
+
+func optionalString(row model.BulkRow, column string) *string {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+func optionalBool(row model.BulkRow, column string, def bool) (bool, error) {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return def, nil
+	}
+	switch strings.ToLower(s) {
+	case "true", "1", "yes", "y":
+		return true, nil
+	case "false", "0", "no", "n":
+		return false, nil
+	}
+	return false, fmt.Errorf("%s must be a boolean", column)
+}
+
+func requiredNumber(row model.BulkRow, column string) (float64, error) {
+	n, err := optionalNumber(row, column)
+	if err != nil {
+		return 0, err
+	}
+	if n == nil {
+		return 0, fmt.Errorf("%s is required", column)
+	}
+	return *n, nil
+}
+
+func optionalNumber(row model.BulkRow, column string) (*float64, error) {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a number", column)
+	}
+	return &n, nil
+}
+
+func optionalTime(row model.BulkRow, column string) (*time.Time, error) {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC3339 timestamp", column)
+	}
+	return &t, nil
+}
+
+func optionalJSONObject(row model.BulkRow, column string) (json.RawMessage, error) {
+	s, ok := rawValue(row, column)
+	if !ok {
+		return nil, nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &obj); err != nil {
+		return nil, fmt.Errorf("%s must be a json object", column)
+	}
+	return json.RawMessage(s), nil
+}
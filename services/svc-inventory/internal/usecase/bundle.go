@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"strings"
+)
+
+// DefineBundle replaces the bill of materials of bundleSku, nested bundles are not supported
+func (uc *inventoryUsecase) DefineBundle(ctx context.Context, bundleSku string, components []model.BundleComponent) ([]model.BundleComponent, error) {
+
+	componentSkus := make([]string, 0, len(components))
+	for _, c := range components {
+		componentSkus = append(componentSkus, c.ComponentSku)
+	}
+
+	existing, err := uc.repoSQL.FindExistingSkus(ctx, append([]string{bundleSku}, componentSkus...))
+	if err != nil {
+		uc.logger.Errorf("failed in FindExistingSkus", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in FindExistingSkus", map[string]interface{}{"error": err.Error()})
+	}
+
+	fieldErrors := map[string]string{}
+	if !existing[bundleSku] {
+		fieldErrors["bundle_sku"] = "sku not found"
+	}
+
+	var missing []string
+	for _, sku := range componentSkus {
+		if !existing[sku] {
+			missing = append(missing, sku)
+		}
+	}
+	if len(missing) > 0 {
+		fieldErrors["components"] = "sku not found: " + strings.Join(missing, ", ")
+	}
+	if len(fieldErrors) > 0 {
+		return nil, grpcErr.NewValidationError("validation error", fieldErrors)
+	}
+
+	// a component cannot be a bundle itself
+	nested, err := uc.repoSQL.GetBundleComponents(ctx, componentSkus)
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundleComponents", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+	if len(nested) > 0 {
+		return nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"components": "nested bundles are not supported: " + nested[0].BundleSku + " is a bundle",
+		})
+	}
+
+	// and the bundle cannot already be a component of another bundle
+	parents, err := uc.repoSQL.GetBundlesContainingSku(ctx, bundleSku)
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundlesContainingSku", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundlesContainingSku", map[string]interface{}{"error": err.Error()})
+	}
+	if len(parents) > 0 {
+		return nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"bundle_sku": "nested bundles are not supported: sku is a component of " + strings.Join(parents, ", "),
+		})
+	}
+
+	if err := uc.repoSQL.ReplaceBundleComponents(ctx, bundleSku, components); err != nil {
+		uc.logger.Errorf("failed in ReplaceBundleComponents", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in ReplaceBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+
+	saved, err := uc.repoSQL.GetBundleComponents(ctx, []string{bundleSku})
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundleComponents", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+
+	return saved, nil
+}
+
+// returns the subset of skus that are bundles
+func (uc *inventoryUsecase) bundleSkus(ctx context.Context, skus []string) (map[string]bool, error) {
+	components, err := uc.repoSQL.GetBundleComponents(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := map[string]bool{}
+	for _, c := range components {
+		bundles[c.BundleSku] = true
+	}
+	return bundles, nil
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-inventory/internal/model"
+)
+
+func TestInventoryUsecase_ReserveBundle(t *testing.T) {
+	bundleHistory := []model.ReservationHistory{
+		{OrderId: mockOrderId, Sku: "GIFT-BOX", Status: model.ReservedStatus, LineType: model.ReservationLineBundle},
+	}
+	// 4 mugs and 5 tea bags build min(4/1, 5/2) = 2 gift boxes
+	giftBoxStock := []model.StockStatus{
+		{SKU: "GIFT-BOX", TotalQuantity: 2, AvailableQuantity: 2, IsBundle: true},
+	}
+	emptyGiftBoxStock := []model.StockStatus{
+		{SKU: "GIFT-BOX", TotalQuantity: 2, ReservedQuantity: 2, AvailableQuantity: 0, IsBundle: true},
+	}
+
+	testCases := []struct {
+		Name           string
+		Quantity       float64
+		Options        model.ReserveOptions
+		Mock           func(dep inventoryDeps)
+		ExpectedLines  []model.ReservedLine
+		ExpectedFailed []model.StockStatus
+	}{
+		{
+			Name:     "bundle reserves its components",
+			Quantity: 2,
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().BeginTransaction(mock.Anything).Return(nil, nil)
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(2)).
+					Return(giftBoxComponents, nil)
+				dep.repoSQL.EXPECT().CommitTransaction(mock.Anything, mock.Anything).Return(nil)
+				dep.repoSQL.EXPECT().GetReservationHistoryByOrderIdAndstatus(mock.Anything, mockOrderId, model.ReservedStatus).
+					Return(bundleHistory, nil)
+			},
+			ExpectedLines: []model.ReservedLine{{Sku: "GIFT-BOX", RequestedQuantity: 2, ReservedQuantity: 2}},
+		},
+		{
+			Name:     "short component fails the whole order",
+			Quantity: 5,
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().BeginTransaction(mock.Anything).Return(nil, nil)
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(5)).
+					Return(nil, errInsufficientStock)
+				dep.repoSQL.EXPECT().RollbackTransaction(mock.Anything, mock.Anything).Return(nil)
+				dep.repoSQL.EXPECT().CheckStockWithMultipleSkus(mock.Anything, []string{"GIFT-BOX"}).
+					Return(giftBoxStock, nil, nil)
+				dep.logger.EXPECT().Infof("insufficient quantity to reserve stock", mock.Anything)
+			},
+			ExpectedFailed: giftBoxStock,
+		},
+		{
+			Name:     "bundles are not backordered",
+			Quantity: 5,
+			Options:  model.ReserveOptions{AllowBackorder: true},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().BeginTransaction(mock.Anything).Return(nil, nil)
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(5)).
+					Return(nil, errInsufficientStock)
+				dep.repoSQL.EXPECT().RollbackTransaction(mock.Anything, mock.Anything).Return(nil)
+				dep.repoSQL.EXPECT().CheckStockWithMultipleSkus(mock.Anything, []string{"GIFT-BOX"}).
+					Return(giftBoxStock, nil, nil)
+				dep.logger.EXPECT().Infof("insufficient quantity to reserve stock", mock.Anything)
+			},
+			ExpectedFailed: giftBoxStock,
+		},
+		{
+			Name:     "partial reserves the bundles its components still build",
+			Quantity: 5,
+			Options:  model.ReserveOptions{Policy: model.ReservationPolicyPartial},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(5)).
+					Return(nil, errInsufficientStock).Once()
+				dep.repoSQL.EXPECT().CheckStockWithMultipleSkus(mock.Anything, []string{"GIFT-BOX"}).
+					Return(giftBoxStock, nil, nil)
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(2)).
+					Return(giftBoxComponents, nil).Once()
+				dep.logger.EXPECT().Infof("insufficient quantity to reserve stock", mock.Anything)
+				dep.repoSQL.EXPECT().GetReservationHistoryByOrderIdAndstatus(mock.Anything, mockOrderId, model.ReservedStatus).
+					Return(bundleHistory, nil)
+			},
+			ExpectedLines:  []model.ReservedLine{{Sku: "GIFT-BOX", RequestedQuantity: 5, ReservedQuantity: 2}},
+			ExpectedFailed: giftBoxStock,
+		},
+		{
+			Name:     "partial reserves nothing when no whole bundle is left",
+			Quantity: 1,
+			Options:  model.ReserveOptions{Policy: model.ReservationPolicyPartial},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().ReserveBundle(mock.Anything, mockOrderId, "GIFT-BOX", float64(1)).
+					Return(nil, errInsufficientStock)
+				dep.repoSQL.EXPECT().CheckStockWithMultipleSkus(mock.Anything, []string{"GIFT-BOX"}).
+					Return(emptyGiftBoxStock, nil, nil)
+				dep.logger.EXPECT().Infof("insufficient quantity to reserve stock", mock.Anything)
+			},
+			ExpectedFailed: emptyGiftBoxStock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dep := newInventoryDeps(t)
+			dep.repoSQL.EXPECT().GetBundleComponents(mock.Anything, []string{"GIFT-BOX"}).
+				Return(giftBoxComponents, nil)
+			tc.Mock(dep)
+
+			result, failed, err := dep.usecase().ReserveStock(context.Background(), mockOrderId, map[string]float64{"GIFT-BOX": tc.Quantity}, tc.Options)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedFailed, failed)
+			if tc.ExpectedLines == nil {
+				assert.Nil(t, result)
+				return
+			}
+			assert.Equal(t, tc.ExpectedLines, result.Lines)
+			assert.Equal(t, bundleHistory, result.History)
+			assert.Empty(t, result.Backorders)
+		})
+	}
+}
+
+func TestInventoryUsecase_ReleaseBundle(t *testing.T) {
+	dep := newInventoryDeps(t)
+
+	// the order holds a GIFT-BOX and a STARTER-KIT that both reserved a MUG-BLUE, only the gift box is released
+	// by its sku, the repository releases the component lines reserved for it and keeps those of the kit
+	dep.repoSQL.EXPECT().ReleaseOrderReservations(mock.Anything, mockOrderId, []string{"GIFT-BOX"}).
+		Return([]string{"GIFT-BOX", "MUG-BLUE", "TEA-BAG"}, nil)
+	giftBox := "GIFT-BOX"
+	released := []model.ReservationHistory{
+		{OrderId: mockOrderId, Sku: "GIFT-BOX", Quantity: 1, Status: model.ReleasedStatus, LineType: model.ReservationLineBundle},
+		{OrderId: mockOrderId, Sku: "MUG-BLUE", Quantity: 1, Status: model.ReleasedStatus, LineType: model.ReservationLineComponent, BundleSku: &giftBox},
+		{OrderId: mockOrderId, Sku: "TEA-BAG", Quantity: 2, Status: model.ReleasedStatus, LineType: model.ReservationLineComponent, BundleSku: &giftBox},
+	}
+	dep.repoSQL.EXPECT().GetReservationHistoryByOrderIdAndstatus(mock.Anything, mockOrderId, model.ReleasedStatus).
+		Return(released, nil)
+
+	history, failed, err := dep.usecase().ReleaseStock(context.Background(), mockOrderId, []string{"GIFT-BOX"})
+
+	assert.NoError(t, err)
+	assert.Nil(t, failed)
+	assert.Equal(t, released, history)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+)
+
+// CommitReservation consumes reserved stock of an order once its goods leave the warehouse, current and
+// reserved stock both go down by the consumed quantity. an empty quantities consumes everything the order
+// still holds. a commit id consumed by an earlier call is not consumed again, alreadyCommitted is then true
+// and consumed holds what the earlier call consumed
+func (uc *inventoryUsecase) CommitReservation(ctx context.Context, orderId, commitId string, quantities map[string]float64) (consumed map[string]float64, alreadyCommitted bool, err error) {
+	consumed, committed, err := uc.repoSQL.CommitReservation(ctx, orderId, commitId, quantities)
+	if errors.Is(err, repository.ErrCommitExceedsReserved) {
+		return nil, false, grpcErr.NewValidationError("validation error", map[string]string{
+			"items": err.Error(),
+		})
+	}
+	if err != nil {
+		uc.logger.Errorf("failed in CommitReservation", "error", err.Error())
+		return nil, false, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CommitReservation", map[string]interface{}{"error": err.Error()})
+	}
+	if !committed {
+		uc.logger.Infof("reservation already committed", "order_id", orderId, "commit_id", commitId)
+		return consumed, true, nil
+	}
+
+	uc.logger.Infof("reservation committed", "order_id", orderId, "commit_id", commitId, "consumed", consumed)
+	return consumed, false, nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+)
+
+func TestInventoryUsecase_CommitReservation(t *testing.T) {
+	const commitId = "shipment-1"
+	exceeds := fmt.Errorf("%w: sku MUG-BLUE holds 2.00, requested to consume 3.00", repository.ErrCommitExceedsReserved)
+
+	testCases := []struct {
+		Name             string
+		Quantities       map[string]float64
+		Mock             func(dep inventoryDeps)
+		ExpectedConsumed map[string]float64
+		AlreadyCommitted bool
+		Err              bool
+		ErrType          grpcErr.ErrorType
+	}{
+		{
+			Name:       "reserved stock is consumed",
+			Quantities: map[string]float64{"MUG-BLUE": 2},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, commitId, map[string]float64{"MUG-BLUE": 2}).
+					Return(map[string]float64{"MUG-BLUE": 2}, true, nil)
+				dep.logger.EXPECT().Infof("reservation committed", mock.Anything)
+			},
+			ExpectedConsumed: map[string]float64{"MUG-BLUE": 2},
+		},
+		{
+			Name:       "repeated commit id returns what the first commit consumed",
+			Quantities: map[string]float64{"MUG-BLUE": 5},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, commitId, map[string]float64{"MUG-BLUE": 5}).
+					Return(map[string]float64{"MUG-BLUE": 2}, false, nil)
+				dep.logger.EXPECT().Infof("reservation already committed", mock.Anything)
+			},
+			ExpectedConsumed: map[string]float64{"MUG-BLUE": 2},
+			AlreadyCommitted: true,
+		},
+		{
+			Name:       "more than is reserved",
+			Quantities: map[string]float64{"MUG-BLUE": 3},
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, commitId, map[string]float64{"MUG-BLUE": 3}).
+					Return(nil, false, exceeds)
+			},
+			Err:     true,
+			ErrType: grpcErr.ValidationError,
+		},
+		{
+			Name: "database error",
+			Mock: func(dep inventoryDeps) {
+				dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, commitId, map[string]float64(nil)).
+					Return(nil, false, errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed in CommitReservation", mock.Anything)
+			},
+			Err:     true,
+			ErrType: grpcErr.DbError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dep := newInventoryDeps(t)
+			tc.Mock(dep)
+
+			consumed, alreadyCommitted, err := dep.usecase().CommitReservation(context.Background(), mockOrderId, commitId, tc.Quantities)
+
+			if tc.Err {
+				var appErr *grpcErr.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tc.ErrType, appErr.Type)
+				assert.Nil(t, consumed)
+				assert.False(t, alreadyCommitted)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedConsumed, consumed)
+			assert.Equal(t, tc.AlreadyCommitted, alreadyCommitted)
+		})
+	}
+}
+
+func TestInventoryUsecase_CommitReservation_PartialThenRest(t *testing.T) {
+	dep := newInventoryDeps(t)
+
+	// the first shipment takes 2 of the 5 reserved mugs, the second takes the rest
+	dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, "shipment-1", map[string]float64{"MUG-BLUE": 2}).
+		Return(map[string]float64{"MUG-BLUE": 2}, true, nil).Once()
+	dep.repoSQL.EXPECT().CommitReservation(mock.Anything, mockOrderId, "shipment-2", map[string]float64(nil)).
+		Return(map[string]float64{"MUG-BLUE": 3}, true, nil).Once()
+	dep.logger.EXPECT().Infof("reservation committed", mock.Anything).Twice()
+
+	uc := dep.usecase()
+
+	consumed, alreadyCommitted, err := uc.CommitReservation(context.Background(), mockOrderId, "shipment-1", map[string]float64{"MUG-BLUE": 2})
+	assert.NoError(t, err)
+	assert.False(t, alreadyCommitted)
+	assert.Equal(t, map[string]float64{"MUG-BLUE": 2}, consumed)
+
+	consumed, alreadyCommitted, err = uc.CommitReservation(context.Background(), mockOrderId, "shipment-2", nil)
+	assert.NoError(t, err)
+	assert.False(t, alreadyCommitted)
+	assert.Equal(t, map[string]float64{"MUG-BLUE": 3}, consumed)
+}
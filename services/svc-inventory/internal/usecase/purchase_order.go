@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const (
+	defaultReplenishmentLookbackDays = 30
+	defaultReplenishmentLeadTimeDays = 7
+	defaultPurchaseOrderPageSize     = 50
+	maxPurchaseOrderPageSize         = 500
+)
+
+type IPurchaseOrderUsecase interface {
+	CreateReplenishmentPurchaseOrder(ctx context.Context, params model.ReplenishmentParams) (*model.PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, status string, pageSize int) ([]model.PurchaseOrder, error)
+	SubmitPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error)
+	ReceivePurchaseOrder(ctx context.Context, id string, quantities map[string]float64) (*model.PurchaseOrder, error)
+}
+
+type purchaseOrderUsecase struct {
+	logger      logger.Logger
+	repoPO      repository.IPurchaseOrderSQLRepository
+	invalidator SkuCacheInvalidator
+	allocator   BackorderAllocator
+}
+
+func NewPurchaseOrderUsecase(log logger.Logger, repo repository.IPurchaseOrderSQLRepository, invalidator SkuCacheInvalidator, allocator BackorderAllocator) IPurchaseOrderUsecase {
+	return &purchaseOrderUsecase{
+		logger:      log,
+		repoPO:      repo,
+		invalidator: invalidator,
+		allocator:   allocator,
+	}
+}
+
+// CreateReplenishmentPurchaseOrder drafts one purchase order covering every sku below its reorder point.
+// each line orders up to max_stock_level when it is above the reorder point, otherwise up to the
+// reorder point plus one lookback window of demand. returns nil when nothing needs to be reordered.
+func (uc *purchaseOrderUsecase) CreateReplenishmentPurchaseOrder(ctx context.Context, params model.ReplenishmentParams) (*model.PurchaseOrder, error) {
+
+	if params.LookbackDays <= 0 {
+		params.LookbackDays = defaultReplenishmentLookbackDays
+	}
+	if params.LeadTimeDays <= 0 {
+		params.LeadTimeDays = defaultReplenishmentLeadTimeDays
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -params.LookbackDays)
+	candidates, err := uc.repoPO.GetReplenishmentCandidates(ctx, params.Skus, since, params.LookbackDays, params.LeadTimeDays)
+	if err != nil {
+		uc.logger.Errorf("failed in GetReplenishmentCandidates", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetReplenishmentCandidates", map[string]interface{}{"error": err.Error()})
+	}
+
+	po := model.PurchaseOrder{
+		Status:   model.PurchaseOrderDraft,
+		Supplier: params.Supplier,
+	}
+	for _, c := range candidates {
+		quantity := suggestedOrderQuantity(c, params.LookbackDays)
+		if quantity <= 0 {
+			continue
+		}
+
+		reorderPoint, avgDailyDemand := c.ReorderPoint, c.AvgDailyDemand
+		po.Lines = append(po.Lines, model.PurchaseOrderLine{
+			Sku:             c.Sku,
+			OrderedQuantity: quantity,
+			ReorderPoint:    &reorderPoint,
+			AvgDailyDemand:  &avgDailyDemand,
+		})
+	}
+
+	if len(po.Lines) == 0 {
+		uc.logger.Info("no sku below its reorder point, purchase order not created")
+		return nil, nil
+	}
+
+	id, err := uc.repoPO.CreatePurchaseOrder(ctx, po)
+	if err != nil {
+		uc.logger.Errorf("failed in CreatePurchaseOrder", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CreatePurchaseOrder", map[string]interface{}{"error": err.Error()})
+	}
+
+	return uc.GetPurchaseOrder(ctx, id)
+}
+
+// rounds up to whole units so the position ends at or above the target
+func suggestedOrderQuantity(c model.ReplenishmentCandidate, lookbackDays int) float64 {
+	target := c.ReorderPoint + c.AvgDailyDemand*float64(lookbackDays)
+	if c.MaxStockLevel != nil && *c.MaxStockLevel > c.ReorderPoint {
+		target = *c.MaxStockLevel
+	}
+
+	position := c.CurrentStock - c.ReservedStock + c.OnOrder
+	return math.Ceil(target - position)
+}
+
+func (uc *purchaseOrderUsecase) GetPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error) {
+
+	po, err := uc.repoPO.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, uc.purchaseOrderError("GetPurchaseOrder", err)
+	}
+
+	return po, nil
+}
+
+func (uc *purchaseOrderUsecase) ListPurchaseOrders(ctx context.Context, status string, pageSize int) ([]model.PurchaseOrder, error) {
+
+	if status != "" && !model.IsPurchaseOrderStatus(status) {
+		return nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"status": "unknown purchase order status",
+		})
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPurchaseOrderPageSize
+	}
+	if pageSize > maxPurchaseOrderPageSize {
+		pageSize = maxPurchaseOrderPageSize
+	}
+
+	orders, err := uc.repoPO.ListPurchaseOrders(ctx, status, pageSize)
+	if err != nil {
+		uc.logger.Errorf("failed in ListPurchaseOrders", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in ListPurchaseOrders", map[string]interface{}{"error": err.Error()})
+	}
+
+	return orders, nil
+}
+
+// SubmitPurchaseOrder sends a draft to the supplier, only drafts can be submitted
+func (uc *purchaseOrderUsecase) SubmitPurchaseOrder(ctx context.Context, id string) (*model.PurchaseOrder, error) {
+
+	err := uc.repoPO.UpdatePurchaseOrderStatus(ctx, id, model.PurchaseOrderDraft, model.PurchaseOrderSubmitted)
+	if err != nil {
+		return nil, uc.purchaseOrderError("UpdatePurchaseOrderStatus", err)
+	}
+
+	return uc.GetPurchaseOrder(ctx, id)
+}
+
+// ReceivePurchaseOrder books delivered quantities, current stock grows through RECEIPT movements.
+// received stock is allocated to waiting backorders first
+func (uc *purchaseOrderUsecase) ReceivePurchaseOrder(ctx context.Context, id string, quantities map[string]float64) (*model.PurchaseOrder, error) {
+
+	err := uc.repoPO.ReceivePurchaseOrder(ctx, id, quantities)
+	if err != nil {
+		return nil, uc.purchaseOrderError("ReceivePurchaseOrder", err)
+	}
+
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	if uc.invalidator != nil {
+		uc.invalidator.InvalidateSkus(ctx, skus...)
+	}
+
+	// the receipt is already booked, a failed allocation is retried by the backorder job
+	if uc.allocator != nil {
+		if _, err := uc.allocator.AllocateBackorders(ctx, skus); err != nil {
+			uc.logger.Warnf("failed to allocate backorders after receiving purchase order %s: %v", id, err)
+		}
+	}
+
+	return uc.GetPurchaseOrder(ctx, id)
+}
+
+// maps repository sentinels to validation errors, anything else is a database error
+func (uc *purchaseOrderUsecase) purchaseOrderError(op string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPurchaseOrderNotFound):
+		return grpcErr.NewValidationError("validation error", map[string]string{
+			"id": "purchase order not found",
+		})
+	case errors.Is(err, repository.ErrPurchaseOrderStatus):
+		return grpcErr.NewValidationError("validation error", map[string]string{
+			"id": err.Error(),
+		})
+	case errors.Is(err, repository.ErrInvalidReceipt):
+		return grpcErr.NewValidationError("validation error", map[string]string{
+			"lines": err.Error(),
+		})
+	}
+
+	uc.logger.Errorf("failed in "+op, "error", err.Error())
+	return grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in "+op, map[string]interface{}{"error": err.Error()})
+}
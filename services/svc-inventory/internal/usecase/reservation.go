@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	"time"
+)
+
+const (
+	// locks the inventory row while availability is checked, reservations of a sku run one at a time
+	ReservationModePessimistic = "pessimistic"
+	// checks availability without a lock and retries the compare and swap update when it loses a race
+	ReservationModeOptimistic = "optimistic"
+)
+
+// ReservationConfig selects how plain skus are reserved, zero values fall back to the defaults.
+// bundles always lock their component rows
+type ReservationConfig struct {
+	Mode           string
+	MaxRetries     int           // optimistic retries after the first attempt
+	RetryBaseDelay time.Duration // backoff before the first retry, doubled on every retry
+	RetryMaxDelay  time.Duration // upper bound of a single backoff
+}
+
+const (
+	defaultReservationMaxRetries     = 5
+	defaultReservationRetryBaseDelay = 2 * time.Millisecond
+	defaultReservationRetryMaxDelay  = 50 * time.Millisecond
+)
+
+func (cfg ReservationConfig) withDefaults() ReservationConfig {
+	if cfg.Mode != ReservationModeOptimistic {
+		cfg.Mode = ReservationModePessimistic
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultReservationMaxRetries
+	}
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = defaultReservationRetryBaseDelay
+	}
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = defaultReservationRetryMaxDelay
+	}
+	return cfg
+}
+
+// reserves a plain sku with the configured concurrency control, in optimistic mode a lost
+// race is retried after a jittered backoff and repository.ErrVersionConflict is returned
+// once the retries are used up
+func (uc *inventoryUsecase) reserveSku(ctx context.Context, orderId, sku string, quantity float64) error {
+	if uc.reservation.Mode != ReservationModeOptimistic {
+		return uc.repoSQL.ReserveStock(ctx, orderId, sku, quantity)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := uc.repoSQL.ReserveStockOptimistic(ctx, orderId, sku, quantity)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			return err
+		}
+		if attempt >= uc.reservation.MaxRetries {
+			uc.logger.Warnf("reservation of %s still conflicting after %d retries", sku, attempt)
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryBackoff(attempt, uc.reservation.RetryBaseDelay, uc.reservation.RetryMaxDelay)):
+		}
+	}
+}
+
+// full jitter, a random delay up to base doubled attempt times and capped at max,
+// spreads out the retries of requests that conflicted with each other
+func retryBackoff(attempt int, base, max time.Duration) time.Duration {
+	ceiling := max
+	if attempt < 32 && base<<attempt > 0 && base<<attempt < max {
+		ceiling = base << attempt
+	}
+	return rand.N(ceiling + 1)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	"ops-monorepo/shared-libs/logger"
+	pg "ops-monorepo/shared-libs/storage/postgres"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Compares the reservation modes under contention against a seeded database:
+//
+//	INVENTORY_BENCH_DB_URI=postgres://... go test ./internal/usecase -run '^$' -bench Reservation -benchtime 5s
+//
+// every goroutine reserves one unit of a few hot skus. stock movements are append only,
+// so run it against a disposable database
+const benchOrderId = "00000000-0000-0000-0000-00000000be0c"
+
+// counts lost compare and swap races
+type conflictCountingRepository struct {
+	repository.IInventorySQLRepository
+	conflicts atomic.Int64
+}
+
+func (r *conflictCountingRepository) ReserveStockOptimistic(ctx context.Context, orderId, sku string, quantity float64) error {
+	err := r.IInventorySQLRepository.ReserveStockOptimistic(ctx, orderId, sku, quantity)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		r.conflicts.Add(1)
+	}
+	return err
+}
+
+func BenchmarkReservation(b *testing.B) {
+	dsn := os.Getenv("INVENTORY_BENCH_DB_URI")
+	if dsn == "" {
+		b.Skip("INVENTORY_BENCH_DB_URI is not set")
+	}
+
+	db, err := pg.NewPgx(dsn, nil, nil, nil)
+	if err != nil {
+		b.Fatalf("failed to connect: %v", err)
+	}
+	defer db.Close()
+
+	log := logger.New(&logger.Config{Level: "error", Format: "console", Output: io.Discard, TimeFormat: time.RFC3339})
+
+	for _, mode := range []string{ReservationModePessimistic, ReservationModeOptimistic} {
+		for _, hotSkus := range []int{1, 16} {
+			b.Run(fmt.Sprintf("%s/skus=%d", mode, hotSkus), func(b *testing.B) {
+				skus := seedBenchSkus(b, db, hotSkus)
+				repo := &conflictCountingRepository{IInventorySQLRepository: repository.NewInventoryRepository(db)}
+				uc := NewInventoryUsecase(log, repo, ReservationConfig{Mode: mode}, nil).(*inventoryUsecase)
+
+				var (
+					next   atomic.Int64
+					failed atomic.Int64
+				)
+				b.SetParallelism(8)
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						sku := skus[int(next.Add(1))%len(skus)]
+						if err := uc.reserveSku(context.Background(), benchOrderId, sku, 1); err != nil {
+							failed.Add(1)
+						}
+					}
+				})
+				b.StopTimer()
+
+				b.ReportMetric(float64(repo.conflicts.Load())/float64(b.N), "conflicts/op")
+				b.ReportMetric(float64(failed.Load())/float64(b.N), "failed/op")
+			})
+		}
+	}
+}
+
+// creates or resets skus with plenty of stock and no reservations
+func seedBenchSkus(b *testing.B, db *pg.PostgresPgx, n int) []string {
+	b.Helper()
+	ctx := context.Background()
+
+	statements := []string{
+		`INSERT INTO inventory_service.uom (code, name) VALUES ('EA', 'Each') ON CONFLICT DO NOTHING`,
+		`INSERT INTO inventory_service.products (id, name) VALUES ('00000000-0000-0000-0000-00000000be0c', 'Reservation benchmark') ON CONFLICT DO NOTHING`,
+		fmt.Sprintf(`INSERT INTO inventory_service.skus (sku, product_id, default_uom)
+			SELECT 'BENCH-RESERVE-' || i, '00000000-0000-0000-0000-00000000be0c', 'EA' FROM generate_series(1, %d) i
+			ON CONFLICT DO NOTHING`, n),
+		fmt.Sprintf(`INSERT INTO inventory_service.sku_inventory (sku, current_stock, reserved_stock)
+			SELECT 'BENCH-RESERVE-' || i, 100000000, 0 FROM generate_series(1, %d) i
+			ON CONFLICT (sku) DO UPDATE SET current_stock = EXCLUDED.current_stock, reserved_stock = 0`, n),
+		`DELETE FROM inventory_service.reservation_history WHERE order_id = '00000000-0000-0000-0000-00000000be0c'`,
+	}
+	for _, stmt := range statements {
+		if _, err := db.Pool().Exec(ctx, stmt); err != nil {
+			b.Fatalf("failed to seed benchmark skus: %v", err)
+		}
+	}
+
+	skus := make([]string, n)
+	for i := range skus {
+		skus[i] = fmt.Sprintf("BENCH-RESERVE-%d", i+1)
+	}
+	return skus
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"sort"
+	"strings"
+)
+
+// RestockReturn puts the returned quantities of an order back into current stock through RETURN
+// movements, a bundle is restocked as its components. a return restocked by an earlier call is not
+// restocked again, alreadyRestocked is then true and restocked holds what the return puts back.
+// restocked skus are allocated to waiting backorders right away
+func (uc *inventoryUsecase) RestockReturn(ctx context.Context, returnId, orderId string, quantities map[string]float64) (restocked map[string]float64, alreadyRestocked bool, err error) {
+
+	skus := make([]string, 0, len(quantities))
+	for sku := range quantities {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+
+	existing, err := uc.repoSQL.FindExistingSkus(ctx, skus)
+	if err != nil {
+		uc.logger.Errorf("failed in FindExistingSkus", "error", err.Error())
+		return nil, false, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in FindExistingSkus", map[string]interface{}{"error": err.Error()})
+	}
+
+	var missing []string
+	for _, sku := range skus {
+		if !existing[sku] {
+			missing = append(missing, sku)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, false, grpcErr.NewValidationError("validation error", map[string]string{
+			"items": "sku not found: " + strings.Join(missing, ", "),
+		})
+	}
+
+	components, err := uc.repoSQL.GetBundleComponents(ctx, skus)
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundleComponents", "error", err.Error())
+		return nil, false, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+
+	// bundles hold no stock, their components go back on the shelf
+	restocked = map[string]float64{}
+	bundles := map[string]bool{}
+	for _, c := range components {
+		bundles[c.BundleSku] = true
+		restocked[c.ComponentSku] += c.Quantity * quantities[c.BundleSku]
+	}
+	for _, sku := range skus {
+		if !bundles[sku] {
+			restocked[sku] += quantities[sku]
+		}
+	}
+
+	ok, err := uc.repoSQL.RestockReturn(ctx, returnId, restocked)
+	if err != nil {
+		uc.logger.Errorf("failed in RestockReturn", "error", err.Error())
+		return nil, false, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in RestockReturn", map[string]interface{}{"error": err.Error()})
+	}
+	if !ok {
+		uc.logger.Infof("return already restocked", "return_id", returnId, "order_id", orderId)
+		return restocked, true, nil
+	}
+
+	uc.logger.Infof("return restocked", "return_id", returnId, "order_id", orderId, "restocked", restocked)
+
+	// the return is already booked, a failed allocation is retried by the backorder job
+	if uc.allocator != nil {
+		restockedSkus := make([]string, 0, len(restocked))
+		for sku := range restocked {
+			restockedSkus = append(restockedSkus, sku)
+		}
+		if _, err := uc.allocator.AllocateBackorders(ctx, restockedSkus); err != nil {
+			uc.logger.Warnf("failed to allocate backorders after restocking return %s: %v", returnId, err)
+		}
+	}
+
+	return restocked, false, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+)
+
+const (
+	defaultSearchPageSize = 50
+	maxSearchPageSize     = 500
+)
+
+// SearchSkus returns one page of matching skus and the attribute facets of the whole filtered set
+func (uc *inventoryUsecase) SearchSkus(ctx context.Context, filter model.SkuSearchFilter, facetKeys []string, pageSize int, cursor string) (items []model.SkuSearchResult, facets []model.AttributeFacet, nextCursor string, err error) {
+
+	if filter.CategoryId != "" && !uuidPattern.MatchString(filter.CategoryId) {
+		return nil, nil, "", grpcErr.NewValidationError("validation error", map[string]string{"category_id": "must be a uuid"})
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	if cursor != "" {
+		sku, err := decodeSearchCursor(cursor)
+		if err != nil {
+			return nil, nil, "", grpcErr.NewValidationError("validation error", map[string]string{"cursor": err.Error()})
+		}
+		filter.CursorSku = sku
+	}
+
+	// fetch one extra row to know whether another page exists
+	items, err = uc.repoSQL.SearchSkus(ctx, filter, pageSize+1)
+	if err != nil {
+		uc.logger.Errorf("failed in SearchSkus", "error", err.Error())
+		return nil, nil, "", grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in SearchSkus", map[string]interface{}{"error": err.Error()})
+	}
+
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		nextCursor = encodeSearchCursor(items[len(items)-1].Sku)
+	}
+
+	facets, err = uc.repoSQL.GetSkuSearchFacets(ctx, filter, facetKeys)
+	if err != nil {
+		uc.logger.Errorf("failed in GetSkuSearchFacets", "error", err.Error())
+		return nil, nil, "", grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetSkuSearchFacets", map[string]interface{}{"error": err.Error()})
+	}
+
+	return items, facets, nextCursor, nil
+}
+
+// cursor is an opaque base64 of the last sku of the page
+func encodeSearchCursor(sku string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(sku))
+}
+
+func decodeSearchCursor(cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(raw) == 0 {
+		return "", errors.New("malformed cursor")
+	}
+	return string(raw), nil
+}
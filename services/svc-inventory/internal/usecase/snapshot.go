@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/internal/repository"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+// a day is snapshotted only once this long has passed after its end, so late commits are included
+const snapshotSettleDelay = 5 * time.Minute
+
+// SnapshotColumns is the column order of an exported snapshot
+var SnapshotColumns = []string{"snapshot_date", "sku", "as_of", "current_stock", "reserved_stock", "available_stock"}
+
+type ISnapshotUsecase interface {
+	// writes every settled day missing since the latest snapshot, returns the days written
+	WriteDueSnapshots(ctx context.Context, now time.Time) ([]time.Time, error)
+	WriteSnapshot(ctx context.Context, day time.Time) (rows int64, err error)
+	ExportSnapshot(ctx context.Context, day time.Time, emit func(model.BulkRow) error) error
+}
+
+type snapshotUsecase struct {
+	logger       logger.Logger
+	repoSnapshot repository.IStockSnapshotSQLRepository
+}
+
+func NewSnapshotUsecase(log logger.Logger, repo repository.IStockSnapshotSQLRepository) ISnapshotUsecase {
+	return &snapshotUsecase{
+		logger:       log,
+		repoSnapshot: repo,
+	}
+}
+
+// last day whose snapshot can be written at now
+func lastSettledDay(now time.Time) time.Time {
+	return now.UTC().Add(-snapshotSettleDelay).Truncate(24*time.Hour).AddDate(0, 0, -1)
+}
+
+func (uc *snapshotUsecase) WriteDueSnapshots(ctx context.Context, now time.Time) ([]time.Time, error) {
+	var next time.Time
+
+	latest, err := uc.repoSnapshot.GetLatestSnapshotDate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil {
+		next = latest.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	} else {
+		// first run, start from the day of the first movement
+		first, err := uc.repoSnapshot.GetFirstMovementTime(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			return nil, nil
+		}
+		next = first.UTC().Truncate(24 * time.Hour)
+	}
+
+	var written []time.Time
+	for day := next; !day.After(lastSettledDay(now)); day = day.AddDate(0, 0, 1) {
+		rows, err := uc.repoSnapshot.WriteDailySnapshot(ctx, day)
+		if err != nil {
+			return written, fmt.Errorf("failed to write snapshot of %s: %w", day.Format(time.DateOnly), err)
+		}
+		uc.logger.Infof("stock snapshot of %s written with %d rows", day.Format(time.DateOnly), rows)
+		written = append(written, day)
+	}
+
+	return written, nil
+}
+
+func (uc *snapshotUsecase) WriteSnapshot(ctx context.Context, day time.Time) (int64, error) {
+	day = day.UTC().Truncate(24 * time.Hour)
+	if day.After(lastSettledDay(time.Now())) {
+		return 0, fmt.Errorf("%s has not ended yet, snapshots are written for past days only", day.Format(time.DateOnly))
+	}
+
+	return uc.repoSnapshot.WriteDailySnapshot(ctx, day)
+}
+
+func (uc *snapshotUsecase) ExportSnapshot(ctx context.Context, day time.Time, emit func(model.BulkRow) error) error {
+	return uc.repoSnapshot.ExportSnapshot(ctx, day, func(rec model.StockSnapshot) error {
+		return emit(model.BulkRow{
+			"snapshot_date":   rec.SnapshotDate.Format(time.DateOnly),
+			"sku":             rec.Sku,
+			"as_of":           rec.AsOf,
+			"current_stock":   rec.CurrentStock,
+			"reserved_stock":  rec.ReservedStock,
+			"available_stock": rec.CurrentStock - rec.ReservedStock,
+		})
+	})
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"ops-monorepo/services/svc-inventory/internal/model"
+	grpcErr "ops-monorepo/shared-libs/grpc/errors"
+	"strings"
+)
+
+const (
+	defaultAlternativesLimit = 3
+	maxAlternativesLimit     = 20
+)
+
+// DefineSubstitutes replaces the substitution rules of sku, rules are one way
+func (uc *inventoryUsecase) DefineSubstitutes(ctx context.Context, sku string, rules []model.SubstituteRule) ([]model.SubstituteRule, error) {
+
+	skus := []string{sku}
+	for _, rule := range rules {
+		skus = append(skus, rule.SubstituteSku)
+	}
+
+	existing, err := uc.repoSQL.FindExistingSkus(ctx, skus)
+	if err != nil {
+		uc.logger.Errorf("failed in FindExistingSkus", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in FindExistingSkus", map[string]interface{}{"error": err.Error()})
+	}
+
+	fieldErrors := map[string]string{}
+	if !existing[sku] {
+		fieldErrors["sku"] = "sku not found"
+	}
+
+	var missing []string
+	for _, rule := range rules {
+		if !existing[rule.SubstituteSku] {
+			missing = append(missing, rule.SubstituteSku)
+		}
+	}
+	if len(missing) > 0 {
+		fieldErrors["substitutes"] = "sku not found: " + strings.Join(missing, ", ")
+	}
+	if len(fieldErrors) > 0 {
+		return nil, grpcErr.NewValidationError("validation error", fieldErrors)
+	}
+
+	if err := uc.repoSQL.ReplaceSubstitutes(ctx, sku, rules); err != nil {
+		uc.logger.Errorf("failed in ReplaceSubstitutes", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in ReplaceSubstitutes", map[string]interface{}{"error": err.Error()})
+	}
+
+	saved, err := uc.repoSQL.GetSubstitutes(ctx, sku)
+	if err != nil {
+		uc.logger.Errorf("failed in GetSubstitutes", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetSubstitutes", map[string]interface{}{"error": err.Error()})
+	}
+
+	return saved, nil
+}
+
+// SuggestAlternatives ranks in stock alternatives able to cover the requested quantity of every sku
+func (uc *inventoryUsecase) SuggestAlternatives(ctx context.Context, quantities map[string]float64, limit int) ([]model.SkuAlternatives, error) {
+
+	if limit <= 0 {
+		limit = defaultAlternativesLimit
+	}
+	if limit > maxAlternativesLimit {
+		limit = maxAlternativesLimit
+	}
+
+	alternatives, err := uc.repoSQL.SuggestAlternatives(ctx, quantities, limit)
+	if err != nil {
+		uc.logger.Errorf("failed in SuggestAlternatives", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in SuggestAlternatives", map[string]interface{}{"error": err.Error()})
+	}
+
+	return alternatives, nil
+}
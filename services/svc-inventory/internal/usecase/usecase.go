@@ -3,27 +3,52 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
 	"ops-monorepo/services/svc-inventory/internal/model"
 	"ops-monorepo/services/svc-inventory/internal/repository"
 	grpcErr "ops-monorepo/shared-libs/grpc/errors"
 	"ops-monorepo/shared-libs/logger"
+	"sort"
 	"strings"
+	"time"
+)
+
+const (
+	defaultReservationPageSize = 50
+	maxReservationPageSize     = 500
 )
 
 type IInventoryUsecase interface {
 	CheckStock(ctx context.Context, skus []string) ([]model.StockStatus, error)
-	ReserveStock(ctx context.Context, orderId string, skusQuantityMap map[string]float64) (reservationHistory []model.ReservationHistory, failedToReserve []model.StockStatus, err error)
+	ReserveStock(ctx context.Context, orderId string, skusQuantityMap map[string]float64, opts model.ReserveOptions) (result *model.ReservationResult, failedToReserve []model.StockStatus, err error)
+	ReleaseStock(ctx context.Context, orderId string, skus []string) (reservationHistory []model.ReservationHistory, failedToRelease []model.StockStatus, err error)
+	AmendReservation(ctx context.Context, orderId string, deltas map[string]float64) (reservationHistory []model.ReservationHistory, failedToReserve []model.StockStatus, err error)
+	DefineBundle(ctx context.Context, bundleSku string, components []model.BundleComponent) ([]model.BundleComponent, error)
+	ListReservations(ctx context.Context, filter model.ReservationFilter, pageSize int, cursor string) (reservations []model.ReservationHistory, totals []model.ReservationSkuTotal, nextCursor string, err error)
+	GetStockAsOf(ctx context.Context, skus []string, asOf time.Time) ([]model.StockPosition, error)
+	SearchSkus(ctx context.Context, filter model.SkuSearchFilter, facetKeys []string, pageSize int, cursor string) (items []model.SkuSearchResult, facets []model.AttributeFacet, nextCursor string, err error)
+	DefineSubstitutes(ctx context.Context, sku string, rules []model.SubstituteRule) ([]model.SubstituteRule, error)
+	SuggestAlternatives(ctx context.Context, quantities map[string]float64, limit int) ([]model.SkuAlternatives, error)
+	RestockReturn(ctx context.Context, returnId, orderId string, quantities map[string]float64) (restocked map[string]float64, alreadyRestocked bool, err error)
+	CommitReservation(ctx context.Context, orderId, commitId string, quantities map[string]float64) (consumed map[string]float64, alreadyCommitted bool, err error)
 }
 
 type inventoryUsecase struct {
-	logger  logger.Logger
-	repoSQL repository.IInventorySQLRepository
+	logger      logger.Logger
+	repoSQL     repository.IInventorySQLRepository
+	reservation ReservationConfig
+	allocator   BackorderAllocator
 }
 
-func NewInventoryUsecase(log logger.Logger, repo repository.IInventorySQLRepository) IInventoryUsecase {
+func NewInventoryUsecase(log logger.Logger, repo repository.IInventorySQLRepository, reservation ReservationConfig, allocator BackorderAllocator) IInventoryUsecase {
 	return &inventoryUsecase{
-		logger:  log,
-		repoSQL: repo,
+		logger:      log,
+		repoSQL:     repo,
+		reservation: reservation.withDefaults(),
+		allocator:   allocator,
 	}
 }
 
@@ -38,29 +63,79 @@ func (uc *inventoryUsecase) CheckStock(ctx context.Context, skus []string) ([]mo
 	return data, nil
 }
 
-func (uc *inventoryUsecase) ReserveStock(ctx context.Context, orderId string, skusQuantityMap map[string]float64) (stockStatus []model.ReservationHistory, failedToReserve []model.StockStatus, err error) {
+// ReserveStock reserves the skus of an order following opts.Policy, ALL_OR_NOTHING when empty.
+// with ALL_OR_NOTHING a short sku fails the whole order and failedToReserve holds the stock status
+// of every requested sku. the per line policies reserve what they can and failedToReserve holds
+// the stock status of the short skus, result is nil when nothing at all could be reserved
+func (uc *inventoryUsecase) ReserveStock(ctx context.Context, orderId string, skusQuantityMap map[string]float64, opts model.ReserveOptions) (result *model.ReservationResult, failedToReserve []model.StockStatus, err error) {
 
-	var successReservedSkus []string
+	if opts.Policy == "" {
+		opts.Policy = model.ReservationPolicyAllOrNothing
+	}
+	if opts.AllowBackorder && opts.Policy != model.ReservationPolicyAllOrNothing {
+		return nil, nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"allow_backorder": "only supported with the " + model.ReservationPolicyAllOrNothing + " reservation policy",
+		})
+	}
 
 	var skusArr []string
 	for sku, _ := range skusQuantityMap {
 		skusArr = append(skusArr, sku)
 	}
 
+	// bundles reserve their components instead of their own stock
+	bundles, err := uc.bundleSkus(ctx, skusArr)
+	if err != nil {
+		uc.logger.Errorf("failed in GetBundleComponents", "error", err.Error())
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetBundleComponents", map[string]interface{}{"error": err.Error()})
+	}
+
+	if opts.Policy == model.ReservationPolicyAllOrNothing {
+		return uc.reserveAllOrNothing(ctx, orderId, skusQuantityMap, skusArr, bundles, opts.AllowBackorder)
+	}
+	return uc.reservePerLine(ctx, orderId, skusQuantityMap, skusArr, bundles, opts.Policy == model.ReservationPolicyPartial)
+}
+
+// reserves every sku of an order or none of them. with allowBackorder the shortfall
+// of a plain sku is queued as a backorder instead of failing the order, bundles still need
+// every component in stock
+func (uc *inventoryUsecase) reserveAllOrNothing(ctx context.Context, orderId string, skusQuantityMap map[string]float64, skusArr []string, bundles map[string]bool, allowBackorder bool) (*model.ReservationResult, []model.StockStatus, error) {
+
+	var (
+		err                 error
+		successReservedSkus []string
+		result              = &model.ReservationResult{}
+	)
+
 	// begin db transaction
 	tx, _ := uc.repoSQL.BeginTransaction(ctx)
 
 	// loop reserve each sku
 	for sku, qty := range skusQuantityMap {
 
-		err := uc.repoSQL.ReserveStock(ctx, orderId, sku, qty)
+		reserved := qty
+		switch {
+		case bundles[sku]:
+			_, err = uc.repoSQL.ReserveBundle(ctx, orderId, sku, qty)
+		case allowBackorder:
+			var backorder *model.Backorder
+			backorder, err = uc.repoSQL.ReserveStockWithBackorder(ctx, orderId, sku, qty)
+			if backorder != nil {
+				result.Backorders = append(result.Backorders, *backorder)
+				reserved -= backorder.Quantity
+			}
+		default:
+			err = uc.reserveSku(ctx, orderId, sku, qty)
+		}
 		if err != nil {
-			errmsg := err.Error()
 			// rollback transaction
 			uc.repoSQL.RollbackTransaction(ctx, tx)
 
+			// every sku commits on its own, undo the ones reserved or queued before this one
+			uc.undoReservations(ctx, orderId, successReservedSkus)
+
 			// handle insufficient business logic
-			if strings.Contains(errmsg, "insufficient available quantity") {
+			if isInsufficientStock(err) {
 
 				// get failed stock current status
 				failedToReserve, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, skusArr)
@@ -73,11 +148,11 @@ func (uc *inventoryUsecase) ReserveStock(ctx context.Context, orderId string, sk
 				return nil, failedToReserve, nil
 			}
 
-			uc.logger.Errorf("something wrong with db: failed in ReserveStock", "error", errmsg)
-			return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in ReserveStock", map[string]interface{}{"error": err.Error()})
+			return nil, nil, uc.reservationError(sku, err)
 		}
 
 		successReservedSkus = append(successReservedSkus, sku)
+		result.Lines = append(result.Lines, model.ReservedLine{Sku: sku, RequestedQuantity: qty, ReservedQuantity: reserved})
 	}
 
 	// commit transaction
@@ -87,77 +162,285 @@ func (uc *inventoryUsecase) ReserveStock(ctx context.Context, orderId string, sk
 	}
 
 	// get reservation history
-	reserveHistory, err := uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
+	result.History, err = uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
 	if err != nil {
 		uc.logger.Errorf("failed in GetReservationHistoryByOrderId", "error", err.Error())
 		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CheckStockWithMultipleSkus", map[string]interface{}{"error": err.Error()})
 	}
 
-	return reserveHistory, nil, nil
+	return result, nil, nil
 }
 
-func (uc *inventoryUsecase) ReleaseStock(ctx context.Context, orderId string) (reservationHistory []model.ReservationHistory, failedToRelease []model.StockStatus, err error) {
+// reserves each sku on its own, a short sku does not fail the others. with partial a short
+// sku reserves what is available, otherwise it reserves nothing
+func (uc *inventoryUsecase) reservePerLine(ctx context.Context, orderId string, skusQuantityMap map[string]float64, skusArr []string, bundles map[string]bool, partial bool) (*model.ReservationResult, []model.StockStatus, error) {
 
-	var successReleasedSkus []string
+	// stable order, lines are returned as requested by sku
+	sort.Strings(skusArr)
+
+	var (
+		result       = &model.ReservationResult{}
+		reservedSkus []string
+		shortSkus    []string
+	)
+	for _, sku := range skusArr {
+		qty := skusQuantityMap[sku]
+
+		reserved, err := uc.reserveLine(ctx, orderId, sku, qty, bundles[sku], partial)
+		if err != nil {
+			uc.undoReservations(ctx, orderId, reservedSkus)
+			return nil, nil, uc.reservationError(sku, err)
+		}
+
+		if reserved > 0 {
+			reservedSkus = append(reservedSkus, sku)
+		}
+		if reserved < qty {
+			shortSkus = append(shortSkus, sku)
+		}
+		result.Lines = append(result.Lines, model.ReservedLine{Sku: sku, RequestedQuantity: qty, ReservedQuantity: reserved})
+	}
+
+	var failedToReserve []model.StockStatus
+	if len(shortSkus) > 0 {
+		var err error
+		failedToReserve, _, err = uc.repoSQL.CheckStockWithMultipleSkus(ctx, shortSkus)
+		if err != nil {
+			uc.undoReservations(ctx, orderId, reservedSkus)
+			return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetStockStatus", map[string]interface{}{"error": err.Error()})
+		}
+		uc.logger.Infof("insufficient quantity to reserve stock", "failed_to_reserve", failedToReserve)
+	}
+
+	// nothing reserved, the order failed as a whole
+	if len(reservedSkus) == 0 {
+		return nil, failedToReserve, nil
+	}
 
-	// get reservation history by orderId and reserved status
-	reservationHistory, err = uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
+	history, err := uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
 	if err != nil {
-		uc.logger.Errorf("something wrong with db: failed in GetReservationHistoryByOrderId", "error", err.Error())
-		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetReservationHistoryByOrderId", map[string]interface{}{"error": err.Error()})
+		uc.logger.Errorf("failed in GetReservationHistoryByOrderId", "error", err.Error())
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetReservationHistoryByOrderIdAndstatus", map[string]interface{}{"error": err.Error()})
 	}
+	result.History = history
+
+	return result, failedToReserve, nil
+}
+
+// reserves a single line and returns the reserved quantity, zero when the line is short
+// and nothing could be reserved. plain skus reserve what is available under a row lock in
+// partial mode, bundles retry with the whole bundles their components still cover
+func (uc *inventoryUsecase) reserveLine(ctx context.Context, orderId, sku string, qty float64, bundle, partial bool) (float64, error) {
 
-	// allskus
-	var reservedSkusArr []string
-	for _, r := range reservationHistory {
-		reservedSkusArr = append(reservedSkusArr, r.Sku)
+	if partial && !bundle {
+		return uc.repoSQL.ReserveAvailableStock(ctx, orderId, sku, qty)
 	}
 
-	// begin db transaction
-	tx, _ := uc.repoSQL.BeginTransaction(ctx)
+	var err error
+	if bundle {
+		_, err = uc.repoSQL.ReserveBundle(ctx, orderId, sku, qty)
+	} else {
+		err = uc.reserveSku(ctx, orderId, sku, qty)
+	}
+	if err == nil {
+		return qty, nil
+	}
+	if !isInsufficientStock(err) {
+		return 0, err
+	}
+	if !partial {
+		return 0, nil
+	}
 
-	for _, reservedSku := range reservationHistory {
+	stocks, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, []string{sku})
+	if err != nil {
+		return 0, err
+	}
+	if len(stocks) == 0 || stocks[0].AvailableQuantity <= 0 {
+		return 0, nil
+	}
 
-		err := uc.repoSQL.ReleaseStock(ctx, reservedSku.Sku, reservedSku.Quantity)
-		if err != nil {
-			// rollback transaction
-			uc.repoSQL.RollbackTransaction(ctx, tx)
-			errmsg := err.Error()
+	available := math.Min(stocks[0].AvailableQuantity, qty)
+	if _, err := uc.repoSQL.ReserveBundle(ctx, orderId, sku, available); err != nil {
+		// lost the components to a concurrent reservation
+		if isInsufficientStock(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return available, nil
+}
 
-			// handle insufficient business logic
-			if strings.Contains(errmsg, "insufficient available quantity") {
+func isInsufficientStock(err error) bool {
+	return strings.Contains(err.Error(), "insufficient available quantity")
+}
 
-				// get failed stock current status
-				failedToRelease, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, reservedSkusArr)
+// maps a failed reservation of sku to an app error
+func (uc *inventoryUsecase) reservationError(sku string, err error) error {
+
+	// too many concurrent reservations of the same sku, the caller can retry the order
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return grpcErr.NewAppError(grpcErr.ConcurrencyConflict, "sku "+sku+" is reserved concurrently, try again", map[string]interface{}{"sku": sku})
+	}
+
+	uc.logger.Errorf("something wrong with db: failed in ReserveStock", "error", err.Error())
+	return grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in ReserveStock", map[string]interface{}{"error": err.Error()})
+}
+
+// releases what a failed order reserved so far and cancels its queued backorders, best effort
+func (uc *inventoryUsecase) undoReservations(ctx context.Context, orderId string, skus []string) {
+	if len(skus) == 0 {
+		return
+	}
+	if _, err := uc.repoSQL.ReleaseOrderReservations(ctx, orderId, skus); err != nil {
+		uc.logger.Errorf("failed to undo reservations of order "+orderId, "error", err.Error())
+	}
+}
+
+// releases the reserved lines of an order, all of them when skus is empty.
+// bundle skus release every component reserved for them.
+func (uc *inventoryUsecase) ReleaseStock(ctx context.Context, orderId string, skus []string) (reservationHistory []model.ReservationHistory, failedToRelease []model.StockStatus, err error) {
+
+	releasedSkus, err := uc.repoSQL.ReleaseOrderReservations(ctx, orderId, skus)
+	if err != nil {
+		errmsg := err.Error()
+
+		// handle insufficient business logic
+		if strings.Contains(errmsg, "insufficient reserved quantity") {
+
+			// get failed stock current status, every reserved sku of the order when none was given
+			statusSkus := skus
+			if len(statusSkus) == 0 {
+				reserved, err := uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReservedStatus)
 				if err != nil {
-					return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetStockStatus", map[string]interface{}{"error": err.Error()})
+					return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetReservationHistoryByOrderIdAndstatus", map[string]interface{}{"error": err.Error()})
+				}
+				for _, r := range reserved {
+					statusSkus = append(statusSkus, r.Sku)
 				}
+			}
 
-				// return failed stock status without app error
-				uc.logger.Infof("insufficient quantity to release stock", "failed_to_release", failedToRelease)
-				return nil, failedToRelease, nil
+			failedToRelease, _, err := uc.repoSQL.CheckStockWithMultipleSkus(ctx, statusSkus)
+			if err != nil {
+				return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in GetStockStatus", map[string]interface{}{"error": err.Error()})
 			}
 
-			// other than insufficient return app error
-			uc.logger.Errorf("something wrong with db: failed in ReleaseStock", "error", errmsg)
-			return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in ReserveStock", map[string]interface{}{"error": err.Error()})
+			// return failed stock status without app error
+			uc.logger.Infof("insufficient quantity to release stock", "failed_to_release", failedToRelease)
+			return nil, failedToRelease, nil
 		}
 
-		successReleasedSkus = append(successReleasedSkus, reservedSku.Sku)
+		// other than insufficient return app error
+		uc.logger.Errorf("something wrong with db: failed in ReleaseOrderReservations", "error", errmsg)
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with db: failed in ReleaseOrderReservations", map[string]interface{}{"error": err.Error()})
 	}
 
-	// commit transaction
-	err = uc.repoSQL.CommitTransaction(ctx, tx)
-	if err != nil {
-		return nil, nil, grpcErr.NewAppError(grpcErr.DbTransactionError, "something wrong with db transaction: failed in CommitTransaction", map[string]interface{}{"error": err.Error()})
+	if len(releasedSkus) == 0 {
+		return nil, nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"order_id": "no reserved stock found for this order",
+		})
 	}
 
-	// get skus inventory status
+	// get released reservation history
 	releasedReserveHistory, err := uc.repoSQL.GetReservationHistoryByOrderIdAndstatus(ctx, orderId, model.ReleasedStatus)
 	if err != nil {
 		uc.logger.Errorf("failed in GetReservationHistoryByOrderId", "error", err.Error())
-		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in CheckStockWithMultipleSkus", map[string]interface{}{"error": err.Error()})
+		return nil, nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetReservationHistoryByOrderIdAndstatus", map[string]interface{}{"error": err.Error()})
 	}
 
 	return releasedReserveHistory, nil, nil
 }
+
+func (uc *inventoryUsecase) ListReservations(ctx context.Context, filter model.ReservationFilter, pageSize int, cursor string) (reservations []model.ReservationHistory, totals []model.ReservationSkuTotal, nextCursor string, err error) {
+
+	if pageSize <= 0 {
+		pageSize = defaultReservationPageSize
+	}
+	if pageSize > maxReservationPageSize {
+		pageSize = maxReservationPageSize
+	}
+
+	if cursor != "" {
+		reservedAt, id, err := decodeReservationCursor(cursor)
+		if err != nil {
+			return nil, nil, "", grpcErr.NewValidationError("validation error", map[string]string{"cursor": err.Error()})
+		}
+		filter.CursorReservedAt = &reservedAt
+		filter.CursorId = id
+	}
+
+	// fetch one extra row to know whether another page exists
+	reservations, err = uc.repoSQL.ListReservationHistory(ctx, filter, pageSize+1)
+	if err != nil {
+		uc.logger.Errorf("failed in ListReservationHistory", "error", err.Error())
+		return nil, nil, "", grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in ListReservationHistory", map[string]interface{}{"error": err.Error()})
+	}
+
+	if len(reservations) > pageSize {
+		reservations = reservations[:pageSize]
+		last := reservations[len(reservations)-1]
+		nextCursor = encodeReservationCursor(last.ReservedAt, last.Id)
+	}
+
+	// totals cover the whole filtered set, not only the current page
+	totals, err = uc.repoSQL.GetReservationTotalsBySku(ctx, filter)
+	if err != nil {
+		uc.logger.Errorf("failed in GetReservationTotalsBySku", "error", err.Error())
+		return nil, nil, "", grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetReservationTotalsBySku", map[string]interface{}{"error": err.Error()})
+	}
+
+	return reservations, totals, nextCursor, nil
+}
+
+func (uc *inventoryUsecase) GetStockAsOf(ctx context.Context, skus []string, asOf time.Time) ([]model.StockPosition, error) {
+
+	positions, err := uc.repoSQL.GetStockAsOf(ctx, skus, asOf)
+	if err != nil {
+		uc.logger.Errorf("failed in GetStockAsOf", "error", err.Error())
+		return nil, grpcErr.NewAppError(grpcErr.DbError, "something wrong with database: failed in GetStockAsOf", map[string]interface{}{"error": err.Error()})
+	}
+
+	// unknown skus are not returned by the query
+	found := make(map[string]bool, len(positions))
+	for _, p := range positions {
+		found[p.Sku] = true
+	}
+	var missing []string
+	for _, sku := range skus {
+		if !found[sku] {
+			missing = append(missing, sku)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, grpcErr.NewValidationError("validation error", map[string]string{
+			"skus": "sku not found: " + strings.Join(missing, ", "),
+		})
+	}
+
+	return positions, nil
+}
+
+// cursor is an opaque base64 of "<reserved_at unix nano>|<reservation id>"
+func encodeReservationCursor(reservedAt time.Time, id string) string {
+	raw := fmt.Sprintf("%d|%s", reservedAt.UnixNano(), id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeReservationCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	var nano int64
+	if _, err := fmt.Sscanf(parts[0], "%d", &nano); err != nil {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+
+	return time.Unix(0, nano).UTC(), parts[1], nil
+}
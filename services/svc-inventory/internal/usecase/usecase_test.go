@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"ops-monorepo/services/svc-inventory/internal/model"
+	"ops-monorepo/services/svc-inventory/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+const mockOrderId = "9680e493-843d-4069-9b38-7495e70d7621"
+
+var (
+	// GIFT-BOX is one MUG-BLUE and two TEA-BAG, STARTER-KIT shares the mug
+	giftBoxComponents = []model.BundleComponent{
+		{BundleSku: "GIFT-BOX", ComponentSku: "MUG-BLUE", Quantity: 1},
+		{BundleSku: "GIFT-BOX", ComponentSku: "TEA-BAG", Quantity: 2},
+	}
+
+	// what the repository returns when a line is short
+	errInsufficientStock = errors.New("insufficient available quantity for SKU TEA-BAG: requested 10.00, available 5.00")
+)
+
+type inventoryDeps struct {
+	logger  *loggerMocks.MockLogger
+	repoSQL *mocks.MockIInventorySQLRepository
+}
+
+func newInventoryDeps(t *testing.T) inventoryDeps {
+	return inventoryDeps{
+		logger:  loggerMocks.NewMockLogger(t),
+		repoSQL: mocks.NewMockIInventorySQLRepository(t),
+	}
+}
+
+func (d inventoryDeps) usecase() IInventoryUsecase {
+	return NewInventoryUsecase(d.logger, d.repoSQL, ReservationConfig{}, nil)
+}
+
+type backorderDeps struct {
+	logger      *loggerMocks.MockLogger
+	repoBO      *mocks.MockIBackorderSQLRepository
+	invalidator *mocks.MockSkuCacheInvalidator
+}
+
+func newBackorderDeps(t *testing.T) backorderDeps {
+	return backorderDeps{
+		logger:      loggerMocks.NewMockLogger(t),
+		repoBO:      mocks.NewMockIBackorderSQLRepository(t),
+		invalidator: mocks.NewMockSkuCacheInvalidator(t),
+	}
+}
+
+func (d backorderDeps) usecase() IBackorderUsecase {
+	return NewBackorderUsecase(d.logger, d.repoBO, d.invalidator)
+}
+
+type purchaseOrderDeps struct {
+	logger      *loggerMocks.MockLogger
+	repoPO      *mocks.MockIPurchaseOrderSQLRepository
+	invalidator *mocks.MockSkuCacheInvalidator
+	allocator   *mocks.MockBackorderAllocator
+}
+
+func newPurchaseOrderDeps(t *testing.T) purchaseOrderDeps {
+	return purchaseOrderDeps{
+		logger:      loggerMocks.NewMockLogger(t),
+		repoPO:      mocks.NewMockIPurchaseOrderSQLRepository(t),
+		invalidator: mocks.NewMockSkuCacheInvalidator(t),
+		allocator:   mocks.NewMockBackorderAllocator(t),
+	}
+}
+
+func (d purchaseOrderDeps) usecase() IPurchaseOrderUsecase {
+	return NewPurchaseOrderUsecase(d.logger, d.repoPO, d.invalidator, d.allocator)
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"ops-monorepo/services/svc-inventory/config"
 	"ops-monorepo/services/svc-inventory/internal"
+	"os"
 )
 
 func main() {
@@ -13,12 +15,31 @@ func main() {
 	// load config
 	config, _ := config.LoadConfig(".env")
 
+	// bulk import/export subcommand
+	if len(os.Args) > 1 && os.Args[1] == "bulk" {
+		if err := internal.RunBulkCommand(config, os.Args[2:]); err != nil {
+			log.Fatalf("bulk: %v", err)
+		}
+		return
+	}
+
+	// stock snapshot subcommand
+	if len(os.Args) > 1 && os.Args[1] == "snapshot" {
+		if err := internal.RunSnapshotCommand(config, os.Args[2:]); err != nil {
+			log.Fatalf("snapshot: %v", err)
+		}
+		return
+	}
+
 	// init server
 	grpc := internal.NewGrpcServer(config)
 
 	// register implementation
 	grpc.Register()
 
+	// background jobs
+	grpc.StartJobs(context.Background())
+
 	// create tcp listener
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", config.Port))
 	if err != nil {
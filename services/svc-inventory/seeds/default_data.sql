@@ -16,17 +16,17 @@ INSERT INTO inventory_service.product_categories (id, name, description) VALUES
 ('a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'Books', 'Books and publications');
 
 -- Seed Products
-INSERT INTO inventory_service.products (id, name, description, category_id) VALUES
-('550e8400-e29b-41d4-a716-446655440000', 'Smartphone X', 'Latest smartphone model', '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d'),
-('550e8400-e29b-41d4-a716-446655440001', 'Wireless Headphones', 'Noise cancelling headphones', '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d'),
-('550e8400-e29b-41d4-a716-446655440002', 'T-Shirt', 'Cotton t-shirt', '1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed'),
-('550e8400-e29b-41d4-a716-446655440003', 'Jeans', 'Denim jeans', '1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed'),
-('550e8400-e29b-41d4-a716-446655440004', 'Rice 5kg', 'Premium quality rice', '6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b'),
-('550e8400-e29b-41d4-a716-446655440005', 'Olive Oil', 'Extra virgin olive oil', '6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b'),
-('550e8400-e29b-41d4-a716-446655440006', 'Office Chair', 'Ergonomic office chair', 'f47ac10b-58cc-4372-a567-0e02b2c3d479'),
-('550e8400-e29b-41d4-a716-446655440007', 'Coffee Table', 'Modern coffee table', 'f47ac10b-58cc-4372-a567-0e02b2c3d479'),
-('550e8400-e29b-41d4-a716-446655440008', 'Programming Book', 'Guide to Go programming', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11'),
-('550e8400-e29b-41d4-a716-446655440009', 'Cookbook', 'International recipes', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11');
+INSERT INTO inventory_service.products (id, name, description, category_id, tax_category) VALUES
+('550e8400-e29b-41d4-a716-446655440000', 'Smartphone X', 'Latest smartphone model', '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440001', 'Wireless Headphones', 'Noise cancelling headphones', '9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440002', 'T-Shirt', 'Cotton t-shirt', '1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440003', 'Jeans', 'Denim jeans', '1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440004', 'Rice 5kg', 'Premium quality rice', '6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b', 'REDUCED'),
+('550e8400-e29b-41d4-a716-446655440005', 'Olive Oil', 'Extra virgin olive oil', '6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b', 'REDUCED'),
+('550e8400-e29b-41d4-a716-446655440006', 'Office Chair', 'Ergonomic office chair', 'f47ac10b-58cc-4372-a567-0e02b2c3d479', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440007', 'Coffee Table', 'Modern coffee table', 'f47ac10b-58cc-4372-a567-0e02b2c3d479', 'STANDARD'),
+('550e8400-e29b-41d4-a716-446655440008', 'Programming Book', 'Guide to Go programming', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'REDUCED'),
+('550e8400-e29b-41d4-a716-446655440009', 'Cookbook', 'International recipes', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'REDUCED');
 
 -- Seed SKUs (updated with new product_id references)
 INSERT INTO inventory_service.skus (sku, product_id, variant_attributes, default_uom) VALUES
@@ -80,4 +80,9 @@ INSERT INTO inventory_service.sku_prices (sku, uom_code, currency, unit_price, v
 ('COOKBOOK-INTL', 'EA','USD', 29.99, '2023-01-01', NULL),
 ('SMARTPHONE-X-BLUE', 'EA','USD', 699.99, '2023-01-01', NULL),
 ('HEADPHONES-WHITE', 'EA','USD', 199.99, '2023-01-01', NULL),
-('JEANS-30-BLACK', 'EA','USD', 49.99, '2023-01-01', NULL);
\ No newline at end of file
+('JEANS-30-BLACK', 'EA','USD', 49.99, '2023-01-01', NULL);
+
+-- Opening balances of the seeded inventory in the stock movement log
+INSERT INTO inventory_service.stock_movements (sku, movement_type, current_delta, reserved_delta, reference)
+SELECT sku, 'OPENING', current_stock, reserved_stock, 'seed'
+FROM inventory_service.sku_inventory;
\ No newline at end of file
@@ -22,6 +22,7 @@ CREATE TABLE IF NOT exists inventory_service.products (
     name VARCHAR(255) NOT NULL,
     description TEXT,
     category_id UUID REFERENCES inventory_service.product_categories(id),
+    tax_category VARCHAR(20) NOT NULL DEFAULT 'STANDARD' CHECK (tax_category IN ('STANDARD', 'REDUCED', 'ZERO', 'EXEMPT')), -- selects the tax rate of the order service
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     discontinued BOOLEAN NOT NULL DEFAULT FALSE
@@ -44,9 +45,21 @@ CREATE TABLE IF NOT exists inventory_service.sku_inventory (
     reserved_stock DECIMAL(12, 3) NOT NULL DEFAULT 0 CHECK (reserved_stock >= 0),
     min_stock_level DECIMAL(12, 3) NOT NULL DEFAULT 0,
     max_stock_level DECIMAL(12, 3),
-    last_stock_update TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    last_stock_update TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    version BIGINT NOT NULL DEFAULT 0 -- bumped on every update, compare and swap reservations check it
 );
 
+CREATE OR REPLACE FUNCTION inventory_service.bump_sku_inventory_version() RETURNS trigger AS $$
+BEGIN
+    NEW.version := OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_sku_inventory_version
+    BEFORE UPDATE ON inventory_service.sku_inventory
+    FOR EACH ROW EXECUTE FUNCTION inventory_service.bump_sku_inventory_version();
+
 CREATE TABLE IF NOT exists inventory_service.sku_prices (
     sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
     uom_code VARCHAR(20) NOT NULL,
@@ -64,10 +77,132 @@ CREATE TABLE IF NOT exists inventory_service.reservation_history (
     sku VARCHAR(50) REFERENCES inventory_service.skus(sku),
     quantity DECIMAL(10, 2) NOT NULL,
     uom VARCHAR(20) NOT NULL,
-    status VARCHAR(20) NOT NULL CHECK (status IN ('RESERVED', 'RELEASED')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('RESERVED', 'RELEASED', 'CONSUMED')),
     reserved_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    released_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    released_at TIMESTAMPTZ,
+    line_type VARCHAR(20) NOT NULL DEFAULT 'STOCK' CHECK (line_type IN ('STOCK', 'BUNDLE', 'COMPONENT')),
+    bundle_sku VARCHAR(50) REFERENCES inventory_service.skus(sku) -- set on COMPONENT lines
+);
+
+-- bill of materials, a sku having components is a bundle and holds no stock of its own
+CREATE TABLE IF NOT exists inventory_service.sku_bundle_components (
+    bundle_sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    component_sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    quantity DECIMAL(12, 3) NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (bundle_sku, component_sku),
+    CHECK (bundle_sku <> component_sku)
+);
+
+-- append only log of every change to current_stock and reserved_stock, source of point in time queries
+CREATE TABLE IF NOT exists inventory_service.stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    movement_type VARCHAR(20) NOT NULL CHECK (movement_type IN ('OPENING', 'RECEIPT', 'ADJUSTMENT', 'RESERVE', 'RELEASE', 'RETURN', 'CONSUME')),
+    current_delta DECIMAL(12, 3) NOT NULL DEFAULT 0,
+    reserved_delta DECIMAL(12, 3) NOT NULL DEFAULT 0,
+    reference VARCHAR(100), -- order id, purchase order id, return id, commit id or import source
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION inventory_service.reject_stock_movement_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_stock_movements_append_only
+    BEFORE UPDATE OR DELETE ON inventory_service.stock_movements
+    FOR EACH ROW EXECUTE FUNCTION inventory_service.reject_stock_movement_change();
+
+-- daily positions rebuilt from stock_movements, as_of is the exclusive end of snapshot_date in UTC
+CREATE TABLE IF NOT exists inventory_service.stock_snapshots (
+    snapshot_date DATE NOT NULL,
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    as_of TIMESTAMPTZ NOT NULL,
+    current_stock DECIMAL(12, 3) NOT NULL,
+    reserved_stock DECIMAL(12, 3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (snapshot_date, sku)
+);
+
+CREATE TABLE IF NOT exists inventory_service.purchase_orders (
+    id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT' CHECK (status IN ('DRAFT', 'SUBMITTED', 'PARTIALLY_RECEIVED', 'RECEIVED')),
+    supplier VARCHAR(100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    submitted_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT exists inventory_service.purchase_order_lines (
+    id UUID PRIMARY KEY,
+    purchase_order_id UUID NOT NULL REFERENCES inventory_service.purchase_orders(id),
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    ordered_quantity DECIMAL(12, 3) NOT NULL CHECK (ordered_quantity > 0),
+    received_quantity DECIMAL(12, 3) NOT NULL DEFAULT 0 CHECK (received_quantity >= 0 AND received_quantity <= ordered_quantity),
+    reorder_point DECIMAL(12, 3),
+    avg_daily_demand DECIMAL(12, 3),
+    UNIQUE (purchase_order_id, sku)
+);
+
+-- one email per subscription, NOTIFIED and EXPIRED are final
+CREATE TABLE IF NOT exists inventory_service.back_in_stock_subscriptions (
+    id UUID PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'NOTIFIED', 'EXPIRED')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    notified_at TIMESTAMPTZ
+);
+
+-- curated substitutes offered when a sku cannot be fulfilled, lower priority is suggested first
+CREATE TABLE IF NOT exists inventory_service.sku_substitutes (
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    substitute_sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    priority INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (sku, substitute_sku),
+    CHECK (sku <> substitute_sku)
+);
+
+-- shortfall of orders reserved with allow_backorder, allocated first in first out per sku
+CREATE TABLE IF NOT exists inventory_service.backorders (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL, -- References order_service.orders(id)
+    sku VARCHAR(50) NOT NULL REFERENCES inventory_service.skus(sku),
+    quantity DECIMAL(12, 3) NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'ALLOCATED', 'CANCELLED')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    allocated_at TIMESTAMPTZ
+);
+
+-- outbox read by svc-order, one event per order once all of its backorders are allocated
+CREATE TABLE IF NOT exists inventory_service.backorder_events (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    acknowledged_at TIMESTAMPTZ
 );
 
 CREATE INDEX idx_skus_product ON inventory_service.skus(product_id);
-CREATE INDEX idx_sku_prices_active ON inventory_service.sku_prices(sku, is_active, valid_from, valid_to);
\ No newline at end of file
+CREATE INDEX idx_sku_prices_active ON inventory_service.sku_prices(sku, is_active, valid_from, valid_to);
+CREATE INDEX idx_reservation_history_order ON inventory_service.reservation_history(order_id, reserved_at DESC);
+CREATE INDEX idx_reservation_history_sku ON inventory_service.reservation_history(sku, reserved_at DESC, id DESC);
+CREATE INDEX idx_reservation_history_reserved_at ON inventory_service.reservation_history(reserved_at DESC, id DESC);
+CREATE INDEX idx_sku_bundle_components_component ON inventory_service.sku_bundle_components(component_sku);
+CREATE INDEX idx_stock_movements_sku_occurred ON inventory_service.stock_movements(sku, occurred_at);
+CREATE INDEX idx_stock_movements_occurred ON inventory_service.stock_movements(occurred_at);
+CREATE INDEX idx_stock_snapshots_sku_as_of ON inventory_service.stock_snapshots(sku, as_of DESC);
+CREATE INDEX idx_purchase_orders_status ON inventory_service.purchase_orders(status, created_at DESC);
+CREATE INDEX idx_purchase_order_lines_sku ON inventory_service.purchase_order_lines(sku);
+CREATE INDEX idx_product_categories_parent ON inventory_service.product_categories(parent_id);
+CREATE INDEX idx_products_category ON inventory_service.products(category_id);
+CREATE INDEX idx_skus_variant_attributes ON inventory_service.skus USING GIN (variant_attributes);
+CREATE UNIQUE INDEX idx_back_in_stock_pending_email ON inventory_service.back_in_stock_subscriptions(sku, email) WHERE status = 'PENDING';
+CREATE INDEX idx_back_in_stock_pending_queue ON inventory_service.back_in_stock_subscriptions(sku, created_at, id) WHERE status = 'PENDING';
+CREATE INDEX idx_sku_substitutes_substitute ON inventory_service.sku_substitutes(substitute_sku);
+CREATE INDEX idx_backorders_pending_queue ON inventory_service.backorders(sku, created_at, id) WHERE status = 'PENDING';
+CREATE INDEX idx_backorders_order ON inventory_service.backorders(order_id);
+CREATE INDEX idx_backorder_events_unacknowledged ON inventory_service.backorder_events(id) WHERE acknowledged_at IS NULL;
\ No newline at end of file
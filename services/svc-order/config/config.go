@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"ops-monorepo/shared-libs/env"
+	"time"
 )
 
 type (
@@ -13,6 +14,13 @@ type (
 		Port         string       `json:"port"`
 		Database     Database     `json:"database"`
 		Redis        Redis        `json:"redis"`
+		Backorder    Backorder    `json:"backorder"`
+		Quote        Quote        `json:"quote"`
+		Payment      Payment      `json:"payment"`
+		Tax          Tax          `json:"tax"`
+		Webhook      Webhook      `json:"webhook"`
+		Cart         Cart         `json:"cart"`
+		Subscription Subscription `json:"subscription"`
 		GrpcServices GrpcServices `json:"grpc_services"`
 	}
 	Database struct {
@@ -22,6 +30,33 @@ type (
 	Redis struct {
 		Uri string `json:"uri"`
 	}
+	Backorder struct {
+		JobEnabled  bool          `json:"job_enabled"`
+		JobInterval time.Duration `json:"job_interval"`
+	}
+	Quote struct {
+		Secret       string        `json:"-"`
+		RandomSecret bool          `json:"random_secret"`
+		TTL          time.Duration `json:"ttl"`
+	}
+	Payment struct {
+		Provider string `json:"provider"`
+	}
+	Tax struct {
+		RulesFile string `json:"rules_file"`
+	}
+	Webhook struct {
+		JobEnabled  bool          `json:"job_enabled"`
+		JobInterval time.Duration `json:"job_interval"`
+		Timeout     time.Duration `json:"timeout"`
+	}
+	Cart struct {
+		TTL time.Duration `json:"ttl"`
+	}
+	Subscription struct {
+		JobEnabled  bool          `json:"job_enabled"`
+		JobInterval time.Duration `json:"job_interval"`
+	}
 
 	GrpcServices struct {
 		ServiceUserGrpcUrl         string `json:"service_user_grpc_url"`
@@ -51,6 +86,40 @@ func LoadConfig(path string) (*Config, error) {
 			Uri: env.Get("REDIS_URI", "").String(),
 		},
 
+		Backorder: Backorder{
+			JobEnabled:  env.Get("BACKORDER_JOB_ENABLED", "false").Bool(),
+			JobInterval: env.Get("BACKORDER_JOB_INTERVAL", "30s").DurationInSecond(),
+		},
+
+		Quote: Quote{
+			Secret:       env.Get("QUOTE_SECRET", "").String(),
+			RandomSecret: env.Get("QUOTE_RANDOM_SECRET", "false").Bool(),
+			TTL:          env.Get("QUOTE_TTL", "15m").DurationInSecond(),
+		},
+
+		Payment: Payment{
+			Provider: env.Get("PAYMENT_PROVIDER", "fake").String(),
+		},
+
+		Tax: Tax{
+			RulesFile: env.Get("TAX_RULES_FILE", "").String(),
+		},
+
+		Webhook: Webhook{
+			JobEnabled:  env.Get("WEBHOOK_JOB_ENABLED", "false").Bool(),
+			JobInterval: env.Get("WEBHOOK_JOB_INTERVAL", "10s").DurationInSecond(),
+			Timeout:     env.Get("WEBHOOK_TIMEOUT", "10s").DurationInSecond(),
+		},
+
+		Cart: Cart{
+			TTL: env.Get("CART_TTL", "168h").DurationInSecond(),
+		},
+
+		Subscription: Subscription{
+			JobEnabled:  env.Get("SUBSCRIPTION_JOB_ENABLED", "false").Bool(),
+			JobInterval: env.Get("SUBSCRIPTION_JOB_INTERVAL", "1m").DurationInSecond(),
+		},
+
 		GrpcServices: GrpcServices{
 			ServiceUserGrpcUrl:         env.Get("SERVICE_USER_GRPC_URL", "").String(),
 			ServiceInventoryGrpcUrl:    env.Get("SERVICE_INVENTORY_GRPC_URL", "").String(),
@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"compress/gzip"
+	"errlib"
+	"io"
+	"net/http"
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/export"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// statuses an order can be searched by and forced into
+var orderStatuses = map[string]bool{
+	model.ORDER_STATUS_PENDING:            true,
+	model.ORDER_STATUS_CONFIRMED:          true,
+	model.ORDER_STATUS_FAILED_RESERVATION: true,
+	model.ORDER_STATUS_CANCELLED:          true,
+	model.ORDER_STATUS_BACKORDERED:        true,
+	model.ORDER_STATUS_PAYMENT_FAILED:     true,
+	model.ORDER_STATUS_FULFILLED:          true,
+}
+
+// actions the audit log can be filtered by
+var adminActions = map[string]bool{
+	model.ADMIN_ACTION_SEARCH_ORDERS:     true,
+	model.ADMIN_ACTION_EXPORT_ORDERS:     true,
+	model.ADMIN_ACTION_FORCE_STATUS:      true,
+	model.ADMIN_ACTION_RETRY_RESERVATION: true,
+}
+
+// longest reason of a forced status
+const maxForceReasonLength = 500
+
+func (h *OrderHandler) SearchAdminOrders(c *gin.Context) {
+
+	// bind query
+	var params types.GetAdminOrdersParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate query
+	filter, errList := orderSearchFilter(params.Status, params.UserId, params.UserEmail, params.Sku, params.CreatedFrom, params.CreatedTo)
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			errList = append(errList, map[string]interface{}{"limit": "limit must be at least 1"})
+		}
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		if *params.Offset < 0 {
+			errList = append(errList, map[string]interface{}{"offset": "offset must not be negative"})
+		}
+		filter.Offset = *params.Offset
+	}
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.SearchOrders(c.Request.Context(), filter, c.GetString("user_email"))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.AdminOrdersSuccessResponse{
+		Data:       result,
+		StatusCode: http.StatusOK,
+		Message:    "orders retrieved",
+	})
+}
+
+func (h *OrderHandler) ExportAdminOrders(c *gin.Context) {
+
+	// bind query
+	var params types.GetAdminOrdersExportParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate query
+	filter, errList := orderSearchFilter(params.Status, params.UserId, params.UserEmail, params.Sku, params.CreatedFrom, params.CreatedTo)
+	format := export.FORMAT_CSV
+	if params.Format != nil {
+		format = strings.ToLower(string(*params.Format))
+		if format != export.FORMAT_CSV && format != export.FORMAT_NDJSON {
+			errList = append(errList, map[string]interface{}{"format": "must be csv or ndjson"})
+		}
+	}
+	columns := export.DefaultColumns
+	if params.Columns != nil {
+		parsed, err := export.ParseColumns(*params.Columns)
+		if err != nil {
+			errList = append(errList, map[string]interface{}{"columns": err.Error()})
+		}
+		columns = parsed
+	}
+	loc := time.UTC
+	if params.Tz != nil && *params.Tz != "" {
+		parsed, err := time.LoadLocation(*params.Tz)
+		if err != nil {
+			errList = append(errList, map[string]interface{}{"tz": "unknown time zone " + *params.Tz})
+		}
+		loc = parsed
+	}
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// the response starts with the first order, until then a failure is still answered with an error
+	var (
+		encoder export.Encoder
+		gz      *gzip.Writer
+	)
+	start := func() error {
+		var w io.Writer = c.Writer
+		c.Header("Content-Type", export.ContentType(format))
+		c.Header("Content-Disposition", `attachment; filename="orders.`+format+`"`)
+		c.Header("Vary", "Accept-Encoding")
+		if export.AcceptsGzip(c.GetHeader("Accept-Encoding")) {
+			c.Header("Content-Encoding", "gzip")
+			gz = gzip.NewWriter(c.Writer)
+			w = gz
+		}
+		c.Status(http.StatusOK)
+
+		var err error
+		encoder, err = export.NewEncoder(format, w, columns, loc)
+		return err
+	}
+
+	// call usecase
+	err := h.usecase.ExportOrders(c.Request.Context(), filter, c.GetString("user_email"), func(order *model.OrderWithItems) error {
+		if encoder == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return encoder.Write(order)
+	})
+	if err != nil {
+		if encoder == nil {
+			if appErr, ok := err.(*errlib.AppError); ok {
+				h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+				return
+			}
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+			return
+		}
+		// the status is already sent, the gzip stream is left unterminated so the client sees a broken download
+		h.logger.Errorf("failed to stream order export", "error", err.Error())
+		return
+	}
+
+	if encoder == nil {
+		if err := start(); err != nil {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+			return
+		}
+	}
+	err = encoder.Close()
+	if err == nil && gz != nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		h.logger.Errorf("failed to write order export", "error", err.Error())
+	}
+}
+
+func (h *OrderHandler) ForceOrderStatus(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// bind json
+	var req types.ForceOrderStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errList := validateForceOrderStatus(req); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.ForceOrderStatus(c.Request.Context(), orderId, req, c.GetString("user_email"))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.AdminOrderSuccessResponse{
+		Data:       map[string]interface{}{"order": result},
+		StatusCode: http.StatusOK,
+		Message:    "order status forced",
+	})
+}
+
+func (h *OrderHandler) RetryReservation(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, failedReserveStock, err := h.usecase.RetryReservation(c.Request.Context(), orderId, c.GetString("user_email"))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	// still short, the order stays FAILED_RESERVATION
+	if len(failedReserveStock) > 0 {
+		c.JSON(http.StatusConflict, toOutOfStockResponse(h.usecase.DescribeOutOfStock(c.Request.Context(), failedReserveStock)))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.AdminOrderSuccessResponse{
+		Data:       map[string]interface{}{"order": result},
+		StatusCode: http.StatusOK,
+		Message:    "order reserved and confirmed",
+	})
+}
+
+func (h *OrderHandler) ListAdminAudit(c *gin.Context) {
+
+	// bind query
+	var params types.GetAdminAuditParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate query
+	filter := model.AdminAuditFilter{}
+	errList := []map[string]interface{}{}
+	if params.OrderId != nil {
+		orderId, err := uuid.Parse(*params.OrderId)
+		if err != nil {
+			errList = append(errList, map[string]interface{}{"order_id": "must be a valid uuid"})
+		}
+		filter.OrderId = &orderId
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.Action != nil {
+		if !adminActions[*params.Action] {
+			errList = append(errList, map[string]interface{}{"action": "unknown action " + *params.Action})
+		}
+		filter.Action = *params.Action
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			errList = append(errList, map[string]interface{}{"limit": "limit must be at least 1"})
+		}
+		filter.Limit = *params.Limit
+	}
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.ListAdminAudit(c.Request.Context(), filter)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.AdminAuditSuccessResponse{
+		Data:       map[string]interface{}{"entries": result},
+		StatusCode: http.StatusOK,
+		Message:    "audit log retrieved",
+	})
+}
+
+// filter of the admin order search and export. statuses can be repeated or comma separated
+func orderSearchFilter(statuses *[]string, userId, userEmail, sku *string, createdFrom, createdTo *time.Time) (model.OrderSearchFilter, []map[string]interface{}) {
+	filter := model.OrderSearchFilter{CreatedFrom: createdFrom, CreatedTo: createdTo}
+	errList := []map[string]interface{}{}
+
+	if statuses != nil {
+		for _, value := range *statuses {
+			for _, status := range strings.Split(value, ",") {
+				status = strings.ToUpper(strings.TrimSpace(status))
+				if status == "" {
+					continue
+				}
+				if !orderStatuses[status] {
+					errList = append(errList, map[string]interface{}{"status": "unknown order status " + status})
+					continue
+				}
+				filter.Statuses = append(filter.Statuses, status)
+			}
+		}
+	}
+	if userId != nil {
+		filter.UserId = strings.TrimSpace(*userId)
+	}
+	if userEmail != nil {
+		filter.UserEmail = strings.TrimSpace(*userEmail)
+	}
+	if sku != nil {
+		filter.Sku = strings.TrimSpace(*sku)
+	}
+	if createdFrom != nil && createdTo != nil && !createdFrom.Before(*createdTo) {
+		errList = append(errList, map[string]interface{}{"created_to": "must be after created_from"})
+	}
+
+	return filter, errList
+}
+
+// the target status must be known and the reason is required
+func validateForceOrderStatus(req types.ForceOrderStatusRequest) []map[string]interface{} {
+	errList := []map[string]interface{}{}
+	if !orderStatuses[string(req.Status)] {
+		errList = append(errList, map[string]interface{}{"status": "unknown order status " + string(req.Status)})
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	switch {
+	case reason == "":
+		errList = append(errList, map[string]interface{}{"reason": "must not be empty"})
+	case len(reason) > maxForceReasonLength:
+		errList = append(errList, map[string]interface{}{"reason": "must be at most 500 characters"})
+	}
+	return errList
+}
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"errlib"
+	"net/http"
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	uc "ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/services/svc-order/validator"
+	"ops-monorepo/shared-libs/logger"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// header a guest cart is identified by, it is set on the response of the request that created the cart
+const cartIdHeader = "X-Cart-Id"
+
+type (
+	ICart interface {
+		GetCart(c *gin.Context)
+		AddCartItem(c *gin.Context)
+		UpdateCartItem(c *gin.Context)
+		RemoveCartItem(c *gin.Context)
+		MergeCart(c *gin.Context)
+		CheckoutCart(c *gin.Context)
+	}
+
+	CartHandler struct {
+		validator  validator.IValidator
+		logger     logger.Logger
+		errHandler errlib.IErrorHandler
+		cart       uc.ICartUsecase
+		orders     uc.IOrderUsecase
+	}
+)
+
+func NewCartHandler(v validator.IValidator, log logger.Logger, eh errlib.IErrorHandler, cart uc.ICartUsecase, orders uc.IOrderUsecase) ICart {
+	return &CartHandler{
+		validator:  v,
+		logger:     log,
+		errHandler: eh,
+		cart:       cart,
+		orders:     orders,
+	}
+}
+
+func (h *CartHandler) GetCart(c *gin.Context) {
+
+	owner, ok := h.cartOwner(c, false)
+	if !ok {
+		return
+	}
+
+	// call usecase
+	cart, err := h.cart.GetCart(c.Request.Context(), owner)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendCart(c, http.StatusOK, cart, "cart retrieved")
+}
+
+func (h *CartHandler) AddCartItem(c *gin.Context) {
+
+	// a guest without a cart gets a new one
+	owner, ok := h.cartOwner(c, true)
+	if !ok {
+		return
+	}
+
+	// bind json
+	var req types.PostCartItemsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errList, _ := h.validator.ValidateOrderItems([]types.StockItemRequest{req}); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	cart, err := h.cart.AddCartItem(c.Request.Context(), owner, req)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendCart(c, http.StatusOK, cart, "item added to cart")
+}
+
+func (h *CartHandler) UpdateCartItem(c *gin.Context) {
+
+	owner, ok := h.cartOwner(c, false)
+	if !ok {
+		return
+	}
+
+	// bind json
+	var req types.PutCartItemsSkuJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request, an item is removed with DELETE
+	if req.QuantityPerUom <= 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"quantity_per_uom": "quantity_per_uom must be greater than zero"},
+		}))
+		return
+	}
+
+	// call usecase
+	cart, err := h.cart.UpdateCartItem(c.Request.Context(), owner, c.Param("sku"), req)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendCart(c, http.StatusOK, cart, "cart item updated")
+}
+
+func (h *CartHandler) RemoveCartItem(c *gin.Context) {
+
+	owner, ok := h.cartOwner(c, false)
+	if !ok {
+		return
+	}
+
+	// call usecase
+	cart, err := h.cart.RemoveCartItem(c.Request.Context(), owner, c.Param("sku"))
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendCart(c, http.StatusOK, cart, "cart item removed")
+}
+
+func (h *CartHandler) MergeCart(c *gin.Context) {
+
+	// only a signed in user has a cart to merge into
+	email := c.GetString("user_email")
+	if email == "" {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrUnauthorized())
+		return
+	}
+
+	// bind json
+	var req types.PostCartMergeJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	guestId, err := uuid.Parse(req.CartId)
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"cart_id": "cart_id must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	cart, err := h.cart.MergeCart(c.Request.Context(), model.CartOwner{GuestId: guestId}, model.CartOwner{UserEmail: email})
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendCart(c, http.StatusOK, cart, "guest cart merged")
+}
+
+func (h *CartHandler) CheckoutCart(c *gin.Context) {
+
+	// orders are placed by signed in users
+	customer := customerOf(c)
+	if customer.Email == "" {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrUnauthorized())
+		return
+	}
+
+	// bind json, every option can be omitted
+	var req types.PostCartCheckoutJSONRequestBody
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+			return
+		}
+	}
+
+	// validate request
+	if errList := validateShippingAddress(req.ShippingAddress); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, failedReserveStock, err := h.cart.Checkout(c.Request.Context(), customer, req)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	sendCreatedOrder(c, h.logger, h.orders, result, failedReserveStock)
+}
+
+// owner of the cart of the request, the signed in user or else the guest of the cart id header. with create a
+// guest without a cart id is given a new one, otherwise the request is refused
+func (h *CartHandler) cartOwner(c *gin.Context, create bool) (model.CartOwner, bool) {
+
+	if email := c.GetString("user_email"); email != "" {
+		return model.CartOwner{UserEmail: email}, true
+	}
+
+	header := strings.TrimSpace(c.GetHeader(cartIdHeader))
+	if header == "" {
+		if !create {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+				{"cart_id": cartIdHeader + " header or a signed in user is required"},
+			}))
+			return model.CartOwner{}, false
+		}
+		return model.CartOwner{GuestId: uuid.New()}, true
+	}
+
+	guestId, err := uuid.Parse(header)
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"cart_id": cartIdHeader + " must be a valid uuid"},
+		}))
+		return model.CartOwner{}, false
+	}
+	return model.CartOwner{GuestId: guestId}, true
+}
+
+func (h *CartHandler) sendCart(c *gin.Context, status int, cart *model.Cart, message string) {
+	if cart.CartId != nil {
+		c.Header(cartIdHeader, cart.CartId.String())
+	}
+	c.JSON(status, types.CartSuccessResponse{
+		Data:       map[string]interface{}{"cart": cart},
+		StatusCode: status,
+		Message:    message,
+	})
+}
+
+func (h *CartHandler) sendError(c *gin.Context, err error) {
+	if appErr, ok := err.(*errlib.AppError); ok {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+		return
+	}
+	h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+}
@@ -1,19 +1,56 @@
 package handler
 
 import (
+	"context"
 	"errlib"
+	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
 	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
 	uc "ops-monorepo/services/svc-order/internal/usecase"
 	"ops-monorepo/services/svc-order/validator"
 	"ops-monorepo/shared-libs/logger"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
 )
 
 type (
 	IOrder interface {
 		CreateOrder(c *gin.Context)
+		CreateQuote(c *gin.Context)
+		GetOrder(c *gin.Context)
+		AmendOrderItems(c *gin.Context)
+		FulfilOrder(c *gin.Context)
+		RequestReturn(c *gin.Context)
+		GetOrderReturns(c *gin.Context)
+		ApproveReturn(c *gin.Context)
+		RejectReturn(c *gin.Context)
+		ReceiveReturn(c *gin.Context)
+		CreateShipment(c *gin.Context)
+		GetOrderShipments(c *gin.Context)
+		ShipShipment(c *gin.Context)
+		DeliverShipment(c *gin.Context)
+		SubscribeBackInStock(c *gin.Context)
+		CreatePromotion(c *gin.Context)
+		ListPromotions(c *gin.Context)
+		CreateWebhookEndpoint(c *gin.Context)
+		ListWebhookEndpoints(c *gin.Context)
+		UpdateWebhookEndpoint(c *gin.Context)
+		ListWebhookDeliveries(c *gin.Context)
+		GetWebhookDelivery(c *gin.Context)
+		ReplayWebhookDelivery(c *gin.Context)
+		SearchAdminOrders(c *gin.Context)
+		ExportAdminOrders(c *gin.Context)
+		ForceOrderStatus(c *gin.Context)
+		RetryReservation(c *gin.Context)
+		ListAdminAudit(c *gin.Context)
 	}
 
 	OrderHandler struct {
@@ -44,28 +81,1030 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 
 	// validate request
 	errlist, _ := h.validator.ValidateOrderItems(req.OrderItems)
+	errlist = append(errlist, validateShippingAddress(req.ShippingAddress)...)
 	if len(errlist) > 0 {
 		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errlist))
 		return
 	}
 
 	// call usecase
-	result, failedReserveStock, err := h.usecase.NewOrder(c.Request.Context(), req)
+	result, failedReserveStock, err := h.usecase.NewOrder(c.Request.Context(), customerOf(c), req)
 	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
 		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
 		return
 	}
 
-	data := map[string]interface{}{"order": result}
+	sendCreatedOrder(c, h.logger, h.usecase, result, failedReserveStock)
+}
+
+func (h *OrderHandler) CreateQuote(c *gin.Context) {
+
+	// bind json
+	var req types.OrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	errlist, _ := h.validator.ValidateOrderItems(req.OrderItems)
+	errlist = append(errlist, validateShippingAddress(req.ShippingAddress)...)
+	if len(errlist) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errlist))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.Quote(c.Request.Context(), customerOf(c), req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.QuoteSuccessResponse{
+		Data:       map[string]interface{}{"quote": result},
+		StatusCode: http.StatusOK,
+		Message:    "order quoted",
+	})
+}
+
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.GetOrderDetail(c.Request.Context(), orderId, customerOf(c), isAdmin(c))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.GetOrderSuccessResponse{
+		Data:       map[string]interface{}{"order": result},
+		StatusCode: http.StatusOK,
+		Message:    "order retrieved",
+	})
+}
+
+func (h *OrderHandler) AmendOrderItems(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// bind json
+	var req types.AmendOrderItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request, removing every item is a cancellation
+	if len(req.OrderItems) == 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"order_items": "must not be empty"},
+		}))
+		return
+	}
+	errlist, _ := h.validator.ValidateOrderItems(req.OrderItems)
+	if len(errlist) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errlist))
+		return
+	}
+
+	// call usecase
+	result, failedReserveStock, err := h.usecase.AmendOrderItems(c.Request.Context(), customerOf(c), orderId, req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	// the order is left as it was, tell the customer what they can order instead
 	if len(failedReserveStock) > 0 {
-		data["failed_reserve"] = failedReserveStock
+		h.logger.Info("order amendment failed reservation, some products are out of stock")
+		c.JSON(http.StatusConflict, toOutOfStockResponse(h.usecase.DescribeOutOfStock(c.Request.Context(), failedReserveStock)))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.AmendOrderItemsSuccessResponse{
+		Data:       map[string]interface{}{"order": result},
+		StatusCode: http.StatusOK,
+		Message:    "order items amended",
+	})
+}
+
+func (h *OrderHandler) FulfilOrder(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.FulfilOrder(c.Request.Context(), orderId)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.FulfilOrderSuccessResponse{
+		Data:       map[string]interface{}{"order": result},
+		StatusCode: http.StatusOK,
+		Message:    "order fulfilled",
+	})
+}
+
+func (h *OrderHandler) RequestReturn(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// bind json
+	var req types.ReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errlist := validateReturnItems(req.Items); len(errlist) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errlist))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.RequestReturn(c.Request.Context(), orderId, req, customerOf(c))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.ReturnSuccessResponse{
+		Data:       map[string]interface{}{"return": result},
+		StatusCode: http.StatusCreated,
+		Message:    "return requested",
+	})
+}
+
+func (h *OrderHandler) GetOrderReturns(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.GetOrderReturns(c.Request.Context(), orderId, customerOf(c), isAdmin(c))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ListReturnsSuccessResponse{
+		Data:       map[string]interface{}{"returns": result},
+		StatusCode: http.StatusOK,
+		Message:    "returns retrieved",
+	})
+}
+
+func (h *OrderHandler) ApproveReturn(c *gin.Context) {
+	h.decideReturn(c, h.usecase.ApproveReturn, "return approved")
+}
+
+func (h *OrderHandler) RejectReturn(c *gin.Context) {
+	h.decideReturn(c, h.usecase.RejectReturn, "return rejected")
+}
+
+// approve and reject take the same request, only the usecase differs
+func (h *OrderHandler) decideReturn(c *gin.Context, decide func(ctx context.Context, returnId uuid.UUID, actor, note string) (*model.OrderReturn, error), message string) {
+
+	// parse return id
+	returnId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// the note is optional, so is the body
+	var req types.ReturnDecisionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+			return
+		}
+	}
+	note := ""
+	if req.Note != nil {
+		note = strings.TrimSpace(*req.Note)
+	}
+
+	// call usecase
+	result, err := decide(c.Request.Context(), returnId, c.GetString("user_email"), note)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ReturnSuccessResponse{
+		Data:       map[string]interface{}{"return": result},
+		StatusCode: http.StatusOK,
+		Message:    message,
+	})
+}
+
+func (h *OrderHandler) ReceiveReturn(c *gin.Context) {
+
+	// parse return id
+	returnId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.ReceiveReturn(c.Request.Context(), returnId, c.GetString("user_email"))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ReturnSuccessResponse{
+		Data:       map[string]interface{}{"return": result},
+		StatusCode: http.StatusOK,
+		Message:    "return received and refunded",
+	})
+}
+
+func (h *OrderHandler) CreateShipment(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// bind json
+	var req types.ShipmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errlist := validateShipment(req); len(errlist) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errlist))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.CreateShipment(c.Request.Context(), orderId, req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.ShipmentSuccessResponse{
+		Data:       map[string]interface{}{"shipment": result},
+		StatusCode: http.StatusCreated,
+		Message:    "shipment packed",
+	})
+}
+
+func (h *OrderHandler) GetOrderShipments(c *gin.Context) {
+
+	// parse order id
+	orderId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.GetOrderShipments(c.Request.Context(), orderId, customerOf(c), isAdmin(c))
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ListShipmentsSuccessResponse{
+		Data:       map[string]interface{}{"shipments": result},
+		StatusCode: http.StatusOK,
+		Message:    "shipments retrieved",
+	})
+}
+
+func (h *OrderHandler) ShipShipment(c *gin.Context) {
+
+	// parse shipment id
+	shipmentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// the tracking number can be given when packing, so the body is optional
+	var req types.ShipShipmentRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+			return
+		}
+	}
+
+	// call usecase
+	result, fulfilled, err := h.usecase.ShipShipment(c.Request.Context(), shipmentId, req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	message := "shipment shipped"
+	if fulfilled {
+		message = "shipment shipped, order fulfilled"
+	}
+	c.JSON(http.StatusOK, types.ShipmentSuccessResponse{
+		Data:       map[string]interface{}{"shipment": result, "order_fulfilled": fulfilled},
+		StatusCode: http.StatusOK,
+		Message:    message,
+	})
+}
+
+func (h *OrderHandler) DeliverShipment(c *gin.Context) {
+
+	// parse shipment id
+	shipmentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.DeliverShipment(c.Request.Context(), shipmentId)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ShipmentSuccessResponse{
+		Data:       map[string]interface{}{"shipment": result},
+		StatusCode: http.StatusOK,
+		Message:    "shipment delivered",
+	})
+}
+
+func (h *OrderHandler) SubscribeBackInStock(c *gin.Context) {
+
+	// the subscription is for the authenticated customer
+	email := c.GetString("user_email")
+	if email == "" {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrUnauthorized())
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.SubscribeBackInStock(c.Request.Context(), c.Param("sku"), email)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.BackInStockSubscriptionSuccessResponse{
+		Data:       map[string]interface{}{"subscription": result},
+		StatusCode: http.StatusCreated,
+		Message:    "subscribed to back in stock notification",
+	})
+}
+
+func (h *OrderHandler) CreatePromotion(c *gin.Context) {
+
+	// bind json
+	var req types.PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errList := validatePromotion(req); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.CreatePromotion(c.Request.Context(), req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.PromotionSuccessResponse{
+		Data:       map[string]interface{}{"promotion": result},
+		StatusCode: http.StatusCreated,
+		Message:    "promotion created",
+	})
+}
+
+func (h *OrderHandler) ListPromotions(c *gin.Context) {
+
+	result, err := h.usecase.ListPromotions(c.Request.Context())
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ListPromotionsSuccessResponse{
+		Data:       map[string]interface{}{"promotions": result},
+		StatusCode: http.StatusOK,
+		Message:    "promotions retrieved",
+	})
+}
+
+func (h *OrderHandler) CreateWebhookEndpoint(c *gin.Context) {
+
+	// bind json
+	var req types.WebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	errList := append(validateWebhookUrl(req.Url), validateWebhookEventTypes(req.EventTypes)...)
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.CreateWebhookEndpoint(c.Request.Context(), req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, types.WebhookEndpointSuccessResponse{
+		Data:       map[string]interface{}{"endpoint": result},
+		StatusCode: http.StatusCreated,
+		Message:    "webhook endpoint registered, store the secret, it is not shown again",
+	})
+}
+
+func (h *OrderHandler) ListWebhookEndpoints(c *gin.Context) {
+
+	result, err := h.usecase.ListWebhookEndpoints(c.Request.Context())
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ListWebhookEndpointsSuccessResponse{
+		Data:       map[string]interface{}{"endpoints": result},
+		StatusCode: http.StatusOK,
+		Message:    "webhook endpoints retrieved",
+	})
+}
+
+func (h *OrderHandler) UpdateWebhookEndpoint(c *gin.Context) {
+
+	// parse endpoint id
+	endpointId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// bind json
+	var req types.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request, omitted fields keep their value
+	errList := []map[string]interface{}{}
+	if req.Url != nil {
+		errList = append(errList, validateWebhookUrl(*req.Url)...)
+	}
+	if req.EventTypes != nil {
+		errList = append(errList, validateWebhookEventTypes(*req.EventTypes)...)
+	}
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.UpdateWebhookEndpoint(c.Request.Context(), endpointId, req)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.WebhookEndpointSuccessResponse{
+		Data:       map[string]interface{}{"endpoint": result},
+		StatusCode: http.StatusOK,
+		Message:    "webhook endpoint updated",
+	})
+}
+
+func (h *OrderHandler) ListWebhookDeliveries(c *gin.Context) {
+
+	// bind query
+	var params types.GetWebhookDeliveriesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate query
+	filter := model.WebhookDeliveryFilter{}
+	errList := []map[string]interface{}{}
+	if params.EndpointId != nil {
+		endpointId, err := uuid.Parse(*params.EndpointId)
+		if err != nil {
+			errList = append(errList, map[string]interface{}{"endpoint_id": "must be a valid uuid"})
+		}
+		filter.EndpointId = &endpointId
+	}
+	if params.Status != nil {
+		switch *params.Status {
+		case model.WEBHOOK_DELIVERY_PENDING, model.WEBHOOK_DELIVERY_SUCCEEDED, model.WEBHOOK_DELIVERY_DEAD:
+			filter.Status = *params.Status
+		default:
+			errList = append(errList, map[string]interface{}{"status": "must be one of PENDING, SUCCEEDED, DEAD"})
+		}
+	}
+	if params.EventType != nil {
+		filter.EventType = *params.EventType
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			errList = append(errList, map[string]interface{}{"limit": "limit must be at least 1"})
+		}
+		filter.Limit = *params.Limit
+	}
+	if len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.ListWebhookDeliveries(c.Request.Context(), filter)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.ListWebhookDeliveriesSuccessResponse{
+		Data:       map[string]interface{}{"deliveries": result},
+		StatusCode: http.StatusOK,
+		Message:    "webhook deliveries retrieved",
+	})
+}
+
+func (h *OrderHandler) GetWebhookDelivery(c *gin.Context) {
+
+	// parse delivery id
+	deliveryId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.GetWebhookDelivery(c.Request.Context(), deliveryId)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.WebhookDeliverySuccessResponse{
+		Data:       map[string]interface{}{"delivery": result},
+		StatusCode: http.StatusOK,
+		Message:    "webhook delivery retrieved",
+	})
+}
+
+func (h *OrderHandler) ReplayWebhookDelivery(c *gin.Context) {
+
+	// parse delivery id
+	deliveryId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return
+	}
+
+	// call usecase
+	result, err := h.usecase.ReplayWebhookDelivery(c.Request.Context(), deliveryId)
+	if err != nil {
+		if appErr, ok := err.(*errlib.AppError); ok {
+			h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+			return
+		}
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, types.WebhookDeliverySuccessResponse{
+		Data:       map[string]interface{}{"delivery": result},
+		StatusCode: http.StatusOK,
+		Message:    "webhook delivery queued again",
+	})
+}
+
+// authenticated user of the request, set by the jwt middleware
+func customerOf(c *gin.Context) model.Customer {
+	return model.Customer{
+		UserId: c.GetString("user_id"),
+		Email:  c.GetString("user_email"),
+	}
+}
+
+// whether the authenticated user has the admin role
+func isAdmin(c *gin.Context) bool {
+	return slices.Contains(c.GetStringSlice("user_roles"), "admin")
+}
+
+// responds to an order placed from a request or a cart
+func sendCreatedOrder(c *gin.Context, log logger.Logger, orders uc.IOrderUsecase, result *model.OrderWithItems, failed []*model.OrderedItemStockStatus) {
+
+	// the order is kept as FAILED_RESERVATION, tell the customer what they can order instead
+	if len(failed) > 0 {
+		log.Info("order failed reservation, some products are out of stock")
+		c.JSON(http.StatusConflict, toOutOfStockResponse(orders.DescribeOutOfStock(c.Request.Context(), failed)))
+		return
+	}
+
+	// part of the order waits for stock, the order is confirmed once all of it is allocated
+	if result.Status == model.ORDER_STATUS_BACKORDERED {
+		log.Info("order created with backordered items")
+		c.JSON(http.StatusCreated, types.CreateOrderSuccessResponse{
+			Data:       map[string]interface{}{"order": result},
+			StatusCode: http.StatusCreated,
+			Message:    "order created, some items are backordered",
+		})
+		return
+	}
+
+	// per line reservation policies confirm what was in stock
+	if hasShortItems(result.Items) {
+		log.Info("order created with short items")
+		c.JSON(http.StatusCreated, types.CreateOrderSuccessResponse{
+			Data:       map[string]interface{}{"order": result},
+			StatusCode: http.StatusCreated,
+			Message:    "order created, some items are short",
+		})
+		return
 	}
 
 	// log and send success response
-	h.logger.Info("order created with pending status")
+	log.Info("order created with pending status")
 	c.JSON(http.StatusCreated, types.CreateOrderSuccessResponse{
-		Data:       data,
+		Data:       map[string]interface{}{"order": result},
 		StatusCode: http.StatusCreated,
 		Message:    "order created with pending status",
 	})
 }
+
+func toOutOfStockResponse(items []model.OutOfStockItem) types.OutofStockResponse {
+	statusCode := http.StatusConflict
+	message := "some products are out of stock"
+	outOfStockItems := []types.OutOfStockItemResp{}
+	actions := []string{}
+
+	for _, item := range items {
+		alternatives := []types.AlternativeSkuResp{}
+		for _, alt := range item.Alternatives {
+			reason := types.AlternativeSkuRespReason(alt.Reason)
+			alternatives = append(alternatives, types.AlternativeSkuResp{
+				Sku:               &alt.Sku,
+				ProductId:         &alt.ProductId,
+				ProductName:       &alt.ProductName,
+				AvailableQuantity: formatQuantity(alt.AvailableQuantity),
+				Reason:            &reason,
+			})
+			actions = append(actions, fmt.Sprintf("replace %s with %s", skuLabel(item.Sku, item.ProductName), skuLabel(alt.Sku, alt.ProductName)))
+		}
+
+		if item.AvailableQuantity > 0 {
+			actions = append(actions, fmt.Sprintf("reduce the quantity of %s to %s", skuLabel(item.Sku, item.ProductName), *formatQuantity(item.AvailableQuantity)))
+		} else {
+			actions = append(actions, fmt.Sprintf("subscribe to be emailed when %s is back in stock", skuLabel(item.Sku, item.ProductName)))
+		}
+
+		outOfStockItems = append(outOfStockItems, types.OutOfStockItemResp{
+			Sku:               &item.Sku,
+			ProductId:         &item.ProductId,
+			ProductName:       &item.ProductName,
+			RequestedQuantity: formatQuantity(item.RequestedQuantity),
+			AvailableQuantity: formatQuantity(item.AvailableQuantity),
+			Alternatives:      &alternatives,
+		})
+	}
+
+	return types.OutofStockResponse{
+		StatusCode:       &statusCode,
+		Message:          &message,
+		Details:          &types.OutOfStockItems{OutOfStockItems: &outOfStockItems},
+		SuggestedActions: &actions,
+	}
+}
+
+// every line needs a sku and a quantity above zero, a sku is listed once
+func validateReturnItems(items []types.ReturnItemRequest) []map[string]interface{} {
+	if len(items) == 0 {
+		return []map[string]interface{}{{"items": "must not be empty"}}
+	}
+
+	errList := []map[string]interface{}{}
+	seen := map[string]bool{}
+	for i, item := range items {
+		switch {
+		case item.Sku == "":
+			errList = append(errList, map[string]interface{}{"sku": "sku is a required field", "row": i + 1})
+		case item.Quantity <= 0:
+			errList = append(errList, map[string]interface{}{"quantity": "quantity must be greater than zero", "row": i + 1})
+		case seen[item.Sku]:
+			errList = append(errList, map[string]interface{}{"sku": validator.ErrMsgFieldShouldUnique, "row": i + 1})
+		}
+		seen[item.Sku] = true
+	}
+	return errList
+}
+
+// a shipment needs a carrier and lines with a sku and a quantity above zero, a sku is listed once
+func validateShipment(req types.ShipmentRequest) []map[string]interface{} {
+	errList := []map[string]interface{}{}
+	if strings.TrimSpace(req.Carrier) == "" {
+		errList = append(errList, map[string]interface{}{"carrier": "carrier is a required field"})
+	}
+	if len(req.Items) == 0 {
+		return append(errList, map[string]interface{}{"items": "must not be empty"})
+	}
+
+	seen := map[string]bool{}
+	for i, item := range req.Items {
+		switch {
+		case item.Sku == "":
+			errList = append(errList, map[string]interface{}{"sku": "sku is a required field", "row": i + 1})
+		case item.Quantity <= 0:
+			errList = append(errList, map[string]interface{}{"quantity": "quantity must be greater than zero", "row": i + 1})
+		case seen[item.Sku]:
+			errList = append(errList, map[string]interface{}{"sku": validator.ErrMsgFieldShouldUnique, "row": i + 1})
+		}
+		seen[item.Sku] = true
+	}
+	return errList
+}
+
+// the country code selects the tax rules, two letters ISO 3166-1
+func validateShippingAddress(address *types.AddressRequest) []map[string]interface{} {
+	if address == nil {
+		return nil
+	}
+	code := strings.TrimSpace(address.CountryCode)
+	if len(code) != 2 || strings.IndexFunc(code, func(r rune) bool { return !unicode.IsLetter(r) || r > unicode.MaxASCII }) >= 0 {
+		return []map[string]interface{}{{"country_code": "country_code must be a two letter ISO 3166-1 code"}}
+	}
+	return nil
+}
+
+// the discount of every type needs its own fields, scoped promotions need the skus or categories they apply to
+func validatePromotion(req types.PromotionRequest) []map[string]interface{} {
+	errList := []map[string]interface{}{}
+	if strings.TrimSpace(req.Code) == "" {
+		errList = append(errList, map[string]interface{}{"code": "code is a required field"})
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		errList = append(errList, map[string]interface{}{"name": "name is a required field"})
+	}
+
+	switch req.DiscountType {
+	case types.PERCENTAGE, types.FIXED:
+		if req.DiscountValue == nil || *req.DiscountValue <= 0 {
+			errList = append(errList, map[string]interface{}{"discount_value": "discount_value must be greater than zero"})
+		} else if req.DiscountType == types.PERCENTAGE && *req.DiscountValue > 100 {
+			errList = append(errList, map[string]interface{}{"discount_value": "discount_value must be at most 100 for PERCENTAGE"})
+		}
+	case types.BUYXGETY:
+		if req.BuyQuantity == nil || *req.BuyQuantity < 1 || req.GetQuantity == nil || *req.GetQuantity < 1 {
+			errList = append(errList, map[string]interface{}{"buy_quantity": "buy_quantity and get_quantity must be at least 1 for BUY_X_GET_Y"})
+		}
+	default:
+		errList = append(errList, map[string]interface{}{"discount_type": "must be one of PERCENTAGE, FIXED, BUY_X_GET_Y"})
+	}
+
+	switch req.Scope {
+	case types.ORDER:
+	case types.SKU, types.CATEGORY:
+		if req.ScopeValues == nil || len(*req.ScopeValues) == 0 {
+			errList = append(errList, map[string]interface{}{"scope_values": "scope_values must not be empty for the SKU and CATEGORY scopes"})
+		}
+	default:
+		errList = append(errList, map[string]interface{}{"scope": "must be one of ORDER, SKU, CATEGORY"})
+	}
+
+	if req.MinOrderValue != nil && *req.MinOrderValue < 0 {
+		errList = append(errList, map[string]interface{}{"min_order_value": "min_order_value must not be negative"})
+	}
+	if (req.MaxUses != nil && *req.MaxUses < 1) || (req.MaxUsesPerUser != nil && *req.MaxUsesPerUser < 1) {
+		errList = append(errList, map[string]interface{}{"max_uses": "max_uses and max_uses_per_user must be at least 1"})
+	}
+	if req.ValidFrom != nil && req.ValidTo != nil && !req.ValidTo.After(*req.ValidFrom) {
+		errList = append(errList, map[string]interface{}{"valid_to": "valid_to must be after valid_from"})
+	}
+	return errList
+}
+
+// events an endpoint can subscribe to
+var webhookEventTypes = map[string]bool{
+	model.WEBHOOK_EVENT_ORDER_CONFIRMED:          true,
+	model.WEBHOOK_EVENT_ORDER_BACKORDERED:        true,
+	model.WEBHOOK_EVENT_ORDER_RESERVATION_FAILED: true,
+	model.WEBHOOK_EVENT_ORDER_CANCELLED:          true,
+	model.WEBHOOK_EVENT_ORDER_PAYMENT_FAILED:     true,
+	model.WEBHOOK_EVENT_ORDER_AMENDED:            true,
+	model.WEBHOOK_EVENT_ORDER_FULFILLED:          true,
+	model.WEBHOOK_EVENT_SHIPMENT_SHIPPED:         true,
+	model.WEBHOOK_EVENT_SHIPMENT_DELIVERED:       true,
+}
+
+// deliveries are posted to absolute http or https urls
+func validateWebhookUrl(rawUrl string) []map[string]interface{} {
+	u, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return []map[string]interface{}{{"url": "url must be an absolute http or https url"}}
+	}
+	return nil
+}
+
+// an endpoint receives at least one known event type, each listed once
+func validateWebhookEventTypes(eventTypes []string) []map[string]interface{} {
+	if len(eventTypes) == 0 {
+		return []map[string]interface{}{{"event_types": "must not be empty"}}
+	}
+
+	errList := []map[string]interface{}{}
+	seen := map[string]bool{}
+	for i, eventType := range eventTypes {
+		switch {
+		case !webhookEventTypes[eventType]:
+			errList = append(errList, map[string]interface{}{"event_types": "unknown event type " + eventType, "row": i + 1})
+		case seen[eventType]:
+			errList = append(errList, map[string]interface{}{"event_types": validator.ErrMsgFieldShouldUnique, "row": i + 1})
+		}
+		seen[eventType] = true
+	}
+	return errList
+}
+
+func hasShortItems(items []model.ItemOrder) bool {
+	for _, item := range items {
+		if item.ShortQuantity != nil && item.ShortQuantity.GreaterThan(fixed.ZERO) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatQuantity(q float64) *string {
+	s := strconv.FormatFloat(q, 'f', -1, 64)
+	return &s
+}
+
+// product name with its sku, or the sku alone when the name is unknown
+func skuLabel(sku, productName string) string {
+	if productName == "" {
+		return sku
+	}
+	return fmt.Sprintf("%s (%s)", productName, sku)
+}
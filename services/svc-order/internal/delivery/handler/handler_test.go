@@ -2,15 +2,18 @@ package handler
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errlib"
 	em "errlib/mocks"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -26,10 +29,10 @@ import (
 )
 
 type handlerDeps struct {
-	validator mocks.MockIValidator
-	usecase   mocks.MockIOrderUsecase
-	logger    ml.MockLogger
-	errLib    em.MockIErrorHandler
+	validator *mocks.MockIValidator
+	usecase   *mocks.MockIOrderUsecase
+	logger    *ml.MockLogger
+	errLib    *em.MockIErrorHandler
 
 	// http
 	ginWriterRsp *gin.ResponseWriter
@@ -87,7 +90,7 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			Mock: func(dep *handlerDeps, w http.ResponseWriter, r *http.Request) {
 
 				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
-				dep.usecase.EXPECT().NewOrder(mock.Anything, mock.Anything).Return(&mockResultUsecase, nil, nil)
+				dep.usecase.EXPECT().NewOrder(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, mock.Anything).Return(&mockResultUsecase, nil, nil)
 				dep.logger.EXPECT().Info("order created with pending status")
 			},
 			StatusCode: http.StatusCreated,
@@ -155,6 +158,69 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			},
 			StatusCode: http.StatusBadRequest,
 		},
+		{
+			Name: "shipping address without a two letter country code",
+			Payload: types.PostOrdersJSONRequestBody{
+				OrderItems: []types.StockItemRequest{
+					{
+						Sku:            "TSHIRT-M-WHITE",
+						QuantityPerUom: 2,
+						Uom:            "EA",
+					},
+				},
+				ShippingAddress: &types.AddressRequest{CountryCode: "USA"},
+			},
+			Mock: func(dep *handlerDeps, w http.ResponseWriter, r *http.Request) {
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil &&
+							err.Status == http.StatusBadRequest &&
+							strings.Contains(err.Message, "Validation error")
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					if w, ok := args.Get(0).(http.ResponseWriter); ok {
+						if err, ok := args.Get(2).(*errlib.AppError); ok {
+							w.WriteHeader(err.Status)
+						}
+					}
+				})
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "out of stock with alternatives",
+			Payload: types.PostOrdersJSONRequestBody{
+				OrderItems: []types.StockItemRequest{
+					{
+						Sku:            "TSHIRT-M-WHITE",
+						QuantityPerUom: 2,
+						Uom:            "EA",
+					},
+				},
+			},
+			Mock: func(dep *handlerDeps, w http.ResponseWriter, r *http.Request) {
+				failed := []*model.OrderedItemStockStatus{
+					{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, AvailableQuantity: 0, SkuUom: "EA"},
+				}
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).Return(&mockResultUsecase, failed, nil)
+				dep.usecase.EXPECT().DescribeOutOfStock(mock.Anything, failed).Return([]model.OutOfStockItem{
+					{
+						Sku:               "TSHIRT-M-WHITE",
+						ProductName:       "Basic T-Shirt",
+						RequestedQuantity: 2,
+						Alternatives: []model.AlternativeSku{
+							{Sku: "TSHIRT-M-BLACK", ProductName: "Basic T-Shirt", AvailableQuantity: 10, Reason: "SAME_PRODUCT"},
+						},
+					},
+				})
+				dep.logger.EXPECT().Info("order failed reservation, some products are out of stock")
+			},
+			StatusCode: http.StatusConflict,
+		},
 		{
 			Name: "failed usecase",
 			Payload: types.PostOrdersJSONRequestBody{
@@ -168,7 +234,7 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			},
 			Mock: func(dep *handlerDeps, w http.ResponseWriter, r *http.Request) {
 				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
-				dep.usecase.EXPECT().NewOrder(mock.Anything, mock.Anything).Return(nil, nil, errors.New("error"))
+				dep.usecase.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("error"))
 				dep.errLib.EXPECT().HandleAndSendErrorResponse(
 					mock.Anything,
 					mock.AnythingOfType("*http.Request"),
@@ -203,10 +269,10 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			mockerrlib := em.NewMockIErrorHandler(t)
 
 			deps := handlerDeps{
-				validator: *mockValidator,
-				usecase:   *mockUsecase,
-				logger:    *mockLogger,
-				errLib:    *mockerrlib,
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
 			}
 
 			// Prepare request payload BEFORE calling Mock
@@ -225,11 +291,16 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 			tc.Mock(&deps, resp, req)
 
 			// Create handler with mocked dependencies
-			handler := NewOrderHandler(&deps.validator, &deps.logger, &deps.errLib, &deps.usecase)
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
 
 			// Setup Gin router
+			// stands in for the jwt middleware
 			r := gin.Default()
-			r.POST(path, handler.CreateOrder)
+			r.POST(path, func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				c.Next()
+			}, handler.CreateOrder)
 
 			// Execute
 			r.ServeHTTP(resp, req)
@@ -239,3 +310,1727 @@ func TestOrderHandler_CreateOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderHandler_CreateQuote(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PostOrdersQuoteJSONRequestBody{
+		OrderItems: []types.StockItemRequest{
+			{
+				Sku:            "OLIVE-OIL-1L",
+				QuantityPerUom: 0.5,
+				Uom:            "L",
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name       string
+		Payload    interface{}
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "valid quote",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().Quote(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, mock.Anything).Return(&model.Quote{
+					Token:       "token",
+					Currency:    "USD",
+					TotalAmount: fixed.NewS("25"),
+					Items:       []model.QuoteItem{},
+				}, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "invalid json binding",
+			Payload: `{"invalid": "json"`,
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "inventory service error",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().Quote(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.ErrInternalServer(errors.New("inventory service error")))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusInternalServerError
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := handlerDeps{
+				validator: mocks.NewMockIValidator(t),
+				usecase:   mocks.NewMockIOrderUsecase(t),
+				logger:    ml.NewMockLogger(t),
+				errLib:    em.NewMockIErrorHandler(t),
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			// stands in for the jwt middleware
+			r.POST("/v1/api/orders/quote", func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				c.Next()
+			}, handler.CreateQuote)
+
+			var payloadBytes []byte
+			if strPayload, ok := tc.Payload.(string); ok {
+				payloadBytes = []byte(strPayload)
+			} else {
+				payloadBytes, _ = json.Marshal(tc.Payload)
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/orders/quote", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_GetOrder(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Roles      []string
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "valid order detail",
+			OrderId: mockOrderId,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().GetOrderDetail(mock.Anything, uuid.MustParse(mockOrderId), model.Customer{UserId: mockUserId, Email: mockUserEmail}, false).Return(&model.OrderDetail{
+					OrderWithItems: mockResultUsecase,
+					Reservations:   []model.OrderReservation{},
+				}, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "admins read any order",
+			OrderId: mockOrderId,
+			Roles:   []string{"admin"},
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().GetOrderDetail(mock.Anything, uuid.MustParse(mockOrderId), mock.Anything, true).Return(&model.OrderDetail{
+					OrderWithItems: mockResultUsecase,
+					Reservations:   []model.OrderReservation{},
+				}, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "invalid order id",
+			OrderId: "not-a-uuid",
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "order not found",
+			OrderId: mockOrderId,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().GetOrderDetail(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.NewAppError(errlib.ErrCodeDataNotFound))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusNotFound
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			// stands in for the jwt middleware
+			r.GET("/v1/api/orders/:id", func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				c.Set("user_roles", tc.Roles)
+				c.Next()
+			}, handler.GetOrder)
+
+			req, _ := http.NewRequest(http.MethodGet, "/v1/api/orders/"+tc.OrderId, nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_AmendOrderItems(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PatchOrdersIdItemsJSONRequestBody{
+		OrderItems: []types.StockItemRequest{
+			{
+				Sku:            "TSHIRT-M-WHITE",
+				QuantityPerUom: 3,
+				Uom:            "EA",
+			},
+		},
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Payload    types.PatchOrdersIdItemsJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "valid amendment",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().AmendOrderItems(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, uuid.MustParse(mockOrderId), payload).
+					Return(&mockResultUsecase, nil, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "invalid order id",
+			OrderId: "not-a-uuid",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "empty order items",
+			OrderId: mockOrderId,
+			Payload: types.PatchOrdersIdItemsJSONRequestBody{},
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "out of stock",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				failed := []*model.OrderedItemStockStatus{
+					{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 1, AvailableQuantity: 0, SkuUom: "EA"},
+				}
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().AmendOrderItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(&mockResultUsecase, failed, nil)
+				dep.usecase.EXPECT().DescribeOutOfStock(mock.Anything, failed).Return([]model.OutOfStockItem{
+					{Sku: "TSHIRT-M-WHITE", ProductName: "Basic T-Shirt", RequestedQuantity: 1},
+				})
+				dep.logger.EXPECT().Info("order amendment failed reservation, some products are out of stock")
+			},
+			StatusCode: http.StatusConflict,
+		},
+		{
+			Name:    "order is not amendable",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				dep.usecase.EXPECT().AmendOrderItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil, errlib.NewAppError(errlib.ErrCodeOrderNotAmendable))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusConflict
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			// stands in for the jwt middleware
+			r.PATCH("/v1/api/orders/:id/items", func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				c.Next()
+			}, handler.AmendOrderItems)
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPatch, "/v1/api/orders/"+tc.OrderId+"/items", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_FulfilOrder(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "order fulfilled",
+			OrderId: mockOrderId,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().FulfilOrder(mock.Anything, uuid.MustParse(mockOrderId)).Return(&mockResultUsecase, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "invalid order id",
+			OrderId: "not-a-uuid",
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "capture declined",
+			OrderId: mockOrderId,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().FulfilOrder(mock.Anything, mock.Anything).
+					Return(nil, errlib.ErrPaymentDeclined(map[string]interface{}{"reason": "payment declined: capture declined"}))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusPaymentRequired
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusPaymentRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/orders/:id/fulfil", handler.FulfilOrder)
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/orders/"+tc.OrderId+"/fulfil", nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_RequestReturn(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PostOrdersIdReturnsJSONRequestBody{
+		Items: []types.ReturnItemRequest{
+			{
+				Sku:      "TSHIRT-M-WHITE",
+				Quantity: 1,
+			},
+		},
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Payload    types.PostOrdersIdReturnsJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "return requested",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().RequestReturn(mock.Anything, uuid.MustParse(mockOrderId), payload, model.Customer{UserId: mockUserId, Email: mockUserEmail}).
+					Return(&model.OrderReturn{Status: model.RETURN_STATUS_REQUESTED}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name:    "invalid order id",
+			OrderId: "not-a-uuid",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "empty items",
+			OrderId: mockOrderId,
+			Payload: types.PostOrdersIdReturnsJSONRequestBody{},
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "duplicate sku",
+			OrderId: mockOrderId,
+			Payload: types.PostOrdersIdReturnsJSONRequestBody{
+				Items: []types.ReturnItemRequest{
+					{Sku: "TSHIRT-M-WHITE", Quantity: 1},
+					{Sku: "TSHIRT-M-WHITE", Quantity: 1},
+				},
+			},
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "order is not returnable",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().RequestReturn(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.NewAppError(errlib.ErrCodeOrderNotReturnable))
+				expectError(dep, http.StatusConflict)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			// stands in for the jwt middleware
+			r.POST("/v1/api/orders/:id/returns", func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				c.Next()
+			}, handler.RequestReturn)
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/orders/"+tc.OrderId+"/returns", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_ReceiveReturn(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	returnId := uuid.New()
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		ReturnId   string
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:     "return received and refunded",
+			ReturnId: returnId.String(),
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ReceiveReturn(mock.Anything, returnId, mock.Anything).
+					Return(&model.OrderReturn{Id: returnId, Status: model.RETURN_STATUS_REFUNDED}, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:     "return not approved",
+			ReturnId: returnId.String(),
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ReceiveReturn(mock.Anything, returnId, mock.Anything).
+					Return(nil, errlib.NewAppError(errlib.ErrCodeReturnStatus))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusConflict
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/returns/:id/receive", handler.ReceiveReturn)
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/returns/"+tc.ReturnId+"/receive", nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_CreateShipment(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PostOrdersIdShipmentsJSONRequestBody{
+		Carrier: "UPS",
+		Items: []types.ShipmentItemRequest{
+			{
+				Sku:      "TSHIRT-M-WHITE",
+				Quantity: 1,
+			},
+		},
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Payload    types.PostOrdersIdShipmentsJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "shipment packed",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().CreateShipment(mock.Anything, uuid.MustParse(mockOrderId), payload).
+					Return(&model.Shipment{Status: model.SHIPMENT_STATUS_PACKED}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name:    "missing carrier",
+			OrderId: mockOrderId,
+			Payload: types.PostOrdersIdShipmentsJSONRequestBody{Items: payload.Items},
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "empty items",
+			OrderId: mockOrderId,
+			Payload: types.PostOrdersIdShipmentsJSONRequestBody{Carrier: "UPS"},
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "order is not shippable",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().CreateShipment(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.NewAppError(errlib.ErrCodeOrderNotShippable))
+				expectError(dep, http.StatusConflict)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/orders/:id/shipments", handler.CreateShipment)
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/orders/"+tc.OrderId+"/shipments", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_ShipShipment(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	shipmentId := uuid.New()
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		ShipmentId string
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:       "shipment shipped and order fulfilled",
+			ShipmentId: shipmentId.String(),
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ShipShipment(mock.Anything, shipmentId, types.ShipShipmentRequest{}).
+					Return(&model.Shipment{Id: shipmentId, Status: model.SHIPMENT_STATUS_SHIPPED}, true, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:       "shipment already shipped",
+			ShipmentId: shipmentId.String(),
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ShipShipment(mock.Anything, shipmentId, mock.Anything).
+					Return(nil, false, errlib.NewAppError(errlib.ErrCodeShipmentStatus))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusConflict
+					}),
+				).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/shipments/:id/ship", handler.ShipShipment)
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/shipments/"+tc.ShipmentId+"/ship", nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_SubscribeBackInStock(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		Name       string
+		Sku        string
+		UserEmail  string
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:      "valid subscription",
+			Sku:       "OLIVE-OIL-1L",
+			UserEmail: mockUserEmail,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().SubscribeBackInStock(mock.Anything, "OLIVE-OIL-1L", mockUserEmail).Return(&model.BackInStockSubscription{
+					Id:     "1d0f8a43-5a1b-4c34-9d0e-0c7b5d1e2f3a",
+					Sku:    "OLIVE-OIL-1L",
+					Email:  mockUserEmail,
+					Status: "PENDING",
+				}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name:      "missing user email",
+			Sku:       "OLIVE-OIL-1L",
+			UserEmail: "",
+			Mock: func(dep *handlerDeps) {
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusUnauthorized
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusUnauthorized,
+		},
+		{
+			Name:      "sku is in stock",
+			Sku:       "OLIVE-OIL-1L",
+			UserEmail: mockUserEmail,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().SubscribeBackInStock(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.ErrValidationError([]map[string]interface{}{{"sku": "sku is in stock"}}))
+				dep.errLib.EXPECT().HandleAndSendErrorResponse(
+					mock.Anything,
+					mock.AnythingOfType("*http.Request"),
+					mock.MatchedBy(func(err *errlib.AppError) bool {
+						return err != nil && err.Status == http.StatusBadRequest
+					}),
+				).Times(1).Run(func(args mock.Arguments) {
+					args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+				})
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			// stands in for the jwt middleware
+			r := gin.Default()
+			r.POST("/v1/api/skus/:sku/back-in-stock-subscriptions", func(c *gin.Context) {
+				if tc.UserEmail != "" {
+					c.Set("user_email", tc.UserEmail)
+				}
+				c.Next()
+			}, handler.SubscribeBackInStock)
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/skus/"+tc.Sku+"/back-in-stock-subscriptions", nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_CreatePromotion(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	value := 10.0
+	tooMuch := 120.0
+	validTo := time.Now().Add(-time.Hour)
+	payload := types.PostPromotionsJSONRequestBody{
+		Code:          "SPRING10",
+		Name:          "Spring sale",
+		DiscountType:  types.PERCENTAGE,
+		DiscountValue: &value,
+		Scope:         types.ORDER,
+	}
+	withChange := func(change func(p *types.PostPromotionsJSONRequestBody)) types.PostPromotionsJSONRequestBody {
+		p := payload
+		change(&p)
+		return p
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name       string
+		Payload    types.PostPromotionsJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "promotion created",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().CreatePromotion(mock.Anything, payload).
+					Return(&model.Promotion{Code: "SPRING10"}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name: "percentage above 100",
+			Payload: withChange(func(p *types.PostPromotionsJSONRequestBody) {
+				p.DiscountValue = &tooMuch
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "buy x get y without quantities",
+			Payload: withChange(func(p *types.PostPromotionsJSONRequestBody) {
+				p.DiscountType = types.BUYXGETY
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "category scope without categories",
+			Payload: withChange(func(p *types.PostPromotionsJSONRequestBody) {
+				p.Scope = types.CATEGORY
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "validity window ends before it starts",
+			Payload: withChange(func(p *types.PostPromotionsJSONRequestBody) {
+				validFrom := time.Now()
+				p.ValidFrom = &validFrom
+				p.ValidTo = &validTo
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/promotions", handler.CreatePromotion)
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/promotions", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_CreateWebhookEndpoint(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PostWebhooksJSONRequestBody{
+		Url:        "https://partner.example.com/hooks",
+		EventTypes: []string{model.WEBHOOK_EVENT_ORDER_CONFIRMED, model.WEBHOOK_EVENT_SHIPMENT_SHIPPED},
+	}
+	withChange := func(change func(p *types.PostWebhooksJSONRequestBody)) types.PostWebhooksJSONRequestBody {
+		p := payload
+		change(&p)
+		return p
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name       string
+		Payload    types.PostWebhooksJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "endpoint registered",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().CreateWebhookEndpoint(mock.Anything, payload).
+					Return(&model.WebhookEndpoint{Url: payload.Url, Secret: "whsec_test"}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name: "url without scheme",
+			Payload: withChange(func(p *types.PostWebhooksJSONRequestBody) {
+				p.Url = "partner.example.com/hooks"
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "no event types",
+			Payload: withChange(func(p *types.PostWebhooksJSONRequestBody) {
+				p.EventTypes = nil
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "unknown event type",
+			Payload: withChange(func(p *types.PostWebhooksJSONRequestBody) {
+				p.EventTypes = []string{"order.exploded"}
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "event type listed twice",
+			Payload: withChange(func(p *types.PostWebhooksJSONRequestBody) {
+				p.EventTypes = []string{model.WEBHOOK_EVENT_ORDER_CONFIRMED, model.WEBHOOK_EVENT_ORDER_CONFIRMED}
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/webhooks", handler.CreateWebhookEndpoint)
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/webhooks", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_ForceOrderStatus(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	payload := types.PostAdminOrdersIdStatusJSONRequestBody{
+		Status: types.ForceOrderStatusRequestStatusCANCELLED,
+		Reason: "customer called to cancel",
+	}
+	withChange := func(change func(p *types.PostAdminOrdersIdStatusJSONRequestBody)) types.PostAdminOrdersIdStatusJSONRequestBody {
+		p := payload
+		change(&p)
+		return p
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name       string
+		OrderId    string
+		Payload    types.PostAdminOrdersIdStatusJSONRequestBody
+		Mock       func(dep *handlerDeps)
+		StatusCode int
+	}{
+		{
+			Name:    "status forced by the admin",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				cancelled := mockResultUsecase.Order
+				cancelled.Status = model.ORDER_STATUS_CANCELLED
+				dep.usecase.EXPECT().ForceOrderStatus(mock.Anything, uuid.MustParse(mockOrderId), payload, "admin@email.com").
+					Return(&cancelled, nil)
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:    "reason missing",
+			OrderId: mockOrderId,
+			Payload: withChange(func(p *types.PostAdminOrdersIdStatusJSONRequestBody) {
+				p.Reason = "  "
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "reason too long",
+			OrderId: mockOrderId,
+			Payload: withChange(func(p *types.PostAdminOrdersIdStatusJSONRequestBody) {
+				p.Reason = strings.Repeat("a", maxForceReasonLength+1)
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "unknown status",
+			OrderId: mockOrderId,
+			Payload: withChange(func(p *types.PostAdminOrdersIdStatusJSONRequestBody) {
+				p.Status = "SHIPPED"
+			}),
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "invalid order id",
+			OrderId: "not-a-uuid",
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:    "order moved concurrently",
+			OrderId: mockOrderId,
+			Payload: payload,
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ForceOrderStatus(mock.Anything, uuid.MustParse(mockOrderId), payload, "admin@email.com").
+					Return(nil, errlib.NewAppError(errlib.ErrCodeOrderModified))
+				expectError(dep, http.StatusConflict)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.POST("/v1/api/admin/orders/:id/status", func(c *gin.Context) {
+				c.Set("user_email", "admin@email.com")
+				handler.ForceOrderStatus(c)
+			})
+
+			payloadBytes, _ := json.Marshal(tc.Payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/admin/orders/"+tc.OrderId+"/status", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestOrderHandler_ExportAdminOrders(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	order := &model.OrderWithItems{
+		Order: mockResultUsecase.Order,
+		Items: []model.ItemOrder{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("2"), PricePerUom: fixed.NewS("50"), UomCode: "EA"},
+		},
+	}
+	streams := func(orders ...*model.OrderWithItems) func(context.Context, model.OrderSearchFilter, string, func(*model.OrderWithItems) error) error {
+		return func(_ context.Context, _ model.OrderSearchFilter, _ string, fn func(*model.OrderWithItems) error) error {
+			for _, o := range orders {
+				if err := fn(o); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	expectError := func(dep *handlerDeps, status int) {
+		dep.errLib.EXPECT().HandleAndSendErrorResponse(
+			mock.Anything,
+			mock.AnythingOfType("*http.Request"),
+			mock.MatchedBy(func(err *errlib.AppError) bool {
+				return err != nil && err.Status == status
+			}),
+		).Times(1).Run(sendError)
+	}
+
+	testCases := []struct {
+		Name           string
+		Query          string
+		AcceptEncoding string
+		Mock           func(dep *handlerDeps)
+		StatusCode     int
+		ExpectedBody   string
+	}{
+		{
+			Name:  "csv with the requested columns",
+			Query: "?status=CONFIRMED&columns=id,sku,quantity_per_uom",
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ExportOrders(mock.Anything, model.OrderSearchFilter{Statuses: []string{model.ORDER_STATUS_CONFIRMED}}, "admin@email.com", mock.Anything).
+					RunAndReturn(streams(order))
+			},
+			StatusCode:   http.StatusOK,
+			ExpectedBody: "id,sku,quantity_per_uom\n" + mockOrderId + ",OLIVE-OIL-1L,2\n",
+		},
+		{
+			Name:           "gzip ndjson when the client accepts it",
+			Query:          "?format=ndjson&columns=id,status&tz=Asia/Tokyo",
+			AcceptEncoding: "gzip, deflate",
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ExportOrders(mock.Anything, mock.Anything, "admin@email.com", mock.Anything).
+					RunAndReturn(streams(order))
+			},
+			StatusCode:   http.StatusOK,
+			ExpectedBody: `{"id":"` + mockOrderId + `","status":"PENDING"}` + "\n",
+		},
+		{
+			Name:  "export without orders has the header",
+			Query: "?columns=id,status",
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ExportOrders(mock.Anything, mock.Anything, "admin@email.com", mock.Anything).
+					RunAndReturn(streams())
+			},
+			StatusCode:   http.StatusOK,
+			ExpectedBody: "id,status\n",
+		},
+		{
+			Name:  "failure before the first order is answered with an error",
+			Query: "",
+			Mock: func(dep *handlerDeps) {
+				dep.usecase.EXPECT().ExportOrders(mock.Anything, mock.Anything, "admin@email.com", mock.Anything).
+					Return(errlib.ErrDBQuery())
+				expectError(dep, errlib.ErrDBQuery().Status)
+			},
+			StatusCode: errlib.ErrDBQuery().Status,
+		},
+		{
+			Name:  "unknown time zone",
+			Query: "?tz=Mars/Olympus",
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:  "unknown column",
+			Query: "?columns=id,password",
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:  "unknown format",
+			Query: "?format=xml",
+			Mock: func(dep *handlerDeps) {
+				expectError(dep, http.StatusBadRequest)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockUsecase := mocks.NewMockIOrderUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			deps := handlerDeps{
+				validator: mockValidator,
+				usecase:   mockUsecase,
+				logger:    mockLogger,
+				errLib:    mockerrlib,
+			}
+
+			tc.Mock(&deps)
+
+			handler := NewOrderHandler(deps.validator, deps.logger, deps.errLib, deps.usecase)
+
+			r := gin.Default()
+			r.GET("/v1/api/admin/orders/export", func(c *gin.Context) {
+				c.Set("user_email", "admin@email.com")
+				handler.ExportAdminOrders(c)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/v1/api/admin/orders/export"+tc.Query, nil)
+			if tc.AcceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tc.AcceptEncoding)
+			}
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+			if tc.ExpectedBody == "" {
+				return
+			}
+
+			body := resp.Body.Bytes()
+			assert.Equal(t, tc.AcceptEncoding != "", resp.Header().Get("Content-Encoding") == "gzip")
+			if resp.Header().Get("Content-Encoding") == "gzip" {
+				gz, err := gzip.NewReader(bytes.NewReader(body))
+				assert.NoError(t, err)
+				body, err = io.ReadAll(gz)
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedBody, string(body))
+		})
+	}
+}
+
+func TestCartHandler_AddCartItem(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	guestId := uuid.MustParse("5b0c4a3e-7c8d-4a4e-9d3b-2f0e6a1c9b7d")
+	payload := types.PostCartItemsJSONRequestBody{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 2}
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+	guestCart := func(_ context.Context, owner model.CartOwner, _ types.StockItemRequest) (*model.Cart, error) {
+		return &model.Cart{CartId: &owner.GuestId, Items: []model.CartLine{}}, nil
+	}
+
+	testCases := []struct {
+		Name           string
+		UserEmail      string
+		CartId         string
+		Mock           func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler)
+		StatusCode     int
+		ExpectedCartId func(header string) bool
+	}{
+		{
+			Name:      "signed in user adds to their cart",
+			UserEmail: mockUserEmail,
+			Mock: func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems([]types.StockItemRequest{payload}).Return(noValidationError, nil)
+				cart.EXPECT().AddCartItem(mock.Anything, model.CartOwner{UserEmail: mockUserEmail}, payload).
+					Return(&model.Cart{Items: []model.CartLine{}}, nil)
+			},
+			StatusCode:     http.StatusOK,
+			ExpectedCartId: func(header string) bool { return header == "" },
+		},
+		{
+			Name:   "guest adds to the cart of the header",
+			CartId: guestId.String(),
+			Mock: func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				cart.EXPECT().AddCartItem(mock.Anything, model.CartOwner{GuestId: guestId}, payload).
+					RunAndReturn(guestCart)
+			},
+			StatusCode:     http.StatusOK,
+			ExpectedCartId: func(header string) bool { return header == guestId.String() },
+		},
+		{
+			Name: "guest without a cart is given one",
+			Mock: func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				cart.EXPECT().AddCartItem(mock.Anything, mock.MatchedBy(func(owner model.CartOwner) bool {
+					return owner.UserEmail == "" && owner.GuestId != uuid.Nil
+				}), payload).
+					RunAndReturn(guestCart)
+			},
+			StatusCode: http.StatusOK,
+			ExpectedCartId: func(header string) bool {
+				_, err := uuid.Parse(header)
+				return err == nil
+			},
+		},
+		{
+			Name:   "invalid cart id header",
+			CartId: "not-a-uuid",
+			Mock: func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler) {
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:      "cart full",
+			UserEmail: mockUserEmail,
+			Mock: func(validator *mocks.MockIValidator, cart *mocks.MockICartUsecase, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems(mock.Anything).Return(noValidationError, nil)
+				cart.EXPECT().AddCartItem(mock.Anything, mock.Anything, payload).
+					Return(nil, errlib.NewAppError(errlib.ErrCodeCartFull))
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockValidator := mocks.NewMockIValidator(t)
+			mockCart := mocks.NewMockICartUsecase(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			tc.Mock(mockValidator, mockCart, mockerrlib)
+
+			handler := NewCartHandler(mockValidator, ml.NewMockLogger(t), mockerrlib, mockCart, mocks.NewMockIOrderUsecase(t))
+
+			r := gin.Default()
+			r.POST("/v1/api/cart/items", func(c *gin.Context) {
+				if tc.UserEmail != "" {
+					c.Set("user_email", tc.UserEmail)
+				}
+				handler.AddCartItem(c)
+			})
+
+			payloadBytes, _ := json.Marshal(payload)
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/cart/items", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.CartId != "" {
+				req.Header.Set(cartIdHeader, tc.CartId)
+			}
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+			if tc.ExpectedCartId != nil {
+				assert.True(t, tc.ExpectedCartId(resp.Header().Get(cartIdHeader)), resp.Header().Get(cartIdHeader))
+			}
+		})
+	}
+}
+
+func TestCartHandler_CheckoutCart(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		UserEmail  string
+		Body       string
+		Mock       func(cart *mocks.MockICartUsecase, logger *ml.MockLogger, errLib *em.MockIErrorHandler)
+		StatusCode int
+	}{
+		{
+			Name:      "order placed from the cart",
+			UserEmail: mockUserEmail,
+			Body:      `{"coupon_code":"WELCOME10"}`,
+			Mock: func(cart *mocks.MockICartUsecase, logger *ml.MockLogger, errLib *em.MockIErrorHandler) {
+				cart.EXPECT().Checkout(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, mock.MatchedBy(func(req types.CartCheckoutRequest) bool {
+					return req.CouponCode != nil && *req.CouponCode == "WELCOME10"
+				})).
+					Return(&mockResultUsecase, nil, nil)
+				logger.EXPECT().Info("order created with pending status")
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name:      "checkout without options",
+			UserEmail: mockUserEmail,
+			Mock: func(cart *mocks.MockICartUsecase, logger *ml.MockLogger, errLib *em.MockIErrorHandler) {
+				cart.EXPECT().Checkout(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, types.CartCheckoutRequest{}).
+					Return(&mockResultUsecase, nil, nil)
+				logger.EXPECT().Info("order created with pending status")
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name: "guests sign in to check out",
+			Mock: func(cart *mocks.MockICartUsecase, logger *ml.MockLogger, errLib *em.MockIErrorHandler) {
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusUnauthorized,
+		},
+		{
+			Name:      "checkout already in progress",
+			UserEmail: mockUserEmail,
+			Mock: func(cart *mocks.MockICartUsecase, logger *ml.MockLogger, errLib *em.MockIErrorHandler) {
+				cart.EXPECT().Checkout(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil, errlib.NewAppError(errlib.ErrCodeCartCheckoutInProgress))
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockCart := mocks.NewMockICartUsecase(t)
+			mockLogger := ml.NewMockLogger(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			tc.Mock(mockCart, mockLogger, mockerrlib)
+
+			handler := NewCartHandler(mocks.NewMockIValidator(t), mockLogger, mockerrlib, mockCart, mocks.NewMockIOrderUsecase(t))
+
+			r := gin.Default()
+			r.POST("/v1/api/cart/checkout", func(c *gin.Context) {
+				if tc.UserEmail != "" {
+					c.Set("user_id", mockUserId)
+					c.Set("user_email", tc.UserEmail)
+				}
+				handler.CheckoutCart(c)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/cart/checkout", strings.NewReader(tc.Body))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
+
+func TestSubscriptionHandler_CreateSubscription(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	sendError := func(args mock.Arguments) {
+		args.Get(0).(http.ResponseWriter).WriteHeader(args.Get(2).(*errlib.AppError).Status)
+	}
+
+	testCases := []struct {
+		Name       string
+		Body       string
+		Mock       func(subscriptions *mocks.MockISubscriptionUsecase, validator *mocks.MockIValidator, errLib *em.MockIErrorHandler)
+		StatusCode int
+	}{
+		{
+			Name: "subscription created",
+			Body: `{"items":[{"sku":"RICE-5KG","uom":"EA","quantity_per_uom":10}],"schedule":"0 8 * * MON","shortage_policy":"PARTIAL"}`,
+			Mock: func(subscriptions *mocks.MockISubscriptionUsecase, validator *mocks.MockIValidator, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems(mock.Anything).Return(nil, nil)
+				subscriptions.EXPECT().CreateSubscription(mock.Anything, model.Customer{UserId: mockUserId, Email: mockUserEmail}, mock.MatchedBy(func(req types.SubscriptionRequest) bool {
+					return req.Schedule == "0 8 * * MON" && *req.ShortagePolicy == types.SubscriptionRequestShortagePolicyPARTIAL && len(req.Items) == 1
+				})).
+					Return(&model.Subscription{Status: model.SUBSCRIPTION_STATUS_ACTIVE}, nil)
+			},
+			StatusCode: http.StatusCreated,
+		},
+		{
+			Name: "unknown shortage policy",
+			Body: `{"items":[{"sku":"RICE-5KG","uom":"EA","quantity_per_uom":10}],"schedule":"@weekly","shortage_policy":"WAIT"}`,
+			Mock: func(subscriptions *mocks.MockISubscriptionUsecase, validator *mocks.MockIValidator, errLib *em.MockIErrorHandler) {
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "no items",
+			Body: `{"items":[],"schedule":"@weekly"}`,
+			Mock: func(subscriptions *mocks.MockISubscriptionUsecase, validator *mocks.MockIValidator, errLib *em.MockIErrorHandler) {
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+		{
+			Name: "invalid schedule",
+			Body: `{"items":[{"sku":"RICE-5KG","uom":"EA","quantity_per_uom":10}],"schedule":"every monday"}`,
+			Mock: func(subscriptions *mocks.MockISubscriptionUsecase, validator *mocks.MockIValidator, errLib *em.MockIErrorHandler) {
+				validator.EXPECT().ValidateOrderItems(mock.Anything).Return(nil, nil)
+				subscriptions.EXPECT().CreateSubscription(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errlib.ErrValidationError([]map[string]interface{}{{"schedule": "cron expression must have 5 fields, got 2"}}))
+				errLib.EXPECT().HandleAndSendErrorResponse(mock.Anything, mock.Anything, mock.Anything).Times(1).Run(sendError)
+			},
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockSubscriptions := mocks.NewMockISubscriptionUsecase(t)
+			mockValidator := mocks.NewMockIValidator(t)
+			mockerrlib := em.NewMockIErrorHandler(t)
+
+			tc.Mock(mockSubscriptions, mockValidator, mockerrlib)
+
+			handler := NewSubscriptionHandler(mockValidator, ml.NewMockLogger(t), mockerrlib, mockSubscriptions)
+
+			r := gin.Default()
+			r.POST("/v1/api/subscriptions", func(c *gin.Context) {
+				c.Set("user_id", mockUserId)
+				c.Set("user_email", mockUserEmail)
+				handler.CreateSubscription(c)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/v1/api/subscriptions", strings.NewReader(tc.Body))
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tc.StatusCode, resp.Code)
+		})
+	}
+}
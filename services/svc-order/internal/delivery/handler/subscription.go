@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errlib"
+	"net/http"
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	uc "ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/services/svc-order/validator"
+	"ops-monorepo/shared-libs/logger"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	ISubscription interface {
+		CreateSubscription(c *gin.Context)
+		ListSubscriptions(c *gin.Context)
+		GetSubscription(c *gin.Context)
+		PauseSubscription(c *gin.Context)
+		ResumeSubscription(c *gin.Context)
+	}
+
+	SubscriptionHandler struct {
+		validator     validator.IValidator
+		logger        logger.Logger
+		errHandler    errlib.IErrorHandler
+		subscriptions uc.ISubscriptionUsecase
+	}
+)
+
+func NewSubscriptionHandler(v validator.IValidator, log logger.Logger, eh errlib.IErrorHandler, subscriptions uc.ISubscriptionUsecase) ISubscription {
+	return &SubscriptionHandler{
+		validator:     v,
+		logger:        log,
+		errHandler:    eh,
+		subscriptions: subscriptions,
+	}
+}
+
+func (h *SubscriptionHandler) CreateSubscription(c *gin.Context) {
+
+	// bind json
+	var req types.PostSubscriptionsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrJSONBinding(err))
+		return
+	}
+
+	// validate request
+	if errList := validateSubscription(req); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+	if errList, _ := h.validator.ValidateOrderItems(req.Items); len(errList) > 0 {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError(errList))
+		return
+	}
+
+	// call usecase
+	result, err := h.subscriptions.CreateSubscription(c.Request.Context(), customerOf(c), req)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendSubscription(c, http.StatusCreated, "subscription", result, "subscription created")
+}
+
+func (h *SubscriptionHandler) ListSubscriptions(c *gin.Context) {
+
+	// call usecase
+	result, err := h.subscriptions.GetSubscriptions(c.Request.Context(), customerOf(c))
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendSubscription(c, http.StatusOK, "subscriptions", result, "subscriptions retrieved")
+}
+
+func (h *SubscriptionHandler) GetSubscription(c *gin.Context) {
+
+	subscriptionId, ok := h.subscriptionId(c)
+	if !ok {
+		return
+	}
+
+	// call usecase
+	result, err := h.subscriptions.GetSubscription(c.Request.Context(), customerOf(c), subscriptionId)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendSubscription(c, http.StatusOK, "subscription", result, "subscription retrieved")
+}
+
+func (h *SubscriptionHandler) PauseSubscription(c *gin.Context) {
+
+	subscriptionId, ok := h.subscriptionId(c)
+	if !ok {
+		return
+	}
+
+	// call usecase
+	result, err := h.subscriptions.PauseSubscription(c.Request.Context(), customerOf(c), subscriptionId)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendSubscription(c, http.StatusOK, "subscription", result, "subscription paused")
+}
+
+func (h *SubscriptionHandler) ResumeSubscription(c *gin.Context) {
+
+	subscriptionId, ok := h.subscriptionId(c)
+	if !ok {
+		return
+	}
+
+	// call usecase
+	result, err := h.subscriptions.ResumeSubscription(c.Request.Context(), customerOf(c), subscriptionId)
+	if err != nil {
+		h.sendError(c, err)
+		return
+	}
+
+	h.sendSubscription(c, http.StatusOK, "subscription", result, "subscription resumed")
+}
+
+// parse subscription id
+func (h *SubscriptionHandler) subscriptionId(c *gin.Context) (uuid.UUID, bool) {
+	subscriptionId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrValidationError([]map[string]interface{}{
+			{"id": "must be a valid uuid"},
+		}))
+		return uuid.Nil, false
+	}
+	return subscriptionId, true
+}
+
+func (h *SubscriptionHandler) sendSubscription(c *gin.Context, status int, key string, data interface{}, message string) {
+	c.JSON(status, types.SubscriptionSuccessResponse{
+		Data:       map[string]interface{}{key: data},
+		StatusCode: status,
+		Message:    message,
+	})
+}
+
+func (h *SubscriptionHandler) sendError(c *gin.Context, err error) {
+	if appErr, ok := err.(*errlib.AppError); ok {
+		h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, appErr)
+		return
+	}
+	h.errHandler.HandleAndSendErrorResponse(c.Writer, c.Request, errlib.ErrInternalServer(err))
+}
+
+// the schedule and time zone are parsed by the usecase, the items by the validator
+func validateSubscription(req types.SubscriptionRequest) []map[string]interface{} {
+	errList := []map[string]interface{}{}
+	if len(req.Items) == 0 {
+		errList = append(errList, map[string]interface{}{"items": "items must not be empty"})
+	}
+	if strings.TrimSpace(req.Schedule) == "" {
+		errList = append(errList, map[string]interface{}{"schedule": "schedule is a required field"})
+	}
+	if req.ShortagePolicy != nil {
+		switch string(*req.ShortagePolicy) {
+		case model.SUBSCRIPTION_SHORTAGE_SKIP, model.SUBSCRIPTION_SHORTAGE_PAUSE, model.SUBSCRIPTION_SHORTAGE_PARTIAL, model.SUBSCRIPTION_SHORTAGE_BACKORDER:
+		default:
+			errList = append(errList, map[string]interface{}{"shortage_policy": "shortage_policy must be one of SKIP, PAUSE, PARTIAL or BACKORDER"})
+		}
+	}
+	errList = append(errList, validateShippingAddress(req.ShippingAddress)...)
+	return errList
+}
@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	uc "ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultBackorderInterval = 30 * time.Second
+
+type (
+	IBackorderJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	backorderJob struct {
+		logger   logger.Logger
+		usecase  uc.IOrderUsecase
+		interval time.Duration
+	}
+)
+
+// NewBackorderJob polls the inventory service every interval and confirms
+// backordered orders whose stock has been allocated
+func NewBackorderJob(log logger.Logger, usecase uc.IOrderUsecase, interval time.Duration) IBackorderJob {
+	if interval <= 0 {
+		interval = defaultBackorderInterval
+	}
+
+	return &backorderJob{
+		logger:   log,
+		usecase:  usecase,
+		interval: interval,
+	}
+}
+
+func (j *backorderJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *backorderJob) run(ctx context.Context) {
+	confirmed, err := j.usecase.ConfirmAllocatedBackorders(ctx)
+	if err != nil {
+		j.logger.Errorf("backorder job failed", "error", err.Error())
+	}
+	if confirmed > 0 {
+		j.logger.Infof("backordered orders confirmed", "count", confirmed)
+	}
+}
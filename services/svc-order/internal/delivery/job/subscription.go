@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	uc "ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultSubscriptionInterval = time.Minute
+
+type (
+	ISubscriptionJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	subscriptionJob struct {
+		logger   logger.Logger
+		usecase  uc.ISubscriptionUsecase
+		interval time.Duration
+	}
+)
+
+// NewSubscriptionJob places the orders of the subscriptions that are due every
+// interval, each replica can run it
+func NewSubscriptionJob(log logger.Logger, usecase uc.ISubscriptionUsecase, interval time.Duration) ISubscriptionJob {
+	if interval <= 0 {
+		interval = defaultSubscriptionInterval
+	}
+
+	return &subscriptionJob{
+		logger:   log,
+		usecase:  usecase,
+		interval: interval,
+	}
+}
+
+func (j *subscriptionJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *subscriptionJob) run(ctx context.Context) {
+	ran, err := j.usecase.RunDueSubscriptions(ctx)
+	if err != nil {
+		j.logger.Errorf("subscription job failed", "error", err.Error())
+	}
+	if ran > 0 {
+		j.logger.Infof("subscription runs done", "count", ran)
+	}
+}
@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	uc "ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/shared-libs/logger"
+	"time"
+)
+
+const defaultWebhookInterval = 10 * time.Second
+
+type (
+	IWebhookJob interface {
+		// runs in the background until ctx is cancelled
+		Start(ctx context.Context)
+	}
+
+	webhookJob struct {
+		logger   logger.Logger
+		usecase  uc.IOrderUsecase
+		interval time.Duration
+	}
+)
+
+// NewWebhookJob sends the webhook deliveries that are due every interval. replicas claim
+// different deliveries, so the job can run on all of them
+func NewWebhookJob(log logger.Logger, usecase uc.IOrderUsecase, interval time.Duration) IWebhookJob {
+	if interval <= 0 {
+		interval = defaultWebhookInterval
+	}
+
+	return &webhookJob{
+		logger:   log,
+		usecase:  usecase,
+		interval: interval,
+	}
+}
+
+func (j *webhookJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (j *webhookJob) run(ctx context.Context) {
+	delivered, err := j.usecase.DispatchWebhooks(ctx)
+	if err != nil {
+		j.logger.Errorf("webhook job failed", "error", err.Error())
+	}
+	if delivered > 0 {
+		j.logger.Infof("webhook deliveries sent", "count", delivered)
+	}
+}
@@ -3,9 +3,133 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package types
 
+import (
+	"time"
+)
+
+// Defines values for AlternativeSkuRespReason.
+const (
+	SAMECATEGORY   AlternativeSkuRespReason = "SAME_CATEGORY"
+	SAMEPRODUCT    AlternativeSkuRespReason = "SAME_PRODUCT"
+	SUBSTITUTERULE AlternativeSkuRespReason = "SUBSTITUTE_RULE"
+)
+
+// Defines values for OrderRequestReservationPolicy.
+const (
+	ALLORNOTHING      OrderRequestReservationPolicy = "ALL_OR_NOTHING"
+	FILLORKILLPERLINE OrderRequestReservationPolicy = "FILL_OR_KILL_PER_LINE"
+	PARTIAL           OrderRequestReservationPolicy = "PARTIAL"
+)
+
+// Defines values for PromotionRequestDiscountType.
+const (
+	BUYXGETY   PromotionRequestDiscountType = "BUY_X_GET_Y"
+	FIXED      PromotionRequestDiscountType = "FIXED"
+	PERCENTAGE PromotionRequestDiscountType = "PERCENTAGE"
+)
+
+// Defines values for PromotionRequestScope.
+const (
+	CATEGORY PromotionRequestScope = "CATEGORY"
+	ORDER    PromotionRequestScope = "ORDER"
+	SKU      PromotionRequestScope = "SKU"
+)
+
+// Defines values for SubscriptionRequestShortagePolicy.
+const (
+	SubscriptionRequestShortagePolicyBACKORDER SubscriptionRequestShortagePolicy = "BACKORDER"
+	SubscriptionRequestShortagePolicyPARTIAL   SubscriptionRequestShortagePolicy = "PARTIAL"
+	SubscriptionRequestShortagePolicyPAUSE     SubscriptionRequestShortagePolicy = "PAUSE"
+	SubscriptionRequestShortagePolicySKIP      SubscriptionRequestShortagePolicy = "SKIP"
+)
+
+// Defines values for ForceOrderStatusRequestStatus.
+const (
+	ForceOrderStatusRequestStatusBACKORDERED       ForceOrderStatusRequestStatus = "BACKORDERED"
+	ForceOrderStatusRequestStatusCANCELLED         ForceOrderStatusRequestStatus = "CANCELLED"
+	ForceOrderStatusRequestStatusCONFIRMED         ForceOrderStatusRequestStatus = "CONFIRMED"
+	ForceOrderStatusRequestStatusFAILEDRESERVATION ForceOrderStatusRequestStatus = "FAILED_RESERVATION"
+	ForceOrderStatusRequestStatusFULFILLED         ForceOrderStatusRequestStatus = "FULFILLED"
+	ForceOrderStatusRequestStatusPAYMENTFAILED     ForceOrderStatusRequestStatus = "PAYMENT_FAILED"
+	ForceOrderStatusRequestStatusPENDING           ForceOrderStatusRequestStatus = "PENDING"
+)
+
+// Defines values for GetAdminOrdersExportParamsFormat.
+const (
+	GetAdminOrdersExportParamsFormatCsv    GetAdminOrdersExportParamsFormat = "csv"
+	GetAdminOrdersExportParamsFormatNdjson GetAdminOrdersExportParamsFormat = "ndjson"
+)
+
+// AddressRequest Address the order is shipped to, its country and region select the tax rules
+type AddressRequest struct {
+	City *string `json:"city,omitempty"`
+
+	// CountryCode ISO 3166-1 alpha-2 country code
+	CountryCode string  `json:"country_code"`
+	Line1       *string `json:"line1,omitempty"`
+	Line2       *string `json:"line2,omitempty"`
+	PostalCode  *string `json:"postal_code,omitempty"`
+
+	// Region State or province code, narrows the tax rules down when the region has its own
+	Region *string `json:"region,omitempty"`
+}
+
+// AdminAuditSuccessResponse defines model for AdminAuditSuccessResponse.
+type AdminAuditSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// AdminOrderSuccessResponse defines model for AdminOrderSuccessResponse.
+type AdminOrderSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// AdminOrdersSuccessResponse defines model for AdminOrdersSuccessResponse.
+type AdminOrdersSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// AlternativeSkuResp defines model for AlternativeSkuResp.
+type AlternativeSkuResp struct {
+	AvailableQuantity *string                   `json:"available_quantity,omitempty"`
+	ProductId         *string                   `json:"product_id,omitempty"`
+	ProductName       *string                   `json:"product_name,omitempty"`
+	Reason            *AlternativeSkuRespReason `json:"reason,omitempty"`
+	Sku               *string                   `json:"sku,omitempty"`
+}
+
+// AlternativeSkuRespReason defines model for AlternativeSkuResp.Reason.
+type AlternativeSkuRespReason string
+
+// AmendOrderItemsRequest defines model for AmendOrderItemsRequest.
+type AmendOrderItemsRequest struct {
+	// OrderItems Items the order should have, skus left out are removed
+	OrderItems []StockItemRequest `json:"order_items"`
+}
+
+// AmendOrderItemsSuccessResponse defines model for AmendOrderItemsSuccessResponse.
+type AmendOrderItemsSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
 // AnyValue defines model for AnyValue.
 type AnyValue = interface{}
 
+// BackInStockSubscriptionSuccessResponse defines model for BackInStockSubscriptionSuccessResponse.
+type BackInStockSubscriptionSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
 // BaseSuccessResponse defines model for BaseSuccessResponse.
 type BaseSuccessResponse struct {
 	Data       *AnyValue `json:"data,omitempty"`
@@ -13,6 +137,31 @@ type BaseSuccessResponse struct {
 	StatusCode int       `json:"status_code"`
 }
 
+// CartCheckoutRequest Options of the order placed from the cart, its items are the lines of the cart
+type CartCheckoutRequest struct {
+	// AllowBackorder Queue quantities that are out of stock instead of failing the order, the order stays BACKORDERED until all of it is allocated
+	AllowBackorder *bool `json:"allow_backorder,omitempty"`
+
+	// CouponCode Coupon code of a promotion to apply, the total amount is net of its discounts
+	CouponCode *string `json:"coupon_code,omitempty"`
+
+	// PaymentMethod Payment method to authorize the order total with, the provider default when omitted
+	PaymentMethod *string `json:"payment_method,omitempty"`
+
+	// ReservationPolicy How items that are short are handled, ALL_OR_NOTHING when omitted
+	ReservationPolicy *OrderRequestReservationPolicy `json:"reservation_policy,omitempty"`
+
+	// ShippingAddress Address the order is shipped to, its country and region select the tax rules
+	ShippingAddress *AddressRequest `json:"shipping_address,omitempty"`
+}
+
+// CartSuccessResponse defines model for CartSuccessResponse.
+type CartSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
 // CreateOrderSuccessResponse defines model for CreateOrderSuccessResponse.
 type CreateOrderSuccessResponse struct {
 	Data       AnyValue `json:"data"`
@@ -20,18 +169,104 @@ type CreateOrderSuccessResponse struct {
 	StatusCode int      `json:"status_code"`
 }
 
+// ForceOrderStatusRequest defines model for ForceOrderStatusRequest.
+type ForceOrderStatusRequest struct {
+	// Reason Why the order is moved outside of the usual flow, kept in the audit log
+	Reason string                        `json:"reason"`
+	Status ForceOrderStatusRequestStatus `json:"status"`
+}
+
+// ForceOrderStatusRequestStatus defines model for ForceOrderStatusRequest.Status.
+type ForceOrderStatusRequestStatus string
+
+// FulfilOrderSuccessResponse defines model for FulfilOrderSuccessResponse.
+type FulfilOrderSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// GetOrderSuccessResponse defines model for GetOrderSuccessResponse.
+type GetOrderSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ListPromotionsSuccessResponse defines model for ListPromotionsSuccessResponse.
+type ListPromotionsSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ListReturnsSuccessResponse defines model for ListReturnsSuccessResponse.
+type ListReturnsSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ListShipmentsSuccessResponse defines model for ListShipmentsSuccessResponse.
+type ListShipmentsSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ListWebhookDeliveriesSuccessResponse defines model for ListWebhookDeliveriesSuccessResponse.
+type ListWebhookDeliveriesSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ListWebhookEndpointsSuccessResponse defines model for ListWebhookEndpointsSuccessResponse.
+type ListWebhookEndpointsSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// MergeCartRequest defines model for MergeCartRequest.
+type MergeCartRequest struct {
+	// CartId Id of the guest cart, its lines are added to the cart of the signed in user
+	CartId string `json:"cart_id"`
+}
+
 // OrderRequest defines model for OrderRequest.
 type OrderRequest struct {
+	// AllowBackorder Queue quantities that are out of stock instead of failing the order, the order stays BACKORDERED until all of it is allocated
+	AllowBackorder *bool `json:"allow_backorder,omitempty"`
+
+	// CouponCode Coupon code of a promotion to apply, the total amount is net of its discounts
+	CouponCode *string            `json:"coupon_code,omitempty"`
 	OrderItems []StockItemRequest `json:"order_items"`
+
+	// PaymentMethod Payment method to authorize the order total with, the provider default when omitted
+	PaymentMethod *string `json:"payment_method,omitempty"`
+
+	// QuoteToken Token of a quote for the same items, the order is placed at the quoted prices or rejected when they changed
+	QuoteToken *string `json:"quote_token,omitempty"`
+
+	// ReservationPolicy How items that are short are handled, ALL_OR_NOTHING when omitted
+	ReservationPolicy *OrderRequestReservationPolicy `json:"reservation_policy,omitempty"`
+
+	// ShippingAddress Address the order is shipped to, its country and region select the tax rules
+	ShippingAddress *AddressRequest `json:"shipping_address,omitempty"`
 }
 
+// OrderRequestReservationPolicy How items that are short are handled, ALL_OR_NOTHING when omitted
+type OrderRequestReservationPolicy string
+
 // OutOfStockItemResp defines model for OutOfStockItemResp.
 type OutOfStockItemResp struct {
-	AvailableQuantity *string `json:"available_quantity,omitempty"`
-	ProductId         *string `json:"product_id,omitempty"`
-	ProductName       *string `json:"product_name,omitempty"`
-	RequestedQuantity *string `json:"requested_quantity,omitempty"`
-	Sku               *string `json:"sku,omitempty"`
+	Alternatives      *[]AlternativeSkuResp `json:"alternatives,omitempty"`
+	AvailableQuantity *string               `json:"available_quantity,omitempty"`
+	ProductId         *string               `json:"product_id,omitempty"`
+	ProductName       *string               `json:"product_name,omitempty"`
+	RequestedQuantity *string               `json:"requested_quantity,omitempty"`
+	Sku               *string               `json:"sku,omitempty"`
 }
 
 // OutOfStockItems defines model for OutOfStockItems.
@@ -47,6 +282,122 @@ type OutofStockResponse struct {
 	SuggestedActions *[]string        `json:"suggested_actions,omitempty"`
 }
 
+// PromotionRequest defines model for PromotionRequest.
+type PromotionRequest struct {
+	// BuyQuantity Units to buy per group, required for BUY_X_GET_Y
+	BuyQuantity *int `json:"buy_quantity,omitempty"`
+
+	// Code Coupon code the promotion is redeemed with, stored upper case
+	Code         string                       `json:"code"`
+	DiscountType PromotionRequestDiscountType `json:"discount_type"`
+
+	// DiscountValue Percentage off for PERCENTAGE, amount off for FIXED
+	DiscountValue *float64 `json:"discount_value,omitempty"`
+
+	// GetQuantity Free units per group, required for BUY_X_GET_Y
+	GetQuantity *int `json:"get_quantity,omitempty"`
+
+	// IsActive Whether the coupon can be redeemed, true when omitted
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// MaxUses Orders the coupon can be redeemed on in total, unlimited when omitted
+	MaxUses *int `json:"max_uses,omitempty"`
+
+	// MaxUsesPerUser Orders the coupon can be redeemed on per user, unlimited when omitted
+	MaxUsesPerUser *int `json:"max_uses_per_user,omitempty"`
+
+	// MinOrderValue Order amount before discounts the coupon needs
+	MinOrderValue *float64              `json:"min_order_value,omitempty"`
+	Name          string                `json:"name"`
+	Scope         PromotionRequestScope `json:"scope"`
+
+	// ScopeValues Skus or category ids the promotion applies to, required for the SKU and CATEGORY scopes
+	ScopeValues *[]string `json:"scope_values,omitempty"`
+
+	// ValidFrom Start of the validity window, now when omitted
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+
+	// ValidTo End of the validity window, open ended when omitted
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+}
+
+// PromotionRequestDiscountType defines model for PromotionRequest.DiscountType.
+type PromotionRequestDiscountType string
+
+// PromotionRequestScope defines model for PromotionRequest.Scope.
+type PromotionRequestScope string
+
+// PromotionSuccessResponse defines model for PromotionSuccessResponse.
+type PromotionSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// QuoteSuccessResponse defines model for QuoteSuccessResponse.
+type QuoteSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ReturnDecisionRequest defines model for ReturnDecisionRequest.
+type ReturnDecisionRequest struct {
+	// Note Why the return was approved or rejected, kept in the return history
+	Note *string `json:"note,omitempty"`
+}
+
+// ReturnItemRequest defines model for ReturnItemRequest.
+type ReturnItemRequest struct {
+	Quantity float64 `json:"quantity"`
+	Sku      string  `json:"sku"`
+}
+
+// ReturnRequest defines model for ReturnRequest.
+type ReturnRequest struct {
+	// Items Order lines and quantities sent back
+	Items  []ReturnItemRequest `json:"items"`
+	Reason *string             `json:"reason,omitempty"`
+}
+
+// ReturnSuccessResponse defines model for ReturnSuccessResponse.
+type ReturnSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// ShipShipmentRequest defines model for ShipShipmentRequest.
+type ShipShipmentRequest struct {
+	// Carrier Carrier that took the parcel, the packed carrier when omitted
+	Carrier *string `json:"carrier,omitempty"`
+
+	// TrackingNumber Tracking number of the carrier, required unless it was given when packing
+	TrackingNumber *string `json:"tracking_number,omitempty"`
+}
+
+// ShipmentItemRequest defines model for ShipmentItemRequest.
+type ShipmentItemRequest struct {
+	Quantity float64 `json:"quantity"`
+	Sku      string  `json:"sku"`
+}
+
+// ShipmentRequest defines model for ShipmentRequest.
+type ShipmentRequest struct {
+	Carrier string `json:"carrier"`
+
+	// Items Order lines and quantities packed in the parcel
+	Items          []ShipmentItemRequest `json:"items"`
+	TrackingNumber *string               `json:"tracking_number,omitempty"`
+}
+
+// ShipmentSuccessResponse defines model for ShipmentSuccessResponse.
+type ShipmentSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
 // StandardErrorResponse defines model for StandardErrorResponse.
 type StandardErrorResponse struct {
 	Details   *string                 `json:"details,omitempty"`
@@ -66,5 +417,198 @@ type StockItemRequest struct {
 	Uom            string  `json:"uom" validate:"required"`
 }
 
+// SubscriptionRequest Template of the orders placed at each run of the subscription
+type SubscriptionRequest struct {
+	// Items Items ordered at each run
+	Items []StockItemRequest `json:"items"`
+
+	// PaymentMethod Payment method to authorize each order with, the provider default when omitted
+	PaymentMethod *string `json:"payment_method,omitempty"`
+
+	// Schedule When the orders are placed, a 5 field cron expression, @hourly, @daily, @weekly, @monthly or @every <duration>. runs are at least an hour apart
+	Schedule string `json:"schedule"`
+
+	// ShippingAddress Address the order is shipped to, its country and region select the tax rules
+	ShippingAddress *AddressRequest `json:"shipping_address,omitempty"`
+
+	// ShortagePolicy What a run does when items are short, SKIP when omitted
+	ShortagePolicy *SubscriptionRequestShortagePolicy `json:"shortage_policy,omitempty"`
+
+	// Timezone IANA time zone the cron expression is read in, UTC when omitted
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// SubscriptionRequestShortagePolicy What a run does when items are short, SKIP when omitted
+type SubscriptionRequestShortagePolicy string
+
+// SubscriptionSuccessResponse defines model for SubscriptionSuccessResponse.
+type SubscriptionSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// UpdateCartItemRequest defines model for UpdateCartItemRequest.
+type UpdateCartItemRequest struct {
+	QuantityPerUom float64 `json:"quantity_per_uom"`
+}
+
+// UpdateWebhookEndpointRequest Fields of the endpoint to change, omitted fields keep their value
+type UpdateWebhookEndpointRequest struct {
+	// Active Inactive endpoints get no new deliveries, their queued deliveries wait until they are active again
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Replaces the event types the endpoint receives
+	EventTypes *[]string `json:"event_types,omitempty"`
+	Url        *string   `json:"url,omitempty"`
+}
+
+// WebhookDeliverySuccessResponse defines model for WebhookDeliverySuccessResponse.
+type WebhookDeliverySuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// WebhookEndpointRequest defines model for WebhookEndpointRequest.
+type WebhookEndpointRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Events sent to the endpoint, see the readme for the list
+	EventTypes []string `json:"event_types"`
+
+	// Url Absolute http or https url the events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookEndpointSuccessResponse defines model for WebhookEndpointSuccessResponse.
+type WebhookEndpointSuccessResponse struct {
+	Data       AnyValue `json:"data"`
+	Message    string   `json:"message"`
+	StatusCode int      `json:"status_code"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// EndpointId Only deliveries to this endpoint
+	EndpointId *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty"`
+
+	// Status PENDING, SUCCEEDED or DEAD, the DEAD deliveries are the dead letter list
+	Status    *string `form:"status,omitempty" json:"status,omitempty"`
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty"`
+
+	// Limit Deliveries returned, newest first, 50 by default and at most 500
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	OrderId *string `form:"order_id,omitempty" json:"order_id,omitempty"`
+	Actor   *string `form:"actor,omitempty" json:"actor,omitempty"`
+	Action  *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Limit Entries returned, newest first, 50 by default and at most 500
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAdminOrdersParams defines parameters for GetAdminOrders.
+type GetAdminOrdersParams struct {
+	// Status Orders in any of these statuses
+	Status    *[]string `form:"status,omitempty" json:"status,omitempty"`
+	UserId    *string   `form:"user_id,omitempty" json:"user_id,omitempty"`
+	UserEmail *string   `form:"user_email,omitempty" json:"user_email,omitempty"`
+
+	// Sku Orders with a line of this sku
+	Sku *string `form:"sku,omitempty" json:"sku,omitempty"`
+
+	// CreatedFrom Orders created at or after this time
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Orders created before this time
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Orders returned, newest first, 50 by default and at most 500
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAdminOrdersExportParams defines parameters for GetAdminOrdersExport.
+type GetAdminOrdersExportParams struct {
+	// Status Orders in any of these statuses
+	Status    *[]string `form:"status,omitempty" json:"status,omitempty"`
+	UserId    *string   `form:"user_id,omitempty" json:"user_id,omitempty"`
+	UserEmail *string   `form:"user_email,omitempty" json:"user_email,omitempty"`
+
+	// Sku Orders with a line of this sku
+	Sku *string `form:"sku,omitempty" json:"sku,omitempty"`
+
+	// CreatedFrom Orders created at or after this time
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Orders created before this time
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Format csv with a row per order line, or ndjson with an object per order. csv when omitted
+	Format *GetAdminOrdersExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Columns Comma separated columns in the order they are exported
+	Columns *string `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// Tz IANA time zone the times are shown in, UTC when omitted
+	Tz *string `form:"tz,omitempty" json:"tz,omitempty"`
+}
+
+// GetAdminOrdersExportParamsFormat defines parameters for GetAdminOrdersExport.
+type GetAdminOrdersExportParamsFormat string
+
 // PostOrdersJSONRequestBody defines body for PostOrders for application/json ContentType.
 type PostOrdersJSONRequestBody = OrderRequest
+
+// PostOrdersQuoteJSONRequestBody defines body for PostOrdersQuote for application/json ContentType.
+type PostOrdersQuoteJSONRequestBody = OrderRequest
+
+// PatchOrdersIdItemsJSONRequestBody defines body for PatchOrdersIdItems for application/json ContentType.
+type PatchOrdersIdItemsJSONRequestBody = AmendOrderItemsRequest
+
+// PostOrdersIdReturnsJSONRequestBody defines body for PostOrdersIdReturns for application/json ContentType.
+type PostOrdersIdReturnsJSONRequestBody = ReturnRequest
+
+// PostOrdersIdShipmentsJSONRequestBody defines body for PostOrdersIdShipments for application/json ContentType.
+type PostOrdersIdShipmentsJSONRequestBody = ShipmentRequest
+
+// PostPromotionsJSONRequestBody defines body for PostPromotions for application/json ContentType.
+type PostPromotionsJSONRequestBody = PromotionRequest
+
+// PostReturnsIdApproveJSONRequestBody defines body for PostReturnsIdApprove for application/json ContentType.
+type PostReturnsIdApproveJSONRequestBody = ReturnDecisionRequest
+
+// PostReturnsIdRejectJSONRequestBody defines body for PostReturnsIdReject for application/json ContentType.
+type PostReturnsIdRejectJSONRequestBody = ReturnDecisionRequest
+
+// PostShipmentsIdShipJSONRequestBody defines body for PostShipmentsIdShip for application/json ContentType.
+type PostShipmentsIdShipJSONRequestBody = ShipShipmentRequest
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookEndpointRequest
+
+// PatchWebhooksIdJSONRequestBody defines body for PatchWebhooksId for application/json ContentType.
+type PatchWebhooksIdJSONRequestBody = UpdateWebhookEndpointRequest
+
+// PostCartItemsJSONRequestBody defines body for PostCartItems for application/json ContentType.
+type PostCartItemsJSONRequestBody = StockItemRequest
+
+// PutCartItemsSkuJSONRequestBody defines body for PutCartItemsSku for application/json ContentType.
+type PutCartItemsSkuJSONRequestBody = UpdateCartItemRequest
+
+// PostCartMergeJSONRequestBody defines body for PostCartMerge for application/json ContentType.
+type PostCartMergeJSONRequestBody = MergeCartRequest
+
+// PostCartCheckoutJSONRequestBody defines body for PostCartCheckout for application/json ContentType.
+type PostCartCheckoutJSONRequestBody = CartCheckoutRequest
+
+// PostSubscriptionsJSONRequestBody defines body for PostSubscriptions for application/json ContentType.
+type PostSubscriptionsJSONRequestBody = SubscriptionRequest
+
+// PostAdminOrdersIdStatusJSONRequestBody defines body for PostAdminOrdersIdStatus for application/json ContentType.
+type PostAdminOrdersIdStatusJSONRequestBody = ForceOrderStatusRequest
@@ -1,18 +1,24 @@
 package internal
 
 import (
+	"crypto/rand"
 	"errlib"
 	"log"
 	"ops-monorepo/services/svc-order/config"
 	"ops-monorepo/services/svc-order/internal/delivery/handler"
+	"ops-monorepo/services/svc-order/internal/delivery/job"
+	"ops-monorepo/services/svc-order/internal/payment"
 	"ops-monorepo/services/svc-order/internal/repository"
+	"ops-monorepo/services/svc-order/internal/tax"
 	"ops-monorepo/services/svc-order/internal/usecase"
+	"ops-monorepo/services/svc-order/internal/webhook"
 	"ops-monorepo/services/svc-order/seeds"
 	"ops-monorepo/services/svc-order/validator"
 	"ops-monorepo/shared-libs/jwt"
 	"ops-monorepo/shared-libs/logger"
 	"os"
 	inventoryv1 "pb_schemas/inventory/v1"
+	notificationv1 "pb_schemas/notification/v1"
 	userv1 "pb_schemas/user/v1"
 
 	gg "ops-monorepo/shared-libs/grpc/client"
@@ -20,6 +26,7 @@ import (
 	"time"
 
 	pg "ops-monorepo/shared-libs/storage/postgres"
+	rd "ops-monorepo/shared-libs/storage/redis"
 )
 
 type Dependencies struct {
@@ -31,20 +38,40 @@ type Dependencies struct {
 }
 
 type GrpcDeps struct {
-	InventoryGrpcClient inventoryv1.InventoryServiceClient
-	UserGrpcClient      userv1.UserServiceClient
+	InventoryGrpcClient    inventoryv1.InventoryServiceClient
+	BackInStockGrpcClient  inventoryv1.BackInStockServiceClient
+	BackorderGrpcClient    inventoryv1.BackorderServiceClient
+	NotificationGrpcClient notificationv1.NotificationServiceClient
+	UserGrpcClient         userv1.UserServiceClient
 }
 
 type Impl struct {
 	Order
+	Cart         Cart
+	Subscription Subscription
 }
 
 type Order struct {
+	job        job.IBackorderJob
+	webhookJob job.IWebhookJob
 	handler    handler.IOrder
 	usecase    usecase.IOrderUsecase
 	repository repository.IOrderSQLRepository
 }
 
+// cart routes are registered only when handler is set
+type Cart struct {
+	handler    handler.ICart
+	usecase    usecase.ICartUsecase
+	repository repository.ICartRepository
+}
+
+type Subscription struct {
+	job     job.ISubscriptionJob
+	handler handler.ISubscription
+	usecase usecase.ISubscriptionUsecase
+}
+
 func InitDependencies(cfg *config.Config) Dependencies {
 
 	if cfg == nil {
@@ -102,16 +129,92 @@ func InitDependencies(cfg *config.Config) Dependencies {
 	}
 	inventoryClient := inventoryv1.NewInventoryServiceClient(invConn)
 	dep.GrpcDeps.InventoryGrpcClient = inventoryClient
+	dep.GrpcDeps.BackInStockGrpcClient = inventoryv1.NewBackInStockServiceClient(invConn)
+	dep.GrpcDeps.BackorderGrpcClient = inventoryv1.NewBackorderServiceClient(invConn)
 	zl.Info("inventory grpc client ok..")
 
+	// shipment emails need the notification service, they are not sent without it
+	if cfg.GrpcServices.ServiceNotificationGrpcUrl != "" {
+		notificationConn, err := clientRegistry.GetConnection(cfg.GrpcServices.ServiceNotificationGrpcUrl)
+		if err != nil || notificationConn == nil {
+			zl.Warnf("cannot establish connection with notification service, shipment emails disabled: %v", err)
+		} else {
+			dep.GrpcDeps.NotificationGrpcClient = notificationv1.NewNotificationServiceClient(notificationConn)
+			zl.Info("notification grpc client ok..")
+		}
+	}
+
 	// validator
 	val := validator.NewValidator()
 
+	// quote tokens, every replica must share the secret. a random secret only verifies quotes issued by this
+	// instance until it restarts, so it is only used for local runs that ask for it
+	quoteSecret := cfg.Quote.Secret
+	if quoteSecret == "" {
+		if !cfg.Quote.RandomSecret {
+			zl.Fatal("QUOTE_SECRET is not set, set it or QUOTE_RANDOM_SECRET=true for a local run")
+		}
+		zl.Warn("QUOTE_SECRET is not set, quotes are signed with a random secret")
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			zl.Fatal("error failed to generate quote secret")
+		}
+		quoteSecret = string(secret)
+	}
+
+	// payment provider
+	var paymentProvider payment.PaymentProvider
+	switch cfg.Payment.Provider {
+	case "fake":
+		zl.Warn("payments are taken by the fake provider, no money is moved")
+		paymentProvider = payment.NewFakeProvider()
+	default:
+		zl.Fatalf("unknown payment provider %q", cfg.Payment.Provider)
+	}
+
+	// tax rules, the built in ones unless a rules file is configured
+	jurisdictions := tax.DefaultJurisdictions
+	if cfg.Tax.RulesFile != "" {
+		if jurisdictions, err = tax.LoadJurisdictions(cfg.Tax.RulesFile); err != nil {
+			zl.Fatalf("error failed to load tax rules: %v", err)
+		}
+	}
+	taxCalculator, err := tax.NewTableCalculator(jurisdictions)
+	if err != nil {
+		zl.Fatalf("error invalid tax rules: %v", err)
+	}
+
 	//order
 	dep.Impl.Order.repository = repository.NewOrderRepository(db)
-	dep.Impl.Order.usecase = usecase.NewOrderUsecase(dep.Impl.Order.repository, zl, dep.GrpcDeps.InventoryGrpcClient)
+	dep.Impl.Order.usecase = usecase.NewOrderUsecase(dep.Impl.Order.repository, zl, dep.GrpcDeps.InventoryGrpcClient, dep.GrpcDeps.BackInStockGrpcClient, dep.GrpcDeps.BackorderGrpcClient, dep.GrpcDeps.NotificationGrpcClient, usecase.NewQuoteSigner(quoteSecret, cfg.Quote.TTL), paymentProvider, taxCalculator, webhook.NewHTTPSender(cfg.Webhook.Timeout))
 	dep.Impl.Order.handler = handler.NewOrderHandler(val, zl, dep.ErrorHandler, dep.Impl.usecase)
+	if cfg.Backorder.JobEnabled {
+		dep.Impl.Order.job = job.NewBackorderJob(zl, dep.Impl.Order.usecase, cfg.Backorder.JobInterval)
+	}
+	if cfg.Webhook.JobEnabled {
+		dep.Impl.Order.webhookJob = job.NewWebhookJob(zl, dep.Impl.Order.usecase, cfg.Webhook.JobInterval)
+	}
 	zl.Info("order module ok..")
 
+	// subscription
+	dep.Impl.Subscription.usecase = usecase.NewSubscriptionUsecase(dep.Impl.Order.repository, zl, dep.Impl.Order.usecase, dep.GrpcDeps.NotificationGrpcClient)
+	dep.Impl.Subscription.handler = handler.NewSubscriptionHandler(val, zl, dep.ErrorHandler, dep.Impl.Subscription.usecase)
+	if cfg.Subscription.JobEnabled {
+		dep.Impl.Subscription.job = job.NewSubscriptionJob(zl, dep.Impl.Subscription.usecase, cfg.Subscription.JobInterval)
+	}
+	zl.Info("subscription module ok..")
+
+	// carts are kept in redis, the cart api is not served without it
+	if cfg.Redis.Uri == "" {
+		zl.Warn("REDIS_URI is not set, cart api disabled")
+	} else if rdb, err := rd.NewRedis(&rd.RedisCfg{Addr: cfg.Redis.Uri}); err != nil {
+		zl.Warnf("redis unavailable, cart api disabled: %v", err)
+	} else {
+		dep.Impl.Cart.repository = repository.NewCartRepository(rdb, cfg.Cart.TTL)
+		dep.Impl.Cart.usecase = usecase.NewCartUsecase(dep.Impl.Cart.repository, zl, dep.Impl.Order.usecase, dep.GrpcDeps.InventoryGrpcClient)
+		dep.Impl.Cart.handler = handler.NewCartHandler(val, zl, dep.ErrorHandler, dep.Impl.Cart.usecase, dep.Impl.Order.usecase)
+		zl.Info("cart module ok..")
+	}
+
 	return dep
 }
@@ -0,0 +1,261 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strconv"
+	"strings"
+	"time"
+
+	// the runtime image has no zoneinfo, time zones of the export are resolved from the embedded copy
+	_ "time/tzdata"
+)
+
+// formats of the order export
+const (
+	FORMAT_CSV    = "csv"
+	FORMAT_NDJSON = "ndjson"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+type (
+	// Encoder writes the orders of an export one at a time, nothing is held but the order being written
+	Encoder interface {
+		Write(order *model.OrderWithItems) error
+		// Close writes what is buffered, the header of a csv export without orders included
+		Close() error
+	}
+
+	// column of the export, item columns have one value per order line
+	column struct {
+		item  bool
+		order func(o *model.Order, loc *time.Location) interface{}
+		line  func(i *model.ItemOrder) interface{}
+	}
+
+	csvEncoder struct {
+		w         *csv.Writer
+		columns   []string
+		loc       *time.Location
+		withItems bool
+		header    bool
+	}
+
+	ndjsonEncoder struct {
+		w         *bufio.Writer
+		enc       *json.Encoder
+		columns   []string
+		loc       *time.Location
+		withItems bool
+	}
+)
+
+// columns the export can be made of, by name
+var columns = map[string]column{
+	"id":                 {order: func(o *model.Order, _ *time.Location) interface{} { return o.Id.String() }},
+	"user_id":            {order: func(o *model.Order, _ *time.Location) interface{} { return o.UserId }},
+	"user_email":         {order: func(o *model.Order, _ *time.Location) interface{} { return o.UserEmail }},
+	"status":             {order: func(o *model.Order, _ *time.Location) interface{} { return o.Status }},
+	"total_amount":       {order: func(o *model.Order, _ *time.Location) interface{} { return o.TotalAmount.String() }},
+	"tax_amount":         {order: func(o *model.Order, _ *time.Location) interface{} { return o.TaxAmount.String() }},
+	"currency":           {order: func(o *model.Order, _ *time.Location) interface{} { return o.Currency }},
+	"prices_include_tax": {order: func(o *model.Order, _ *time.Location) interface{} { return o.PricesIncludeTax }},
+	"promotion_id": {order: func(o *model.Order, _ *time.Location) interface{} {
+		if o.PromotionId == nil {
+			return nil
+		}
+		return o.PromotionId.String()
+	}},
+	"shipping_country": {order: func(o *model.Order, _ *time.Location) interface{} {
+		if o.ShippingAddress == nil {
+			return nil
+		}
+		return o.ShippingAddress.CountryCode
+	}},
+	"created_at": {order: func(o *model.Order, loc *time.Location) interface{} { return o.CreatedAt.In(loc).Format(time.RFC3339) }},
+	"updated_at": {order: func(o *model.Order, loc *time.Location) interface{} { return o.UpdateAt.In(loc).Format(time.RFC3339) }},
+
+	"item_id":          {item: true, line: func(i *model.ItemOrder) interface{} { return i.Id.String() }},
+	"sku":              {item: true, line: func(i *model.ItemOrder) interface{} { return i.Sku }},
+	"quantity_per_uom": {item: true, line: func(i *model.ItemOrder) interface{} { return i.QuantityPerUom.String() }},
+	"price_per_uom":    {item: true, line: func(i *model.ItemOrder) interface{} { return i.PricePerUom.String() }},
+	"uom_code":         {item: true, line: func(i *model.ItemOrder) interface{} { return i.UomCode }},
+	"confirmed_quantity": {item: true, line: func(i *model.ItemOrder) interface{} {
+		if i.ConfirmedQuantity == nil {
+			return nil
+		}
+		return i.ConfirmedQuantity.String()
+	}},
+	"short_quantity": {item: true, line: func(i *model.ItemOrder) interface{} {
+		if i.ShortQuantity == nil {
+			return nil
+		}
+		return i.ShortQuantity.String()
+	}},
+}
+
+// DefaultColumns are exported when the request names none
+var DefaultColumns = []string{
+	"id", "user_id", "user_email", "status", "total_amount", "tax_amount", "currency", "created_at", "updated_at",
+	"item_id", "sku", "quantity_per_uom", "price_per_uom", "uom_code",
+}
+
+// ParseColumns reads a comma separated list of column names, in the order they are exported
+func ParseColumns(value string) ([]string, error) {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %s", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("column %s is listed twice", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("at least one column is required")
+	}
+	return names, nil
+}
+
+// ContentType of an export in format
+func ContentType(format string) string {
+	if format == FORMAT_NDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// NewEncoder writes orders to w in format with columns, times are shown in loc
+func NewEncoder(format string, w io.Writer, names []string, loc *time.Location) (Encoder, error) {
+	withItems := false
+	for _, name := range names {
+		withItems = withItems || columns[name].item
+	}
+
+	switch format {
+	case FORMAT_CSV:
+		return &csvEncoder{w: csv.NewWriter(w), columns: names, loc: loc, withItems: withItems}, nil
+	case FORMAT_NDJSON:
+		buf := bufio.NewWriter(w)
+		return &ndjsonEncoder{w: buf, enc: json.NewEncoder(buf), columns: names, loc: loc, withItems: withItems}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// AcceptsGzip reports whether an Accept-Encoding header allows a gzip response
+func AcceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		// gzip;q=0 refuses it
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				q = parsed
+			}
+		}
+		return q > 0
+	}
+	return false
+}
+
+// csv has one row per order line, the order columns repeated on each. an order without lines, or an export
+// without item columns, has a single row
+func (e *csvEncoder) Write(order *model.OrderWithItems) error {
+	e.writeHeader()
+	if !e.withItems || len(order.Items) == 0 {
+		return e.w.Write(e.row(&order.Order, nil))
+	}
+	for i := range order.Items {
+		if err := e.w.Write(e.row(&order.Order, &order.Items[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *csvEncoder) Close() error {
+	e.writeHeader()
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder) writeHeader() {
+	if e.header {
+		return
+	}
+	e.header = true
+	e.w.Write(e.columns)
+}
+
+func (e *csvEncoder) row(order *model.Order, item *model.ItemOrder) []string {
+	row := make([]string, len(e.columns))
+	for i, name := range e.columns {
+		col := columns[name]
+		var value interface{}
+		switch {
+		case !col.item:
+			value = col.order(order, e.loc)
+		case item != nil:
+			value = col.line(item)
+		}
+		row[i] = csvValue(value)
+	}
+	return row
+}
+
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return v
+	}
+	return fmt.Sprint(value)
+}
+
+// ndjson has one object per order, its lines are nested under items when an item column is exported
+func (e *ndjsonEncoder) Write(order *model.OrderWithItems) error {
+	object := map[string]interface{}{}
+	for _, name := range e.columns {
+		if col := columns[name]; !col.item {
+			object[name] = col.order(&order.Order, e.loc)
+		}
+	}
+	if e.withItems {
+		lines := make([]map[string]interface{}, 0, len(order.Items))
+		for i := range order.Items {
+			line := map[string]interface{}{}
+			for _, name := range e.columns {
+				if col := columns[name]; col.item {
+					line[name] = col.line(&order.Items[i])
+				}
+			}
+			lines = append(lines, line)
+		}
+		object["items"] = lines
+	}
+	return e.enc.Encode(object)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.w.Flush()
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+)
+
+func exportOrder() *model.OrderWithItems {
+	orderId := uuid.MustParse("9680e493-843d-4069-9b38-7495e70d7621")
+	short := fixed.NewS("1")
+	return &model.OrderWithItems{
+		Order: model.Order{
+			Id:          orderId,
+			UserEmail:   "user@email.com",
+			Status:      model.ORDER_STATUS_CONFIRMED,
+			TotalAmount: fixed.NewS("12.5"),
+			Currency:    "USD",
+			CreatedAt:   time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC),
+		},
+		Items: []model.ItemOrder{
+			{OrderId: orderId, Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("2"), PricePerUom: fixed.NewS("5"), UomCode: "EA"},
+			{OrderId: orderId, Sku: "RICE-5KG", QuantityPerUom: fixed.NewS("1"), PricePerUom: fixed.NewS("2.5"), UomCode: "EA", ShortQuantity: &short},
+		},
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Value       string
+		Expected    []string
+		ExpectedErr bool
+	}{
+		{Name: "columns keep their order", Value: " sku, ID ,status", Expected: []string{"sku", "id", "status"}},
+		{Name: "unknown column", Value: "id,password", ExpectedErr: true},
+		{Name: "column listed twice", Value: "id,id", ExpectedErr: true},
+		{Name: "no column", Value: " , ", ExpectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			columns, err := ParseColumns(tc.Value)
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, columns)
+		})
+	}
+}
+
+func TestCSVEncoder(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	testCases := []struct {
+		Name     string
+		Columns  []string
+		Orders   []*model.OrderWithItems
+		Expected string
+	}{
+		{
+			Name:    "a row per order line in the requested time zone",
+			Columns: []string{"id", "created_at", "sku", "short_quantity"},
+			Orders:  []*model.OrderWithItems{exportOrder()},
+			Expected: "id,created_at,sku,short_quantity\n" +
+				"9680e493-843d-4069-9b38-7495e70d7621,2024-01-02T00:30:00+01:00,OLIVE-OIL-1L,\n" +
+				"9680e493-843d-4069-9b38-7495e70d7621,2024-01-02T00:30:00+01:00,RICE-5KG,1\n",
+		},
+		{
+			Name:     "a row per order without item columns",
+			Columns:  []string{"status", "total_amount"},
+			Orders:   []*model.OrderWithItems{exportOrder()},
+			Expected: "status,total_amount\nCONFIRMED,12.5\n",
+		},
+		{
+			Name:     "empty export has the header",
+			Columns:  []string{"id", "sku"},
+			Expected: "id,sku\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			encoder, err := NewEncoder(FORMAT_CSV, &buf, tc.Columns, berlin)
+			assert.NoError(t, err)
+
+			for _, order := range tc.Orders {
+				assert.NoError(t, encoder.Write(order))
+			}
+			assert.NoError(t, encoder.Close())
+			assert.Equal(t, tc.Expected, buf.String())
+		})
+	}
+}
+
+func TestNDJSONEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	encoder, err := NewEncoder(FORMAT_NDJSON, &buf, []string{"status", "promotion_id", "sku", "quantity_per_uom"}, time.UTC)
+	assert.NoError(t, err)
+
+	assert.NoError(t, encoder.Write(exportOrder()))
+	assert.NoError(t, encoder.Write(exportOrder()))
+	assert.NoError(t, encoder.Close())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+
+	var object map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &object))
+	assert.Equal(t, map[string]interface{}{
+		"status":       "CONFIRMED",
+		"promotion_id": nil,
+		"items": []interface{}{
+			map[string]interface{}{"sku": "OLIVE-OIL-1L", "quantity_per_uom": "2"},
+			map[string]interface{}{"sku": "RICE-5KG", "quantity_per_uom": "1"},
+		},
+	}, object)
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	testCases := map[string]bool{
+		"":                    false,
+		"gzip":                true,
+		"deflate, gzip;q=0.5": true,
+		"GZIP":                true,
+		"gzip;q=0":            false,
+		"br, *":               true,
+		"identity":            false,
+	}
+
+	for header, expected := range testCases {
+		assert.Equal(t, expected, AcceptsGzip(header), header)
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	inventoryv1 "pb_schemas/inventory/v1"
 	"time"
 
@@ -13,6 +14,124 @@ const (
 	ORDER_STATUS_CONFIRMED          = "CONFIRMED"
 	ORDER_STATUS_FAILED_RESERVATION = "FAILED_RESERVATION"
 	ORDER_STATUS_CANCELLED          = "CANCELLED"
+	// part of the order waits for stock, confirmed once the inventory service allocated all of it
+	ORDER_STATUS_BACKORDERED = "BACKORDERED"
+	// the payment was not authorized, the reserved stock is released
+	ORDER_STATUS_PAYMENT_FAILED = "PAYMENT_FAILED"
+	// the payment was captured and the goods left the warehouse
+	ORDER_STATUS_FULFILLED = "FULFILLED"
+)
+
+// states of a shipment, a PACKED shipment is SHIPPED once the carrier took it and DELIVERED once it arrived
+const (
+	SHIPMENT_STATUS_PACKED    = "PACKED"
+	SHIPMENT_STATUS_SHIPPED   = "SHIPPED"
+	SHIPMENT_STATUS_DELIVERED = "DELIVERED"
+)
+
+// states of a payment attempt, PENDING until the provider answered
+const (
+	PAYMENT_STATUS_PENDING    = "PENDING"
+	PAYMENT_STATUS_AUTHORIZED = "AUTHORIZED"
+	PAYMENT_STATUS_DECLINED   = "DECLINED"
+	PAYMENT_STATUS_FAILED     = "FAILED"
+	PAYMENT_STATUS_CAPTURED   = "CAPTURED"
+	PAYMENT_STATUS_VOIDED     = "VOIDED"
+	PAYMENT_STATUS_REFUNDED   = "REFUNDED"
+)
+
+// states of a return, an admin approves or rejects a REQUESTED return.
+// approved goods are RECEIVED back into stock, then the refund makes it REFUNDED
+const (
+	RETURN_STATUS_REQUESTED = "REQUESTED"
+	RETURN_STATUS_APPROVED  = "APPROVED"
+	RETURN_STATUS_REJECTED  = "REJECTED"
+	RETURN_STATUS_RECEIVED  = "RECEIVED"
+	RETURN_STATUS_REFUNDED  = "REFUNDED"
+)
+
+// kinds of discount of a promotion
+const (
+	// discount_value percent off the lines in scope
+	PROMOTION_TYPE_PERCENTAGE = "PERCENTAGE"
+	// discount_value off the lines in scope, spread over them by amount
+	PROMOTION_TYPE_FIXED = "FIXED"
+	// for every buy_quantity of a sku in scope, get_quantity more of it are free
+	PROMOTION_TYPE_BUY_X_GET_Y = "BUY_X_GET_Y"
+)
+
+// lines of an order a promotion applies to, scope_values holds the skus or category ids
+const (
+	PROMOTION_SCOPE_ORDER    = "ORDER"
+	PROMOTION_SCOPE_SKU      = "SKU"
+	PROMOTION_SCOPE_CATEGORY = "CATEGORY"
+)
+
+// events of the order history
+const (
+	ORDER_EVENT_ITEMS_AMENDED = "ITEMS_AMENDED"
+)
+
+// events sent to webhook endpoints, an endpoint receives the types it subscribed to
+const (
+	WEBHOOK_EVENT_ORDER_CONFIRMED          = "order.confirmed"
+	WEBHOOK_EVENT_ORDER_BACKORDERED        = "order.backordered"
+	WEBHOOK_EVENT_ORDER_RESERVATION_FAILED = "order.reservation_failed"
+	WEBHOOK_EVENT_ORDER_CANCELLED          = "order.cancelled"
+	WEBHOOK_EVENT_ORDER_PAYMENT_FAILED     = "order.payment_failed"
+	WEBHOOK_EVENT_ORDER_AMENDED            = "order.amended"
+	WEBHOOK_EVENT_ORDER_FULFILLED          = "order.fulfilled"
+	WEBHOOK_EVENT_SHIPMENT_SHIPPED         = "shipment.shipped"
+	WEBHOOK_EVENT_SHIPMENT_DELIVERED       = "shipment.delivered"
+)
+
+// states of a webhook delivery, PENDING until the endpoint accepted it. a delivery that kept failing
+// is DEAD until an admin replays it
+const (
+	WEBHOOK_DELIVERY_PENDING   = "PENDING"
+	WEBHOOK_DELIVERY_SUCCEEDED = "SUCCEEDED"
+	WEBHOOK_DELIVERY_DEAD      = "DEAD"
+)
+
+// actions written to the admin audit log
+const (
+	ADMIN_ACTION_SEARCH_ORDERS     = "SEARCH_ORDERS"
+	ADMIN_ACTION_EXPORT_ORDERS     = "EXPORT_ORDERS"
+	ADMIN_ACTION_FORCE_STATUS      = "FORCE_STATUS"
+	ADMIN_ACTION_RETRY_RESERVATION = "RETRY_RESERVATION"
+)
+
+// availability of a cart line, UNAVAILABLE when the sku is no longer sold
+const (
+	CART_LINE_IN_STOCK     = "IN_STOCK"
+	CART_LINE_OUT_OF_STOCK = "OUT_OF_STOCK"
+	CART_LINE_UNAVAILABLE  = "UNAVAILABLE"
+)
+
+// states of a subscription, a PAUSED subscription has no runs until it is resumed
+const (
+	SUBSCRIPTION_STATUS_ACTIVE = "ACTIVE"
+	SUBSCRIPTION_STATUS_PAUSED = "PAUSED"
+)
+
+// what a run does when items of the subscription are out of stock. SKIP places nothing and waits for the next
+// run, PAUSE does the same and pauses the subscription, PARTIAL orders what is in stock and BACKORDER queues
+// what is not
+const (
+	SUBSCRIPTION_SHORTAGE_SKIP      = "SKIP"
+	SUBSCRIPTION_SHORTAGE_PAUSE     = "PAUSE"
+	SUBSCRIPTION_SHORTAGE_PARTIAL   = "PARTIAL"
+	SUBSCRIPTION_SHORTAGE_BACKORDER = "BACKORDER"
+)
+
+// outcome of a subscription run, PENDING while its order is placed
+const (
+	SUBSCRIPTION_RUN_PENDING     = "PENDING"
+	SUBSCRIPTION_RUN_PLACED      = "PLACED"
+	SUBSCRIPTION_RUN_PARTIAL     = "PARTIAL"
+	SUBSCRIPTION_RUN_BACKORDERED = "BACKORDERED"
+	SUBSCRIPTION_RUN_SKIPPED     = "SKIPPED"
+	SUBSCRIPTION_RUN_FAILED      = "FAILED"
 )
 
 type (
@@ -25,6 +144,28 @@ type (
 		Currency    string      `json:"currency"`
 		CreatedAt   time.Time   `json:"created_at"`
 		UpdateAt    time.Time   `json:"updated_at"`
+		// promotion of the coupon code the order was placed with
+		PromotionId *uuid.UUID `json:"promotion_id,omitempty"`
+		// address the order ships to, it selects the tax rules. orders without one are not taxed
+		ShippingAddress *Address `json:"shipping_address,omitempty"`
+		// tax of the order, part of the total amount when prices include tax and added to it otherwise
+		TaxAmount        fixed.Fixed `json:"tax_amount"`
+		PricesIncludeTax bool        `json:"prices_include_tax"`
+	}
+
+	// authenticated user an order is placed for
+	Customer struct {
+		UserId string
+		Email  string
+	}
+
+	Address struct {
+		CountryCode string `json:"country_code"`
+		Region      string `json:"region,omitempty"`
+		City        string `json:"city,omitempty"`
+		PostalCode  string `json:"postal_code,omitempty"`
+		Line1       string `json:"line1,omitempty"`
+		Line2       string `json:"line2,omitempty"`
 	}
 
 	ItemOrder struct {
@@ -34,11 +175,455 @@ type (
 		QuantityPerUom fixed.Fixed `json:"quantity_per_uom"`
 		PricePerUom    fixed.Fixed `json:"price_per_uom"`
 		UomCode        string      `json:"uom_code"`
+		// set for orders placed with a per line reservation policy, confirmed plus short is the quantity
+		ConfirmedQuantity *fixed.Fixed `json:"confirmed_quantity,omitempty"`
+		ShortQuantity     *fixed.Fixed `json:"short_quantity,omitempty"`
 	}
 
 	OrderWithItems struct {
 		Order
-		Items []ItemOrder `json:"items"`
+		Items      []ItemOrder     `json:"items"`
+		Backorders []Backorder     `json:"backorders,omitempty"`
+		Payment    *Payment        `json:"payment,omitempty"`
+		Discounts  []OrderDiscount `json:"discounts,omitempty"`
+		Taxes      []OrderTax      `json:"taxes,omitempty"`
+	}
+
+	// payment attempt of an order, every authorization is a new attempt
+	Payment struct {
+		Id               uuid.UUID   `json:"id"`
+		OrderId          uuid.UUID   `json:"order_id"`
+		Provider         string      `json:"provider"`
+		PaymentMethod    string      `json:"payment_method"`
+		Status           string      `json:"status"`
+		Amount           fixed.Fixed `json:"amount"`
+		CapturedAmount   fixed.Fixed `json:"captured_amount"`
+		RefundedAmount   fixed.Fixed `json:"refunded_amount"`
+		Currency         string      `json:"currency"`
+		AuthorizationRef string      `json:"authorization_ref,omitempty"`
+		CaptureRef       string      `json:"capture_ref,omitempty"`
+		FailureReason    string      `json:"failure_reason,omitempty"`
+		CreatedAt        time.Time   `json:"created_at"`
+		UpdatedAt        time.Time   `json:"updated_at"`
+	}
+
+	// discount rule redeemed with its coupon code. a promotion can be redeemed from valid_from until valid_to,
+	// max_uses and max_uses_per_user count the orders placed with it that did not fail
+	Promotion struct {
+		Id             uuid.UUID   `json:"id"`
+		Code           string      `json:"code"`
+		Name           string      `json:"name"`
+		DiscountType   string      `json:"discount_type"`
+		DiscountValue  fixed.Fixed `json:"discount_value"`
+		BuyQuantity    int         `json:"buy_quantity,omitempty"`
+		GetQuantity    int         `json:"get_quantity,omitempty"`
+		Scope          string      `json:"scope"`
+		ScopeValues    []string    `json:"scope_values"`
+		MinOrderValue  fixed.Fixed `json:"min_order_value"`
+		MaxUses        *int        `json:"max_uses,omitempty"`
+		MaxUsesPerUser *int        `json:"max_uses_per_user,omitempty"`
+		UsedCount      int         `json:"used_count"`
+		ValidFrom      time.Time   `json:"valid_from"`
+		ValidTo        *time.Time  `json:"valid_to,omitempty"`
+		IsActive       bool        `json:"is_active"`
+		CreatedAt      time.Time   `json:"created_at"`
+	}
+
+	// part of a promotion taken off an order item, the total amount of the order is net of its discounts
+	OrderDiscount struct {
+		Id          uuid.UUID   `json:"id"`
+		OrderId     uuid.UUID   `json:"order_id"`
+		OrderItemId uuid.UUID   `json:"order_item_id"`
+		PromotionId uuid.UUID   `json:"promotion_id"`
+		Code        string      `json:"code"`
+		Sku         string      `json:"sku"`
+		Description string      `json:"description"`
+		Amount      fixed.Fixed `json:"amount"`
+	}
+
+	// tax of an order item in the jurisdiction of the shipping address, taxable amount is the
+	// amount of the item after its discounts and without its tax
+	OrderTax struct {
+		Id            uuid.UUID   `json:"id"`
+		OrderId       uuid.UUID   `json:"order_id"`
+		OrderItemId   uuid.UUID   `json:"order_item_id"`
+		Jurisdiction  string      `json:"jurisdiction"`
+		Sku           string      `json:"sku"`
+		TaxCategory   string      `json:"tax_category"`
+		Rate          fixed.Fixed `json:"rate"`
+		TaxableAmount fixed.Fixed `json:"taxable_amount"`
+		TaxAmount     fixed.Fixed `json:"tax_amount"`
+	}
+
+	// order line quantity queued by svc-inventory until stock arrives
+	Backorder struct {
+		Sku       string    `json:"sku"`
+		Quantity  float64   `json:"quantity"`
+		Status    string    `json:"status"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	// change made to an order after it was placed
+	OrderHistory struct {
+		Id        int64                  `json:"id"`
+		OrderId   uuid.UUID              `json:"order_id"`
+		Event     string                 `json:"event"`
+		Details   map[string]interface{} `json:"details"`
+		CreatedAt time.Time              `json:"created_at"`
+	}
+
+	// items changed by an amendment, written together with its history entry. the discounts replace
+	// those of an order with a promotion and the taxes those of an order with a shipping address
+	OrderAmendment struct {
+		Added     []ItemOrder
+		Changed   []ItemOrder
+		Removed   []uuid.UUID
+		Discounts []OrderDiscount
+		Taxes     []OrderTax
+		History   OrderHistory
+	}
+
+	// quantity of a sku before and after an amendment, zero when the sku was added or removed
+	ItemQuantityChange struct {
+		Sku  string      `json:"sku"`
+		From fixed.Fixed `json:"from"`
+		To   fixed.Fixed `json:"to"`
+	}
+
+	// parcel of an order, its items cover part or all of the quantity of order lines
+	Shipment struct {
+		Id             uuid.UUID      `json:"id"`
+		OrderId        uuid.UUID      `json:"order_id"`
+		Status         string         `json:"status"`
+		Carrier        string         `json:"carrier"`
+		TrackingNumber string         `json:"tracking_number"`
+		CreatedAt      time.Time      `json:"created_at"`
+		UpdatedAt      time.Time      `json:"updated_at"`
+		ShippedAt      *time.Time     `json:"shipped_at,omitempty"`
+		DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+		Items          []ShipmentItem `json:"items"`
+	}
+
+	ShipmentItem struct {
+		Id          uuid.UUID   `json:"id"`
+		ShipmentId  uuid.UUID   `json:"shipment_id"`
+		OrderItemId uuid.UUID   `json:"order_item_id"`
+		Sku         string      `json:"sku"`
+		Quantity    fixed.Fixed `json:"quantity"`
+		UomCode     string      `json:"uom_code"`
+	}
+
+	// goods of a fulfilled order sent back by the customer, refunded at the prices of the order items
+	// less their discounts
+	OrderReturn struct {
+		Id           uuid.UUID       `json:"id"`
+		OrderId      uuid.UUID       `json:"order_id"`
+		Status       string          `json:"status"`
+		Reason       string          `json:"reason"`
+		RequestedBy  string          `json:"requested_by"`
+		RefundAmount fixed.Fixed     `json:"refund_amount"`
+		Currency     string          `json:"currency"`
+		RefundRef    string          `json:"refund_ref,omitempty"`
+		CreatedAt    time.Time       `json:"created_at"`
+		UpdatedAt    time.Time       `json:"updated_at"`
+		Items        []ReturnItem    `json:"items"`
+		History      []ReturnHistory `json:"history"`
+	}
+
+	// returned quantity of an order line, price_per_uom is copied from the order item
+	ReturnItem struct {
+		Id          uuid.UUID   `json:"id"`
+		ReturnId    uuid.UUID   `json:"return_id"`
+		OrderItemId uuid.UUID   `json:"order_item_id"`
+		Sku         string      `json:"sku"`
+		Quantity    fixed.Fixed `json:"quantity"`
+		PricePerUom fixed.Fixed `json:"price_per_uom"`
+		UomCode     string      `json:"uom_code"`
+		// share of the discounts of the order item, taken off the refund
+		DiscountAmount fixed.Fixed `json:"discount_amount"`
+		// share of the tax of the order item, added to the refund when it was added to the order
+		TaxAmount fixed.Fixed `json:"tax_amount"`
+	}
+
+	// status change of a return, from_status is empty for the request itself
+	ReturnHistory struct {
+		Id         int64     `json:"id"`
+		ReturnId   uuid.UUID `json:"return_id"`
+		FromStatus string    `json:"from_status,omitempty"`
+		ToStatus   string    `json:"to_status"`
+		Actor      string    `json:"actor"`
+		Note       string    `json:"note,omitempty"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+
+	// partner url notified about order events, the secret signs every delivery and is only returned
+	// when the endpoint is registered
+	WebhookEndpoint struct {
+		Id          uuid.UUID `json:"id"`
+		Url         string    `json:"url"`
+		Secret      string    `json:"secret,omitempty"`
+		EventTypes  []string  `json:"event_types"`
+		Description string    `json:"description,omitempty"`
+		Active      bool      `json:"active"`
+		CreatedAt   time.Time `json:"created_at"`
+		UpdatedAt   time.Time `json:"updated_at"`
+	}
+
+	// body of a webhook delivery, every endpoint subscribed to the type gets the same event
+	WebhookEvent struct {
+		Id         uuid.UUID              `json:"id"`
+		Type       string                 `json:"type"`
+		OccurredAt time.Time              `json:"occurred_at"`
+		Data       map[string]interface{} `json:"data"`
+	}
+
+	// event queued for one endpoint, next_attempt_at is when a PENDING delivery is sent again
+	WebhookDelivery struct {
+		Id             uuid.UUID        `json:"id"`
+		EndpointId     uuid.UUID        `json:"endpoint_id"`
+		EventId        uuid.UUID        `json:"event_id"`
+		EventType      string           `json:"event_type"`
+		Payload        json.RawMessage  `json:"payload"`
+		Status         string           `json:"status"`
+		Attempts       int              `json:"attempts"`
+		NextAttemptAt  time.Time        `json:"next_attempt_at"`
+		LastStatusCode *int             `json:"last_status_code,omitempty"`
+		LastError      string           `json:"last_error,omitempty"`
+		CreatedAt      time.Time        `json:"created_at"`
+		UpdatedAt      time.Time        `json:"updated_at"`
+		DeliveredAt    *time.Time       `json:"delivered_at,omitempty"`
+		Log            []WebhookAttempt `json:"log,omitempty"`
+		// url and secret of the endpoint, set on deliveries claimed for sending
+		Url    string `json:"-"`
+		Secret string `json:"-"`
+	}
+
+	// single try to send a delivery, status code is unset when the endpoint did not answer
+	WebhookAttempt struct {
+		Id          int64     `json:"id"`
+		DeliveryId  uuid.UUID `json:"delivery_id"`
+		StatusCode  *int      `json:"status_code,omitempty"`
+		Error       string    `json:"error,omitempty"`
+		DurationMs  int64     `json:"duration_ms"`
+		AttemptedAt time.Time `json:"attempted_at"`
+	}
+
+	// filters of the delivery log, empty fields match every delivery
+	WebhookDeliveryFilter struct {
+		EndpointId *uuid.UUID
+		Status     string
+		EventType  string
+		Limit      int
+	}
+
+	// filters of the admin order search, empty fields match every order. the created range is [from, to)
+	OrderSearchFilter struct {
+		Statuses    []string
+		UserId      string
+		UserEmail   string
+		Sku         string
+		CreatedFrom *time.Time
+		CreatedTo   *time.Time
+		Limit       int
+		Offset      int
+	}
+
+	// page of the admin order search, total counts every order matching the filter
+	OrderSearchResult struct {
+		Orders []Order `json:"orders"`
+		Total  int     `json:"total"`
+		Limit  int     `json:"limit"`
+		Offset int     `json:"offset"`
+	}
+
+	// action taken by an admin, order id is nil for searches and exports
+	AdminAuditEntry struct {
+		Id        int64                  `json:"id"`
+		Actor     string                 `json:"actor"`
+		Action    string                 `json:"action"`
+		OrderId   *uuid.UUID             `json:"order_id,omitempty"`
+		Reason    string                 `json:"reason,omitempty"`
+		Details   map[string]interface{} `json:"details"`
+		CreatedAt time.Time              `json:"created_at"`
+	}
+
+	// filters of the admin audit log, empty fields match every entry
+	AdminAuditFilter struct {
+		OrderId *uuid.UUID
+		Actor   string
+		Action  string
+		Limit   int
+	}
+
+	// reservation held by svc-inventory for an order line
+	OrderReservation struct {
+		Sku        string     `json:"sku"`
+		Quantity   float64    `json:"quantity"`
+		Uom        string     `json:"uom"`
+		Status     string     `json:"status"`
+		ReservedAt time.Time  `json:"reserved_at"`
+		ReleasedAt *time.Time `json:"released_at,omitempty"`
+		LineType   string     `json:"line_type"`
+		BundleSku  string     `json:"bundle_sku,omitempty"`
+	}
+
+	OrderDetail struct {
+		OrderWithItems
+		Reservations []OrderReservation `json:"reservations"`
+	}
+
+	BackInStockSubscription struct {
+		Id         string     `json:"id"`
+		Sku        string     `json:"sku"`
+		Email      string     `json:"email"`
+		Status     string     `json:"status"`
+		CreatedAt  time.Time  `json:"created_at"`
+		ExpiresAt  time.Time  `json:"expires_at"`
+		NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	}
+
+	// in stock sku the customer can order instead of an out of stock one
+	AlternativeSku struct {
+		Sku               string  `json:"sku"`
+		ProductId         string  `json:"product_id"`
+		ProductName       string  `json:"product_name"`
+		AvailableQuantity float64 `json:"available_quantity"`
+		Reason            string  `json:"reason"`
+	}
+
+	OutOfStockItem struct {
+		Sku               string           `json:"sku"`
+		ProductId         string           `json:"product_id"`
+		ProductName       string           `json:"product_name"`
+		RequestedQuantity float64          `json:"requested_quantity"`
+		AvailableQuantity float64          `json:"available_quantity"`
+		Alternatives      []AlternativeSku `json:"alternatives"`
+	}
+
+	// prices and availability of an order request, nothing is inserted or reserved
+	Quote struct {
+		Token       string      `json:"quote_token"`
+		ExpiresAt   time.Time   `json:"expires_at"`
+		Currency    string      `json:"currency"`
+		TotalAmount fixed.Fixed `json:"total_amount"`
+		Items       []QuoteItem `json:"items"`
+		// discounts of the coupon code, the total amount is net of them
+		CouponCode string          `json:"coupon_code,omitempty"`
+		Discounts  []QuoteDiscount `json:"discounts,omitempty"`
+		// tax of the shipping address, added to the total amount unless prices include it
+		TaxAmount        fixed.Fixed `json:"tax_amount"`
+		PricesIncludeTax bool        `json:"prices_include_tax"`
+		Taxes            []QuoteTax  `json:"taxes,omitempty"`
+	}
+
+	QuoteItem struct {
+		Sku               string      `json:"sku"`
+		QuantityPerUom    fixed.Fixed `json:"quantity_per_uom"`
+		PricePerUom       fixed.Fixed `json:"price_per_uom"`
+		UomCode           string      `json:"uom_code"`
+		Amount            fixed.Fixed `json:"amount"`
+		AvailableQuantity float64     `json:"available_quantity"`
+		InStock           bool        `json:"in_stock"`
+	}
+
+	QuoteDiscount struct {
+		Sku         string      `json:"sku"`
+		Description string      `json:"description"`
+		Amount      fixed.Fixed `json:"amount"`
+	}
+
+	QuoteTax struct {
+		Sku          string      `json:"sku"`
+		Jurisdiction string      `json:"jurisdiction"`
+		TaxCategory  string      `json:"tax_category"`
+		Rate         fixed.Fixed `json:"rate"`
+		TaxAmount    fixed.Fixed `json:"tax_amount"`
+	}
+
+	// quoted line whose price is no longer the current one
+	QuotePriceChange struct {
+		Sku          string       `json:"sku"`
+		QuotedPrice  fixed.Fixed  `json:"quoted_price"`
+		CurrentPrice *fixed.Fixed `json:"current_price"`
+	}
+
+	// owner of a cart, a signed in user by email or a guest by the cart id it was given
+	CartOwner struct {
+		UserEmail string
+		GuestId   uuid.UUID
+	}
+
+	// line of a cart as it is stored, adding a sku again adds to its quantity
+	CartItem struct {
+		Sku            string      `json:"sku"`
+		Uom            string      `json:"uom"`
+		QuantityPerUom fixed.Fixed `json:"quantity_per_uom"`
+		AddedAt        time.Time   `json:"added_at"`
+		UpdatedAt      time.Time   `json:"updated_at"`
+	}
+
+	// cart with the current price and availability of its lines, cart id is set for guest carts
+	Cart struct {
+		CartId   *uuid.UUID  `json:"cart_id,omitempty"`
+		Items    []CartLine  `json:"items"`
+		Currency string      `json:"currency"`
+		Subtotal fixed.Fixed `json:"subtotal"`
+	}
+
+	// price and amount are zero for UNAVAILABLE lines, they are not part of the subtotal
+	CartLine struct {
+		CartItem
+		PricePerUom       fixed.Fixed `json:"price_per_uom"`
+		Amount            fixed.Fixed `json:"amount"`
+		AvailableQuantity float64     `json:"available_quantity"`
+		Status            string      `json:"status"`
+	}
+
+	// order placed again on a schedule, either a cron expression read in timezone or "@every <duration>"
+	Subscription struct {
+		Id              uuid.UUID          `json:"id"`
+		UserId          string             `json:"user_id"`
+		UserEmail       string             `json:"user_email"`
+		Status          string             `json:"status"`
+		Schedule        string             `json:"schedule"`
+		Timezone        string             `json:"timezone"`
+		ShortagePolicy  string             `json:"shortage_policy"`
+		PaymentMethod   *string            `json:"payment_method,omitempty"`
+		ShippingAddress *Address           `json:"shipping_address,omitempty"`
+		Items           []SubscriptionItem `json:"items"`
+		NextRunAt       *time.Time         `json:"next_run_at,omitempty"` // nil while paused
+		LastRunAt       *time.Time         `json:"last_run_at,omitempty"`
+		CreatedAt       time.Time          `json:"created_at"`
+		UpdatedAt       time.Time          `json:"updated_at"`
+	}
+
+	SubscriptionItem struct {
+		Sku            string      `json:"sku"`
+		Uom            string      `json:"uom"`
+		QuantityPerUom fixed.Fixed `json:"quantity_per_uom"`
+	}
+
+	// a run of a subscription, one per scheduled time. order id is set once its order was placed
+	SubscriptionRun struct {
+		Id             uuid.UUID  `json:"id"`
+		SubscriptionId uuid.UUID  `json:"subscription_id"`
+		ScheduledFor   time.Time  `json:"scheduled_for"`
+		Status         string     `json:"status"`
+		OrderId        *uuid.UUID `json:"order_id,omitempty"`
+		Error          string     `json:"error,omitempty"`
+		CreatedAt      time.Time  `json:"created_at"`
+		FinishedAt     *time.Time `json:"finished_at,omitempty"`
+	}
+
+	// run claimed by the scheduler together with its subscription
+	DueSubscriptionRun struct {
+		Run          SubscriptionRun
+		Subscription Subscription
+	}
+
+	SubscriptionDetail struct {
+		Subscription
+		Runs []SubscriptionRun `json:"runs"`
 	}
 
 	OrderResponse struct {
@@ -0,0 +1,131 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/robaho/fixed"
+)
+
+// payment methods with a fixed outcome on the fake provider, every other method is approved
+const (
+	FakeMethodDeclined        = "fake_card_declined"
+	FakeMethodCaptureDeclined = "fake_card_capture_declined"
+)
+
+// prefixes of the fake references, the authorized or captured amount follows them
+const (
+	fakeAuthPrefix          = "fake_auth_"
+	fakeNoCaptureAuthPrefix = "fake_auth_nocapture_"
+	fakeCapturePrefix       = "fake_cap_"
+	fakeRefundPrefix        = "fake_ref_"
+)
+
+// FakeProvider is an in-process provider for local runs and tests. its outcome only depends on the payment
+// method and the references are derived from the idempotency key, so runs are repeatable. it keeps no state,
+// a reference carries its amount and whether it can be captured, so every replica and a restarted process
+// accept the references of the others. the payments table tells whether an authorization was captured or voided
+type FakeProvider struct{}
+
+var _ PaymentProvider = (*FakeProvider)(nil)
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, request AuthorizeRequest) (Transaction, error) {
+	if request.IdempotencyKey == "" {
+		return Transaction{}, errors.New("idempotency key is required")
+	}
+	if request.Amount.LessThan(fixed.ZERO) {
+		return Transaction{}, fmt.Errorf("%w: invalid amount", ErrDeclined)
+	}
+	if request.PaymentMethod == FakeMethodDeclined {
+		return Transaction{}, fmt.Errorf("%w: card declined", ErrDeclined)
+	}
+
+	prefix := fakeAuthPrefix
+	if request.PaymentMethod == FakeMethodCaptureDeclined {
+		prefix = fakeNoCaptureAuthPrefix
+	}
+	ref := fakeReference(prefix, request.Amount, request.IdempotencyKey)
+
+	return Transaction{Reference: ref, Amount: request.Amount}, nil
+}
+
+// Capture takes at most the authorized amount, the capture of an authorization always has the same reference
+func (p *FakeProvider) Capture(ctx context.Context, authorizationRef string, amount fixed.Fixed) (Transaction, error) {
+	authorized, capturable, err := parseFakeAuthorization(authorizationRef)
+	if err != nil {
+		return Transaction{}, err
+	}
+	switch {
+	case !capturable:
+		return Transaction{}, fmt.Errorf("%w: capture declined", ErrDeclined)
+	case amount.LessThan(fixed.ZERO) || amount.GreaterThan(authorized):
+		return Transaction{}, fmt.Errorf("%w: amount exceeds the authorized %s", ErrDeclined, authorized.String())
+	}
+
+	return Transaction{Reference: fakeReference(fakeCapturePrefix, amount, authorizationRef), Amount: amount}, nil
+}
+
+func (p *FakeProvider) Void(ctx context.Context, authorizationRef string) error {
+	_, _, err := parseFakeAuthorization(authorizationRef)
+	return err
+}
+
+// Refund returns part or all of a capture, a single refund is at most the captured amount
+func (p *FakeProvider) Refund(ctx context.Context, captureRef string, amount fixed.Fixed, idempotencyKey string) (Transaction, error) {
+	if idempotencyKey == "" {
+		return Transaction{}, errors.New("idempotency key is required")
+	}
+
+	captured, _, ok := cutFakeReference(captureRef, fakeCapturePrefix)
+	if !ok {
+		return Transaction{}, fmt.Errorf("unknown capture %s", captureRef)
+	}
+	if amount.LessThan(fixed.ZERO) || amount.GreaterThan(captured) {
+		return Transaction{}, fmt.Errorf("%w: amount exceeds the captured %s", ErrDeclined, captured.String())
+	}
+
+	return Transaction{Reference: fakeRefundPrefix + idempotencyKey, Amount: amount}, nil
+}
+
+// prefix, amount and key of a fake reference
+func fakeReference(prefix string, amount fixed.Fixed, key string) string {
+	return prefix + amount.String() + "_" + key
+}
+
+// amount of an authorization and whether it can be captured
+func parseFakeAuthorization(ref string) (fixed.Fixed, bool, error) {
+	if amount, _, ok := cutFakeReference(ref, fakeNoCaptureAuthPrefix); ok {
+		return amount, false, nil
+	}
+	if amount, _, ok := cutFakeReference(ref, fakeAuthPrefix); ok {
+		return amount, true, nil
+	}
+	return fixed.ZERO, false, fmt.Errorf("unknown authorization %s", ref)
+}
+
+// amount and key of a reference with prefix, false when it is not one
+func cutFakeReference(ref, prefix string) (fixed.Fixed, string, bool) {
+	rest, ok := strings.CutPrefix(ref, prefix)
+	if !ok {
+		return fixed.ZERO, "", false
+	}
+	value, key, ok := strings.Cut(rest, "_")
+	if !ok || key == "" {
+		return fixed.ZERO, "", false
+	}
+	amount, err := fixed.Parse(value)
+	if err != nil {
+		return fixed.ZERO, "", false
+	}
+	return amount, key, true
+}
@@ -0,0 +1,125 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeProvider(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name          string
+		Method        string
+		CaptureAmount string
+		RefundAmount  string
+		DeclinedAt    string
+	}{
+		{
+			Name:          "authorize, capture and refund part of it",
+			CaptureAmount: "80",
+			RefundAmount:  "30",
+		},
+		{
+			Name:       "declined card",
+			Method:     FakeMethodDeclined,
+			DeclinedAt: "authorize",
+		},
+		{
+			Name:          "declined capture",
+			Method:        FakeMethodCaptureDeclined,
+			CaptureAmount: "80",
+			DeclinedAt:    "capture",
+		},
+		{
+			Name:          "capture above the authorized amount",
+			CaptureAmount: "120",
+			DeclinedAt:    "capture",
+		},
+		{
+			Name:          "refund above the captured amount",
+			CaptureAmount: "80",
+			RefundAmount:  "90",
+			DeclinedAt:    "refund",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			provider := NewFakeProvider()
+			request := AuthorizeRequest{
+				OrderId:        uuid.New(),
+				Amount:         fixed.NewS("100"),
+				Currency:       "USD",
+				PaymentMethod:  tc.Method,
+				IdempotencyKey: uuid.NewString(),
+			}
+
+			auth, err := provider.Authorize(ctx, request)
+			if tc.DeclinedAt == "authorize" {
+				assert.ErrorIs(t, err, ErrDeclined)
+				return
+			}
+			assert.NoError(t, err)
+
+			// the same key returns the same authorization
+			retried, err := provider.Authorize(ctx, request)
+			assert.NoError(t, err)
+			assert.Equal(t, auth.Reference, retried.Reference)
+
+			// the authorization is captured by another replica or after a restart
+			provider = NewFakeProvider()
+
+			capture, err := provider.Capture(ctx, auth.Reference, fixed.NewS(tc.CaptureAmount))
+			if tc.DeclinedAt == "capture" {
+				assert.ErrorIs(t, err, ErrDeclined)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, capture.Amount.Equal(fixed.NewS(tc.CaptureAmount)))
+
+			// a retried capture returns the same capture
+			retriedCapture, err := provider.Capture(ctx, auth.Reference, fixed.NewS(tc.CaptureAmount))
+			assert.NoError(t, err)
+			assert.Equal(t, capture.Reference, retriedCapture.Reference)
+
+			refundKey := uuid.NewString()
+			refund, err := provider.Refund(ctx, capture.Reference, fixed.NewS(tc.RefundAmount), refundKey)
+			if tc.DeclinedAt == "refund" {
+				assert.ErrorIs(t, err, ErrDeclined)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEqual(t, capture.Reference, refund.Reference)
+
+			// the same key returns the same refund
+			retriedRefund, err := provider.Refund(ctx, capture.Reference, fixed.NewS(tc.RefundAmount), refundKey)
+			assert.NoError(t, err)
+			assert.Equal(t, refund.Reference, retriedRefund.Reference)
+			_, err = provider.Refund(ctx, capture.Reference, fixed.NewS("50"), uuid.NewString())
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFakeProvider_Void(t *testing.T) {
+	ctx := context.Background()
+
+	auth, err := NewFakeProvider().Authorize(ctx, AuthorizeRequest{
+		OrderId:        uuid.New(),
+		Amount:         fixed.NewS("100"),
+		Currency:       "USD",
+		IdempotencyKey: uuid.NewString(),
+	})
+	assert.NoError(t, err)
+
+	// any replica voids an authorization, references it never issued are unknown
+	assert.NoError(t, NewFakeProvider().Void(ctx, auth.Reference))
+	assert.Error(t, NewFakeProvider().Void(ctx, "fake_auth_unknown"))
+	_, err = NewFakeProvider().Capture(ctx, "stripe_auth_123", fixed.NewS("10"))
+	assert.Error(t, err)
+}
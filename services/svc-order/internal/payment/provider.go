@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// ErrDeclined is wrapped by provider errors that refuse the payment, the message tells why.
+// other errors mean the provider could not be reached or failed and the call can be retried
+var ErrDeclined = errors.New("payment declined")
+
+type (
+	// PaymentProvider takes the payment of an order. the amount is authorized when the order is confirmed,
+	// captured when it is fulfilled, voided when the order does not go ahead and refunded when goods come back
+	PaymentProvider interface {
+		// name of the provider stored with its payments
+		Name() string
+		Authorize(ctx context.Context, request AuthorizeRequest) (Transaction, error)
+		Capture(ctx context.Context, authorizationRef string, amount fixed.Fixed) (Transaction, error)
+		Void(ctx context.Context, authorizationRef string) error
+		// a retried refund with the same idempotency key returns the first refund instead of a new one
+		Refund(ctx context.Context, captureRef string, amount fixed.Fixed, idempotencyKey string) (Transaction, error)
+	}
+
+	AuthorizeRequest struct {
+		OrderId       uuid.UUID
+		Amount        fixed.Fixed
+		Currency      string
+		PaymentMethod string
+		// a retried request with the same key returns the first authorization instead of a new one
+		IdempotencyKey string
+	}
+
+	// reference and amount of an operation accepted by the provider
+	Transaction struct {
+		Reference string
+		Amount    fixed.Fixed
+	}
+)
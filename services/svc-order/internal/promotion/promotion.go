@@ -0,0 +1,150 @@
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/robaho/fixed"
+)
+
+// ErrNotApplicable is wrapped by the errors of a promotion that cannot be applied, the message tells why
+var ErrNotApplicable = errors.New("coupon cannot be applied")
+
+// discounts are rounded to cents
+const amountDecimals = 2
+
+var hundred = fixed.NewI(100, 0)
+
+type (
+	// Line is an order line a promotion is applied to, quantity is the charged quantity
+	Line struct {
+		Sku        string
+		CategoryId string
+		Quantity   fixed.Fixed
+		Price      fixed.Fixed
+	}
+
+	// Discount is the part of a promotion taken off a line, at most the amount of the line
+	Discount struct {
+		Sku    string
+		Amount fixed.Fixed
+	}
+)
+
+func (l Line) Amount() fixed.Fixed {
+	return l.Quantity.Mul(l.Price)
+}
+
+// Redeemable tells why p cannot be redeemed at now, nil when it is active and within its validity window
+func Redeemable(p model.Promotion, now time.Time) error {
+	switch {
+	case !p.IsActive:
+		return fmt.Errorf("%w: coupon is not active", ErrNotApplicable)
+	case now.Before(p.ValidFrom):
+		return fmt.Errorf("%w: coupon is valid from %s", ErrNotApplicable, p.ValidFrom.Format(time.RFC3339))
+	case p.ValidTo != nil && !now.Before(*p.ValidTo):
+		return fmt.Errorf("%w: coupon has expired", ErrNotApplicable)
+	case p.MaxUses != nil && p.UsedCount >= *p.MaxUses:
+		return fmt.Errorf("%w: coupon usage limit reached", ErrNotApplicable)
+	}
+	return nil
+}
+
+// Apply returns the discounts of p on lines, one per discounted line in the order of lines. the minimum order
+// value is compared with the amount of all lines. the validity and usage limits are not checked, see Redeemable
+func Apply(p model.Promotion, lines []Line) ([]Discount, error) {
+
+	subtotal := fixed.ZERO
+	for _, l := range lines {
+		subtotal = subtotal.Add(l.Amount())
+	}
+	if subtotal.LessThan(p.MinOrderValue) {
+		return nil, fmt.Errorf("%w: order value must be at least %s", ErrNotApplicable, p.MinOrderValue.String())
+	}
+
+	var eligible []Line
+	for _, l := range lines {
+		if inScope(p, l) && l.Amount().GreaterThan(fixed.ZERO) {
+			eligible = append(eligible, l)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil, fmt.Errorf("%w: no item of the order is eligible", ErrNotApplicable)
+	}
+
+	var discounts []Discount
+	switch p.DiscountType {
+	case model.PROMOTION_TYPE_PERCENTAGE:
+		for _, l := range eligible {
+			discounts = appendDiscount(discounts, l, l.Amount().Mul(p.DiscountValue).Div(hundred))
+		}
+	case model.PROMOTION_TYPE_FIXED:
+		discounts = spread(p.DiscountValue, eligible)
+	case model.PROMOTION_TYPE_BUY_X_GET_Y:
+		// every complete group of buy plus get units has get free units
+		group := fixed.NewI(int64(p.BuyQuantity+p.GetQuantity), 0)
+		for _, l := range eligible {
+			groups := fixed.NewI(l.Quantity.Div(group).Int(), 0)
+			free := groups.Mul(fixed.NewI(int64(p.GetQuantity), 0))
+			discounts = appendDiscount(discounts, l, free.Mul(l.Price))
+		}
+	default:
+		return nil, fmt.Errorf("unknown discount type %s", p.DiscountType)
+	}
+
+	if len(discounts) == 0 {
+		return nil, fmt.Errorf("%w: no item of the order is eligible", ErrNotApplicable)
+	}
+	return discounts, nil
+}
+
+func inScope(p model.Promotion, l Line) bool {
+	switch p.Scope {
+	case model.PROMOTION_SCOPE_SKU:
+		return slices.Contains(p.ScopeValues, l.Sku)
+	case model.PROMOTION_SCOPE_CATEGORY:
+		return l.CategoryId != "" && slices.Contains(p.ScopeValues, l.CategoryId)
+	}
+	return true
+}
+
+// rounds amount to cents and caps it at the amount of the line, zero discounts are left out
+func appendDiscount(discounts []Discount, l Line, amount fixed.Fixed) []Discount {
+	amount = amount.Round(amountDecimals)
+	if amount.GreaterThan(l.Amount()) {
+		amount = l.Amount()
+	}
+	if !amount.GreaterThan(fixed.ZERO) {
+		return discounts
+	}
+	return append(discounts, Discount{Sku: l.Sku, Amount: amount})
+}
+
+// spreads value over lines by their amount, at most their total. the last line takes the rounding
+// difference so the discounts add up to the value
+func spread(value fixed.Fixed, lines []Line) []Discount {
+
+	total := fixed.ZERO
+	for _, l := range lines {
+		total = total.Add(l.Amount())
+	}
+	if value.GreaterThan(total) {
+		value = total
+	}
+
+	var discounts []Discount
+	remaining := value
+	for i, l := range lines {
+		share := value.Mul(l.Amount()).Div(total).Round(amountDecimals)
+		if i == len(lines)-1 || share.GreaterThan(remaining) {
+			share = remaining
+		}
+		remaining = remaining.Sub(share)
+		discounts = appendDiscount(discounts, l, share)
+	}
+	return discounts
+}
@@ -0,0 +1,161 @@
+package promotion
+
+import (
+	"testing"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	lines := []Line{
+		{Sku: "OLIVE-OIL-1L", CategoryId: "food", Quantity: fixed.NewS("1"), Price: fixed.NewS("50")},
+		{Sku: "TSHIRT-M-WHITE", CategoryId: "apparel", Quantity: fixed.NewS("5"), Price: fixed.NewS("25")},
+		{Sku: "TSHIRT-L-BLACK", CategoryId: "apparel", Quantity: fixed.NewS("1"), Price: fixed.NewS("19.99")},
+	}
+
+	testCases := []struct {
+		Name       string
+		Promotion  model.Promotion
+		Expected   map[string]string
+		NotApplied bool
+	}{
+		{
+			Name: "percentage off the whole order",
+			Promotion: model.Promotion{
+				DiscountType:  model.PROMOTION_TYPE_PERCENTAGE,
+				DiscountValue: fixed.NewS("10"),
+				Scope:         model.PROMOTION_SCOPE_ORDER,
+			},
+			Expected: map[string]string{"OLIVE-OIL-1L": "5", "TSHIRT-M-WHITE": "12.5", "TSHIRT-L-BLACK": "2"},
+		},
+		{
+			Name: "fixed amount spread over the lines of a category",
+			Promotion: model.Promotion{
+				DiscountType:  model.PROMOTION_TYPE_FIXED,
+				DiscountValue: fixed.NewS("10"),
+				Scope:         model.PROMOTION_SCOPE_CATEGORY,
+				ScopeValues:   []string{"apparel"},
+			},
+			Expected: map[string]string{"TSHIRT-M-WHITE": "8.62", "TSHIRT-L-BLACK": "1.38"},
+		},
+		{
+			Name: "fixed amount above the lines in scope",
+			Promotion: model.Promotion{
+				DiscountType:  model.PROMOTION_TYPE_FIXED,
+				DiscountValue: fixed.NewS("80"),
+				Scope:         model.PROMOTION_SCOPE_SKU,
+				ScopeValues:   []string{"OLIVE-OIL-1L"},
+			},
+			Expected: map[string]string{"OLIVE-OIL-1L": "50"},
+		},
+		{
+			Name: "buy two get one free",
+			Promotion: model.Promotion{
+				DiscountType: model.PROMOTION_TYPE_BUY_X_GET_Y,
+				BuyQuantity:  2,
+				GetQuantity:  1,
+				Scope:        model.PROMOTION_SCOPE_CATEGORY,
+				ScopeValues:  []string{"apparel"},
+			},
+			Expected: map[string]string{"TSHIRT-M-WHITE": "25"},
+		},
+		{
+			Name: "order below the minimum value",
+			Promotion: model.Promotion{
+				DiscountType:  model.PROMOTION_TYPE_PERCENTAGE,
+				DiscountValue: fixed.NewS("10"),
+				Scope:         model.PROMOTION_SCOPE_ORDER,
+				MinOrderValue: fixed.NewS("500"),
+			},
+			NotApplied: true,
+		},
+		{
+			Name: "no line in scope",
+			Promotion: model.Promotion{
+				DiscountType:  model.PROMOTION_TYPE_PERCENTAGE,
+				DiscountValue: fixed.NewS("10"),
+				Scope:         model.PROMOTION_SCOPE_SKU,
+				ScopeValues:   []string{"COFFEE-1KG"},
+			},
+			NotApplied: true,
+		},
+		{
+			Name: "too few units for a free one",
+			Promotion: model.Promotion{
+				DiscountType: model.PROMOTION_TYPE_BUY_X_GET_Y,
+				BuyQuantity:  2,
+				GetQuantity:  1,
+				Scope:        model.PROMOTION_SCOPE_SKU,
+				ScopeValues:  []string{"TSHIRT-L-BLACK"},
+			},
+			NotApplied: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			discounts, err := Apply(tc.Promotion, lines)
+
+			if tc.NotApplied {
+				assert.ErrorIs(t, err, ErrNotApplicable)
+				assert.Empty(t, discounts)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, discounts, len(tc.Expected))
+			for _, d := range discounts {
+				assert.True(t, fixed.NewS(tc.Expected[d.Sku]).Equal(d.Amount), "%s discount is %s", d.Sku, d.Amount)
+			}
+		})
+	}
+}
+
+func TestRedeemable(t *testing.T) {
+	now := time.Now()
+	ended := now.Add(-time.Hour)
+	limit := 2
+
+	testCases := []struct {
+		Name       string
+		Promotion  model.Promotion
+		Redeemable bool
+	}{
+		{
+			Name:       "active promotion within its window",
+			Promotion:  model.Promotion{IsActive: true, ValidFrom: now.Add(-time.Hour)},
+			Redeemable: true,
+		},
+		{
+			Name:      "inactive promotion",
+			Promotion: model.Promotion{ValidFrom: now.Add(-time.Hour)},
+		},
+		{
+			Name:      "not started yet",
+			Promotion: model.Promotion{IsActive: true, ValidFrom: now.Add(time.Hour)},
+		},
+		{
+			Name:      "expired",
+			Promotion: model.Promotion{IsActive: true, ValidFrom: now.Add(-2 * time.Hour), ValidTo: &ended},
+		},
+		{
+			Name:      "used up",
+			Promotion: model.Promotion{IsActive: true, ValidFrom: now.Add(-time.Hour), MaxUses: &limit, UsedCount: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := Redeemable(tc.Promotion, now)
+			if tc.Redeemable {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrNotApplicable)
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+const adminOrderColumns = `
+	o.id, o.user_id, o.user_email, o.status, o.total_amount, o.currency, o.created_at, o.updated_at, o.promotion_id,
+	o.shipping_address, o.tax_amount, o.prices_include_tax
+`
+
+func adminOrderFields(order *model.Order) []interface{} {
+	return []interface{}{
+		&order.Id, &order.UserId, &order.UserEmail, &order.Status, &order.TotalAmount, &order.Currency,
+		&order.CreatedAt, &order.UpdateAt, &order.PromotionId, &order.ShippingAddress, &order.TaxAmount,
+		&order.PricesIncludeTax,
+	}
+}
+
+// where clause of the order search on the orders table aliased o, args are numbered from $1
+func orderSearchWhere(filter model.OrderSearchFilter) (string, []interface{}) {
+	where := "TRUE"
+	args := []interface{}{}
+	if len(filter.Statuses) > 0 {
+		args = append(args, filter.Statuses)
+		where += " AND o.status = ANY($" + strconv.Itoa(len(args)) + ")"
+	}
+	if filter.UserId != "" {
+		args = append(args, filter.UserId)
+		where += " AND o.user_id = $" + strconv.Itoa(len(args))
+	}
+	if filter.UserEmail != "" {
+		args = append(args, filter.UserEmail)
+		where += " AND LOWER(o.user_email) = LOWER($" + strconv.Itoa(len(args)) + ")"
+	}
+	if filter.Sku != "" {
+		args = append(args, filter.Sku)
+		where += " AND EXISTS (SELECT 1 FROM order_service.order_items i WHERE i.order_id = o.id AND i.sku = $" + strconv.Itoa(len(args)) + ")"
+	}
+	if filter.CreatedFrom != nil {
+		args = append(args, *filter.CreatedFrom)
+		where += " AND o.created_at >= $" + strconv.Itoa(len(args))
+	}
+	if filter.CreatedTo != nil {
+		args = append(args, *filter.CreatedTo)
+		where += " AND o.created_at < $" + strconv.Itoa(len(args))
+	}
+	return where, args
+}
+
+// SearchOrders returns a page of the orders of every user matching filter, newest first, and how many match in total
+func (o *OrderSQLRepository) SearchOrders(ctx context.Context, filter model.OrderSearchFilter) ([]model.Order, int, error) {
+	where, args := orderSearchWhere(filter)
+
+	var total int
+	err := o.Pgx.Pool().QueryRow(ctx, `SELECT COUNT(*) FROM order_service.orders o WHERE `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := `SELECT ` + adminOrderColumns + ` FROM order_service.orders o
+		WHERE ` + where + `
+		ORDER BY o.created_at DESC, o.id
+		LIMIT $` + strconv.Itoa(len(args)-1) + ` OFFSET $` + strconv.Itoa(len(args))
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	orders := []model.Order{}
+	for rows.Next() {
+		var order model.Order
+		if err := rows.Scan(adminOrderFields(&order)...); err != nil {
+			return nil, 0, err
+		}
+		orders = append(orders, order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// ForceOrderStatus moves order to status to and writes the audit entry in one transaction. nothing is written
+// and false is returned when the order is no longer in order.Status
+func (o *OrderSQLRepository) ForceOrderStatus(ctx context.Context, order *model.Order, to string, entry *model.AdminAuditEntry) (bool, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	updatedAt := time.Now()
+	tag, err := tx.Exec(ctx,
+		"UPDATE order_service.orders SET status = $3, updated_at = $4 WHERE id = $1 AND status = $2",
+		order.Id, order.Status, to, updatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode audit details: %w", err)
+	}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO order_service.admin_audit_log (actor, action, order_id, reason, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		entry.Actor, entry.Action, entry.OrderId, entry.Reason, details, updatedAt,
+	).Scan(&entry.Id)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert audit entry: %w", err)
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	order.Status = to
+	order.UpdateAt = updatedAt
+	entry.CreatedAt = updatedAt
+	return true, nil
+}
+
+func (o *OrderSQLRepository) InsertAdminAuditEntry(ctx context.Context, entry *model.AdminAuditEntry) error {
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	entry.CreatedAt = time.Now()
+	return o.Pgx.Pool().QueryRow(ctx,
+		`INSERT INTO order_service.admin_audit_log (actor, action, order_id, reason, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		entry.Actor, entry.Action, entry.OrderId, entry.Reason, details, entry.CreatedAt,
+	).Scan(&entry.Id)
+}
+
+// GetAdminAuditLog returns the audit entries matching filter, newest first
+func (o *OrderSQLRepository) GetAdminAuditLog(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error) {
+	where := "TRUE"
+	args := []interface{}{}
+	if filter.OrderId != nil {
+		args = append(args, *filter.OrderId)
+		where += " AND order_id = $" + strconv.Itoa(len(args))
+	}
+	if filter.Actor != "" {
+		args = append(args, filter.Actor)
+		where += " AND actor = $" + strconv.Itoa(len(args))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		where += " AND action = $" + strconv.Itoa(len(args))
+	}
+	args = append(args, filter.Limit)
+
+	query := `
+		SELECT id, actor, action, order_id, reason, details, created_at
+		FROM order_service.admin_audit_log
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []model.AdminAuditEntry{}
+	for rows.Next() {
+		var (
+			entry   model.AdminAuditEntry
+			details []byte
+		)
+		if err := rows.Scan(&entry.Id, &entry.Actor, &entry.Action, &entry.OrderId, &entry.Reason, &details, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(details, &entry.Details); err != nil {
+			return nil, fmt.Errorf("failed to decode audit details: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// rows fetched from the export cursor at a time
+const exportFetchSize = 500
+
+// StreamOrders calls fn with every order matching filter and its items, oldest first. the rows are read through a
+// server side cursor in batches of exportFetchSize so only one batch and one order are held at a time. an error
+// from fn stops the stream and is returned as is
+func (o *OrderSQLRepository) StreamOrders(ctx context.Context, filter model.OrderSearchFilter, fn func(order *model.OrderWithItems) error) error {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	// the cursor lives until the transaction ends
+	where, args := orderSearchWhere(filter)
+	_, err = tx.Exec(ctx, `DECLARE order_export NO SCROLL CURSOR FOR
+		SELECT `+adminOrderColumns+`,
+			i.id, i.sku, i.quantity_per_uom, i.price_per_uom, i.uom_code, i.confirmed_quantity, i.short_quantity
+		FROM order_service.orders o
+		LEFT JOIN order_service.order_items i ON i.order_id = o.id
+		WHERE `+where+`
+		ORDER BY o.created_at, o.id, i.id`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to declare export cursor: %w", err)
+	}
+
+	var current *model.OrderWithItems
+	for {
+		rows, err := tx.Query(ctx, "FETCH FORWARD "+strconv.Itoa(exportFetchSize)+" FROM order_export")
+		if err != nil {
+			return fmt.Errorf("failed to fetch export rows: %w", err)
+		}
+
+		fetched := 0
+		for rows.Next() {
+			fetched++
+			var (
+				order model.Order
+				item  struct {
+					Id               *uuid.UUID
+					Sku, UomCode     *string
+					Quantity, Price  *fixed.Fixed
+					Confirmed, Short *fixed.Fixed
+				}
+			)
+			fields := append(adminOrderFields(&order),
+				&item.Id, &item.Sku, &item.Quantity, &item.Price, &item.UomCode, &item.Confirmed, &item.Short)
+			if err := rows.Scan(fields...); err != nil {
+				rows.Close()
+				return err
+			}
+
+			// rows of an order are consecutive, the previous order is complete once another one starts
+			if current == nil || current.Id != order.Id {
+				if current != nil {
+					if err := fn(current); err != nil {
+						rows.Close()
+						return err
+					}
+				}
+				current = &model.OrderWithItems{Order: order, Items: []model.ItemOrder{}}
+			}
+			if item.Id != nil {
+				current.Items = append(current.Items, model.ItemOrder{
+					Id:                *item.Id,
+					OrderId:           order.Id,
+					Sku:               *item.Sku,
+					QuantityPerUom:    *item.Quantity,
+					PricePerUom:       *item.Price,
+					UomCode:           *item.UomCode,
+					ConfirmedQuantity: item.Confirmed,
+					ShortQuantity:     item.Short,
+				})
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		if fetched < exportFetchSize {
+			break
+		}
+	}
+
+	if current != nil {
+		if err := fn(current); err != nil {
+			return err
+		}
+	}
+
+	return o.CommitTransaction(ctx, tx)
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	storage "ops-monorepo/shared-libs/storage/redis"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const cartKeyPrefix = "order:cart"
+
+// optimistic updates of a cart are retried this many times when another request changed it first
+const cartUpdateRetries = 5
+
+var ErrCartContention = errors.New("cart kept changing during the update")
+
+// deletes the checkout lock only while it still holds the token of its owner
+var unlockCartScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type (
+	ICartRepository interface {
+		GetCart(ctx context.Context, owner model.CartOwner) ([]model.CartItem, error)
+		// UpdateCart calls fn with the lines of the cart by sku and stores what fn left in them. an error
+		// from fn is returned as is and nothing is stored
+		UpdateCart(ctx context.Context, owner model.CartOwner, fn func(items map[string]model.CartItem) error) ([]model.CartItem, error)
+		// MergeCart calls fn with the lines of both carts, stores the lines of to and deletes from
+		MergeCart(ctx context.Context, from, to model.CartOwner, fn func(to, from map[string]model.CartItem) error) ([]model.CartItem, error)
+		// LockCart takes the checkout lock of a cart for ttl, ok is false when it is already taken
+		LockCart(ctx context.Context, owner model.CartOwner, ttl time.Duration) (token string, ok bool, err error)
+		UnlockCart(ctx context.Context, owner model.CartOwner, token string) error
+	}
+
+	// CartRedisRepository keeps each cart in a redis hash of its lines by sku. every write extends the
+	// expiry of the cart to ttl
+	CartRedisRepository struct {
+		rdb *redis.Client
+		ttl time.Duration
+	}
+)
+
+func NewCartRepository(rdb *storage.Redis, ttl time.Duration) *CartRedisRepository {
+	return &CartRedisRepository{
+		rdb: rdb.Client(),
+		ttl: ttl,
+	}
+}
+
+func cartKey(owner model.CartOwner) string {
+	if owner.UserEmail != "" {
+		return fmt.Sprintf("%s:user:%s", cartKeyPrefix, strings.ToLower(owner.UserEmail))
+	}
+	return fmt.Sprintf("%s:guest:%s", cartKeyPrefix, owner.GuestId)
+}
+
+func cartLockKey(owner model.CartOwner) string {
+	return cartKey(owner) + ":checkout"
+}
+
+func (r *CartRedisRepository) GetCart(ctx context.Context, owner model.CartOwner) ([]model.CartItem, error) {
+	items, err := readCart(ctx, r.rdb, cartKey(owner))
+	if err != nil {
+		return nil, err
+	}
+	return sortedCartItems(items), nil
+}
+
+func (r *CartRedisRepository) UpdateCart(ctx context.Context, owner model.CartOwner, fn func(items map[string]model.CartItem) error) ([]model.CartItem, error) {
+	key := cartKey(owner)
+
+	var result []model.CartItem
+	update := func(tx *redis.Tx) error {
+		items, err := readCart(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			return r.writeCart(ctx, pipe, key, items)
+		})
+		result = sortedCartItems(items)
+		return err
+	}
+
+	if err := r.watch(ctx, update, key); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *CartRedisRepository) MergeCart(ctx context.Context, from, to model.CartOwner, fn func(to, from map[string]model.CartItem) error) ([]model.CartItem, error) {
+	fromKey, toKey := cartKey(from), cartKey(to)
+
+	var result []model.CartItem
+	merge := func(tx *redis.Tx) error {
+		fromItems, err := readCart(ctx, tx, fromKey)
+		if err != nil {
+			return err
+		}
+		toItems, err := readCart(ctx, tx, toKey)
+		if err != nil {
+			return err
+		}
+		if err := fn(toItems, fromItems); err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if err := r.writeCart(ctx, pipe, toKey, toItems); err != nil {
+				return err
+			}
+			return pipe.Del(ctx, fromKey).Err()
+		})
+		result = sortedCartItems(toItems)
+		return err
+	}
+
+	if err := r.watch(ctx, merge, fromKey, toKey); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *CartRedisRepository) LockCart(ctx context.Context, owner model.CartOwner, ttl time.Duration) (string, bool, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return "", false, err
+	}
+	token := hex.EncodeToString(raw)
+
+	ok, err := r.rdb.SetNX(ctx, cartLockKey(owner), token, ttl).Result()
+	if err != nil {
+		return "", false, err
+	}
+	return token, ok, nil
+}
+
+func (r *CartRedisRepository) UnlockCart(ctx context.Context, owner model.CartOwner, token string) error {
+	return unlockCartScript.Run(ctx, r.rdb, []string{cartLockKey(owner)}, token).Err()
+}
+
+// runs fn in a transaction watching keys, again when one of them changed before it committed
+func (r *CartRedisRepository) watch(ctx context.Context, fn func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < cartUpdateRetries; i++ {
+		err := r.rdb.Watch(ctx, fn, keys...)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		return err
+	}
+	return ErrCartContention
+}
+
+// replaces the lines of the cart at key, an empty cart is deleted
+func (r *CartRedisRepository) writeCart(ctx context.Context, pipe redis.Pipeliner, key string, items map[string]model.CartItem) error {
+	pipe.Del(ctx, key)
+	if len(items) == 0 {
+		return nil
+	}
+
+	fields := make([]interface{}, 0, len(items)*2)
+	for sku, item := range items {
+		value, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode cart item: %w", err)
+		}
+		fields = append(fields, sku, value)
+	}
+	pipe.HSet(ctx, key, fields...)
+	pipe.Expire(ctx, key, r.ttl)
+	return nil
+}
+
+func readCart(ctx context.Context, rdb redis.Cmdable, key string) (map[string]model.CartItem, error) {
+	fields, err := rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]model.CartItem, len(fields))
+	for sku, value := range fields {
+		var item model.CartItem
+		if err := json.Unmarshal([]byte(value), &item); err != nil {
+			return nil, fmt.Errorf("failed to decode cart item %s: %w", sku, err)
+		}
+		items[sku] = item
+	}
+	return items, nil
+}
+
+// lines in the order they were added
+func sortedCartItems(items map[string]model.CartItem) []model.CartItem {
+	sorted := make([]model.CartItem, 0, len(items))
+	for _, item := range items {
+		sorted = append(sorted, item)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].AddedAt.Equal(sorted[j].AddedAt) {
+			return sorted[i].AddedAt.Before(sorted[j].AddedAt)
+		}
+		return sorted[i].Sku < sorted[j].Sku
+	})
+	return sorted
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InsertPayment records a payment attempt before the provider is called
+func (o *OrderSQLRepository) InsertPayment(ctx context.Context, payment *model.Payment) error {
+	query := `
+		INSERT INTO order_service.payments (id, order_id, provider, payment_method, status, amount, captured_amount, refunded_amount,
+			currency, authorization_ref, capture_ref, failure_reason, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), NULLIF($11, ''), NULLIF($12, ''), $13, $14)
+	`
+
+	if payment.Id == uuid.Nil {
+		payment.Id = uuid.New()
+	}
+
+	now := time.Now()
+	if payment.CreatedAt.IsZero() {
+		payment.CreatedAt = now
+	}
+	payment.UpdatedAt = now
+
+	_, err := o.Pgx.Pool().Exec(ctx, query,
+		payment.Id,
+		payment.OrderId,
+		payment.Provider,
+		payment.PaymentMethod,
+		payment.Status,
+		payment.Amount,
+		payment.CapturedAmount,
+		payment.RefundedAmount,
+		payment.Currency,
+		payment.AuthorizationRef,
+		payment.CaptureRef,
+		payment.FailureReason,
+		payment.CreatedAt,
+		payment.UpdatedAt,
+	)
+
+	return err
+}
+
+// UpdatePayment stores the state, amounts and provider references of a payment attempt
+func (o *OrderSQLRepository) UpdatePayment(ctx context.Context, payment *model.Payment) error {
+	query := `
+		UPDATE order_service.payments
+		SET status = $2, captured_amount = $3, refunded_amount = $4, authorization_ref = NULLIF($5, ''),
+			capture_ref = NULLIF($6, ''), failure_reason = NULLIF($7, ''), updated_at = $8
+		WHERE id = $1
+	`
+
+	payment.UpdatedAt = time.Now()
+
+	_, err := o.Pgx.Pool().Exec(ctx, query,
+		payment.Id,
+		payment.Status,
+		payment.CapturedAmount,
+		payment.RefundedAmount,
+		payment.AuthorizationRef,
+		payment.CaptureRef,
+		payment.FailureReason,
+		payment.UpdatedAt,
+	)
+
+	return err
+}
+
+// GetOrderPayment returns the payment in effect for an order, the latest attempt that holds or took
+// the money, otherwise the latest attempt. nil when the order has no payment
+func (o *OrderSQLRepository) GetOrderPayment(ctx context.Context, orderId uuid.UUID) (*model.Payment, error) {
+	query := `
+		SELECT id, order_id, provider, payment_method, status, amount, captured_amount, refunded_amount, currency,
+			COALESCE(authorization_ref, ''), COALESCE(capture_ref, ''), COALESCE(failure_reason, ''), created_at, updated_at
+		FROM order_service.payments
+		WHERE order_id = $1
+		ORDER BY status = ANY($2) DESC, created_at DESC
+		LIMIT 1
+	`
+
+	settled := []string{model.PAYMENT_STATUS_AUTHORIZED, model.PAYMENT_STATUS_CAPTURED, model.PAYMENT_STATUS_REFUNDED}
+
+	var payment model.Payment
+	err := o.Pgx.Pool().QueryRow(ctx, query, orderId, settled).Scan(
+		&payment.Id,
+		&payment.OrderId,
+		&payment.Provider,
+		&payment.PaymentMethod,
+		&payment.Status,
+		&payment.Amount,
+		&payment.CapturedAmount,
+		&payment.RefundedAmount,
+		&payment.Currency,
+		&payment.AuthorizationRef,
+		&payment.CaptureRef,
+		&payment.FailureReason,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.PgxErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrPromotionUsedUp is returned when placing an order would exceed a usage limit of its promotion
+var ErrPromotionUsedUp = errors.New("promotion usage limit reached")
+
+// orders that failed do not use up their promotion
+var unredeemedStatuses = []string{model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_CANCELLED, model.ORDER_STATUS_PAYMENT_FAILED}
+
+const promotionColumns = `
+	p.id, p.code, p.name, p.discount_type, p.discount_value, p.buy_quantity, p.get_quantity, p.scope,
+	p.scope_values, p.min_order_value, p.max_uses, p.max_uses_per_user, p.valid_from, p.valid_to,
+	p.is_active, p.created_at,
+	(SELECT COUNT(*) FROM order_service.orders o WHERE o.promotion_id = p.id AND o.status <> ALL($1)) AS used_count
+`
+
+func (o *OrderSQLRepository) InsertPromotion(ctx context.Context, promotion *model.Promotion) error {
+	if promotion.Id == uuid.Nil {
+		promotion.Id = uuid.New()
+	}
+	promotion.CreatedAt = time.Now()
+
+	_, err := o.Pgx.Pool().Exec(ctx,
+		`INSERT INTO order_service.promotions (id, code, name, discount_type, discount_value, buy_quantity, get_quantity,
+			scope, scope_values, min_order_value, max_uses, max_uses_per_user, valid_from, valid_to, is_active, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		promotion.Id, promotion.Code, promotion.Name, promotion.DiscountType, promotion.DiscountValue, promotion.BuyQuantity,
+		promotion.GetQuantity, promotion.Scope, promotion.ScopeValues, promotion.MinOrderValue, promotion.MaxUses,
+		promotion.MaxUsesPerUser, promotion.ValidFrom, promotion.ValidTo, promotion.IsActive, promotion.CreatedAt,
+	)
+	return err
+}
+
+// GetPromotions returns every promotion with its usage, newest first
+func (o *OrderSQLRepository) GetPromotions(ctx context.Context) ([]model.Promotion, error) {
+	return o.getPromotions(ctx, "TRUE")
+}
+
+// GetPromotionByCode returns the promotion of a coupon code with its usage, nil when it does not exist
+func (o *OrderSQLRepository) GetPromotionByCode(ctx context.Context, code string) (*model.Promotion, error) {
+	promotions, err := o.getPromotions(ctx, "p.code = $2", code)
+	if err != nil || len(promotions) == 0 {
+		return nil, err
+	}
+	return &promotions[0], nil
+}
+
+// GetPromotionById returns a promotion with its usage, nil when it does not exist
+func (o *OrderSQLRepository) GetPromotionById(ctx context.Context, promotionId uuid.UUID) (*model.Promotion, error) {
+	promotions, err := o.getPromotions(ctx, "p.id = $2", promotionId)
+	if err != nil || len(promotions) == 0 {
+		return nil, err
+	}
+	return &promotions[0], nil
+}
+
+func (o *OrderSQLRepository) getPromotions(ctx context.Context, where string, args ...interface{}) ([]model.Promotion, error) {
+	query := `SELECT ` + promotionColumns + ` FROM order_service.promotions p WHERE ` + where + ` ORDER BY p.created_at DESC`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, append([]interface{}{unredeemedStatuses}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	promotions := []model.Promotion{}
+	for rows.Next() {
+		var p model.Promotion
+		err := rows.Scan(
+			&p.Id,
+			&p.Code,
+			&p.Name,
+			&p.DiscountType,
+			&p.DiscountValue,
+			&p.BuyQuantity,
+			&p.GetQuantity,
+			&p.Scope,
+			&p.ScopeValues,
+			&p.MinOrderValue,
+			&p.MaxUses,
+			&p.MaxUsesPerUser,
+			&p.ValidFrom,
+			&p.ValidTo,
+			&p.IsActive,
+			&p.CreatedAt,
+			&p.UsedCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// GetOrderDiscounts returns the discount lines of an order
+func (o *OrderSQLRepository) GetOrderDiscounts(ctx context.Context, orderId uuid.UUID) ([]model.OrderDiscount, error) {
+	query := `
+		SELECT id, order_id, order_item_id, promotion_id, code, sku, description, amount
+		FROM order_service.order_discounts
+		WHERE order_id = $1
+		ORDER BY sku
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	discounts := []model.OrderDiscount{}
+	for rows.Next() {
+		var d model.OrderDiscount
+		err := rows.Scan(&d.Id, &d.OrderId, &d.OrderItemId, &d.PromotionId, &d.Code, &d.Sku, &d.Description, &d.Amount)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return discounts, nil
+}
+
+// locks the promotion of order until tx ends and returns ErrPromotionUsedUp when one more order would exceed
+// its total or per user limit, concurrent orders with the same promotion are counted one after the other
+func checkPromotionUsage(ctx context.Context, tx sql.PgxTx, order *model.Order) error {
+	var maxUses, maxUsesPerUser *int
+	err := tx.QueryRow(ctx,
+		"SELECT max_uses, max_uses_per_user FROM order_service.promotions WHERE id = $1 FOR UPDATE",
+		*order.PromotionId,
+	).Scan(&maxUses, &maxUsesPerUser)
+	if err != nil {
+		return fmt.Errorf("failed to lock promotion: %w", err)
+	}
+	if maxUses == nil && maxUsesPerUser == nil {
+		return nil
+	}
+
+	var used, usedByUser int
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE user_id = $2)
+		FROM order_service.orders
+		WHERE promotion_id = $1 AND status <> ALL($3)`,
+		*order.PromotionId, order.UserId, unredeemedStatuses,
+	).Scan(&used, &usedByUser)
+	if err != nil {
+		return fmt.Errorf("failed to count promotion usage: %w", err)
+	}
+
+	if (maxUses != nil && used >= *maxUses) || (maxUsesPerUser != nil && usedByUser >= *maxUsesPerUser) {
+		return ErrPromotionUsedUp
+	}
+	return nil
+}
+
+// replaces the discount lines of order with discounts
+func replaceOrderDiscounts(ctx context.Context, tx sql.PgxTx, orderId uuid.UUID, discounts []model.OrderDiscount) error {
+	_, err := tx.Exec(ctx, "DELETE FROM order_service.order_discounts WHERE order_id = $1", orderId)
+	if err != nil {
+		return fmt.Errorf("failed to delete order discounts: %w", err)
+	}
+
+	for _, d := range discounts {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO order_service.order_discounts (id, order_id, order_item_id, promotion_id, code, sku, description, amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			d.Id, orderId, d.OrderItemId, d.PromotionId, d.Code, d.Sku, d.Description, d.Amount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert order discount: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// InsertReturn writes a return with its items and first history entry in one transaction. the order
+// version is bumped so concurrent requests cannot return the same quantity twice, nothing is written
+// and false is returned when the order was updated since it was read or is no longer in status
+func (o *OrderSQLRepository) InsertReturn(ctx context.Context, order *model.Order, status string, orderReturn *model.OrderReturn) (bool, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	now := time.Now()
+	tag, err := tx.Exec(ctx,
+		"UPDATE order_service.orders SET updated_at = $2 WHERE id = $1 AND updated_at = $3 AND status = $4",
+		order.Id, now, order.UpdateAt, status,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if orderReturn.Id == uuid.Nil {
+		orderReturn.Id = uuid.New()
+	}
+	orderReturn.CreatedAt = now
+	orderReturn.UpdatedAt = now
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO order_service.returns (id, order_id, status, reason, requested_by, refund_amount, currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		orderReturn.Id, orderReturn.OrderId, orderReturn.Status, orderReturn.Reason, orderReturn.RequestedBy, orderReturn.RefundAmount, orderReturn.Currency, orderReturn.CreatedAt, orderReturn.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert return: %w", err)
+	}
+
+	for i := range orderReturn.Items {
+		item := &orderReturn.Items[i]
+		if item.Id == uuid.Nil {
+			item.Id = uuid.New()
+		}
+		item.ReturnId = orderReturn.Id
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO order_service.return_items (id, return_id, order_item_id, sku, quantity, price_per_uom, uom_code, discount_amount, tax_amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			item.Id, item.ReturnId, item.OrderItemId, item.Sku, item.Quantity, item.PricePerUom, item.UomCode, item.DiscountAmount, item.TaxAmount,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert return item: %w", err)
+		}
+	}
+
+	for i := range orderReturn.History {
+		if err = insertReturnHistory(ctx, tx, orderReturn.Id, &orderReturn.History[i], now); err != nil {
+			return false, err
+		}
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	order.UpdateAt = now
+	return true, nil
+}
+
+// TransitionReturn moves a return from one status to orderReturn.Status and appends the history entry in one
+// transaction. false is returned when the return is no longer in from
+func (o *OrderSQLRepository) TransitionReturn(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory) (bool, error) {
+	return o.transitionReturn(ctx, orderReturn, from, entry, nil)
+}
+
+// RefundReturn records the refund of a RECEIVED return: the return becomes REFUNDED with orderReturn.RefundRef, the
+// amount is added to the refunded amount of the payment, which is REFUNDED once all of its capture is
+// refunded, and the history entry is appended, all in one transaction. false when the return is no longer RECEIVED
+func (o *OrderSQLRepository) RefundReturn(ctx context.Context, orderReturn *model.OrderReturn, entry model.ReturnHistory, paymentId uuid.UUID, amount fixed.Fixed) (bool, error) {
+	return o.transitionReturn(ctx, orderReturn, model.RETURN_STATUS_RECEIVED, entry, func(tx sql.PgxTx, now time.Time) error {
+		// added in place, concurrent refunds of other returns of the order must not overwrite each other
+		_, err := tx.Exec(ctx,
+			`UPDATE order_service.payments
+			SET refunded_amount = refunded_amount + $2,
+				status = CASE WHEN refunded_amount + $2 >= captured_amount THEN $3 ELSE status END,
+				updated_at = $4
+			WHERE id = $1`,
+			paymentId, amount, model.PAYMENT_STATUS_REFUNDED, now,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+		return nil
+	})
+}
+
+func (o *OrderSQLRepository) transitionReturn(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory, also func(tx sql.PgxTx, now time.Time) error) (bool, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	now := time.Now()
+	tag, err := tx.Exec(ctx,
+		`UPDATE order_service.returns
+		SET status = $2, refund_ref = NULLIF($3, ''), updated_at = $4
+		WHERE id = $1 AND status = $5`,
+		orderReturn.Id, orderReturn.Status, orderReturn.RefundRef, now, from,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update return: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if also != nil {
+		if err = also(tx, now); err != nil {
+			return false, err
+		}
+	}
+
+	if err = insertReturnHistory(ctx, tx, orderReturn.Id, &entry, now); err != nil {
+		return false, err
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	orderReturn.UpdatedAt = now
+	orderReturn.History = append(orderReturn.History, entry)
+	return true, nil
+}
+
+func insertReturnHistory(ctx context.Context, tx sql.PgxTx, returnId uuid.UUID, entry *model.ReturnHistory, createdAt time.Time) error {
+	entry.ReturnId = returnId
+	entry.CreatedAt = createdAt
+
+	err := tx.QueryRow(ctx,
+		`INSERT INTO order_service.return_history (return_id, from_status, to_status, actor, note, created_at)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+		RETURNING id`,
+		returnId, entry.FromStatus, entry.ToStatus, entry.Actor, entry.Note, entry.CreatedAt,
+	).Scan(&entry.Id)
+	if err != nil {
+		return fmt.Errorf("failed to insert return history: %w", err)
+	}
+	return nil
+}
+
+// GetReturnedQuantities sums the quantity of every order item held by returns that were not rejected
+func (o *OrderSQLRepository) GetReturnedQuantities(ctx context.Context, orderId uuid.UUID) (map[uuid.UUID]fixed.Fixed, error) {
+	query := `
+		SELECT ri.order_item_id, SUM(ri.quantity)
+		FROM order_service.return_items ri
+		JOIN order_service.returns r ON r.id = ri.return_id
+		WHERE r.order_id = $1 AND r.status <> $2
+		GROUP BY ri.order_item_id
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, orderId, model.RETURN_STATUS_REJECTED)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	returned := map[uuid.UUID]fixed.Fixed{}
+	for rows.Next() {
+		var itemId uuid.UUID
+		var quantity fixed.Fixed
+		if err := rows.Scan(&itemId, &quantity); err != nil {
+			return nil, err
+		}
+		returned[itemId] = quantity
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return returned, nil
+}
+
+// GetReturn returns a return with its items and history, nil when it does not exist
+func (o *OrderSQLRepository) GetReturn(ctx context.Context, returnId uuid.UUID) (*model.OrderReturn, error) {
+	returns, err := o.getReturns(ctx, "r.id = $1", returnId)
+	if err != nil {
+		return nil, err
+	}
+	if len(returns) == 0 {
+		return nil, nil
+	}
+	return &returns[0], nil
+}
+
+// GetOrderReturns returns every return of an order with its items and history, oldest first
+func (o *OrderSQLRepository) GetOrderReturns(ctx context.Context, orderId uuid.UUID) ([]model.OrderReturn, error) {
+	return o.getReturns(ctx, "r.order_id = $1", orderId)
+}
+
+func (o *OrderSQLRepository) getReturns(ctx context.Context, where string, arg interface{}) ([]model.OrderReturn, error) {
+	query := `
+		SELECT r.id, r.order_id, r.status, r.reason, r.requested_by, r.refund_amount, r.currency,
+			COALESCE(r.refund_ref, ''), r.created_at, r.updated_at
+		FROM order_service.returns r
+		WHERE ` + where + `
+		ORDER BY r.created_at, r.id
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	returns := []model.OrderReturn{}
+	index := map[uuid.UUID]int{}
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var orderReturn model.OrderReturn
+		err := rows.Scan(
+			&orderReturn.Id,
+			&orderReturn.OrderId,
+			&orderReturn.Status,
+			&orderReturn.Reason,
+			&orderReturn.RequestedBy,
+			&orderReturn.RefundAmount,
+			&orderReturn.Currency,
+			&orderReturn.RefundRef,
+			&orderReturn.CreatedAt,
+			&orderReturn.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		orderReturn.Items = []model.ReturnItem{}
+		orderReturn.History = []model.ReturnHistory{}
+		index[orderReturn.Id] = len(returns)
+		ids = append(ids, orderReturn.Id)
+		returns = append(returns, orderReturn)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return returns, nil
+	}
+
+	itemRows, err := o.Pgx.Pool().Query(ctx, `
+		SELECT id, return_id, order_item_id, sku, quantity, price_per_uom, uom_code, discount_amount, tax_amount
+		FROM order_service.return_items
+		WHERE return_id = ANY($1)
+		ORDER BY sku
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		var item model.ReturnItem
+		err := itemRows.Scan(
+			&item.Id,
+			&item.ReturnId,
+			&item.OrderItemId,
+			&item.Sku,
+			&item.Quantity,
+			&item.PricePerUom,
+			&item.UomCode,
+			&item.DiscountAmount,
+			&item.TaxAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		orderReturn := &returns[index[item.ReturnId]]
+		orderReturn.Items = append(orderReturn.Items, item)
+	}
+	if err = itemRows.Err(); err != nil {
+		return nil, err
+	}
+
+	historyRows, err := o.Pgx.Pool().Query(ctx, `
+		SELECT id, return_id, COALESCE(from_status, ''), to_status, actor, note, created_at
+		FROM order_service.return_history
+		WHERE return_id = ANY($1)
+		ORDER BY created_at, id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer historyRows.Close()
+
+	for historyRows.Next() {
+		var entry model.ReturnHistory
+		err := historyRows.Scan(
+			&entry.Id,
+			&entry.ReturnId,
+			&entry.FromStatus,
+			&entry.ToStatus,
+			&entry.Actor,
+			&entry.Note,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		orderReturn := &returns[index[entry.ReturnId]]
+		orderReturn.History = append(orderReturn.History, entry)
+	}
+	if err = historyRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return returns, nil
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// InsertShipment writes a shipment with its items in one transaction. the order version is bumped so
+// concurrent requests cannot pack the same quantity twice, nothing is written and false is returned when
+// the order was updated since it was read or is no longer CONFIRMED
+func (o *OrderSQLRepository) InsertShipment(ctx context.Context, order *model.Order, shipment *model.Shipment) (bool, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	now := time.Now()
+	tag, err := tx.Exec(ctx,
+		"UPDATE order_service.orders SET updated_at = $2 WHERE id = $1 AND updated_at = $3 AND status = $4",
+		order.Id, now, order.UpdateAt, model.ORDER_STATUS_CONFIRMED,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if shipment.Id == uuid.Nil {
+		shipment.Id = uuid.New()
+	}
+	shipment.CreatedAt = now
+	shipment.UpdatedAt = now
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO order_service.shipments (id, order_id, status, carrier, tracking_number, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		shipment.Id, shipment.OrderId, shipment.Status, shipment.Carrier, shipment.TrackingNumber, shipment.CreatedAt, shipment.UpdatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert shipment: %w", err)
+	}
+
+	for i := range shipment.Items {
+		item := &shipment.Items[i]
+		if item.Id == uuid.Nil {
+			item.Id = uuid.New()
+		}
+		item.ShipmentId = shipment.Id
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO order_service.shipment_items (id, shipment_id, order_item_id, sku, quantity, uom_code)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			item.Id, item.ShipmentId, item.OrderItemId, item.Sku, item.Quantity, item.UomCode,
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to insert shipment item: %w", err)
+		}
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	order.UpdateAt = now
+	return true, nil
+}
+
+// TransitionShipment moves a shipment from one status to shipment.Status with its carrier, tracking number
+// and shipped and delivered times. false is returned when the shipment is no longer in from
+func (o *OrderSQLRepository) TransitionShipment(ctx context.Context, shipment *model.Shipment, from string) (bool, error) {
+	now := time.Now()
+	tag, err := o.Pgx.Pool().Exec(ctx,
+		`UPDATE order_service.shipments
+		SET status = $2, carrier = $3, tracking_number = $4, shipped_at = $5, delivered_at = $6, updated_at = $7
+		WHERE id = $1 AND status = $8`,
+		shipment.Id, shipment.Status, shipment.Carrier, shipment.TrackingNumber, shipment.ShippedAt, shipment.DeliveredAt, now, from,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update shipment: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	shipment.UpdatedAt = now
+	return true, nil
+}
+
+// GetShipmentQuantities sums the quantity of every order item held by shipments in one of statuses
+func (o *OrderSQLRepository) GetShipmentQuantities(ctx context.Context, orderId uuid.UUID, statuses []string) (map[uuid.UUID]fixed.Fixed, error) {
+	query := `
+		SELECT si.order_item_id, SUM(si.quantity)
+		FROM order_service.shipment_items si
+		JOIN order_service.shipments s ON s.id = si.shipment_id
+		WHERE s.order_id = $1 AND s.status = ANY($2)
+		GROUP BY si.order_item_id
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, orderId, statuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	quantities := map[uuid.UUID]fixed.Fixed{}
+	for rows.Next() {
+		var itemId uuid.UUID
+		var quantity fixed.Fixed
+		if err := rows.Scan(&itemId, &quantity); err != nil {
+			return nil, err
+		}
+		quantities[itemId] = quantity
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return quantities, nil
+}
+
+// GetShipment returns a shipment with its items, nil when it does not exist
+func (o *OrderSQLRepository) GetShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error) {
+	shipments, err := o.getShipments(ctx, "s.id = $1", shipmentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(shipments) == 0 {
+		return nil, nil
+	}
+	return &shipments[0], nil
+}
+
+// GetOrderShipments returns every shipment of an order with its items, oldest first
+func (o *OrderSQLRepository) GetOrderShipments(ctx context.Context, orderId uuid.UUID) ([]model.Shipment, error) {
+	return o.getShipments(ctx, "s.order_id = $1", orderId)
+}
+
+func (o *OrderSQLRepository) getShipments(ctx context.Context, where string, arg interface{}) ([]model.Shipment, error) {
+	query := `
+		SELECT s.id, s.order_id, s.status, s.carrier, s.tracking_number, s.created_at, s.updated_at,
+			s.shipped_at, s.delivered_at
+		FROM order_service.shipments s
+		WHERE ` + where + `
+		ORDER BY s.created_at, s.id
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shipments := []model.Shipment{}
+	index := map[uuid.UUID]int{}
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var shipment model.Shipment
+		err := rows.Scan(
+			&shipment.Id,
+			&shipment.OrderId,
+			&shipment.Status,
+			&shipment.Carrier,
+			&shipment.TrackingNumber,
+			&shipment.CreatedAt,
+			&shipment.UpdatedAt,
+			&shipment.ShippedAt,
+			&shipment.DeliveredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		shipment.Items = []model.ShipmentItem{}
+		index[shipment.Id] = len(shipments)
+		ids = append(ids, shipment.Id)
+		shipments = append(shipments, shipment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return shipments, nil
+	}
+
+	itemRows, err := o.Pgx.Pool().Query(ctx, `
+		SELECT id, shipment_id, order_item_id, sku, quantity, uom_code
+		FROM order_service.shipment_items
+		WHERE shipment_id = ANY($1)
+		ORDER BY sku
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		var item model.ShipmentItem
+		err := itemRows.Scan(
+			&item.Id,
+			&item.ShipmentId,
+			&item.OrderItemId,
+			&item.Sku,
+			&item.Quantity,
+			&item.UomCode,
+		)
+		if err != nil {
+			return nil, err
+		}
+		shipment := &shipments[index[item.ShipmentId]]
+		shipment.Items = append(shipment.Items, item)
+	}
+	if err = itemRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
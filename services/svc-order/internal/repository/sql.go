@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"ops-monorepo/services/svc-order/internal/model"
 	sql "ops-monorepo/shared-libs/storage/postgres"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/robaho/fixed"
 )
 
 type (
@@ -21,18 +23,79 @@ type (
 		UpdateOrderWithTx(ctx context.Context, tx sql.PgxTx, order *model.Order) error
 		UpdateOrderStatus(ctx context.Context, orderId uuid.UUID, status string) error
 		UpdateOrderStatusWithTx(ctx context.Context, tx sql.PgxTx, orderId uuid.UUID, status string) error
+		TransitionOrderStatus(ctx context.Context, orderId uuid.UUID, from, to string) (bool, error)
 
 		// insert item order
 		InsertItemOrderWithTx(ctx context.Context, tx sql.PgxTx, itemOrder model.ItemOrder) error
 		UpdateItemOrderWithTx(ctx context.Context, tx sql.PgxTx, itemOrder model.ItemOrder) error
 
 		// insert order with items
-		InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder) error
+		InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error
+		UpdateOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error
+		AmendOrderItems(ctx context.Context, order *model.Order, statuses []string, amendment model.OrderAmendment) (bool, error)
 
 		// get order
 		GetOrderById(ctx context.Context, orderId uuid.UUID) (*model.Order, error)
 		GetOrderItemsByOrderId(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error)
 		GetOrderWithItems(ctx context.Context, orderId uuid.UUID) (*model.Order, []model.ItemOrder, error)
+
+		// promotions
+		InsertPromotion(ctx context.Context, promotion *model.Promotion) error
+		GetPromotions(ctx context.Context) ([]model.Promotion, error)
+		GetPromotionByCode(ctx context.Context, code string) (*model.Promotion, error)
+		GetPromotionById(ctx context.Context, promotionId uuid.UUID) (*model.Promotion, error)
+		GetOrderDiscounts(ctx context.Context, orderId uuid.UUID) ([]model.OrderDiscount, error)
+
+		// taxes
+		GetOrderTaxes(ctx context.Context, orderId uuid.UUID) ([]model.OrderTax, error)
+
+		// payments
+		InsertPayment(ctx context.Context, payment *model.Payment) error
+		UpdatePayment(ctx context.Context, payment *model.Payment) error
+		GetOrderPayment(ctx context.Context, orderId uuid.UUID) (*model.Payment, error)
+
+		// returns
+		InsertReturn(ctx context.Context, order *model.Order, status string, orderReturn *model.OrderReturn) (bool, error)
+		TransitionReturn(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory) (bool, error)
+		RefundReturn(ctx context.Context, orderReturn *model.OrderReturn, entry model.ReturnHistory, paymentId uuid.UUID, amount fixed.Fixed) (bool, error)
+		GetReturnedQuantities(ctx context.Context, orderId uuid.UUID) (map[uuid.UUID]fixed.Fixed, error)
+		GetReturn(ctx context.Context, returnId uuid.UUID) (*model.OrderReturn, error)
+		GetOrderReturns(ctx context.Context, orderId uuid.UUID) ([]model.OrderReturn, error)
+
+		// shipments
+		InsertShipment(ctx context.Context, order *model.Order, shipment *model.Shipment) (bool, error)
+		TransitionShipment(ctx context.Context, shipment *model.Shipment, from string) (bool, error)
+		GetShipmentQuantities(ctx context.Context, orderId uuid.UUID, statuses []string) (map[uuid.UUID]fixed.Fixed, error)
+		GetShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error)
+		GetOrderShipments(ctx context.Context, orderId uuid.UUID) ([]model.Shipment, error)
+
+		// webhooks
+		InsertWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error
+		UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (bool, error)
+		GetWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error)
+		GetWebhookEndpoint(ctx context.Context, endpointId uuid.UUID) (*model.WebhookEndpoint, error)
+		InsertWebhookDeliveries(ctx context.Context, event model.WebhookEvent, payload []byte) (int, error)
+		ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error)
+		RecordWebhookAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt model.WebhookAttempt) error
+		ReplayWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error)
+		GetWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+		GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)
+
+		// admin
+		SearchOrders(ctx context.Context, filter model.OrderSearchFilter) ([]model.Order, int, error)
+		StreamOrders(ctx context.Context, filter model.OrderSearchFilter, fn func(order *model.OrderWithItems) error) error
+		ForceOrderStatus(ctx context.Context, order *model.Order, to string, entry *model.AdminAuditEntry) (bool, error)
+		InsertAdminAuditEntry(ctx context.Context, entry *model.AdminAuditEntry) error
+		GetAdminAuditLog(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error)
+
+		// subscriptions
+		InsertSubscription(ctx context.Context, subscription *model.Subscription) error
+		GetSubscription(ctx context.Context, subscriptionId uuid.UUID) (*model.Subscription, error)
+		GetUserSubscriptions(ctx context.Context, userId string) ([]model.Subscription, error)
+		SetSubscriptionStatus(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time) (bool, error)
+		ClaimDueSubscriptions(ctx context.Context, limit int, now time.Time, next func(subscription *model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error)
+		FinishSubscriptionRun(ctx context.Context, run *model.SubscriptionRun) error
+		GetSubscriptionRuns(ctx context.Context, subscriptionId uuid.UUID, limit int) ([]model.SubscriptionRun, error)
 	}
 
 	OrderSQLRepository struct {
@@ -60,8 +123,9 @@ func (o *OrderSQLRepository) CommitTransaction(ctx context.Context, tx sql.PgxTx
 
 func (o *OrderSQLRepository) InsertOrderWithTx(ctx context.Context, tx sql.PgxTx, order *model.Order) error {
 	query := `
-		INSERT INTO order_service.orders (id, user_id, user_email, status, total_amount, currency, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO order_service.orders (id, user_id, user_email, status, total_amount, currency, created_at, updated_at, promotion_id,
+			shipping_address, tax_amount, prices_include_tax)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 
 	if order.Id == uuid.Nil {
@@ -85,6 +149,10 @@ func (o *OrderSQLRepository) InsertOrderWithTx(ctx context.Context, tx sql.PgxTx
 		order.Currency,
 		order.CreatedAt,
 		order.UpdateAt,
+		order.PromotionId,
+		order.ShippingAddress,
+		order.TaxAmount,
+		order.PricesIncludeTax,
 	)
 
 	return err
@@ -93,7 +161,8 @@ func (o *OrderSQLRepository) InsertOrderWithTx(ctx context.Context, tx sql.PgxTx
 func (o *OrderSQLRepository) UpdateOrderWithTx(ctx context.Context, tx sql.PgxTx, order *model.Order) error {
 	query := `
 		UPDATE order_service.orders 
-		SET user_id = $2, user_email = $3, status = $4, total_amount = $5, currency = $6, updated_at = $7
+		SET user_id = $2, user_email = $3, status = $4, total_amount = $5, currency = $6, updated_at = $7,
+			tax_amount = $8, prices_include_tax = $9
 		WHERE id = $1
 	`
 
@@ -107,6 +176,8 @@ func (o *OrderSQLRepository) UpdateOrderWithTx(ctx context.Context, tx sql.PgxTx
 		order.TotalAmount,
 		order.Currency,
 		order.UpdateAt,
+		order.TaxAmount,
+		order.PricesIncludeTax,
 	)
 
 	return err
@@ -134,10 +205,25 @@ func (o *OrderSQLRepository) UpdateOrderStatusWithTx(ctx context.Context, tx sql
 	return err
 }
 
+// moves the order to status to only while it is still in status from, reports whether it moved
+func (o *OrderSQLRepository) TransitionOrderStatus(ctx context.Context, orderId uuid.UUID, from, to string) (bool, error) {
+	query := `
+		UPDATE order_service.orders 
+		SET status = $3, updated_at = $4
+		WHERE id = $1 AND status = $2
+	`
+
+	tag, err := o.Pgx.Pool().Exec(ctx, query, orderId, from, to, time.Now())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
 func (o *OrderSQLRepository) InsertItemOrderWithTx(ctx context.Context, tx sql.PgxTx, itemOrder model.ItemOrder) error {
 	query := `
-		INSERT INTO order_service.order_items (id, order_id, sku, quantity_per_uom,  price_per_uom, uom_code)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO order_service.order_items (id, order_id, sku, quantity_per_uom,  price_per_uom, uom_code, confirmed_quantity, short_quantity)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
 	if itemOrder.Id == uuid.Nil {
@@ -151,6 +237,8 @@ func (o *OrderSQLRepository) InsertItemOrderWithTx(ctx context.Context, tx sql.P
 		itemOrder.QuantityPerUom,
 		itemOrder.PricePerUom,
 		itemOrder.UomCode,
+		itemOrder.ConfirmedQuantity,
+		itemOrder.ShortQuantity,
 	)
 
 	return err
@@ -159,7 +247,7 @@ func (o *OrderSQLRepository) InsertItemOrderWithTx(ctx context.Context, tx sql.P
 func (o *OrderSQLRepository) UpdateItemOrderWithTx(ctx context.Context, tx sql.PgxTx, itemOrder model.ItemOrder) error {
 	query := `
 		UPDATE order_service.order_items 
-		SET order_id = $2, sku = $3, quantity_per_uom = $4, price_per_uom = $5, uom_code = $6
+		SET order_id = $2, sku = $3, quantity_per_uom = $4, price_per_uom = $5, uom_code = $6, confirmed_quantity = $7, short_quantity = $8
 		WHERE id = $1
 	`
 
@@ -170,13 +258,17 @@ func (o *OrderSQLRepository) UpdateItemOrderWithTx(ctx context.Context, tx sql.P
 		itemOrder.QuantityPerUom,
 		itemOrder.PricePerUom,
 		itemOrder.UomCode,
+		itemOrder.ConfirmedQuantity,
+		itemOrder.ShortQuantity,
 	)
 
 	return err
 }
 
-// batch operations
-func (o *OrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder) error {
+// batch operations. an order with a promotion is only inserted, with its discounts, while the promotion
+// usage limits allow one more order, ErrPromotionUsedUp is returned otherwise. the taxes of an order with
+// a shipping address are inserted with it
+func (o *OrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error {
 	tx, err := o.BeginTransaction(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -188,6 +280,12 @@ func (o *OrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *mo
 		}
 	}()
 
+	if order.PromotionId != nil {
+		if err = checkPromotionUsage(ctx, tx, order); err != nil {
+			return err
+		}
+	}
+
 	if err = o.InsertOrderWithTx(ctx, tx, order); err != nil {
 		return fmt.Errorf("failed to insert order: %w", err)
 	}
@@ -199,6 +297,59 @@ func (o *OrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *mo
 		}
 	}
 
+	if order.PromotionId != nil {
+		if err = replaceOrderDiscounts(ctx, tx, order.Id, discounts); err != nil {
+			return err
+		}
+	}
+	if order.ShippingAddress != nil {
+		if err = replaceOrderTaxes(ctx, tx, order.Id, taxes); err != nil {
+			return err
+		}
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updates the order and every given item in one transaction, the discounts of an order with a promotion
+// and the taxes of an order with a shipping address are replaced
+func (o *OrderSQLRepository) UpdateOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			o.RollbackTransaction(ctx, tx)
+		}
+	}()
+
+	if err = o.UpdateOrderWithTx(ctx, tx, order); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	for _, item := range items {
+		if err = o.UpdateItemOrderWithTx(ctx, tx, item); err != nil {
+			return fmt.Errorf("failed to update order item: %w", err)
+		}
+	}
+
+	if order.PromotionId != nil {
+		if err = replaceOrderDiscounts(ctx, tx, order.Id, discounts); err != nil {
+			return err
+		}
+	}
+	if order.ShippingAddress != nil {
+		if err = replaceOrderTaxes(ctx, tx, order.Id, taxes); err != nil {
+			return err
+		}
+	}
+
 	if err = o.CommitTransaction(ctx, tx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -206,10 +357,83 @@ func (o *OrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *mo
 	return nil
 }
 
+// AmendOrderItems writes the total and tax of order, the added, changed and removed items, the discounts of an order
+// with a promotion, the taxes of an order with a shipping address and the history entry in one transaction. nothing is written and false is returned when the order was updated since it was
+// read, order.UpdateAt is compared, or when its status is not one of statuses
+func (o *OrderSQLRepository) AmendOrderItems(ctx context.Context, order *model.Order, statuses []string, amendment model.OrderAmendment) (bool, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	query := `
+		UPDATE order_service.orders 
+		SET total_amount = $2, updated_at = $3, tax_amount = $6
+		WHERE id = $1 AND updated_at = $4 AND status = ANY($5)
+	`
+
+	updatedAt := time.Now()
+	tag, err := tx.Exec(ctx, query, order.Id, order.TotalAmount, updatedAt, order.UpdateAt, statuses, order.TaxAmount)
+	if err != nil {
+		return false, fmt.Errorf("failed to update order: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	for _, item := range amendment.Added {
+		if err = o.InsertItemOrderWithTx(ctx, tx, item); err != nil {
+			return false, fmt.Errorf("failed to insert order item: %w", err)
+		}
+	}
+	for _, item := range amendment.Changed {
+		if err = o.UpdateItemOrderWithTx(ctx, tx, item); err != nil {
+			return false, fmt.Errorf("failed to update order item: %w", err)
+		}
+	}
+	if order.PromotionId != nil {
+		if err = replaceOrderDiscounts(ctx, tx, order.Id, amendment.Discounts); err != nil {
+			return false, err
+		}
+	}
+	if order.ShippingAddress != nil {
+		if err = replaceOrderTaxes(ctx, tx, order.Id, amendment.Taxes); err != nil {
+			return false, err
+		}
+	}
+	if len(amendment.Removed) > 0 {
+		_, err = tx.Exec(ctx, "DELETE FROM order_service.order_items WHERE order_id = $1 AND id = ANY($2)", order.Id, amendment.Removed)
+		if err != nil {
+			return false, fmt.Errorf("failed to delete order items: %w", err)
+		}
+	}
+
+	details, err := json.Marshal(amendment.History.Details)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode order history details: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		"INSERT INTO order_service.order_history (order_id, event, details, created_at) VALUES ($1, $2, $3, $4)",
+		order.Id, amendment.History.Event, details, updatedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert order history: %w", err)
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	order.UpdateAt = updatedAt
+	return true, nil
+}
+
 // GetOrderById
 func (o *OrderSQLRepository) GetOrderById(ctx context.Context, orderId uuid.UUID) (*model.Order, error) {
 	query := `
-		SELECT id, user_id, user_email, status, total_amount, currency, created_at, updated_at
+		SELECT id, user_id, user_email, status, total_amount, currency, created_at, updated_at, promotion_id,
+			shipping_address, tax_amount, prices_include_tax
 		FROM order_service.orders 
 		WHERE id = $1
 	`
@@ -226,6 +450,10 @@ func (o *OrderSQLRepository) GetOrderById(ctx context.Context, orderId uuid.UUID
 		&order.Currency,
 		&order.CreatedAt,
 		&order.UpdateAt,
+		&order.PromotionId,
+		&order.ShippingAddress,
+		&order.TaxAmount,
+		&order.PricesIncludeTax,
 	)
 
 	if err != nil {
@@ -240,7 +468,7 @@ func (o *OrderSQLRepository) GetOrderById(ctx context.Context, orderId uuid.UUID
 
 func (o *OrderSQLRepository) GetOrderItemsByOrderId(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error) {
 	query := `
-		SELECT id, order_id, sku, quantity_per_uom,  price_per_uom, uom_code
+		SELECT id, order_id, sku, quantity_per_uom,  price_per_uom, uom_code, confirmed_quantity, short_quantity
 		FROM order_service.order_items 
 		WHERE order_id = $1
 	`
@@ -261,6 +489,8 @@ func (o *OrderSQLRepository) GetOrderItemsByOrderId(ctx context.Context, orderId
 			&item.QuantityPerUom,
 			&item.PricePerUom,
 			&item.UomCode,
+			&item.ConfirmedQuantity,
+			&item.ShortQuantity,
 		)
 		if err != nil {
 			return nil, err
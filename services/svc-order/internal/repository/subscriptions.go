@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// a pool or a transaction
+type querier interface {
+	Query(ctx context.Context, query string, args ...any) (sql.PgxRows, error)
+}
+
+const subscriptionColumns = `
+	s.id, s.user_id, s.user_email, s.status, s.schedule, s.timezone, s.shortage_policy, s.payment_method, s.shipping_address,
+	s.next_run_at, s.last_run_at, s.created_at, s.updated_at
+`
+
+const subscriptionRunColumns = `id, subscription_id, scheduled_for, status, order_id, error, created_at, finished_at`
+
+// InsertSubscription writes a subscription with its items
+func (o *OrderSQLRepository) InsertSubscription(ctx context.Context, subscription *model.Subscription) error {
+	if subscription.Id == uuid.Nil {
+		subscription.Id = uuid.New()
+	}
+	now := time.Now()
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO order_service.subscriptions (id, user_id, user_email, status, schedule, timezone, shortage_policy,
+			payment_method, shipping_address, next_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		subscription.Id, subscription.UserId, subscription.UserEmail, subscription.Status, subscription.Schedule, subscription.Timezone,
+		subscription.ShortagePolicy, subscription.PaymentMethod, subscription.ShippingAddress, subscription.NextRunAt,
+		subscription.CreatedAt, subscription.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert subscription: %w", err)
+	}
+
+	for _, item := range subscription.Items {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO order_service.subscription_items (subscription_id, sku, uom, quantity_per_uom)
+			VALUES ($1, $2, $3, $4)`,
+			subscription.Id, item.Sku, item.Uom, item.QuantityPerUom,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert subscription item: %w", err)
+		}
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubscription returns a subscription with its items, nil when it does not exist
+func (o *OrderSQLRepository) GetSubscription(ctx context.Context, subscriptionId uuid.UUID) (*model.Subscription, error) {
+	subscriptions, err := o.getSubscriptions(ctx, o.Pgx.Pool(), "s.id = $1", "", subscriptionId)
+	if err != nil || len(subscriptions) == 0 {
+		return nil, err
+	}
+	return &subscriptions[0], nil
+}
+
+// GetUserSubscriptions returns the subscriptions of a user with their items, newest first
+func (o *OrderSQLRepository) GetUserSubscriptions(ctx context.Context, userId string) ([]model.Subscription, error) {
+	return o.getSubscriptions(ctx, o.Pgx.Pool(), "s.user_id = $1", "ORDER BY s.created_at DESC", userId)
+}
+
+// SetSubscriptionStatus pauses or resumes a subscription, next run at is nil for a paused one. false is
+// returned when it does not exist
+func (o *OrderSQLRepository) SetSubscriptionStatus(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time) (bool, error) {
+	now := time.Now()
+	tag, err := o.Pgx.Pool().Exec(ctx,
+		`UPDATE order_service.subscriptions
+		SET status = $2, next_run_at = $3, updated_at = $4
+		WHERE id = $1`,
+		subscription.Id, status, nextRunAt, now,
+	)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	subscription.Status = status
+	subscription.NextRunAt = nextRunAt
+	subscription.UpdatedAt = now
+	return true, nil
+}
+
+// ClaimDueSubscriptions starts a run for up to limit ACTIVE subscriptions whose next run is due at now, the
+// earliest first. next tells the run after the claimed one, a subscription without one is paused. the claim
+// moves next_run_at past now in the same transaction, so other replicas skip the subscription, and the unique
+// run per scheduled time keeps a time from being run twice
+func (o *OrderSQLRepository) ClaimDueSubscriptions(ctx context.Context, limit int, now time.Time, next func(subscription *model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error) {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	subscriptions, err := o.getSubscriptions(ctx, tx, "s.status = $1 AND s.next_run_at <= $2",
+		"ORDER BY s.next_run_at LIMIT $3 FOR UPDATE OF s SKIP LOCKED",
+		model.SUBSCRIPTION_STATUS_ACTIVE, now, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	due := []model.DueSubscriptionRun{}
+	for i := range subscriptions {
+		subscription := subscriptions[i]
+		scheduledFor := *subscription.NextRunAt
+
+		status := model.SUBSCRIPTION_STATUS_ACTIVE
+		nextRunAt := next(&subscription)
+		if nextRunAt == nil {
+			status = model.SUBSCRIPTION_STATUS_PAUSED
+		}
+		_, err = tx.Exec(ctx,
+			`UPDATE order_service.subscriptions
+			SET status = $2, next_run_at = $3, last_run_at = $4, updated_at = $5
+			WHERE id = $1`,
+			subscription.Id, status, nextRunAt, scheduledFor, now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update subscription: %w", err)
+		}
+		subscription.Status = status
+		subscription.NextRunAt = nextRunAt
+		subscription.LastRunAt = &scheduledFor
+
+		run := model.SubscriptionRun{
+			Id:             uuid.New(),
+			SubscriptionId: subscription.Id,
+			ScheduledFor:   scheduledFor,
+			Status:         model.SUBSCRIPTION_RUN_PENDING,
+			CreatedAt:      now,
+		}
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO order_service.subscription_runs (id, subscription_id, scheduled_for, status, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (subscription_id, scheduled_for) DO NOTHING`,
+			run.Id, run.SubscriptionId, run.ScheduledFor, run.Status, run.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert subscription run: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			continue
+		}
+
+		due = append(due, model.DueSubscriptionRun{Run: run, Subscription: subscription})
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return due, nil
+}
+
+// FinishSubscriptionRun writes the outcome of a run
+func (o *OrderSQLRepository) FinishSubscriptionRun(ctx context.Context, run *model.SubscriptionRun) error {
+	now := time.Now()
+	_, err := o.Pgx.Pool().Exec(ctx,
+		`UPDATE order_service.subscription_runs
+		SET status = $2, order_id = $3, error = $4, finished_at = $5
+		WHERE id = $1`,
+		run.Id, run.Status, run.OrderId, run.Error, now,
+	)
+	if err != nil {
+		return err
+	}
+
+	run.FinishedAt = &now
+	return nil
+}
+
+// GetSubscriptionRuns returns the latest limit runs of a subscription, newest first
+func (o *OrderSQLRepository) GetSubscriptionRuns(ctx context.Context, subscriptionId uuid.UUID, limit int) ([]model.SubscriptionRun, error) {
+	rows, err := o.Pgx.Pool().Query(ctx, `
+		SELECT `+subscriptionRunColumns+`
+		FROM order_service.subscription_runs
+		WHERE subscription_id = $1
+		ORDER BY scheduled_for DESC
+		LIMIT $2
+	`, subscriptionId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []model.SubscriptionRun{}
+	for rows.Next() {
+		var r model.SubscriptionRun
+		err := rows.Scan(
+			&r.Id,
+			&r.SubscriptionId,
+			&r.ScheduledFor,
+			&r.Status,
+			&r.OrderId,
+			&r.Error,
+			&r.CreatedAt,
+			&r.FinishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// subscriptions matching where with their items, suffix orders, limits or locks them
+func (o *OrderSQLRepository) getSubscriptions(ctx context.Context, db querier, where, suffix string, args ...interface{}) ([]model.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM order_service.subscriptions s WHERE ` + where + ` ` + suffix
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := []model.Subscription{}
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var s model.Subscription
+		err := rows.Scan(
+			&s.Id,
+			&s.UserId,
+			&s.UserEmail,
+			&s.Status,
+			&s.Schedule,
+			&s.Timezone,
+			&s.ShortagePolicy,
+			&s.PaymentMethod,
+			&s.ShippingAddress,
+			&s.NextRunAt,
+			&s.LastRunAt,
+			&s.CreatedAt,
+			&s.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		s.Items = []model.SubscriptionItem{}
+		subscriptions = append(subscriptions, s)
+		ids = append(ids, s.Id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return subscriptions, nil
+	}
+
+	itemRows, err := db.Query(ctx, `
+		SELECT subscription_id, sku, uom, quantity_per_uom
+		FROM order_service.subscription_items
+		WHERE subscription_id = ANY($1)
+		ORDER BY sku
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer itemRows.Close()
+
+	index := make(map[uuid.UUID]int, len(subscriptions))
+	for i := range subscriptions {
+		index[subscriptions[i].Id] = i
+	}
+	for itemRows.Next() {
+		var subscriptionId uuid.UUID
+		var item model.SubscriptionItem
+		if err := itemRows.Scan(&subscriptionId, &item.Sku, &item.Uom, &item.QuantityPerUom); err != nil {
+			return nil, err
+		}
+		s := &subscriptions[index[subscriptionId]]
+		s.Items = append(s.Items, item)
+	}
+
+	if err = itemRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+
+	"github.com/google/uuid"
+)
+
+func (o *OrderSQLRepository) GetOrderTaxes(ctx context.Context, orderId uuid.UUID) ([]model.OrderTax, error) {
+	query := `
+		SELECT id, order_id, order_item_id, jurisdiction, sku, tax_category, rate, taxable_amount, tax_amount
+		FROM order_service.order_taxes
+		WHERE order_id = $1
+		ORDER BY sku
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	taxes := []model.OrderTax{}
+	for rows.Next() {
+		var t model.OrderTax
+		err := rows.Scan(&t.Id, &t.OrderId, &t.OrderItemId, &t.Jurisdiction, &t.Sku, &t.TaxCategory, &t.Rate, &t.TaxableAmount, &t.TaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		taxes = append(taxes, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return taxes, nil
+}
+
+// the taxes of an order are always written as a whole, the previous ones are deleted
+func replaceOrderTaxes(ctx context.Context, tx sql.PgxTx, orderId uuid.UUID, taxes []model.OrderTax) error {
+	_, err := tx.Exec(ctx, "DELETE FROM order_service.order_taxes WHERE order_id = $1", orderId)
+	if err != nil {
+		return fmt.Errorf("failed to delete order taxes: %w", err)
+	}
+
+	for _, t := range taxes {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO order_service.order_taxes (id, order_id, order_item_id, jurisdiction, sku, tax_category, rate, taxable_amount, tax_amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			t.Id, orderId, t.OrderItemId, t.Jurisdiction, t.Sku, t.TaxCategory, t.Rate, t.TaxableAmount, t.TaxAmount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert order tax: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"ops-monorepo/services/svc-order/internal/model"
+	sql "ops-monorepo/shared-libs/storage/postgres"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const webhookDeliveryColumns = `
+	d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at,
+	d.last_status_code, d.last_error, d.created_at, d.updated_at, d.delivered_at
+`
+
+func (o *OrderSQLRepository) InsertWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error {
+	if endpoint.Id == uuid.Nil {
+		endpoint.Id = uuid.New()
+	}
+	now := time.Now()
+	endpoint.CreatedAt = now
+	endpoint.UpdatedAt = now
+
+	_, err := o.Pgx.Pool().Exec(ctx,
+		`INSERT INTO order_service.webhook_endpoints (id, url, secret, event_types, description, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		endpoint.Id, endpoint.Url, endpoint.Secret, endpoint.EventTypes, endpoint.Description, endpoint.Active,
+		endpoint.CreatedAt, endpoint.UpdatedAt,
+	)
+	return err
+}
+
+// UpdateWebhookEndpoint writes the url, event types, description and state of an endpoint, false is
+// returned when it does not exist
+func (o *OrderSQLRepository) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (bool, error) {
+	now := time.Now()
+	tag, err := o.Pgx.Pool().Exec(ctx,
+		`UPDATE order_service.webhook_endpoints
+		SET url = $2, event_types = $3, description = $4, is_active = $5, updated_at = $6
+		WHERE id = $1`,
+		endpoint.Id, endpoint.Url, endpoint.EventTypes, endpoint.Description, endpoint.Active, now,
+	)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	endpoint.UpdatedAt = now
+	return true, nil
+}
+
+// GetWebhookEndpoints returns every endpoint without its secret, newest first
+func (o *OrderSQLRepository) GetWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+	return o.getWebhookEndpoints(ctx, "TRUE")
+}
+
+// GetWebhookEndpoint returns an endpoint without its secret, nil when it does not exist
+func (o *OrderSQLRepository) GetWebhookEndpoint(ctx context.Context, endpointId uuid.UUID) (*model.WebhookEndpoint, error) {
+	endpoints, err := o.getWebhookEndpoints(ctx, "id = $1", endpointId)
+	if err != nil || len(endpoints) == 0 {
+		return nil, err
+	}
+	return &endpoints[0], nil
+}
+
+func (o *OrderSQLRepository) getWebhookEndpoints(ctx context.Context, where string, args ...interface{}) ([]model.WebhookEndpoint, error) {
+	query := `
+		SELECT id, url, event_types, description, is_active, created_at, updated_at
+		FROM order_service.webhook_endpoints
+		WHERE ` + where + `
+		ORDER BY created_at DESC
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	endpoints := []model.WebhookEndpoint{}
+	for rows.Next() {
+		var e model.WebhookEndpoint
+		err := rows.Scan(
+			&e.Id,
+			&e.Url,
+			&e.EventTypes,
+			&e.Description,
+			&e.Active,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+// InsertWebhookDeliveries queues an event for every active endpoint subscribed to its type and returns
+// how many deliveries were queued
+func (o *OrderSQLRepository) InsertWebhookDeliveries(ctx context.Context, event model.WebhookEvent, payload []byte) (int, error) {
+	tag, err := o.Pgx.Pool().Exec(ctx,
+		`INSERT INTO order_service.webhook_deliveries (id, endpoint_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+		SELECT uuid_generate_v4(), e.id, $1, $2, $3, $4, $5, $5, $5
+		FROM order_service.webhook_endpoints e
+		WHERE e.is_active AND $2 = ANY(e.event_types)
+		ON CONFLICT (endpoint_id, event_id) DO NOTHING`,
+		event.Id, event.Type, payload, model.WEBHOOK_DELIVERY_PENDING, event.OccurredAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// ClaimWebhookDeliveries returns up to limit PENDING deliveries of active endpoints that are due, oldest
+// first, with the url and secret of their endpoint. claimed deliveries are not due again for lease so
+// other replicas skip them while they are sent
+func (o *OrderSQLRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error) {
+	query := `
+		WITH due AS (
+			SELECT d.id
+			FROM order_service.webhook_deliveries d
+			JOIN order_service.webhook_endpoints e ON e.id = d.endpoint_id
+			WHERE d.status = $1 AND d.next_attempt_at <= NOW() AND e.is_active
+			ORDER BY d.next_attempt_at
+			LIMIT $2
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE order_service.webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $3)
+		FROM due, order_service.webhook_endpoints e
+		WHERE d.id = due.id AND e.id = d.endpoint_id
+		RETURNING ` + webhookDeliveryColumns + `, e.url, e.secret
+	`
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, model.WEBHOOK_DELIVERY_PENDING, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []model.WebhookDelivery{}
+	for rows.Next() {
+		var d model.WebhookDelivery
+		if err := rows.Scan(append(webhookDeliveryFields(&d), &d.Url, &d.Secret)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt writes the outcome of a try to send a delivery together with its log entry
+func (o *OrderSQLRepository) RecordWebhookAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt model.WebhookAttempt) error {
+	tx, err := o.BeginTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer o.RollbackTransaction(ctx, tx)
+
+	delivery.UpdatedAt = attempt.AttemptedAt
+	_, err = tx.Exec(ctx,
+		`UPDATE order_service.webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6, delivered_at = $7, updated_at = $8
+		WHERE id = $1`,
+		delivery.Id, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError,
+		delivery.DeliveredAt, delivery.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO order_service.webhook_attempts (delivery_id, status_code, error, duration_ms, attempted_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		delivery.Id, attempt.StatusCode, attempt.Error, attempt.DurationMs, attempt.AttemptedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook attempt: %w", err)
+	}
+
+	if err = o.CommitTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReplayWebhookDelivery queues a delivery again with a fresh set of attempts, false is returned when it
+// does not exist or is still PENDING
+func (o *OrderSQLRepository) ReplayWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error) {
+	now := time.Now()
+	tag, err := o.Pgx.Pool().Exec(ctx,
+		`UPDATE order_service.webhook_deliveries
+		SET status = $2, attempts = 0, next_attempt_at = $3, updated_at = $3
+		WHERE id = $1 AND status <> $2`,
+		delivery.Id, model.WEBHOOK_DELIVERY_PENDING, now,
+	)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	delivery.Status = model.WEBHOOK_DELIVERY_PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+	return true, nil
+}
+
+// GetWebhookDeliveries returns the deliveries matching filter, newest first
+func (o *OrderSQLRepository) GetWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	where := "TRUE"
+	args := []interface{}{}
+	if filter.EndpointId != nil {
+		args = append(args, *filter.EndpointId)
+		where += " AND d.endpoint_id = $" + strconv.Itoa(len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where += " AND d.status = $" + strconv.Itoa(len(args))
+	}
+	if filter.EventType != "" {
+		args = append(args, filter.EventType)
+		where += " AND d.event_type = $" + strconv.Itoa(len(args))
+	}
+	args = append(args, filter.Limit)
+
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM order_service.webhook_deliveries d
+		WHERE ` + where + `
+		ORDER BY d.created_at DESC, d.id
+		LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := o.Pgx.Pool().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []model.WebhookDelivery{}
+	for rows.Next() {
+		var d model.WebhookDelivery
+		if err := rows.Scan(webhookDeliveryFields(&d)...); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetWebhookDelivery returns a delivery with its attempts, nil when it does not exist
+func (o *OrderSQLRepository) GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+	var d model.WebhookDelivery
+	err := o.Pgx.Pool().QueryRow(ctx,
+		`SELECT `+webhookDeliveryColumns+` FROM order_service.webhook_deliveries d WHERE d.id = $1`,
+		deliveryId,
+	).Scan(webhookDeliveryFields(&d)...)
+	if err != nil {
+		if err == sql.PgxErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := o.Pgx.Pool().Query(ctx, `
+		SELECT id, delivery_id, status_code, error, duration_ms, attempted_at
+		FROM order_service.webhook_attempts
+		WHERE delivery_id = $1
+		ORDER BY attempted_at, id
+	`, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	d.Log = []model.WebhookAttempt{}
+	for rows.Next() {
+		var a model.WebhookAttempt
+		err := rows.Scan(
+			&a.Id,
+			&a.DeliveryId,
+			&a.StatusCode,
+			&a.Error,
+			&a.DurationMs,
+			&a.AttemptedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		d.Log = append(d.Log, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func webhookDeliveryFields(d *model.WebhookDelivery) []interface{} {
+	return []interface{}{
+		&d.Id,
+		&d.EndpointId,
+		&d.EventId,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptAt,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+		&d.DeliveredAt,
+	}
+}
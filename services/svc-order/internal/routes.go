@@ -28,10 +28,84 @@ func (s *Server) RegisterRoutes() {
 		// Create order endpoint requires authentication
 		protected.POST("/orders", s.order.handler.CreateOrder)
 
+		// Prices and availability of an order without creating it
+		protected.POST("/orders/quote", s.order.handler.CreateQuote)
+
+		// Order detail including its stock reservations
+		protected.GET("/orders/:id", s.order.handler.GetOrder)
+
+		// Change the items of a PENDING or CONFIRMED order
+		protected.PATCH("/orders/:id/items", s.order.handler.AmendOrderItems)
+
+		// Capture the payment of a CONFIRMED order once its goods leave the warehouse
+		protected.POST("/orders/:id/fulfil", middleware.RequireRole("admin"), s.order.handler.FulfilOrder)
+
+		// Customer returns of a FULFILLED order
+		protected.POST("/orders/:id/returns", s.order.handler.RequestReturn)
+		protected.GET("/orders/:id/returns", s.order.handler.GetOrderReturns)
+
+		// Admins decide on returns and book the goods back, receiving restocks and refunds
+		protected.POST("/returns/:id/approve", middleware.RequireRole("admin"), s.order.handler.ApproveReturn)
+		protected.POST("/returns/:id/reject", middleware.RequireRole("admin"), s.order.handler.RejectReturn)
+		protected.POST("/returns/:id/receive", middleware.RequireRole("admin"), s.order.handler.ReceiveReturn)
+
+		// Warehouse staff pack orders into shipments and hand them to carriers, shipping the last line fulfils the order
+		protected.POST("/orders/:id/shipments", middleware.RequireRole("admin"), s.order.handler.CreateShipment)
+		protected.GET("/orders/:id/shipments", s.order.handler.GetOrderShipments)
+		protected.POST("/shipments/:id/ship", middleware.RequireRole("admin"), s.order.handler.ShipShipment)
+		protected.POST("/shipments/:id/deliver", middleware.RequireRole("admin"), s.order.handler.DeliverShipment)
+
+		// Coupon codes redeemed on orders and quotes
+		protected.POST("/promotions", middleware.RequireRole("admin"), s.order.handler.CreatePromotion)
+		protected.GET("/promotions", middleware.RequireRole("admin"), s.order.handler.ListPromotions)
+
+		// Partner endpoints notified about order events, with the log of every delivery. dead deliveries can be replayed
+		protected.POST("/webhooks", middleware.RequireRole("admin"), s.order.handler.CreateWebhookEndpoint)
+		protected.GET("/webhooks", middleware.RequireRole("admin"), s.order.handler.ListWebhookEndpoints)
+		protected.PATCH("/webhooks/:id", middleware.RequireRole("admin"), s.order.handler.UpdateWebhookEndpoint)
+		protected.GET("/webhook-deliveries", middleware.RequireRole("admin"), s.order.handler.ListWebhookDeliveries)
+		protected.GET("/webhook-deliveries/:id", middleware.RequireRole("admin"), s.order.handler.GetWebhookDelivery)
+		protected.POST("/webhook-deliveries/:id/replay", middleware.RequireRole("admin"), s.order.handler.ReplayWebhookDelivery)
+
+		// Email the customer once an out of stock sku is available again
+		protected.POST("/skus/:sku/back-in-stock-subscriptions", s.order.handler.SubscribeBackInStock)
+
+		// Recurring orders placed on a schedule, each run is listed with the subscription
+		protected.POST("/subscriptions", s.subscription.handler.CreateSubscription)
+		protected.GET("/subscriptions", s.subscription.handler.ListSubscriptions)
+		protected.GET("/subscriptions/:id", s.subscription.handler.GetSubscription)
+		protected.POST("/subscriptions/:id/pause", s.subscription.handler.PauseSubscription)
+		protected.POST("/subscriptions/:id/resume", s.subscription.handler.ResumeSubscription)
+
 		// You can add role-based protection like this:
 		// protected.POST("/orders", middleware.RequireRole("user", "admin"), s.order.handler.CreateOrder)
 	}
 
+	// Support staff work on the orders of every user, each action is written to the audit log
+	admin := protected.Group("/admin/orders")
+	admin.Use(middleware.RequireRole("admin"))
+	{
+		admin.GET("", s.order.handler.SearchAdminOrders)
+		admin.GET("/export", s.order.handler.ExportAdminOrders)
+		admin.GET("/audit", s.order.handler.ListAdminAudit)
+		admin.POST("/:id/status", s.order.handler.ForceOrderStatus)
+		admin.POST("/:id/reservation", s.order.handler.RetryReservation)
+	}
+
+	// Carts of signed in users and of guests by their X-Cart-Id, guests sign in to merge their cart and check out
+	if s.cart.handler != nil {
+		cart := v1.Group("/cart")
+		cart.Use(middleware.OptionalJWTAuthMiddleware(authConfig))
+		{
+			cart.GET("", s.cart.handler.GetCart)
+			cart.POST("/items", s.cart.handler.AddCartItem)
+			cart.PUT("/items/:sku", s.cart.handler.UpdateCartItem)
+			cart.DELETE("/items/:sku", s.cart.handler.RemoveCartItem)
+			cart.POST("/merge", s.cart.handler.MergeCart)
+			cart.POST("/checkout", s.cart.handler.CheckoutCart)
+		}
+	}
+
 	// Public routes (no authentication required)
 	// v1.GET("/health", s.HealthCheck)
 }
@@ -0,0 +1,117 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Spec        string
+		ExpectedErr bool
+	}{
+		{Name: "cron expression", Spec: "30 8 * * MON-FRI"},
+		{Name: "steps and lists", Spec: "*/15 8,12,18 1-7 JAN/3 *"},
+		{Name: "shorthand", Spec: "@weekly"},
+		{Name: "interval", Spec: "@every 168h"},
+		{Name: "interval too short", Spec: "@every 30s", ExpectedErr: true},
+		{Name: "interval not a duration", Spec: "@every week", ExpectedErr: true},
+		{Name: "missing field", Spec: "0 8 * *", ExpectedErr: true},
+		{Name: "value out of range", Spec: "0 24 * * *", ExpectedErr: true},
+		{Name: "reversed range", Spec: "0 8 * * FRI-MON", ExpectedErr: true},
+		{Name: "zero step", Spec: "*/0 * * * *", ExpectedErr: true},
+		{Name: "day that never comes", Spec: "0 0 31 FEB *", ExpectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := Parse(tc.Spec, time.UTC)
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	// a wednesday
+	from := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Spec     string
+		Loc      *time.Location
+		From     time.Time
+		Expected time.Time
+	}{
+		{
+			Name:     "next monday morning",
+			Spec:     "0 8 * * MON",
+			From:     from,
+			Expected: time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "later the same day",
+			Spec:     "30 14 * * *",
+			From:     from,
+			Expected: time.Date(2024, 1, 3, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			Name:     "strictly after the given time",
+			Spec:     "0 10 * * *",
+			From:     from,
+			Expected: time.Date(2024, 1, 4, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "day of month or day of week",
+			Spec:     "0 0 15 * SUN",
+			From:     from,
+			Expected: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "sunday as 7",
+			Spec:     "0 0 * * 7",
+			From:     from,
+			Expected: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "first of the next month",
+			Spec:     "@monthly",
+			From:     from,
+			Expected: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "leap day",
+			Spec:     "0 0 29 2 *",
+			From:     from,
+			Expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "in the time zone of the schedule",
+			Spec:     "0 8 * * *",
+			Loc:      berlin,
+			From:     from,
+			Expected: time.Date(2024, 1, 4, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "interval",
+			Spec:     "@every 168h",
+			From:     from,
+			Expected: time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			s, err := Parse(tc.Spec, tc.Loc)
+			assert.NoError(t, err)
+			assert.True(t, tc.Expected.Equal(s.Next(tc.From)), s.Next(tc.From).String())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"ops-monorepo/services/svc-order/config"
 
 	"github.com/gin-gonic/gin"
@@ -8,9 +9,11 @@ import (
 
 // represents the API server with all dependencies
 type Server struct {
-	Config *config.Config
-	Router *gin.Engine
-	order  *Order
+	Config       *config.Config
+	Router       *gin.Engine
+	order        *Order
+	cart         *Cart
+	subscription *Subscription
 }
 
 // creates a new server instance
@@ -18,9 +21,11 @@ func NewHTTPServer(cfg *config.Config) *Server {
 
 	dep := InitDependencies(cfg)
 	return &Server{
-		Config: cfg,
-		Router: gin.New(),
-		order:  &dep.Impl.Order,
+		Config:       cfg,
+		Router:       gin.New(),
+		order:        &dep.Impl.Order,
+		cart:         &dep.Impl.Cart,
+		subscription: &dep.Impl.Subscription,
 	}
 }
 
@@ -28,3 +33,16 @@ func (s *Server) Start(addr string) error {
 	s.Router.SetTrustedProxies(nil)
 	return s.Router.Run(addr)
 }
+
+// starts background jobs enabled in the config
+func (s *Server) StartJobs(ctx context.Context) {
+	if s.order.job != nil {
+		s.order.job.Start(ctx)
+	}
+	if s.order.webhookJob != nil {
+		s.order.webhookJob.Start(ctx)
+	}
+	if s.subscription.job != nil {
+		s.subscription.job.Start(ctx)
+	}
+}
@@ -0,0 +1,66 @@
+package tax
+
+import (
+	"context"
+	"errors"
+
+	"github.com/robaho/fixed"
+)
+
+// ErrNoJurisdiction is wrapped by the errors of an address no tax rules are known for
+var ErrNoJurisdiction = errors.New("no tax rules for the address")
+
+// tax categories of the products, a product without one is STANDARD
+const (
+	CATEGORY_STANDARD = "STANDARD"
+	CATEGORY_REDUCED  = "REDUCED"
+	CATEGORY_ZERO     = "ZERO"
+	CATEGORY_EXEMPT   = "EXEMPT"
+)
+
+// rounding of the tax amounts to cents
+const (
+	// every line is rounded, the order tax is the sum of the lines
+	ROUNDING_LINE = "LINE"
+	// the order tax is rounded once, the last taxed line takes the difference of the rounded lines
+	ROUNDING_ORDER = "ORDER"
+)
+
+type (
+	// TaxCalculator works out the tax of the lines of an order shipped to an address
+	TaxCalculator interface {
+		Calculate(ctx context.Context, address Address, lines []Line) (*Result, error)
+	}
+
+	// Address selects the jurisdiction, the region narrows the rules of the country down when it has its own
+	Address struct {
+		CountryCode string
+		Region      string
+	}
+
+	// Line is the amount charged for an order line, after its discounts
+	Line struct {
+		Sku         string
+		TaxCategory string
+		Amount      fixed.Fixed
+	}
+
+	// LineTax is the tax of a line. taxable amount is the amount the rate applies to, the line amount
+	// itself for prices without tax and the line amount less the tax for prices including it
+	LineTax struct {
+		Sku           string
+		TaxCategory   string
+		Rate          fixed.Fixed
+		TaxableAmount fixed.Fixed
+		TaxAmount     fixed.Fixed
+	}
+
+	// Result is the tax of an order in the currency of its lines, one line tax per line in the same order
+	Result struct {
+		Jurisdiction     string
+		PricesIncludeTax bool
+		Rounding         string
+		Lines            []LineTax
+		TaxAmount        fixed.Fixed
+	}
+)
@@ -0,0 +1,171 @@
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/robaho/fixed"
+)
+
+// tax amounts are rounded to cents
+const amountDecimals = 2
+
+var hundred = fixed.NewI(100, 0)
+
+// Jurisdiction holds the tax rules of a country, or of a region of it when code is COUNTRY-REGION.
+// rates are percentages per tax category, categories without a rate use the STANDARD one, ZERO and
+// EXEMPT are never taxed
+type Jurisdiction struct {
+	Code             string                 `json:"code"`
+	Name             string                 `json:"name"`
+	PricesIncludeTax bool                   `json:"prices_include_tax"`
+	Rounding         string                 `json:"rounding"`
+	Rates            map[string]fixed.Fixed `json:"rates"`
+}
+
+// DefaultJurisdictions are the rules used when no rules file is configured. US states without their own
+// rules have no sales tax collected
+var DefaultJurisdictions = []Jurisdiction{
+	{Code: "US", Name: "United States", Rounding: ROUNDING_ORDER, Rates: rates("0", "0")},
+	{Code: "US-CA", Name: "California", Rounding: ROUNDING_ORDER, Rates: rates("7.25", "0")},
+	{Code: "US-NY", Name: "New York", Rounding: ROUNDING_ORDER, Rates: rates("4", "0")},
+	{Code: "US-TX", Name: "Texas", Rounding: ROUNDING_ORDER, Rates: rates("6.25", "0")},
+	{Code: "CA", Name: "Canada", Rounding: ROUNDING_ORDER, Rates: rates("5", "0")},
+	{Code: "CA-ON", Name: "Ontario", Rounding: ROUNDING_ORDER, Rates: rates("13", "0")},
+	{Code: "GB", Name: "United Kingdom", PricesIncludeTax: true, Rounding: ROUNDING_LINE, Rates: rates("20", "5")},
+	{Code: "DE", Name: "Germany", PricesIncludeTax: true, Rounding: ROUNDING_LINE, Rates: rates("19", "7")},
+	{Code: "FR", Name: "France", PricesIncludeTax: true, Rounding: ROUNDING_LINE, Rates: rates("20", "5.5")},
+	{Code: "AU", Name: "Australia", PricesIncludeTax: true, Rounding: ROUNDING_LINE, Rates: rates("10", "0")},
+}
+
+func rates(standard, reduced string) map[string]fixed.Fixed {
+	return map[string]fixed.Fixed{
+		CATEGORY_STANDARD: fixed.NewS(standard),
+		CATEGORY_REDUCED:  fixed.NewS(reduced),
+	}
+}
+
+// TableCalculator applies the rules of a fixed table of jurisdictions
+type TableCalculator struct {
+	jurisdictions map[string]Jurisdiction
+}
+
+// NewTableCalculator checks every jurisdiction has a STANDARD rate and a known rounding, LINE when empty
+func NewTableCalculator(jurisdictions []Jurisdiction) (*TableCalculator, error) {
+
+	table := map[string]Jurisdiction{}
+	for _, j := range jurisdictions {
+		j.Code = strings.ToUpper(j.Code)
+		if _, ok := j.Rates[CATEGORY_STANDARD]; !ok {
+			return nil, fmt.Errorf("jurisdiction %s has no %s rate", j.Code, CATEGORY_STANDARD)
+		}
+		switch j.Rounding {
+		case "":
+			j.Rounding = ROUNDING_LINE
+		case ROUNDING_LINE, ROUNDING_ORDER:
+		default:
+			return nil, fmt.Errorf("jurisdiction %s has unknown rounding %s", j.Code, j.Rounding)
+		}
+		if _, ok := table[j.Code]; ok {
+			return nil, fmt.Errorf("jurisdiction %s is listed twice", j.Code)
+		}
+		table[j.Code] = j
+	}
+	return &TableCalculator{jurisdictions: table}, nil
+}
+
+// LoadJurisdictions reads a JSON array of jurisdictions
+func LoadJurisdictions(path string) ([]Jurisdiction, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var jurisdictions []Jurisdiction
+	if err := json.Unmarshal(data, &jurisdictions); err != nil {
+		return nil, fmt.Errorf("invalid tax rules file %s: %w", path, err)
+	}
+	return jurisdictions, nil
+}
+
+func (t *TableCalculator) Calculate(ctx context.Context, address Address, lines []Line) (*Result, error) {
+
+	j, ok := t.jurisdiction(address)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoJurisdiction, strings.ToUpper(address.CountryCode))
+	}
+
+	result := &Result{
+		Jurisdiction:     j.Code,
+		PricesIncludeTax: j.PricesIncludeTax,
+		Rounding:         j.Rounding,
+		Lines:            make([]LineTax, 0, len(lines)),
+		TaxAmount:        fixed.NewF(0),
+	}
+
+	// unrounded tax of every line
+	exact := make([]fixed.Fixed, len(lines))
+	sum := fixed.NewF(0)
+	for i, l := range lines {
+		category := l.TaxCategory
+		if category == "" {
+			category = CATEGORY_STANDARD
+		}
+		rate := j.rate(category)
+
+		exact[i] = l.Amount.Mul(rate).Div(hundred)
+		if j.PricesIncludeTax {
+			exact[i] = l.Amount.Mul(rate).Div(hundred.Add(rate))
+		}
+		sum = sum.Add(exact[i])
+
+		result.Lines = append(result.Lines, LineTax{Sku: l.Sku, TaxCategory: category, Rate: rate})
+	}
+
+	last := -1
+	for i := range result.Lines {
+		result.Lines[i].TaxAmount = exact[i].Round(amountDecimals)
+		result.TaxAmount = result.TaxAmount.Add(result.Lines[i].TaxAmount)
+		if exact[i].GreaterThan(fixed.ZERO) {
+			last = i
+		}
+	}
+	if j.Rounding == ROUNDING_ORDER && last >= 0 {
+		total := sum.Round(amountDecimals)
+		result.Lines[last].TaxAmount = result.Lines[last].TaxAmount.Add(total.Sub(result.TaxAmount))
+		result.TaxAmount = total
+	}
+
+	for i, l := range lines {
+		result.Lines[i].TaxableAmount = l.Amount
+		if j.PricesIncludeTax {
+			result.Lines[i].TaxableAmount = l.Amount.Sub(result.Lines[i].TaxAmount)
+		}
+	}
+	return result, nil
+}
+
+// the rules of the region when it has its own, of the country otherwise
+func (t *TableCalculator) jurisdiction(address Address) (Jurisdiction, bool) {
+	country := strings.ToUpper(strings.TrimSpace(address.CountryCode))
+	if region := strings.ToUpper(strings.TrimSpace(address.Region)); region != "" {
+		if j, ok := t.jurisdictions[country+"-"+region]; ok {
+			return j, true
+		}
+	}
+	j, ok := t.jurisdictions[country]
+	return j, ok
+}
+
+func (j Jurisdiction) rate(category string) fixed.Fixed {
+	switch category {
+	case CATEGORY_ZERO, CATEGORY_EXEMPT:
+		return fixed.NewF(0)
+	}
+	if rate, ok := j.Rates[category]; ok {
+		return rate
+	}
+	return j.Rates[CATEGORY_STANDARD]
+}
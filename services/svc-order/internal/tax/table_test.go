@@ -0,0 +1,182 @@
+package tax
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableCalculator_Calculate(t *testing.T) {
+	calculator, err := NewTableCalculator(DefaultJurisdictions)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		Name                 string
+		Address              Address
+		Lines                []Line
+		ExpectedJurisdiction string
+		ExpectedLines        []string
+		ExpectedTaxable      []string
+		ExpectedTotal        string
+		ExpectedErr          error
+	}{
+		{
+			Name:                 "region rules on top of prices without tax",
+			Address:              Address{CountryCode: "us", Region: "ca"},
+			Lines:                []Line{{Sku: "TSHIRT-M-WHITE", Amount: fixed.NewS("50")}},
+			ExpectedJurisdiction: "US-CA",
+			ExpectedLines:        []string{"3.63"},
+			ExpectedTaxable:      []string{"50"},
+			ExpectedTotal:        "3.63",
+		},
+		{
+			Name:                 "region without its own rules uses the country",
+			Address:              Address{CountryCode: "US", Region: "OR"},
+			Lines:                []Line{{Sku: "TSHIRT-M-WHITE", Amount: fixed.NewS("50")}},
+			ExpectedJurisdiction: "US",
+			ExpectedLines:        []string{"0"},
+			ExpectedTaxable:      []string{"50"},
+			ExpectedTotal:        "0",
+		},
+		{
+			Name:    "prices including tax with a reduced and a zero rated line",
+			Address: Address{CountryCode: "DE"},
+			Lines: []Line{
+				{Sku: "TSHIRT-M-WHITE", TaxCategory: CATEGORY_STANDARD, Amount: fixed.NewS("119")},
+				{Sku: "OLIVE-OIL-1L", TaxCategory: CATEGORY_REDUCED, Amount: fixed.NewS("25")},
+				{Sku: "GIFT-CARD", TaxCategory: CATEGORY_EXEMPT, Amount: fixed.NewS("20")},
+			},
+			ExpectedJurisdiction: "DE",
+			ExpectedLines:        []string{"19", "1.64", "0"},
+			ExpectedTaxable:      []string{"100", "23.36", "20"},
+			ExpectedTotal:        "20.64",
+		},
+		{
+			Name:    "line rounding sums the rounded lines",
+			Address: Address{CountryCode: "GB"},
+			Lines: []Line{
+				{Sku: "A", Amount: fixed.NewS("0.05")},
+				{Sku: "B", Amount: fixed.NewS("0.05")},
+				{Sku: "C", Amount: fixed.NewS("0.05")},
+			},
+			ExpectedJurisdiction: "GB",
+			// 0.0083 each
+			ExpectedLines:   []string{"0.01", "0.01", "0.01"},
+			ExpectedTaxable: []string{"0.04", "0.04", "0.04"},
+			ExpectedTotal:   "0.03",
+		},
+		{
+			Name:    "order rounding rounds the total once",
+			Address: Address{CountryCode: "CA", Region: "ON"},
+			Lines: []Line{
+				{Sku: "A", Amount: fixed.NewS("0.15")},
+				{Sku: "B", Amount: fixed.NewS("0.15")},
+				{Sku: "C", Amount: fixed.NewS("0.15")},
+			},
+			ExpectedJurisdiction: "CA-ON",
+			// 0.0195 each, 0.0585 in total
+			ExpectedLines:   []string{"0.02", "0.02", "0.02"},
+			ExpectedTaxable: []string{"0.15", "0.15", "0.15"},
+			ExpectedTotal:   "0.06",
+		},
+		{
+			Name:    "order rounding gives the difference to the last taxed line",
+			Address: Address{CountryCode: "CA", Region: "ON"},
+			Lines: []Line{
+				{Sku: "A", Amount: fixed.NewS("0.1")},
+				{Sku: "B", Amount: fixed.NewS("0.1")},
+				{Sku: "C", Amount: fixed.NewS("0.1")},
+				{Sku: "D", TaxCategory: CATEGORY_ZERO, Amount: fixed.NewS("5")},
+			},
+			ExpectedJurisdiction: "CA-ON",
+			// 0.013 each, 0.039 in total
+			ExpectedLines:   []string{"0.01", "0.01", "0.02", "0"},
+			ExpectedTaxable: []string{"0.1", "0.1", "0.1", "5"},
+			ExpectedTotal:   "0.04",
+		},
+		{
+			Name:        "country without rules",
+			Address:     Address{CountryCode: "XX"},
+			Lines:       []Line{{Sku: "A", Amount: fixed.NewS("10")}},
+			ExpectedErr: ErrNoJurisdiction,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := calculator.Calculate(context.Background(), tc.Address, tc.Lines)
+
+			if tc.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tc.ExpectedErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedJurisdiction, result.Jurisdiction)
+			assert.True(t, fixed.NewS(tc.ExpectedTotal).Equal(result.TaxAmount), "total is %s", result.TaxAmount)
+			assert.Len(t, result.Lines, len(tc.ExpectedLines))
+			for i, l := range result.Lines {
+				assert.True(t, fixed.NewS(tc.ExpectedLines[i]).Equal(l.TaxAmount), "%s tax is %s", l.Sku, l.TaxAmount)
+				assert.True(t, fixed.NewS(tc.ExpectedTaxable[i]).Equal(l.TaxableAmount), "%s taxable is %s", l.Sku, l.TaxableAmount)
+			}
+		})
+	}
+}
+
+func TestNewTableCalculator(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Jurisdictions []Jurisdiction
+		ExpectedErr   bool
+	}{
+		{
+			Name:          "default rules",
+			Jurisdictions: DefaultJurisdictions,
+		},
+		{
+			Name:          "no standard rate",
+			Jurisdictions: []Jurisdiction{{Code: "NL", Rates: map[string]fixed.Fixed{CATEGORY_REDUCED: fixed.NewS("9")}}},
+			ExpectedErr:   true,
+		},
+		{
+			Name:          "unknown rounding",
+			Jurisdictions: []Jurisdiction{{Code: "NL", Rounding: "HALF_EVEN", Rates: rates("21", "9")}},
+			ExpectedErr:   true,
+		},
+		{
+			Name:          "same code twice",
+			Jurisdictions: []Jurisdiction{{Code: "NL", Rates: rates("21", "9")}, {Code: "nl", Rates: rates("21", "9")}},
+			ExpectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := NewTableCalculator(tc.Jurisdictions)
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadJurisdictions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax_rules.json")
+	err := os.WriteFile(path, []byte(`[
+		{"code": "NL", "name": "Netherlands", "prices_include_tax": true, "rates": {"STANDARD": 21, "REDUCED": 9}}
+	]`), 0o600)
+	assert.NoError(t, err)
+
+	jurisdictions, err := LoadJurisdictions(path)
+	assert.NoError(t, err)
+	assert.Len(t, jurisdictions, 1)
+	assert.True(t, jurisdictions[0].PricesIncludeTax)
+	assert.True(t, fixed.NewS("9").Equal(jurisdictions[0].Rates[CATEGORY_REDUCED]))
+}
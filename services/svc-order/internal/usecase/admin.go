@@ -0,0 +1,317 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"strings"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// size of a page of the admin order search and of the audit log
+const (
+	defaultAdminPageLimit = 50
+	maxAdminPageLimit     = 500
+)
+
+// statuses in which an order holds reserved stock or an authorized payment
+var holdingOrderStatuses = map[string]bool{
+	model.ORDER_STATUS_PENDING:     true,
+	model.ORDER_STATUS_CONFIRMED:   true,
+	model.ORDER_STATUS_BACKORDERED: true,
+}
+
+// statuses in which an order holds nothing, forcing a holding order into one releases its stock and payment
+var releasedOrderStatuses = map[string]bool{
+	model.ORDER_STATUS_FAILED_RESERVATION: true,
+	model.ORDER_STATUS_CANCELLED:          true,
+	model.ORDER_STATUS_PAYMENT_FAILED:     true,
+}
+
+// webhook event of an order moved to a status by an admin
+var forcedStatusEvents = map[string]string{
+	model.ORDER_STATUS_CONFIRMED:          model.WEBHOOK_EVENT_ORDER_CONFIRMED,
+	model.ORDER_STATUS_BACKORDERED:        model.WEBHOOK_EVENT_ORDER_BACKORDERED,
+	model.ORDER_STATUS_FAILED_RESERVATION: model.WEBHOOK_EVENT_ORDER_RESERVATION_FAILED,
+	model.ORDER_STATUS_CANCELLED:          model.WEBHOOK_EVENT_ORDER_CANCELLED,
+	model.ORDER_STATUS_PAYMENT_FAILED:     model.WEBHOOK_EVENT_ORDER_PAYMENT_FAILED,
+	model.ORDER_STATUS_FULFILLED:          model.WEBHOOK_EVENT_ORDER_FULFILLED,
+}
+
+// SearchOrders returns a page of the orders of every user, newest first. the search is written to the audit log
+func (u *OrderUsecase) SearchOrders(ctx context.Context, filter model.OrderSearchFilter, actor string) (*model.OrderSearchResult, error) {
+
+	filter.Limit = adminPageLimit(filter.Limit)
+	orders, total, err := u.repoSQL.SearchOrders(ctx, filter)
+	if err != nil {
+		u.logger.Errorf("failed in SearchOrders", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	entry := &model.AdminAuditEntry{
+		Actor:   actor,
+		Action:  model.ADMIN_ACTION_SEARCH_ORDERS,
+		Details: map[string]interface{}{"filter": auditFilter(filter), "total": total},
+	}
+	if err := u.repoSQL.InsertAdminAuditEntry(ctx, entry); err != nil {
+		u.logger.Errorf("failed in InsertAdminAuditEntry", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return &model.OrderSearchResult{Orders: orders, Total: total, Limit: filter.Limit, Offset: filter.Offset}, nil
+}
+
+// ExportOrders calls fn with every order matching filter and its items, oldest first, as they are read from the
+// database. the export is written to the audit log before the first order
+func (u *OrderUsecase) ExportOrders(ctx context.Context, filter model.OrderSearchFilter, actor string, fn func(order *model.OrderWithItems) error) error {
+
+	entry := &model.AdminAuditEntry{
+		Actor:   actor,
+		Action:  model.ADMIN_ACTION_EXPORT_ORDERS,
+		Details: map[string]interface{}{"filter": auditFilter(filter)},
+	}
+	if err := u.repoSQL.InsertAdminAuditEntry(ctx, entry); err != nil {
+		u.logger.Errorf("failed in InsertAdminAuditEntry", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+
+	if err := u.repoSQL.StreamOrders(ctx, filter, fn); err != nil {
+		u.logger.Errorf("failed in StreamOrders", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+
+	return nil
+}
+
+// ForceOrderStatus moves an order to any status outside of the usual flow, the reason is kept in the audit log.
+// an order that held stock or a payment and is moved to a status that holds none has its reservations released
+// and its authorization voided. nothing else happens, no stock is reserved and no payment is captured
+func (u *OrderUsecase) ForceOrderStatus(ctx context.Context, orderId uuid.UUID, request types.ForceOrderStatusRequest, actor string) (*model.Order, error) {
+
+	order, err := u.repoSQL.GetOrderById(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderById", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	to := string(request.Status)
+	if order.Status == to {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"status": "order is already " + order.Status},
+		})
+	}
+
+	from := order.Status
+	release := holdingOrderStatuses[from] && releasedOrderStatuses[to]
+	entry := &model.AdminAuditEntry{
+		Actor:   actor,
+		Action:  model.ADMIN_ACTION_FORCE_STATUS,
+		OrderId: &orderId,
+		Reason:  strings.TrimSpace(request.Reason),
+		Details: map[string]interface{}{"from": from, "to": to, "released": release},
+	}
+
+	moved, err := u.repoSQL.ForceOrderStatus(ctx, order, to, entry)
+	if err != nil {
+		u.logger.Errorf("failed in ForceOrderStatus", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !moved {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+	u.logger.Warnf("order %s forced from %s to %s by %s: %s", orderId, from, order.Status, actor, entry.Reason)
+
+	if release {
+		u.releaseOrder(ctx, orderId)
+	}
+	if eventType, ok := forcedStatusEvents[order.Status]; ok {
+		u.publishOrderEvent(ctx, eventType, order, order.Status, map[string]interface{}{"forced": true, "reason": entry.Reason})
+	}
+
+	return order, nil
+}
+
+// RetryReservation reserves the stock of a FAILED_RESERVATION order again with the ALL_OR_NOTHING policy and
+// authorizes its payment. the order is confirmed once both succeed, short items leave it FAILED_RESERVATION
+// and are returned. every outcome is written to the audit log
+func (u *OrderUsecase) RetryReservation(ctx context.Context, orderId uuid.UUID, actor string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, nil, errlib.ErrDBQuery()
+	}
+	if order == nil {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if order.Status != model.ORDER_STATUS_FAILED_RESERVATION {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeOrderNotReservable)
+	}
+
+	// the order is PENDING while it is reserved, a concurrent retry finds it moved
+	moved, err := u.repoSQL.TransitionOrderStatus(ctx, orderId, model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_PENDING)
+	if err != nil {
+		u.logger.Errorf("failed in TransitionOrderStatus", "error", err.Error())
+		return nil, nil, errlib.ErrDBQuery()
+	}
+	if !moved {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+
+	entry := &model.AdminAuditEntry{
+		Actor:   actor,
+		Action:  model.ADMIN_ACTION_RETRY_RESERVATION,
+		OrderId: &orderId,
+		Details: map[string]interface{}{},
+	}
+	defer u.recordAudit(ctx, entry)
+
+	reserveResp, errReserve := u.inventoryGrpcClient.ReserveStock(ctx, &inventoryv1.StandardInventoryRequest{
+		OrderId:           orderId.String(),
+		Items:             orderInventoryItems(items),
+		ReservationPolicy: inventoryv1.ReservationPolicy_ALL_OR_NOTHING,
+	})
+	if errReserve != nil {
+		u.logger.Errorf("failed reserve stock to inventory service", "error", errReserve.Error())
+		u.restoreFailedReservation(ctx, orderId)
+		entry.Details["result"] = "ERROR"
+		entry.Details["error"] = errReserve.Error()
+		return nil, nil, errlib.ErrReservationStock(errReserve)
+	}
+	if failed := reserveResp.FailedProcessedItems.GetItems(); len(failed) > 0 {
+		u.restoreFailedReservation(ctx, orderId)
+		entry.Details["result"] = model.ORDER_STATUS_FAILED_RESERVATION
+		entry.Details["short_skus"] = failedSkus(failed)
+		return &model.OrderWithItems{Order: *order, Items: items}, failed, nil
+	}
+
+	order.Status = model.ORDER_STATUS_PENDING
+	authorized, err := u.authorizePayment(ctx, *order, "")
+	if err != nil {
+		u.releaseUnpaidOrder(ctx, orderId)
+		entry.Details["result"] = model.ORDER_STATUS_PAYMENT_FAILED
+		return nil, nil, err
+	}
+
+	if err := u.repoSQL.UpdateOrderStatus(ctx, orderId, model.ORDER_STATUS_CONFIRMED); err != nil {
+		u.logger.Errorf("failed update order status to confirmed", "error", err.Error())
+		entry.Details["result"] = "ERROR"
+		entry.Details["error"] = err.Error()
+		return nil, nil, errlib.ErrDBQuery()
+	}
+	order.Status = model.ORDER_STATUS_CONFIRMED
+	entry.Details["result"] = model.ORDER_STATUS_CONFIRMED
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_CONFIRMED, order, order.Status, nil)
+
+	return &model.OrderWithItems{Order: *order, Items: items, Payment: authorized}, nil, nil
+}
+
+// ListAdminAudit returns the audit log newest first
+func (u *OrderUsecase) ListAdminAudit(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error) {
+
+	filter.Limit = adminPageLimit(filter.Limit)
+	entries, err := u.repoSQL.GetAdminAuditLog(ctx, filter)
+	if err != nil {
+		u.logger.Errorf("failed in GetAdminAuditLog", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return entries, nil
+}
+
+func adminPageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultAdminPageLimit
+	}
+	if limit > maxAdminPageLimit {
+		return maxAdminPageLimit
+	}
+	return limit
+}
+
+// filter of a search as written to the audit log, unset filters are left out
+func auditFilter(filter model.OrderSearchFilter) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if len(filter.Statuses) > 0 {
+		fields["statuses"] = filter.Statuses
+	}
+	if filter.UserId != "" {
+		fields["user_id"] = filter.UserId
+	}
+	if filter.UserEmail != "" {
+		fields["user_email"] = filter.UserEmail
+	}
+	if filter.Sku != "" {
+		fields["sku"] = filter.Sku
+	}
+	if filter.CreatedFrom != nil {
+		fields["created_from"] = filter.CreatedFrom
+	}
+	if filter.CreatedTo != nil {
+		fields["created_to"] = filter.CreatedTo
+	}
+	return fields
+}
+
+// writes an audit entry of an action that already happened, a failure is logged
+func (u *OrderUsecase) recordAudit(ctx context.Context, entry *model.AdminAuditEntry) {
+	if err := u.repoSQL.InsertAdminAuditEntry(ctx, entry); err != nil {
+		u.logger.Errorf("failed in InsertAdminAuditEntry", "action", entry.Action, "error", err.Error())
+	}
+}
+
+// puts a retried order back to FAILED_RESERVATION, best effort
+func (u *OrderUsecase) restoreFailedReservation(ctx context.Context, orderId uuid.UUID) {
+	if _, err := u.repoSQL.TransitionOrderStatus(ctx, orderId, model.ORDER_STATUS_PENDING, model.ORDER_STATUS_FAILED_RESERVATION); err != nil {
+		u.logger.Errorf("failed update order status to failed reservation", "error", err.Error())
+	}
+}
+
+// releases the reservations of an order and voids its authorized payment, best effort
+func (u *OrderUsecase) releaseOrder(ctx context.Context, orderId uuid.UUID) {
+
+	// without items every reservation and backorder of the order is released
+	_, err := u.inventoryGrpcClient.ReleaseStock(ctx, &inventoryv1.StandardInventoryRequest{
+		OrderId: orderId.String(),
+	})
+	if err != nil {
+		u.logger.Errorf("failed release stock of order "+orderId.String(), "error", err.Error())
+	}
+
+	current, err := u.repoSQL.GetOrderPayment(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderPayment", "error", err.Error())
+		return
+	}
+	if current != nil && current.Status == model.PAYMENT_STATUS_AUTHORIZED {
+		u.voidPayment(ctx, current)
+	}
+}
+
+// inventory items of the order lines, their full quantity is requested
+func orderInventoryItems(items []model.ItemOrder) []*inventoryv1.InventoryItem {
+	inventoryItems := make([]*inventoryv1.InventoryItem, 0, len(items))
+	for _, item := range items {
+		inventoryItems = append(inventoryItems, &inventoryv1.InventoryItem{
+			Sku:          item.Sku,
+			ReqQtyPerUom: item.QuantityPerUom.Float(),
+			Uom:          item.UomCode,
+		})
+	}
+	return inventoryItems
+}
+
+func failedSkus(failed []*model.OrderedItemStockStatus) []string {
+	skus := make([]string, 0, len(failed))
+	for _, f := range failed {
+		skus = append(skus, f.Sku)
+	}
+	return skus
+}
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"testing"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+const mockAdminEmail = "admin@email.com"
+
+func orderWithStatus(status string) *model.Order {
+	order := mockOrder
+	order.Status = status
+	return &order
+}
+
+func TestOrderUsecase_ForceOrderStatus(t *testing.T) {
+	authorized := &model.Payment{OrderId: mockOrderId, Status: model.PAYMENT_STATUS_AUTHORIZED, AuthorizationRef: "auth_1"}
+
+	testCases := []struct {
+		Name           string
+		Request        types.ForceOrderStatusRequest
+		Mock           func(dep *usecaseDeps, provider *mocks.MockPaymentProvider)
+		ExpectedErr    string
+		ExpectedStatus string
+	}{
+		{
+			Name:    "cancelling a confirmed order releases its stock and voids its payment",
+			Request: types.ForceOrderStatusRequest{Status: types.ForceOrderStatusRequestStatusCANCELLED, Reason: "customer called to cancel"},
+			Mock: func(dep *usecaseDeps, provider *mocks.MockPaymentProvider) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), nil)
+				dep.repoSQL.EXPECT().ForceOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CANCELLED, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+					return e.Actor == mockAdminEmail && e.Action == model.ADMIN_ACTION_FORCE_STATUS && *e.OrderId == mockOrderId &&
+						e.Reason == "customer called to cancel" && e.Details["from"] == model.ORDER_STATUS_CONFIRMED && e.Details["released"] == true
+				})).
+					Run(func(_ context.Context, order *model.Order, to string, _ *model.AdminAuditEntry) { order.Status = to }).
+					Return(true, nil)
+				dep.logger.EXPECT().Warnf("order %s forced from %s to %s by %s: %s", mock.Anything)
+				dep.inventoryGrpcClient.EXPECT().ReleaseStock(mock.Anything, mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+					return req.OrderId == mockOrderId.String() && len(req.Items) == 0
+				})).
+					Return(&inventoryv1.InventoryReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(authorized, nil)
+				provider.EXPECT().Void(mock.Anything, "auth_1").
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Status == model.PAYMENT_STATUS_VOIDED
+				})).
+					Return(nil)
+			},
+			ExpectedStatus: model.ORDER_STATUS_CANCELLED,
+		},
+		{
+			Name:    "fulfilling a confirmed order only changes its status",
+			Request: types.ForceOrderStatusRequest{Status: types.ForceOrderStatusRequestStatusFULFILLED, Reason: "captured by hand in the provider dashboard"},
+			Mock: func(dep *usecaseDeps, provider *mocks.MockPaymentProvider) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), nil)
+				dep.repoSQL.EXPECT().ForceOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_FULFILLED, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+					return e.Details["released"] == false
+				})).
+					Run(func(_ context.Context, order *model.Order, to string, _ *model.AdminAuditEntry) { order.Status = to }).
+					Return(true, nil)
+				dep.logger.EXPECT().Warnf("order %s forced from %s to %s by %s: %s", mock.Anything)
+			},
+			ExpectedStatus: model.ORDER_STATUS_FULFILLED,
+		},
+		{
+			Name:    "order already in the status",
+			Request: types.ForceOrderStatusRequest{Status: types.ForceOrderStatusRequestStatusCONFIRMED, Reason: "stuck"},
+			Mock: func(dep *usecaseDeps, provider *mocks.MockPaymentProvider) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name:    "order moved by a concurrent request",
+			Request: types.ForceOrderStatusRequest{Status: types.ForceOrderStatusRequestStatusCANCELLED, Reason: "stuck"},
+			Mock: func(dep *usecaseDeps, provider *mocks.MockPaymentProvider) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_PENDING), nil)
+				dep.repoSQL.EXPECT().ForceOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CANCELLED, mock.Anything).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderModified,
+		},
+		{
+			Name:    "unknown order",
+			Request: types.ForceOrderStatusRequest{Status: types.ForceOrderStatusRequestStatusCANCELLED, Reason: "stuck"},
+			Mock: func(dep *usecaseDeps, provider *mocks.MockPaymentProvider) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:              loggerMocks.NewMockLogger(t),
+				repoSQL:             mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient: grpcMocks.NewMockInvClient(t),
+			}
+			provider := mocks.NewMockPaymentProvider(t)
+
+			tc.Mock(&deps, provider)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, nil, nil, nil, mockQuoteSigner, provider, mockTaxCalculator, nil)
+			result, err := usecase.ForceOrderStatus(context.Background(), mockOrderId, tc.Request, mockAdminEmail)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedStatus, result.Status)
+		})
+	}
+}
+
+func TestOrderUsecase_RetryReservation(t *testing.T) {
+	isAuthorized := func(p *model.Payment) bool {
+		return p.Status == model.PAYMENT_STATUS_AUTHORIZED && p.AuthorizationRef != ""
+	}
+	// the audit entry of the retry with its result
+	auditedAs := func(result string) interface{} {
+		return mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+			return e.Action == model.ADMIN_ACTION_RETRY_RESERVATION && e.Actor == mockAdminEmail && e.Details["result"] == result
+		})
+	}
+	// every line is requested again in full with ALL_OR_NOTHING
+	reservesOrder := mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+		return req.OrderId == mockOrderId.String() && req.ReservationPolicy == inventoryv1.ReservationPolicy_ALL_OR_NOTHING &&
+			len(req.Items) == 2 && req.Items[0].Sku == "OLIVE-OIL-1L" && req.Items[0].ReqQtyPerUom == 0.5 && req.Items[1].Uom == "EA"
+	})
+
+	testCases := []struct {
+		Name           string
+		Mock           func(dep *usecaseDeps)
+		ExpectedErr    string
+		ExpectedShort  bool
+		ExpectedStatus string
+	}{
+		{
+			Name: "stock is back, the order is confirmed",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_FAILED_RESERVATION), mockItems, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_PENDING).
+					Return(true, nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, reservesOrder).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.AnythingOfType("*model.Payment")).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(isAuthorized)).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+				dep.repoSQL.EXPECT().InsertAdminAuditEntry(mock.Anything, auditedAs(model.ORDER_STATUS_CONFIRMED)).
+					Return(nil)
+			},
+			ExpectedStatus: model.ORDER_STATUS_CONFIRMED,
+		},
+		{
+			Name: "still short, the order goes back to failed reservation",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_FAILED_RESERVATION), mockItems, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_PENDING).
+					Return(true, nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, reservesOrder).
+					Return(mockReserveFailedResponse, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_PENDING, model.ORDER_STATUS_FAILED_RESERVATION).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().InsertAdminAuditEntry(mock.Anything, auditedAs(model.ORDER_STATUS_FAILED_RESERVATION)).
+					Return(nil)
+			},
+			ExpectedShort:  true,
+			ExpectedStatus: model.ORDER_STATUS_FAILED_RESERVATION,
+		},
+		{
+			Name: "inventory unreachable",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_FAILED_RESERVATION), mockItems, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_PENDING).
+					Return(true, nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, reservesOrder).
+					Return(nil, errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed reserve stock to inventory service", mock.Anything)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_PENDING, model.ORDER_STATUS_FAILED_RESERVATION).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().InsertAdminAuditEntry(mock.Anything, auditedAs("ERROR")).
+					Return(nil)
+			},
+			// the reservation error code has no registry entry and surfaces as an internal error
+			ExpectedErr: errlib.ErrCodeInternalServer,
+		},
+		{
+			Name: "order that did not fail reservation",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotReservable,
+		},
+		{
+			Name: "order retried by a concurrent request",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_FAILED_RESERVATION), mockItems, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_FAILED_RESERVATION, model.ORDER_STATUS_PENDING).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderModified,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:              loggerMocks.NewMockLogger(t),
+				repoSQL:             mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient: grpcMocks.NewMockInvClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, short, err := usecase.RetryReservation(context.Background(), mockOrderId, mockAdminEmail)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedShort, len(short) > 0)
+			assert.Equal(t, tc.ExpectedStatus, result.Status)
+		})
+	}
+}
+
+func TestOrderUsecase_ExportOrders(t *testing.T) {
+	filter := model.OrderSearchFilter{Statuses: []string{model.ORDER_STATUS_CONFIRMED}}
+
+	t.Run("export is audited before the orders are streamed", func(t *testing.T) {
+		repo := mocks.NewMockIOrderSQLRepository(t)
+		audited := false
+		repo.EXPECT().InsertAdminAuditEntry(mock.Anything, mock.MatchedBy(func(e *model.AdminAuditEntry) bool {
+			return e.Action == model.ADMIN_ACTION_EXPORT_ORDERS && e.Actor == mockAdminEmail && e.OrderId == nil && e.Details["filter"] != nil
+		})).
+			Run(func(_ context.Context, _ *model.AdminAuditEntry) { audited = true }).
+			Return(nil)
+		repo.EXPECT().StreamOrders(mock.Anything, filter, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ model.OrderSearchFilter, fn func(*model.OrderWithItems) error) error {
+				assert.True(t, audited)
+				return fn(&model.OrderWithItems{Order: mockOrder, Items: mockItems})
+			})
+
+		usecase := NewOrderUsecase(repo, loggerMocks.NewMockLogger(t), nil, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+		streamed := []*model.OrderWithItems{}
+		err := usecase.ExportOrders(context.Background(), filter, mockAdminEmail, func(order *model.OrderWithItems) error {
+			streamed = append(streamed, order)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, streamed, 1)
+		assert.Len(t, streamed[0].Items, 2)
+	})
+
+	t.Run("nothing is streamed when the audit fails", func(t *testing.T) {
+		repo := mocks.NewMockIOrderSQLRepository(t)
+		repo.EXPECT().InsertAdminAuditEntry(mock.Anything, mock.Anything).
+			Return(errors.New("db down"))
+		logger := loggerMocks.NewMockLogger(t)
+		logger.EXPECT().Errorf("failed in InsertAdminAuditEntry", mock.Anything)
+
+		usecase := NewOrderUsecase(repo, logger, nil, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+		err := usecase.ExportOrders(context.Background(), filter, mockAdminEmail, func(order *model.OrderWithItems) error {
+			t.Fatal("no order is expected")
+			return nil
+		})
+
+		appErr, ok := err.(*errlib.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errlib.ErrCodeDBQuery, appErr.Code)
+	})
+}
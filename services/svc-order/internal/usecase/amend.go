@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"fmt"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"slices"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statuses whose items can still be changed, a CONFIRMED order holds its reservations
+var amendableStatuses = []string{model.ORDER_STATUS_PENDING, model.ORDER_STATUS_CONFIRMED}
+
+// AmendOrderItems changes the items of a PENDING or CONFIRMED order to the requested ones.
+// the inventory service reserves the increases and releases the decreases in one transaction,
+// then the items, total and history entry are written in one transaction. the reservation
+// change is reverted when the order cannot be written. items keep their price, added skus are
+// priced like a new order. a line changed to a new quantity is reserved in full, short
+// lines that keep their quantity stay short. the promotion of the order is applied again to
+// the amended items, without its validity and usage limits, and the tax of its shipping address
+// is worked out again. a total above the authorized amount needs a new authorization. only the customer who
+// placed the order can amend it
+func (u *OrderUsecase) AmendOrderItems(ctx context.Context, customer model.Customer, orderId uuid.UUID, request types.AmendOrderItemsRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, nil, errlib.ErrDBQuery()
+	}
+	if order == nil || !placedBy(order, customer) {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if !slices.Contains(amendableStatuses, order.Status) {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeOrderNotAmendable)
+	}
+
+	current := map[string]model.ItemOrder{}
+	for _, item := range items {
+		current[item.Sku] = item
+	}
+
+	if order.Status == model.ORDER_STATUS_CONFIRMED {
+		if err := u.checkPackedQuantities(ctx, orderId, items, request); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// added skus are priced like a new order
+	var added []types.StockItemRequest
+	for _, item := range request.OrderItems {
+		if _, ok := current[item.Sku]; !ok {
+			added = append(added, item)
+		}
+	}
+	// the promotion needs the category and the tax needs the tax category of every item
+	lookup := added
+	if order.PromotionId != nil || order.ShippingAddress != nil {
+		lookup = request.OrderItems
+	}
+	prices := map[string]*inventoryv1.InventoryStatus{}
+	taxCategories := map[string]string{}
+	if len(lookup) > 0 {
+		stockStatus, err := u.checkStock(ctx, toInventoryItems(lookup))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, s := range stockStatus.Items {
+			prices[s.Sku] = s
+		}
+		taxCategories = stockTaxCategories(stockStatus.Items)
+		for _, item := range added {
+			if _, ok := prices[item.Sku]; !ok {
+				return nil, nil, errlib.ErrValidationError([]map[string]interface{}{
+					{"sku": item.Sku + " does not exist"},
+				})
+			}
+		}
+	}
+
+	var (
+		amendment model.OrderAmendment
+		changes   []*inventoryv1.ReservationChange
+		amended   []model.ItemQuantityChange
+		result    []model.ItemOrder
+		requested = map[string]bool{}
+	)
+	for _, req := range request.OrderItems {
+		requested[req.Sku] = true
+		qty := fixed.NewF(req.QuantityPerUom)
+
+		item, ok := current[req.Sku]
+		if !ok {
+			price := prices[req.Sku]
+			item = model.ItemOrder{
+				Id:             uuid.New(),
+				OrderId:        orderId,
+				Sku:            req.Sku,
+				QuantityPerUom: qty,
+				PricePerUom:    fixed.NewF(price.SkuPrice),
+				UomCode:        price.SkuUom,
+			}
+			amendment.Added = append(amendment.Added, item)
+			changes = append(changes, &inventoryv1.ReservationChange{Sku: req.Sku, QuantityDelta: qty.Float()})
+			amended = append(amended, model.ItemQuantityChange{Sku: req.Sku, From: fixed.ZERO, To: qty})
+			result = append(result, item)
+			continue
+		}
+
+		if !qty.Equal(item.QuantityPerUom) {
+			delta := qty.Sub(reservedQuantity(item))
+			amended = append(amended, model.ItemQuantityChange{Sku: req.Sku, From: item.QuantityPerUom, To: qty})
+
+			item.QuantityPerUom = qty
+			if item.ConfirmedQuantity != nil {
+				confirmed, short := qty, fixed.ZERO
+				item.ConfirmedQuantity = &confirmed
+				item.ShortQuantity = &short
+			}
+			amendment.Changed = append(amendment.Changed, item)
+			if !delta.Equal(fixed.ZERO) {
+				changes = append(changes, &inventoryv1.ReservationChange{Sku: req.Sku, QuantityDelta: delta.Float()})
+			}
+		}
+		result = append(result, item)
+	}
+	for _, item := range items {
+		if requested[item.Sku] {
+			continue
+		}
+		amendment.Removed = append(amendment.Removed, item.Id)
+		amended = append(amended, model.ItemQuantityChange{Sku: item.Sku, From: item.QuantityPerUom, To: fixed.ZERO})
+		if reserved := reservedQuantity(item); reserved.GreaterThan(fixed.ZERO) {
+			changes = append(changes, &inventoryv1.ReservationChange{Sku: item.Sku, QuantityDelta: -reserved.Float()})
+		}
+	}
+
+	// same items as before, nothing to amend
+	if len(amended) == 0 {
+		return &model.OrderWithItems{Order: *order, Items: items}, nil, nil
+	}
+
+	if len(changes) > 0 {
+		resp, err := u.inventoryGrpcClient.AmendReservation(ctx, &inventoryv1.AmendReservationRequest{
+			OrderId: orderId.String(),
+			Changes: changes,
+		})
+		if err != nil {
+			// bundle skus or a release the order does not hold, the message tells which
+			if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+				return nil, nil, errlib.ErrValidationError([]map[string]interface{}{
+					{"order_items": st.Message()},
+				})
+			}
+
+			u.logger.Errorf("failed amend reservation to inventory service", "error", err.Error())
+			return nil, nil, errlib.ErrInternalServer(err)
+		}
+
+		// nothing was changed, the order is returned as it is
+		if failed := resp.GetFailedProcessedItems().GetItems(); len(failed) > 0 {
+			return &model.OrderWithItems{Order: *order, Items: items}, failed, nil
+		}
+	}
+
+	total := fixed.NewF(0)
+	for _, item := range result {
+		total = total.Add(reservedQuantity(item).Mul(item.PricePerUom))
+	}
+	if order.PromotionId != nil {
+		if amendment.Discounts, err = u.amendedDiscounts(ctx, *order.PromotionId, result, prices); err != nil {
+			u.revertReservationChanges(ctx, orderId, changes)
+			return nil, nil, err
+		}
+		total = total.Sub(discountTotal(amendment.Discounts))
+	}
+	priced := *order
+	priced.TotalAmount = total
+	if amendment.Taxes, err = u.taxOrder(ctx, &priced, result, amendment.Discounts, taxCategories); err != nil {
+		u.revertReservationChanges(ctx, orderId, changes)
+		return nil, nil, err
+	}
+	total = priced.TotalAmount
+	amendment.History = model.OrderHistory{
+		OrderId: orderId,
+		Event:   model.ORDER_EVENT_ITEMS_AMENDED,
+		Details: map[string]interface{}{
+			"changes":        amended,
+			"previous_total": order.TotalAmount,
+			"total_amount":   total,
+		},
+	}
+
+	// a total above the authorized amount is authorized again, the previous authorization
+	// is voided once the order is written
+	var authorized, replaced *model.Payment
+	if total.GreaterThan(order.TotalAmount) {
+		current, err := u.repoSQL.GetOrderPayment(ctx, orderId)
+		if err != nil {
+			u.revertReservationChanges(ctx, orderId, changes)
+			u.logger.Errorf("failed in GetOrderPayment", "error", err.Error())
+			return nil, nil, errlib.ErrDBQuery()
+		}
+		if current != nil && current.Status == model.PAYMENT_STATUS_AUTHORIZED && total.GreaterThan(current.Amount) {
+			amended := *order
+			amended.TotalAmount = total
+			if authorized, err = u.authorizePayment(ctx, amended, current.PaymentMethod); err != nil {
+				u.revertReservationChanges(ctx, orderId, changes)
+				return nil, nil, err
+			}
+			replaced = current
+		}
+	}
+	order.TotalAmount = total
+	order.TaxAmount = priced.TaxAmount
+
+	written, err := u.repoSQL.AmendOrderItems(ctx, order, amendableStatuses, amendment)
+	if err != nil || !written {
+		u.revertReservationChanges(ctx, orderId, changes)
+		if authorized != nil {
+			u.voidPayment(ctx, authorized)
+		}
+		if err != nil {
+			u.logger.Errorf("failed in AmendOrderItems", "error", err.Error())
+			return nil, nil, errlib.ErrDBQuery()
+		}
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+	if replaced != nil {
+		u.voidPayment(ctx, replaced)
+	}
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_AMENDED, order, order.Status, map[string]interface{}{
+		"changes": amended,
+	})
+
+	return &model.OrderWithItems{Order: *order, Items: result, Payment: authorized}, nil, nil
+}
+
+// quantity of an item held by the inventory service, short items only hold the confirmed part
+func reservedQuantity(item model.ItemOrder) fixed.Fixed {
+	if item.ConfirmedQuantity != nil {
+		return *item.ConfirmedQuantity
+	}
+	return item.QuantityPerUom
+}
+
+// discounts of the promotion of an order on its amended items, prices holds the category of every item
+func (u *OrderUsecase) amendedDiscounts(ctx context.Context, promotionId uuid.UUID, items []model.ItemOrder, prices map[string]*inventoryv1.InventoryStatus) ([]model.OrderDiscount, error) {
+
+	promo, err := u.repoSQL.GetPromotionById(ctx, promotionId)
+	if err != nil {
+		u.logger.Errorf("failed in GetPromotionById", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if promo == nil {
+		return nil, errlib.ErrInternalServer(fmt.Errorf("promotion %s does not exist", promotionId))
+	}
+
+	categories := map[string]string{}
+	for sku, s := range prices {
+		categories[sku] = s.CategoryId
+	}
+	discounts, err := reappliedDiscounts(promo, items, categories)
+	if err != nil {
+		u.logger.Errorf("failed to apply promotion", "error", err.Error())
+		return nil, errlib.ErrInternalServer(err)
+	}
+	return discounts, nil
+}
+
+// lines of a CONFIRMED order that are packed or shipped cannot be removed or go below the packed quantity,
+// the order items would no longer match the shipments
+func (u *OrderUsecase) checkPackedQuantities(ctx context.Context, orderId uuid.UUID, items []model.ItemOrder, request types.AmendOrderItemsRequest) error {
+
+	packed, err := u.repoSQL.GetShipmentQuantities(ctx, orderId, shipmentStatuses)
+	if err != nil {
+		u.logger.Errorf("failed in GetShipmentQuantities", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+
+	requested := map[string]fixed.Fixed{}
+	for _, req := range request.OrderItems {
+		requested[req.Sku] = fixed.NewF(req.QuantityPerUom)
+	}
+	for _, item := range items {
+		quantity := packed[item.Id]
+		if !quantity.GreaterThan(fixed.ZERO) {
+			continue
+		}
+		if qty, ok := requested[item.Sku]; !ok || qty.LessThan(quantity) {
+			return errlib.ErrValidationError([]map[string]interface{}{
+				{"quantity": fmt.Sprintf("%s cannot go below its packed %s", item.Sku, quantity.String())},
+			})
+		}
+	}
+
+	return nil
+}
+
+// applies the inverse of changes, best effort
+func (u *OrderUsecase) revertReservationChanges(ctx context.Context, orderId uuid.UUID, changes []*inventoryv1.ReservationChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	inverse := make([]*inventoryv1.ReservationChange, 0, len(changes))
+	for _, c := range changes {
+		inverse = append(inverse, &inventoryv1.ReservationChange{Sku: c.Sku, QuantityDelta: -c.QuantityDelta})
+	}
+
+	resp, err := u.inventoryGrpcClient.AmendReservation(ctx, &inventoryv1.AmendReservationRequest{
+		OrderId: orderId.String(),
+		Changes: inverse,
+	})
+	if err == nil && len(resp.GetFailedProcessedItems().GetItems()) > 0 {
+		err = errors.New("stock of a released sku was reserved by another order")
+	}
+	if err != nil {
+		u.logger.Errorf("failed to revert reservation changes of order "+orderId.String(), "error", err.Error())
+	}
+}
@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+	inventoryv1 "pb_schemas/inventory/v1"
+)
+
+func TestOrderUsecase_AmendOrderItems(t *testing.T) {
+	// the usecase updates the order it read, every case gets its own copy
+	orderWithStatus := func(status string) *model.Order {
+		order := mockOrder
+		order.Status = status
+		return &order
+	}
+
+	// OLIVE-OIL-1L 0.5 -> 1, TSHIRT-M-WHITE removed, MUG-BLUE added
+	amendRequest := types.AmendOrderItemsRequest{
+		OrderItems: []types.StockItemRequest{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: 1, Uom: "L"},
+			{Sku: "MUG-BLUE", QuantityPerUom: 3, Uom: "EA"},
+		},
+	}
+	mugStock := &inventoryv1.InventoryStatusResponse{
+		Items: []*inventoryv1.InventoryStatus{
+			{Sku: "MUG-BLUE", RequestedQuantity: 3, SkuPrice: 10, SkuUom: "EA"},
+		},
+	}
+	expectedChanges := map[string]float64{"OLIVE-OIL-1L": 0.5, "MUG-BLUE": 3, "TSHIRT-M-WHITE": -2}
+	matchChanges := func(expected map[string]float64) func(req *inventoryv1.AmendReservationRequest) bool {
+		return func(req *inventoryv1.AmendReservationRequest) bool {
+			if len(req.Changes) != len(expected) {
+				return false
+			}
+			for _, c := range req.Changes {
+				if expected[c.Sku] != c.QuantityDelta {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	// the authorization of the order before it is amended
+	previousAuth, _ := mockPaymentProvider.Authorize(context.Background(), payment.AuthorizeRequest{
+		OrderId:        mockOrderId,
+		Amount:         fixed.NewS("100"),
+		Currency:       "USD",
+		IdempotencyKey: "amend-previous-authorization",
+	})
+	previousPaymentId := uuid.New()
+	authorizedPayment := func() *model.Payment {
+		return &model.Payment{
+			Id:               previousPaymentId,
+			OrderId:          mockOrderId,
+			Status:           model.PAYMENT_STATUS_AUTHORIZED,
+			Amount:           fixed.NewS("100"),
+			Currency:         "USD",
+			AuthorizationRef: previousAuth.Reference,
+		}
+	}
+
+	inverseChanges := map[string]float64{}
+	for sku, delta := range expectedChanges {
+		inverseChanges[sku] = -delta
+	}
+
+	testCases := []struct {
+		Name          string
+		Request       types.AmendOrderItemsRequest
+		Mock          func(dep *usecaseDeps)
+		ExpectedErr   string
+		ExpectedTotal string
+		ExpectFailed  bool
+	}{
+		{
+			Name:    "successful amendment reserves increases and releases decreases",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mugStock, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(expectedChanges))).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().AmendOrderItems(mock.Anything, mock.AnythingOfType("*model.Order"), amendableStatuses, mock.MatchedBy(func(a model.OrderAmendment) bool {
+					return len(a.Added) == 1 && len(a.Changed) == 1 && len(a.Removed) == 1 &&
+						a.History.Event == model.ORDER_EVENT_ITEMS_AMENDED
+				})).
+					Return(true, nil)
+			},
+			ExpectedTotal: "80",
+		},
+		{
+			Name: "higher total is authorized again and the previous authorization voided",
+			Request: types.AmendOrderItemsRequest{
+				OrderItems: []types.StockItemRequest{
+					{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+					{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+					{Sku: "MUG-BLUE", QuantityPerUom: 3, Uom: "EA"},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mugStock, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(map[string]float64{"MUG-BLUE": 3}))).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(authorizedPayment(), nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Amount.Equal(fixed.NewS("105"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Id != previousPaymentId && p.Status == model.PAYMENT_STATUS_AUTHORIZED
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().AmendOrderItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Id == previousPaymentId && p.Status == model.PAYMENT_STATUS_VOIDED
+				})).
+					Return(nil)
+			},
+			ExpectedTotal: "105",
+		},
+		{
+			Name: "same items leave the order untouched",
+			Request: types.AmendOrderItemsRequest{
+				OrderItems: []types.StockItemRequest{
+					{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+					{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+			},
+			ExpectedTotal: "100",
+		},
+		{
+			Name:    "packed line cannot be removed",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("1")}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "line cannot go below its shipped quantity",
+			Request: types.AmendOrderItemsRequest{
+				OrderItems: []types.StockItemRequest{
+					{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+					{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 1, Uom: "EA"},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("2")}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name:    "order not found",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(nil, nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+		{
+			Name:    "cancelled order cannot be amended",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CANCELLED), mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotAmendable,
+		},
+		{
+			Name:    "insufficient stock leaves the order untouched",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mugStock, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.Anything).
+					Return(mockReserveFailedResponse, nil)
+			},
+			ExpectedTotal: "100",
+			ExpectFailed:  true,
+		},
+		{
+			Name:    "order of another customer is not found",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				order := orderWithStatus(model.ORDER_STATUS_CONFIRMED)
+				order.UserId = "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f"
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(order, mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+		{
+			Name:    "concurrent modification reverts the reservation change",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mugStock, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(expectedChanges))).
+					Return(mockReserveSuccessResponse, nil).Once()
+				dep.repoSQL.EXPECT().AmendOrderItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(inverseChanges))).
+					Return(mockReserveSuccessResponse, nil).Once()
+			},
+			ExpectedErr: errlib.ErrCodeOrderModified,
+		},
+		{
+			Name:    "database error reverts the reservation change",
+			Request: amendRequest,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(orderWithStatus(model.ORDER_STATUS_CONFIRMED), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mugStock, nil)
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(expectedChanges))).
+					Return(mockReserveSuccessResponse, nil).Once()
+				dep.repoSQL.EXPECT().AmendOrderItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, errors.New("connection reset"))
+				dep.inventoryGrpcClient.EXPECT().AmendReservation(mock.Anything, mock.MatchedBy(matchChanges(inverseChanges))).
+					Return(mockReserveSuccessResponse, nil).Once()
+				dep.logger.EXPECT().Errorf("failed in AmendOrderItems", mock.Anything, mock.Anything)
+			},
+			ExpectedErr: errlib.ErrCodeDBQuery,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, failedItems, err := usecase.AmendOrderItems(context.Background(), mockCustomer, mockOrderId, tc.Request)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, result.TotalAmount.Equal(fixed.NewS(tc.ExpectedTotal)))
+			assert.Equal(t, tc.ExpectFailed, len(failedItems) > 0)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/repository"
+	grpc "ops-monorepo/shared-libs/grpc/client"
+	"ops-monorepo/shared-libs/logger"
+
+	"github.com/robaho/fixed"
+)
+
+// most lines a cart holds
+const maxCartItems = 100
+
+// a checkout that has not finished by then no longer blocks the next one
+const cartCheckoutLockTTL = time.Minute
+
+type (
+	ICartUsecase interface {
+		GetCart(ctx context.Context, owner model.CartOwner) (*model.Cart, error)
+		AddCartItem(ctx context.Context, owner model.CartOwner, request types.StockItemRequest) (*model.Cart, error)
+		UpdateCartItem(ctx context.Context, owner model.CartOwner, sku string, request types.UpdateCartItemRequest) (*model.Cart, error)
+		RemoveCartItem(ctx context.Context, owner model.CartOwner, sku string) (*model.Cart, error)
+		MergeCart(ctx context.Context, guest, owner model.CartOwner) (*model.Cart, error)
+		Checkout(ctx context.Context, customer model.Customer, request types.CartCheckoutRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)
+	}
+
+	// CartUsecase keeps the carts of users and guests, prices and availability are read from the inventory
+	// service each time a cart is shown and the order of a checkout is placed through the order usecase
+	CartUsecase struct {
+		logger              logger.Logger
+		repoCart            repository.ICartRepository
+		orders              IOrderUsecase
+		inventoryGrpcClient grpc.InvClient
+		now                 func() time.Time
+	}
+)
+
+func NewCartUsecase(cart repository.ICartRepository, log logger.Logger, orders IOrderUsecase, invClient grpc.InvClient) ICartUsecase {
+	return &CartUsecase{
+		logger:              log,
+		repoCart:            cart,
+		orders:              orders,
+		inventoryGrpcClient: invClient,
+		now:                 time.Now,
+	}
+}
+
+// GetCart returns the cart of owner with the current price and availability of its lines
+func (u *CartUsecase) GetCart(ctx context.Context, owner model.CartOwner) (*model.Cart, error) {
+
+	items, err := u.repoCart.GetCart(ctx, owner)
+	if err != nil {
+		u.logger.Errorf("failed in GetCart", "error", err.Error())
+		return nil, errlib.ErrStorageAccess()
+	}
+
+	return u.pricedCart(ctx, owner, items)
+}
+
+// AddCartItem adds a line to the cart, a sku already in the cart has the quantity added to its line
+func (u *CartUsecase) AddCartItem(ctx context.Context, owner model.CartOwner, request types.StockItemRequest) (*model.Cart, error) {
+
+	// only skus the inventory knows are added
+	stockStatus, err := u.checkStock(ctx, []*inventoryv1.InventoryItem{
+		{Sku: request.Sku, ReqQtyPerUom: request.QuantityPerUom, Uom: request.Uom},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(stockStatus.Items) == 0 {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"sku": request.Sku + " does not exist"},
+		})
+	}
+
+	now := u.now()
+	items, err := u.repoCart.UpdateCart(ctx, owner, func(items map[string]model.CartItem) error {
+		item, ok := items[request.Sku]
+		if !ok {
+			if len(items) >= maxCartItems {
+				return errlib.NewAppError(errlib.ErrCodeCartFull)
+			}
+			items[request.Sku] = model.CartItem{
+				Sku:            request.Sku,
+				Uom:            request.Uom,
+				QuantityPerUom: fixed.NewF(request.QuantityPerUom),
+				AddedAt:        now,
+				UpdatedAt:      now,
+			}
+			return nil
+		}
+
+		if item.Uom != request.Uom {
+			return errlib.ErrValidationError([]map[string]interface{}{
+				{"uom": request.Sku + " is in the cart in " + item.Uom},
+			})
+		}
+		item.QuantityPerUom = item.QuantityPerUom.Add(fixed.NewF(request.QuantityPerUom))
+		item.UpdatedAt = now
+		items[request.Sku] = item
+		return nil
+	})
+	if err != nil {
+		return nil, u.cartError("UpdateCart", err)
+	}
+
+	return u.pricedCart(ctx, owner, items)
+}
+
+// UpdateCartItem sets the quantity of a line of the cart
+func (u *CartUsecase) UpdateCartItem(ctx context.Context, owner model.CartOwner, sku string, request types.UpdateCartItemRequest) (*model.Cart, error) {
+
+	now := u.now()
+	items, err := u.repoCart.UpdateCart(ctx, owner, func(items map[string]model.CartItem) error {
+		item, ok := items[sku]
+		if !ok {
+			return errlib.NewAppError(errlib.ErrCodeDataNotFound)
+		}
+		item.QuantityPerUom = fixed.NewF(request.QuantityPerUom)
+		item.UpdatedAt = now
+		items[sku] = item
+		return nil
+	})
+	if err != nil {
+		return nil, u.cartError("UpdateCart", err)
+	}
+
+	return u.pricedCart(ctx, owner, items)
+}
+
+// RemoveCartItem removes a line from the cart
+func (u *CartUsecase) RemoveCartItem(ctx context.Context, owner model.CartOwner, sku string) (*model.Cart, error) {
+
+	items, err := u.repoCart.UpdateCart(ctx, owner, func(items map[string]model.CartItem) error {
+		if _, ok := items[sku]; !ok {
+			return errlib.NewAppError(errlib.ErrCodeDataNotFound)
+		}
+		delete(items, sku)
+		return nil
+	})
+	if err != nil {
+		return nil, u.cartError("UpdateCart", err)
+	}
+
+	return u.pricedCart(ctx, owner, items)
+}
+
+// MergeCart moves the lines of a guest cart into the cart of the user who signed in. quantities of a sku in both
+// carts are added up, the line of the user is kept when the uoms differ. a guest cart that expired merges nothing
+func (u *CartUsecase) MergeCart(ctx context.Context, guest, owner model.CartOwner) (*model.Cart, error) {
+
+	now := u.now()
+	items, err := u.repoCart.MergeCart(ctx, guest, owner, func(to, from map[string]model.CartItem) error {
+		for sku, item := range from {
+			existing, ok := to[sku]
+			switch {
+			case !ok:
+				if len(to) >= maxCartItems {
+					return errlib.NewAppError(errlib.ErrCodeCartFull)
+				}
+				to[sku] = item
+			case existing.Uom == item.Uom:
+				existing.QuantityPerUom = existing.QuantityPerUom.Add(item.QuantityPerUom)
+				existing.UpdatedAt = now
+				to[sku] = existing
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, u.cartError("MergeCart", err)
+	}
+
+	return u.pricedCart(ctx, owner, items)
+}
+
+// Checkout places an order for the lines of the cart. the cart is locked while the order is placed so a second
+// checkout of the same cart is refused instead of ordering twice. the ordered lines leave the cart once the
+// order is placed, the cart is kept as it is when the order failed. the order belongs to the customer who owns
+// the cart
+func (u *CartUsecase) Checkout(ctx context.Context, customer model.Customer, request types.CartCheckoutRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+
+	owner := model.CartOwner{UserEmail: customer.Email}
+	token, ok, err := u.repoCart.LockCart(ctx, owner, cartCheckoutLockTTL)
+	if err != nil {
+		u.logger.Errorf("failed in LockCart", "error", err.Error())
+		return nil, nil, errlib.ErrStorageAccess()
+	}
+	if !ok {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeCartCheckoutInProgress)
+	}
+	defer func() {
+		if err := u.repoCart.UnlockCart(ctx, owner, token); err != nil {
+			u.logger.Errorf("failed in UnlockCart", "error", err.Error())
+		}
+	}()
+
+	items, err := u.repoCart.GetCart(ctx, owner)
+	if err != nil {
+		u.logger.Errorf("failed in GetCart", "error", err.Error())
+		return nil, nil, errlib.ErrStorageAccess()
+	}
+	if len(items) == 0 {
+		return nil, nil, errlib.NewAppError(errlib.ErrCodeCartEmpty)
+	}
+
+	orderRequest := types.OrderRequest{
+		AllowBackorder:    request.AllowBackorder,
+		CouponCode:        request.CouponCode,
+		PaymentMethod:     request.PaymentMethod,
+		ReservationPolicy: request.ReservationPolicy,
+		ShippingAddress:   request.ShippingAddress,
+	}
+	for _, item := range items {
+		orderRequest.OrderItems = append(orderRequest.OrderItems, types.StockItemRequest{
+			Sku:            item.Sku,
+			Uom:            item.Uom,
+			QuantityPerUom: item.QuantityPerUom.Float(),
+		})
+	}
+
+	order, failed, err := u.orders.NewOrder(ctx, customer, orderRequest)
+	if err != nil || len(failed) > 0 {
+		return order, failed, err
+	}
+
+	// the order is placed, a line changed by another request meanwhile stays in the cart
+	ordered := make(map[string]model.CartItem, len(items))
+	for _, item := range items {
+		ordered[item.Sku] = item
+	}
+	_, err = u.repoCart.UpdateCart(ctx, owner, func(current map[string]model.CartItem) error {
+		for sku, item := range current {
+			if line, ok := ordered[sku]; ok && line.Uom == item.Uom && line.QuantityPerUom.Equal(item.QuantityPerUom) {
+				delete(current, sku)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		u.logger.Errorf("failed to clear cart of order %s: %v", order.Order.Id, err)
+	}
+
+	return order, nil, nil
+}
+
+// cart of owner with the price and availability of each line from the inventory service
+func (u *CartUsecase) pricedCart(ctx context.Context, owner model.CartOwner, items []model.CartItem) (*model.Cart, error) {
+
+	cart := &model.Cart{
+		Items:    []model.CartLine{},
+		Currency: orderCurrency,
+		Subtotal: fixed.NewF(0),
+	}
+	if owner.UserEmail == "" {
+		guestId := owner.GuestId
+		cart.CartId = &guestId
+	}
+	if len(items) == 0 {
+		return cart, nil
+	}
+
+	inventoryItems := make([]*inventoryv1.InventoryItem, 0, len(items))
+	for _, item := range items {
+		inventoryItems = append(inventoryItems, &inventoryv1.InventoryItem{
+			Sku:          item.Sku,
+			ReqQtyPerUom: item.QuantityPerUom.Float(),
+			Uom:          item.Uom,
+		})
+	}
+	stockStatus, err := u.checkStock(ctx, inventoryItems)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]*inventoryv1.InventoryStatus, len(stockStatus.Items))
+	for _, s := range stockStatus.Items {
+		statuses[s.Sku] = s
+	}
+
+	for _, item := range items {
+		line := model.CartLine{CartItem: item, Status: model.CART_LINE_UNAVAILABLE}
+		// skus the inventory no longer knows are left out of the subtotal
+		if s, ok := statuses[item.Sku]; ok {
+			line.PricePerUom = fixed.NewF(s.SkuPrice)
+			line.Amount = item.QuantityPerUom.Mul(line.PricePerUom)
+			line.AvailableQuantity = s.AvailableQuantity
+			line.Status = model.CART_LINE_OUT_OF_STOCK
+			if s.AvailableQuantity >= item.QuantityPerUom.Float() {
+				line.Status = model.CART_LINE_IN_STOCK
+			}
+			cart.Subtotal = cart.Subtotal.Add(line.Amount)
+		}
+		cart.Items = append(cart.Items, line)
+	}
+
+	return cart, nil
+}
+
+func (u *CartUsecase) checkStock(ctx context.Context, inventoryItems []*inventoryv1.InventoryItem) (*inventoryv1.InventoryStatusResponse, error) {
+
+	stockStatus, err := u.inventoryGrpcClient.CheckStock(ctx, &inventoryv1.StandardInventoryRequest{
+		Items: inventoryItems,
+	})
+	if err != nil {
+		u.logger.Errorf("failed check stock to inventory service", map[string]interface{}{"error": err})
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	return stockStatus, nil
+}
+
+// errors of the business rules in the update functions are returned as they are, the others are storage errors
+func (u *CartUsecase) cartError(op string, err error) error {
+	var appErr *errlib.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	u.logger.Errorf("failed in "+op, "error", err.Error())
+	return errlib.ErrStorageAccess()
+}
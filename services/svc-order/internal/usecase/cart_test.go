@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+var (
+	mockCartNow   = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	mockCartUser  = model.CartOwner{UserEmail: "user@email.com"}
+	mockCartGuest = model.CartOwner{GuestId: uuid.MustParse("5b0c4a3e-7c8d-4a4e-9d3b-2f0e6a1c9b7d")}
+)
+
+type cartDeps struct {
+	logger              *loggerMocks.MockLogger
+	repoCart            *mocks.MockICartRepository
+	orders              *mocks.MockIOrderUsecase
+	inventoryGrpcClient *grpcMocks.MockInvClient
+}
+
+func newCartDeps(t *testing.T) (*cartDeps, *CartUsecase) {
+	deps := &cartDeps{
+		logger:              loggerMocks.NewMockLogger(t),
+		repoCart:            mocks.NewMockICartRepository(t),
+		orders:              mocks.NewMockIOrderUsecase(t),
+		inventoryGrpcClient: grpcMocks.NewMockInvClient(t),
+	}
+	usecase := &CartUsecase{
+		logger:              deps.logger,
+		repoCart:            deps.repoCart,
+		orders:              deps.orders,
+		inventoryGrpcClient: deps.inventoryGrpcClient,
+		now:                 func() time.Time { return mockCartNow },
+	}
+	return deps, usecase
+}
+
+func cartItem(sku, uom, quantity string) model.CartItem {
+	return model.CartItem{Sku: sku, Uom: uom, QuantityPerUom: fixed.NewS(quantity), AddedAt: mockCartNow.Add(-time.Hour), UpdatedAt: mockCartNow.Add(-time.Hour)}
+}
+
+func cartItems(items ...model.CartItem) map[string]model.CartItem {
+	bySku := map[string]model.CartItem{}
+	for _, item := range items {
+		bySku[item.Sku] = item
+	}
+	return bySku
+}
+
+// runs the update function of the repository on stored the way redis would
+func updateStoredCart(stored map[string]model.CartItem) func(context.Context, model.CartOwner, func(map[string]model.CartItem) error) ([]model.CartItem, error) {
+	return func(_ context.Context, _ model.CartOwner, fn func(map[string]model.CartItem) error) ([]model.CartItem, error) {
+		if err := fn(stored); err != nil {
+			return nil, err
+		}
+		return sortedItems(stored), nil
+	}
+}
+
+func sortedItems(items map[string]model.CartItem) []model.CartItem {
+	sorted := []model.CartItem{}
+	for _, item := range items {
+		sorted = append(sorted, item)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Sku < sorted[j].Sku })
+	return sorted
+}
+
+func stockOf(sku string, available, price float64) *inventoryv1.InventoryStatus {
+	return &inventoryv1.InventoryStatus{Sku: sku, AvailableQuantity: available, SkuPrice: price, SkuUom: "EA"}
+}
+
+func TestCartUsecase_AddCartItem(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Owner         model.CartOwner
+		Request       types.StockItemRequest
+		Stored        map[string]model.CartItem
+		Mock          func(dep *cartDeps, stored map[string]model.CartItem)
+		ExpectedErr   string
+		ExpectedItems map[string]string
+	}{
+		{
+			Name:    "new sku is added to the cart",
+			Owner:   mockCartUser,
+			Request: types.StockItemRequest{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 2},
+			Stored:  cartItems(cartItem("OLIVE-OIL-1L", "EA", "1")),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("RICE-5KG", 10, 2.5)}}, nil).Once()
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("OLIVE-OIL-1L", 10, 5), stockOf("RICE-5KG", 10, 2.5)}}, nil).Once()
+				dep.repoCart.EXPECT().UpdateCart(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(updateStoredCart(stored))
+			},
+			ExpectedItems: map[string]string{"OLIVE-OIL-1L": "1", "RICE-5KG": "2"},
+		},
+		{
+			Name:    "sku already in the cart has the quantity added",
+			Owner:   mockCartGuest,
+			Request: types.StockItemRequest{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 2},
+			Stored:  cartItems(cartItem("RICE-5KG", "EA", "1")),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("RICE-5KG", 10, 2.5)}}, nil).Twice()
+				dep.repoCart.EXPECT().UpdateCart(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(updateStoredCart(stored))
+			},
+			ExpectedItems: map[string]string{"RICE-5KG": "3"},
+		},
+		{
+			Name:    "sku in the cart in another uom",
+			Owner:   mockCartUser,
+			Request: types.StockItemRequest{Sku: "RICE-5KG", Uom: "BOX", QuantityPerUom: 1},
+			Stored:  cartItems(cartItem("RICE-5KG", "EA", "1")),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("RICE-5KG", 10, 2.5)}}, nil)
+				dep.repoCart.EXPECT().UpdateCart(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(updateStoredCart(stored))
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name:    "unknown sku",
+			Owner:   mockCartUser,
+			Request: types.StockItemRequest{Sku: "NOPE", Uom: "EA", QuantityPerUom: 1},
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name:    "redis unreachable",
+			Owner:   mockCartUser,
+			Request: types.StockItemRequest{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 1},
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("RICE-5KG", 10, 2.5)}}, nil)
+				dep.repoCart.EXPECT().UpdateCart(mock.Anything, mockCartUser, mock.Anything).
+					Return(nil, errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed in UpdateCart", mock.Anything)
+			},
+			ExpectedErr: errlib.ErrCodeStorageAccess,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps, usecase := newCartDeps(t)
+			tc.Mock(deps, tc.Stored)
+
+			cart, err := usecase.AddCartItem(context.Background(), tc.Owner, tc.Request)
+			if tc.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tc.ExpectedErr, err.(*errlib.AppError).Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			quantities := map[string]string{}
+			for _, line := range cart.Items {
+				quantities[line.Sku] = line.QuantityPerUom.String()
+			}
+			assert.Equal(t, tc.ExpectedItems, quantities)
+			assert.Equal(t, tc.Owner.UserEmail == "", cart.CartId != nil)
+		})
+	}
+}
+
+func TestCartUsecase_AddCartItem_CartFull(t *testing.T) {
+	deps, usecase := newCartDeps(t)
+
+	stored := map[string]model.CartItem{}
+	for i := 0; i < maxCartItems; i++ {
+		item := cartItem(uuid.NewString(), "EA", "1")
+		stored[item.Sku] = item
+	}
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("RICE-5KG", 10, 2.5)}}, nil)
+	deps.repoCart.EXPECT().UpdateCart(mock.Anything, mockCartUser, mock.Anything).
+		RunAndReturn(updateStoredCart(stored))
+
+	_, err := usecase.AddCartItem(context.Background(), mockCartUser, types.StockItemRequest{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 1})
+	assert.Error(t, err)
+	assert.Equal(t, errlib.ErrCodeCartFull, err.(*errlib.AppError).Code)
+	assert.Len(t, stored, maxCartItems)
+}
+
+func TestCartUsecase_GetCart(t *testing.T) {
+	deps, usecase := newCartDeps(t)
+
+	deps.repoCart.EXPECT().GetCart(mock.Anything, mockCartUser).
+		Return([]model.CartItem{cartItem("OLIVE-OIL-1L", "EA", "2"), cartItem("RICE-5KG", "EA", "4"), cartItem("GONE", "EA", "1")}, nil)
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+		return len(req.Items) == 3 && req.Items[1].Sku == "RICE-5KG" && req.Items[1].ReqQtyPerUom == 4
+	})).
+		Return(&inventoryv1.InventoryStatusResponse{Items: []*inventoryv1.InventoryStatus{stockOf("OLIVE-OIL-1L", 10, 5), stockOf("RICE-5KG", 3, 2.5)}}, nil)
+
+	cart, err := usecase.GetCart(context.Background(), mockCartUser)
+	assert.NoError(t, err)
+	assert.Nil(t, cart.CartId)
+	assert.Equal(t, "20", cart.Subtotal.String())
+
+	statuses := []string{}
+	for _, line := range cart.Items {
+		statuses = append(statuses, line.Status)
+	}
+	assert.Equal(t, []string{model.CART_LINE_IN_STOCK, model.CART_LINE_OUT_OF_STOCK, model.CART_LINE_UNAVAILABLE}, statuses)
+	assert.Equal(t, "10", cart.Items[1].Amount.String())
+	assert.True(t, cart.Items[2].Amount.IsZero())
+}
+
+func TestCartUsecase_MergeCart(t *testing.T) {
+	deps, usecase := newCartDeps(t)
+
+	user := cartItems(cartItem("OLIVE-OIL-1L", "EA", "1"), cartItem("RICE-5KG", "EA", "1"))
+	guest := cartItems(cartItem("OLIVE-OIL-1L", "EA", "2"), cartItem("RICE-5KG", "BOX", "1"), cartItem("FLOUR-1KG", "EA", "3"))
+	deps.repoCart.EXPECT().MergeCart(mock.Anything, mockCartGuest, mockCartUser, mock.Anything).
+		RunAndReturn(func(_ context.Context, _, _ model.CartOwner, fn func(to, from map[string]model.CartItem) error) ([]model.CartItem, error) {
+			if err := fn(user, guest); err != nil {
+				return nil, err
+			}
+			return sortedItems(user), nil
+		})
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(&inventoryv1.InventoryStatusResponse{}, nil)
+
+	_, err := usecase.MergeCart(context.Background(), mockCartGuest, mockCartUser)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", user["OLIVE-OIL-1L"].QuantityPerUom.String())
+	// the line of the user is kept when the guest had the sku in another uom
+	assert.Equal(t, "EA", user["RICE-5KG"].Uom)
+	assert.Equal(t, "1", user["RICE-5KG"].QuantityPerUom.String())
+	assert.Equal(t, "3", user["FLOUR-1KG"].QuantityPerUom.String())
+}
+
+func TestCartUsecase_Checkout(t *testing.T) {
+	ordered := &model.OrderWithItems{Order: model.Order{Id: mockOrderId, Status: model.ORDER_STATUS_PENDING}}
+	failed := []*model.OrderedItemStockStatus{{}}
+
+	testCases := []struct {
+		Name         string
+		Stored       map[string]model.CartItem
+		Mock         func(dep *cartDeps, stored map[string]model.CartItem)
+		ExpectedErr  string
+		ExpectedLeft []string
+	}{
+		{
+			Name:   "the ordered lines leave the cart",
+			Stored: cartItems(cartItem("OLIVE-OIL-1L", "EA", "2"), cartItem("RICE-5KG", "EA", "1")),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.repoCart.EXPECT().GetCart(mock.Anything, mockCartUser).
+					Return(sortedItems(stored), nil)
+				dep.orders.EXPECT().NewOrder(mock.Anything, mockCustomer, mock.MatchedBy(func(req types.OrderRequest) bool {
+					return len(req.OrderItems) == 2 && req.OrderItems[0].Sku == "OLIVE-OIL-1L" && req.OrderItems[0].QuantityPerUom == 2 &&
+						req.CouponCode != nil && *req.CouponCode == "WELCOME10"
+				})).
+					Run(func(_ context.Context, _ model.Customer, _ types.OrderRequest) {
+						// a line added while the order was placed stays in the cart
+						stored["FLOUR-1KG"] = cartItem("FLOUR-1KG", "EA", "1")
+					}).
+					Return(ordered, nil, nil)
+				dep.repoCart.EXPECT().UpdateCart(mock.Anything, mockCartUser, mock.Anything).
+					RunAndReturn(updateStoredCart(stored))
+			},
+			ExpectedLeft: []string{"FLOUR-1KG"},
+		},
+		{
+			Name:   "the cart is kept when items are out of stock",
+			Stored: cartItems(cartItem("RICE-5KG", "EA", "1")),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.repoCart.EXPECT().GetCart(mock.Anything, mockCartUser).
+					Return(sortedItems(stored), nil)
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).
+					Return(ordered, failed, nil)
+			},
+			ExpectedLeft: []string{"RICE-5KG"},
+		},
+		{
+			Name:   "empty cart",
+			Stored: cartItems(),
+			Mock: func(dep *cartDeps, stored map[string]model.CartItem) {
+				dep.repoCart.EXPECT().GetCart(mock.Anything, mockCartUser).
+					Return([]model.CartItem{}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeCartEmpty,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps, usecase := newCartDeps(t)
+			deps.repoCart.EXPECT().LockCart(mock.Anything, mockCartUser, cartCheckoutLockTTL).
+				Return("token", true, nil)
+			deps.repoCart.EXPECT().UnlockCart(mock.Anything, mockCartUser, "token").
+				Return(nil)
+			tc.Mock(deps, tc.Stored)
+
+			coupon := "WELCOME10"
+			_, _, err := usecase.Checkout(context.Background(), mockCustomer, types.CartCheckoutRequest{CouponCode: &coupon})
+			if tc.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tc.ExpectedErr, err.(*errlib.AppError).Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			left := []string{}
+			for _, item := range sortedItems(tc.Stored) {
+				left = append(left, item.Sku)
+			}
+			assert.Equal(t, tc.ExpectedLeft, left)
+		})
+	}
+}
+
+func TestCartUsecase_Checkout_InProgress(t *testing.T) {
+	deps, usecase := newCartDeps(t)
+
+	deps.repoCart.EXPECT().LockCart(mock.Anything, mockCartUser, cartCheckoutLockTTL).
+		Return("", false, nil)
+
+	_, _, err := usecase.Checkout(context.Background(), mockCustomer, types.CartCheckoutRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, errlib.ErrCodeCartCheckoutInProgress, err.(*errlib.AppError).Code)
+}
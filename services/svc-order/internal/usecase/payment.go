@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// authorizes the total amount of the order and records the attempt, an order is only confirmed
+// once its payment is authorized. a declined payment is PAYMENT_DECLINED, a provider failure PAYMENT_FAILED
+func (u *OrderUsecase) authorizePayment(ctx context.Context, order model.Order, method string) (*model.Payment, error) {
+
+	attempt := &model.Payment{
+		Id:             uuid.New(),
+		OrderId:        order.Id,
+		Provider:       u.paymentProvider.Name(),
+		PaymentMethod:  method,
+		Status:         model.PAYMENT_STATUS_PENDING,
+		Amount:         order.TotalAmount,
+		CapturedAmount: fixed.ZERO,
+		RefundedAmount: fixed.ZERO,
+		Currency:       order.Currency,
+	}
+	if err := u.repoSQL.InsertPayment(ctx, attempt); err != nil {
+		u.logger.Errorf("failed in InsertPayment", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	// the attempt id keeps a retried authorization from holding the amount twice
+	tx, errAuthorize := u.paymentProvider.Authorize(ctx, payment.AuthorizeRequest{
+		OrderId:        order.Id,
+		Amount:         order.TotalAmount,
+		Currency:       order.Currency,
+		PaymentMethod:  method,
+		IdempotencyKey: attempt.Id.String(),
+	})
+	switch {
+	case errAuthorize == nil:
+		attempt.Status = model.PAYMENT_STATUS_AUTHORIZED
+		attempt.AuthorizationRef = tx.Reference
+	case errors.Is(errAuthorize, payment.ErrDeclined):
+		attempt.Status = model.PAYMENT_STATUS_DECLINED
+		attempt.FailureReason = errAuthorize.Error()
+	default:
+		attempt.Status = model.PAYMENT_STATUS_FAILED
+		attempt.FailureReason = errAuthorize.Error()
+	}
+
+	if err := u.repoSQL.UpdatePayment(ctx, attempt); err != nil {
+		u.logger.Errorf("failed in UpdatePayment", "error", err.Error())
+		// an authorization that is not recorded would never be captured or voided
+		if errAuthorize == nil {
+			if err := u.paymentProvider.Void(ctx, attempt.AuthorizationRef); err != nil {
+				u.logger.Errorf("failed to void unrecorded payment "+attempt.Id.String(), "error", err.Error())
+			}
+		}
+		return nil, errlib.ErrDBQuery()
+	}
+
+	if errAuthorize != nil {
+		return attempt, u.paymentError(errAuthorize)
+	}
+	return attempt, nil
+}
+
+// app error of a payment provider error
+func (u *OrderUsecase) paymentError(err error) error {
+	if errors.Is(err, payment.ErrDeclined) {
+		return errlib.ErrPaymentDeclined(map[string]interface{}{"reason": err.Error()})
+	}
+
+	u.logger.Errorf("failed call to payment provider", "error", err.Error())
+	return errlib.NewAppError(errlib.ErrCodePaymentFailed)
+}
+
+// releases the stock reserved for an order whose payment was not authorized and marks it PAYMENT_FAILED, best effort
+func (u *OrderUsecase) releaseUnpaidOrder(ctx context.Context, orderId uuid.UUID) {
+
+	// without items every reservation and backorder of the order is released
+	_, err := u.inventoryGrpcClient.ReleaseStock(ctx, &inventoryv1.StandardInventoryRequest{
+		OrderId: orderId.String(),
+	})
+	if err != nil {
+		u.logger.Errorf("failed release stock of unpaid order "+orderId.String(), "error", err.Error())
+	}
+
+	if err := u.repoSQL.UpdateOrderStatus(ctx, orderId, model.ORDER_STATUS_PAYMENT_FAILED); err != nil {
+		u.logger.Errorf("failed update order status to payment failed", "error", err.Error())
+		return
+	}
+	u.publishOrderEventById(ctx, model.WEBHOOK_EVENT_ORDER_PAYMENT_FAILED, orderId)
+}
+
+// voids an authorization the order no longer uses, best effort
+func (u *OrderUsecase) voidPayment(ctx context.Context, attempt *model.Payment) {
+
+	if err := u.paymentProvider.Void(ctx, attempt.AuthorizationRef); err != nil {
+		u.logger.Errorf("failed to void payment "+attempt.Id.String(), "error", err.Error())
+		return
+	}
+
+	attempt.Status = model.PAYMENT_STATUS_VOIDED
+	if err := u.repoSQL.UpdatePayment(ctx, attempt); err != nil {
+		u.logger.Errorf("failed in UpdatePayment", "error", err.Error())
+	}
+}
+
+// FulfilOrder consumes the stock still reserved for a CONFIRMED order, captures its authorized payment and
+// marks it FULFILLED. an order with PACKED shipments is fulfilled once they ship. the total amount is
+// captured, it is never above the authorized amount. a payment captured by an earlier call is not captured
+// again, so a failed status update can be retried
+func (u *OrderUsecase) FulfilOrder(ctx context.Context, orderId uuid.UUID) (*model.OrderWithItems, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if order.Status != model.ORDER_STATUS_CONFIRMED {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderNotFulfillable)
+	}
+
+	packed, err := u.repoSQL.GetShipmentQuantities(ctx, orderId, []string{model.SHIPMENT_STATUS_PACKED})
+	if err != nil {
+		u.logger.Errorf("failed in GetShipmentQuantities", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if len(packed) > 0 {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderNotFulfillable)
+	}
+
+	// the order id commits whatever no shipment consumed, once
+	if err := u.commitReservation(ctx, orderId, orderId, nil); err != nil {
+		return nil, err
+	}
+
+	attempt, err := u.fulfil(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OrderWithItems{Order: *order, Items: items, Payment: attempt}, nil
+}
+
+// captures the authorized payment of a CONFIRMED order and moves it to FULFILLED
+func (u *OrderUsecase) fulfil(ctx context.Context, order *model.Order) (*model.Payment, error) {
+
+	attempt, err := u.repoSQL.GetOrderPayment(ctx, order.Id)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderPayment", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if attempt == nil || (attempt.Status != model.PAYMENT_STATUS_AUTHORIZED && attempt.Status != model.PAYMENT_STATUS_CAPTURED) {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderNotFulfillable)
+	}
+
+	if attempt.Status == model.PAYMENT_STATUS_AUTHORIZED {
+		tx, err := u.paymentProvider.Capture(ctx, attempt.AuthorizationRef, order.TotalAmount)
+		if err != nil {
+			return nil, u.paymentError(err)
+		}
+
+		attempt.Status = model.PAYMENT_STATUS_CAPTURED
+		attempt.CaptureRef = tx.Reference
+		attempt.CapturedAmount = tx.Amount
+		if err := u.repoSQL.UpdatePayment(ctx, attempt); err != nil {
+			u.logger.Errorf("failed in UpdatePayment", "error", err.Error())
+			return nil, errlib.ErrDBQuery()
+		}
+	}
+
+	moved, err := u.repoSQL.TransitionOrderStatus(ctx, order.Id, model.ORDER_STATUS_CONFIRMED, model.ORDER_STATUS_FULFILLED)
+	if err != nil {
+		u.logger.Errorf("failed in TransitionOrderStatus", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !moved {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+	order.Status = model.ORDER_STATUS_FULFILLED
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_FULFILLED, order, order.Status, nil)
+
+	return attempt, nil
+}
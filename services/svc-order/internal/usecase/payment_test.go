@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+	inventoryv1 "pb_schemas/inventory/v1"
+)
+
+func TestOrderUsecase_FulfilOrder(t *testing.T) {
+	confirmedOrder := func() *model.Order {
+		order := mockOrder
+		order.Status = model.ORDER_STATUS_CONFIRMED
+		return &order
+	}
+	// every case authorizes its own payment on the fake provider
+	authorizedPayment := func(key, method string) *model.Payment {
+		tx, _ := mockPaymentProvider.Authorize(context.Background(), payment.AuthorizeRequest{
+			OrderId:        mockOrderId,
+			Amount:         fixed.NewS("100"),
+			Currency:       "USD",
+			PaymentMethod:  method,
+			IdempotencyKey: key,
+		})
+		return &model.Payment{
+			Id:               uuid.New(),
+			OrderId:          mockOrderId,
+			PaymentMethod:    method,
+			Status:           model.PAYMENT_STATUS_AUTHORIZED,
+			Amount:           fixed.NewS("100"),
+			Currency:         "USD",
+			AuthorizationRef: tx.Reference,
+		}
+	}
+
+	// the stock no shipment consumed is committed under the order id
+	commitsEverything := func(req *inventoryv1.CommitReservationRequest) bool {
+		return req.OrderId == mockOrderId.String() && req.CommitId == mockOrderId.String() && len(req.Items) == 0
+	}
+
+	testCases := []struct {
+		Name        string
+		Provider    func(t *testing.T) payment.PaymentProvider
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name: "captures the payment and fulfils the order",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.MatchedBy(commitsEverything)).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(authorizedPayment("fulfil-captured", ""), nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Status == model.PAYMENT_STATUS_CAPTURED && p.CaptureRef != "" && p.CapturedAmount.Equal(fixed.NewS("100"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_CONFIRMED, model.ORDER_STATUS_FULFILLED).
+					Return(true, nil)
+			},
+		},
+		{
+			Name: "payment captured by an earlier call is not captured again",
+			Mock: func(dep *usecaseDeps) {
+				captured := authorizedPayment("fulfil-retried", "")
+				captured.Status = model.PAYMENT_STATUS_CAPTURED
+
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.MatchedBy(commitsEverything)).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(captured, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_CONFIRMED, model.ORDER_STATUS_FULFILLED).
+					Return(true, nil)
+			},
+		},
+		{
+			Name: "pending order cannot be fulfilled",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotFulfillable,
+		},
+		{
+			Name: "order with packed shipments waits for them to ship",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[0].Id: fixed.NewS("0.5")}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotFulfillable,
+		},
+		{
+			Name: "order without an authorized payment cannot be fulfilled",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.MatchedBy(commitsEverything)).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotFulfillable,
+		},
+		{
+			Name: "declined capture leaves the order confirmed",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.MatchedBy(commitsEverything)).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(authorizedPayment("fulfil-declined", payment.FakeMethodCaptureDeclined), nil)
+			},
+			ExpectedErr: errlib.ErrCodePaymentDeclined,
+		},
+		{
+			Name: "unreachable provider",
+			Provider: func(t *testing.T) payment.PaymentProvider {
+				provider := mocks.NewMockPaymentProvider(t)
+				provider.EXPECT().Capture(mock.Anything, "auth-ref", mock.Anything).
+					Return(payment.Transaction{}, errors.New("connection refused"))
+				return provider
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_PACKED}).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.MatchedBy(commitsEverything)).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(&model.Payment{Status: model.PAYMENT_STATUS_AUTHORIZED, AuthorizationRef: "auth-ref"}, nil)
+				dep.logger.EXPECT().Errorf("failed call to payment provider", mock.Anything, mock.Anything)
+			},
+			ExpectedErr: errlib.ErrCodePaymentFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			var provider payment.PaymentProvider = mockPaymentProvider
+			if tc.Provider != nil {
+				provider = tc.Provider(t)
+			}
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, provider, mockTaxCalculator, nil)
+			result, err := usecase.FulfilOrder(context.Background(), mockOrderId)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.ORDER_STATUS_FULFILLED, result.Status)
+			assert.Equal(t, model.PAYMENT_STATUS_CAPTURED, result.Payment.Status)
+		})
+	}
+}
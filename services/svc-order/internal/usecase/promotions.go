@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"strings"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/promotion"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// CreatePromotion adds a promotion redeemed with its coupon code, codes are unique and stored upper case
+func (u *OrderUsecase) CreatePromotion(ctx context.Context, request types.PromotionRequest) (*model.Promotion, error) {
+
+	code := strings.ToUpper(strings.TrimSpace(request.Code))
+	existing, err := u.repoSQL.GetPromotionByCode(ctx, code)
+	if err != nil {
+		u.logger.Errorf("failed in GetPromotionByCode", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if existing != nil {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"code": code + " already exists"},
+		})
+	}
+
+	p := &model.Promotion{
+		Code:           code,
+		Name:           request.Name,
+		DiscountType:   string(request.DiscountType),
+		Scope:          string(request.Scope),
+		ScopeValues:    []string{},
+		MaxUses:        request.MaxUses,
+		MaxUsesPerUser: request.MaxUsesPerUser,
+		ValidFrom:      time.Now(),
+		ValidTo:        request.ValidTo,
+		IsActive:       true,
+	}
+	if request.DiscountValue != nil {
+		p.DiscountValue = fixed.NewF(*request.DiscountValue)
+	}
+	if request.BuyQuantity != nil {
+		p.BuyQuantity = *request.BuyQuantity
+	}
+	if request.GetQuantity != nil {
+		p.GetQuantity = *request.GetQuantity
+	}
+	if request.ScopeValues != nil {
+		p.ScopeValues = *request.ScopeValues
+	}
+	if request.MinOrderValue != nil {
+		p.MinOrderValue = fixed.NewF(*request.MinOrderValue)
+	}
+	if request.ValidFrom != nil {
+		p.ValidFrom = *request.ValidFrom
+	}
+	if request.IsActive != nil {
+		p.IsActive = *request.IsActive
+	}
+
+	if err := u.repoSQL.InsertPromotion(ctx, p); err != nil {
+		u.logger.Errorf("failed in InsertPromotion", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	return p, nil
+}
+
+func (u *OrderUsecase) ListPromotions(ctx context.Context) ([]model.Promotion, error) {
+
+	promotions, err := u.repoSQL.GetPromotions(ctx)
+	if err != nil {
+		u.logger.Errorf("failed in GetPromotions", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	return promotions, nil
+}
+
+// promotion of a coupon code that can be redeemed now
+func (u *OrderUsecase) redeemablePromotion(ctx context.Context, code string) (*model.Promotion, error) {
+
+	p, err := u.repoSQL.GetPromotionByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		u.logger.Errorf("failed in GetPromotionByCode", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if p == nil {
+		return nil, errlib.ErrCouponNotApplicable(map[string]interface{}{"reason": "coupon does not exist"})
+	}
+	if err := promotion.Redeemable(*p, time.Now()); err != nil {
+		return nil, couponNotApplicable(err)
+	}
+	return p, nil
+}
+
+func couponNotApplicable(err error) error {
+	return errlib.ErrCouponNotApplicable(map[string]interface{}{
+		"reason": strings.TrimPrefix(err.Error(), promotion.ErrNotApplicable.Error()+": "),
+	})
+}
+
+// category of every sku of the stock status, for promotions scoped to categories
+func stockCategories(stockStatus *inventoryv1.InventoryStatusResponse) map[string]string {
+	categories := map[string]string{}
+	for _, s := range stockStatus.Items {
+		categories[s.Sku] = s.CategoryId
+	}
+	return categories
+}
+
+// discounts of p on the charged quantity of items, one per discounted item
+func orderDiscounts(p *model.Promotion, items []model.ItemOrder, categories map[string]string) ([]model.OrderDiscount, error) {
+
+	ids := map[string]uuid.UUID{}
+	lines := make([]promotion.Line, 0, len(items))
+	for _, item := range items {
+		ids[item.Sku] = item.Id
+		lines = append(lines, promotion.Line{
+			Sku:        item.Sku,
+			CategoryId: categories[item.Sku],
+			Quantity:   reservedQuantity(item),
+			Price:      item.PricePerUom,
+		})
+	}
+
+	applied, err := promotion.Apply(*p, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts := make([]model.OrderDiscount, 0, len(applied))
+	for _, d := range applied {
+		discounts = append(discounts, model.OrderDiscount{
+			Id:          uuid.New(),
+			OrderId:     items[0].OrderId,
+			OrderItemId: ids[d.Sku],
+			PromotionId: p.Id,
+			Code:        p.Code,
+			Sku:         d.Sku,
+			Description: p.Name,
+			Amount:      d.Amount,
+		})
+	}
+	return discounts, nil
+}
+
+// discounts of p once the charged quantities of items changed, none when p no longer applies to them.
+// the validity and usage limits were checked when the order was placed
+func reappliedDiscounts(p *model.Promotion, items []model.ItemOrder, categories map[string]string) ([]model.OrderDiscount, error) {
+	discounts, err := orderDiscounts(p, items, categories)
+	if errors.Is(err, promotion.ErrNotApplicable) {
+		return nil, nil
+	}
+	return discounts, err
+}
+
+// total of discounts
+func discountTotal(discounts []model.OrderDiscount) fixed.Fixed {
+	total := fixed.NewF(0)
+	for _, d := range discounts {
+		total = total.Add(d.Amount)
+	}
+	return total
+}
+
+// part of amount, the discounts or tax of item, charged for quantity units on top of the before units
+// already returned. the shares are rounded on the running total so all units of a line add up to amount
+func returnedShare(item model.ItemOrder, amount, before, quantity fixed.Fixed) fixed.Fixed {
+	charged := reservedQuantity(item)
+	if amount.Equal(fixed.ZERO) || !charged.GreaterThan(fixed.ZERO) {
+		return fixed.NewF(0)
+	}
+	upTo := amount.Mul(before.Add(quantity)).Div(charged).Round(2)
+	prior := amount.Mul(before).Div(charged).Round(2)
+	return upTo.Sub(prior)
+}
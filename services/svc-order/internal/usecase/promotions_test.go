@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"testing"
+	"time"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/repository"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+var (
+	mockPromotionId = uuid.MustParse("0b6f1c3e-5a7d-4f0e-9d1b-2c8e4f6a7b90")
+	mockCouponCode  = "SPRING10"
+)
+
+func mockPromotion(scope string, values ...string) *model.Promotion {
+	return &model.Promotion{
+		Id:            mockPromotionId,
+		Code:          mockCouponCode,
+		Name:          "Spring sale",
+		DiscountType:  model.PROMOTION_TYPE_PERCENTAGE,
+		DiscountValue: fixed.NewS("10"),
+		Scope:         scope,
+		ScopeValues:   values,
+		ValidFrom:     time.Now().Add(-time.Hour),
+		IsActive:      true,
+	}
+}
+
+func TestOrderUsecase_NewOrderWithCoupon(t *testing.T) {
+	coupon := "spring10"
+	orderItems := []types.StockItemRequest{
+		{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+		{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+	}
+	categorizedStock := &inventoryv1.InventoryStatusResponse{
+		Items: []*inventoryv1.InventoryStatus{
+			{Sku: "OLIVE-OIL-1L", RequestedQuantity: 0.5, SkuPrice: 50, SkuUom: "L", CategoryId: "food"},
+			{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, SkuPrice: 25, SkuUom: "EA", CategoryId: "apparel"},
+		},
+	}
+	expired := mockPromotion(model.PROMOTION_SCOPE_ORDER)
+	ended := time.Now().Add(-time.Minute)
+	expired.ValidTo = &ended
+
+	testCases := []struct {
+		Name              string
+		Mock              func(dep *usecaseDeps)
+		ExpectedErr       string
+		ExpectedTotal     string
+		ExpectedDiscounts map[string]string
+	}{
+		{
+			Name: "percentage off the whole order",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(mockPromotion(model.PROMOTION_SCOPE_ORDER), nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(categorizedStock, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.MatchedBy(func(order *model.Order) bool {
+					return order.PromotionId != nil && *order.PromotionId == mockPromotionId
+				}), mock.Anything, mock.MatchedBy(func(discounts []model.OrderDiscount) bool {
+					return len(discounts) == 2 && discounts[0].Code == mockCouponCode
+				}), mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Amount.Equal(fixed.NewS("67.5"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+			},
+			// 75 less 2.5 and 5
+			ExpectedTotal:     "67.5",
+			ExpectedDiscounts: map[string]string{"OLIVE-OIL-1L": "2.5", "TSHIRT-M-WHITE": "5"},
+		},
+		{
+			Name: "category scope only discounts its items",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(mockPromotion(model.PROMOTION_SCOPE_CATEGORY, "apparel"), nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(categorizedStock, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.Anything).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+			},
+			ExpectedTotal:     "70",
+			ExpectedDiscounts: map[string]string{"TSHIRT-M-WHITE": "5"},
+		},
+		{
+			Name: "unknown coupon code",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeCouponNotApplicable,
+		},
+		{
+			Name: "expired coupon",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(expired, nil)
+			},
+			ExpectedErr: errlib.ErrCodeCouponNotApplicable,
+		},
+		{
+			Name: "no item in the scope of the coupon",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(mockPromotion(model.PROMOTION_SCOPE_SKU, "COFFEE-1KG"), nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(categorizedStock, nil)
+			},
+			ExpectedErr: errlib.ErrCodeCouponNotApplicable,
+		},
+		{
+			Name: "usage limit reached by a concurrent order",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(mockPromotion(model.PROMOTION_SCOPE_ORDER), nil)
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(categorizedStock, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(repository.ErrPromotionUsedUp)
+			},
+			ExpectedErr: errlib.ErrCodeCouponNotApplicable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, _, err := usecase.NewOrder(context.Background(), mockCustomer, types.OrderRequest{OrderItems: orderItems, CouponCode: &coupon})
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, fixed.NewS(tc.ExpectedTotal).Equal(result.TotalAmount), "total is %s", result.TotalAmount)
+			assert.Len(t, result.Discounts, len(tc.ExpectedDiscounts))
+			for _, d := range result.Discounts {
+				assert.True(t, fixed.NewS(tc.ExpectedDiscounts[d.Sku]).Equal(d.Amount), "%s discount is %s", d.Sku, d.Amount)
+			}
+		})
+	}
+}
+
+func TestOrderUsecase_NewOrderWithCouponPerUser(t *testing.T) {
+	coupon := mockCouponCode
+	orderItems := []types.StockItemRequest{{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"}}
+	oncePerUser := 1
+	promo := mockPromotion(model.PROMOTION_SCOPE_ORDER)
+	promo.MaxUsesPerUser = &oncePerUser
+	otherCustomer := model.Customer{UserId: "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f", Email: "other@email.com"}
+
+	deps := usecaseDeps{
+		logger:                loggerMocks.NewMockLogger(t),
+		repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+		inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+		backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+		backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+	}
+	deps.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+		Return(promo, nil)
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(mockStockResponse, nil)
+
+	// the repository counts the redemptions of the promotion per user of the order
+	redeemed := map[string]int{}
+	deps.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, order *model.Order, _ []model.ItemOrder, _ []model.OrderDiscount, _ []model.OrderTax) error {
+			if redeemed[order.UserId] >= *promo.MaxUsesPerUser {
+				return repository.ErrPromotionUsedUp
+			}
+			redeemed[order.UserId]++
+			return nil
+		})
+	deps.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+		Return(mockReserveSuccessResponse, nil)
+	deps.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.Anything).
+		Return(nil)
+	deps.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil)
+	deps.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+		Return(nil)
+
+	usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	request := types.OrderRequest{OrderItems: orderItems, CouponCode: &coupon}
+
+	first, _, err := usecase.NewOrder(context.Background(), mockCustomer, request)
+	assert.NoError(t, err)
+	assert.Equal(t, mockUserId, first.UserId)
+	assert.Equal(t, mockUserEmail, first.UserEmail)
+
+	second, _, err := usecase.NewOrder(context.Background(), otherCustomer, request)
+	assert.NoError(t, err)
+	assert.Equal(t, otherCustomer.UserId, second.UserId)
+	assert.Equal(t, otherCustomer.Email, second.UserEmail)
+
+	_, _, err = usecase.NewOrder(context.Background(), mockCustomer, request)
+	appErr, ok := err.(*errlib.AppError)
+	assert.True(t, ok)
+	assert.Equal(t, errlib.ErrCodeCouponNotApplicable, appErr.Code)
+}
+
+func TestOrderUsecase_QuoteWithCoupon(t *testing.T) {
+	coupon := mockCouponCode
+	deps := usecaseDeps{
+		logger:                loggerMocks.NewMockLogger(t),
+		repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+		inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+		backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+		backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+	}
+	deps.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+		Return(mockPromotion(model.PROMOTION_SCOPE_SKU, "OLIVE-OIL-1L"), nil)
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(mockStockResponse, nil)
+
+	usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	quote, err := usecase.Quote(context.Background(), mockCustomer, types.OrderRequest{
+		OrderItems: []types.StockItemRequest{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+		},
+		CouponCode: &coupon,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, mockCouponCode, quote.CouponCode)
+	assert.Len(t, quote.Discounts, 1)
+	assert.True(t, fixed.NewS("2.5").Equal(quote.Discounts[0].Amount))
+	assert.True(t, fixed.NewS("72.5").Equal(quote.TotalAmount))
+}
+
+func TestOrderUsecase_RequestReturnWithDiscount(t *testing.T) {
+	order := mockOrder
+	order.Status = model.ORDER_STATUS_FULFILLED
+	order.PromotionId = &mockPromotionId
+	discounts := []model.OrderDiscount{
+		{OrderItemId: mockItems[1].Id, PromotionId: mockPromotionId, Sku: "TSHIRT-M-WHITE", Amount: fixed.NewS("5")},
+	}
+
+	testCases := []struct {
+		Name             string
+		Returned         map[uuid.UUID]fixed.Fixed
+		Quantity         float64
+		ExpectedDiscount string
+		ExpectedRefund   string
+	}{
+		{
+			Name:             "share of the discount of the returned units",
+			Returned:         map[uuid.UUID]fixed.Fixed{},
+			Quantity:         1,
+			ExpectedDiscount: "2.5",
+			ExpectedRefund:   "22.5",
+		},
+		{
+			Name:             "last unit takes what earlier returns left",
+			Returned:         map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("1")},
+			Quantity:         1,
+			ExpectedDiscount: "2.5",
+			ExpectedRefund:   "22.5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+			deps.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+				Return(&order, mockItems, nil)
+			deps.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+				Return(tc.Returned, nil)
+			deps.repoSQL.EXPECT().GetOrderDiscounts(mock.Anything, mockOrderId).
+				Return(discounts, nil)
+			deps.repoSQL.EXPECT().InsertReturn(mock.Anything, mock.Anything, model.ORDER_STATUS_FULFILLED, mock.Anything).
+				Return(true, nil)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.RequestReturn(context.Background(), mockOrderId, types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: tc.Quantity}},
+			}, mockCustomer)
+
+			assert.NoError(t, err)
+			assert.True(t, fixed.NewS(tc.ExpectedDiscount).Equal(result.Items[0].DiscountAmount))
+			assert.True(t, fixed.NewS(tc.ExpectedRefund).Equal(result.RefundAmount))
+		})
+	}
+}
+
+func TestOrderUsecase_CreatePromotion(t *testing.T) {
+	value := 10.0
+
+	testCases := []struct {
+		Name        string
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name: "code is stored upper case",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(nil, nil)
+				dep.repoSQL.EXPECT().InsertPromotion(mock.Anything, mock.MatchedBy(func(p *model.Promotion) bool {
+					return p.Code == mockCouponCode && p.IsActive && p.DiscountValue.Equal(fixed.NewS("10"))
+				})).
+					Return(nil)
+			},
+		},
+		{
+			Name: "code already exists",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetPromotionByCode(mock.Anything, mockCouponCode).
+					Return(mockPromotion(model.PROMOTION_SCOPE_ORDER), nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.CreatePromotion(context.Background(), types.PromotionRequest{
+				Code:          " spring10 ",
+				Name:          "Spring sale",
+				DiscountType:  types.PERCENTAGE,
+				DiscountValue: &value,
+				Scope:         types.ORDER,
+			})
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, mockCouponCode, result.Code)
+		})
+	}
+}
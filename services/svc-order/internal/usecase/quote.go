@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errlib"
+	"errors"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"strings"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/promotion"
+
+	"github.com/robaho/fixed"
+)
+
+var (
+	ErrInvalidQuoteToken = errors.New("invalid quote token")
+	ErrExpiredQuoteToken = errors.New("quote token has expired")
+)
+
+type (
+	// prices the customer was quoted, carried by the quote token
+	QuoteClaims struct {
+		UserId    string       `json:"sub"`
+		Items     []QuotedItem `json:"items"`
+		Currency  string       `json:"currency"`
+		ExpiresAt int64        `json:"exp"`
+	}
+
+	QuotedItem struct {
+		Sku            string      `json:"sku"`
+		QuantityPerUom fixed.Fixed `json:"qty"`
+		Uom            string      `json:"uom"`
+		PricePerUom    fixed.Fixed `json:"price"`
+	}
+
+	// QuoteSigner issues and verifies quote tokens, a base64 encoded claims payload
+	// and its HMAC-SHA256 signature separated by a dot
+	QuoteSigner struct {
+		secret []byte
+		ttl    time.Duration
+		now    func() time.Time
+	}
+)
+
+func NewQuoteSigner(secret string, ttl time.Duration) *QuoteSigner {
+	return &QuoteSigner{
+		secret: []byte(secret),
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Sign sets the expiry of the claims and returns the token with its expiry
+func (s *QuoteSigner) Sign(claims QuoteClaims) (string, time.Time, error) {
+	expiresAt := s.now().Add(s.ttl).Truncate(time.Second)
+	claims.ExpiresAt = expiresAt.Unix()
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.signature(encoded)), expiresAt, nil
+}
+
+// Verify returns the claims of a token signed with the same secret that has not expired yet
+func (s *QuoteSigner) Verify(token string) (*QuoteClaims, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidQuoteToken
+	}
+
+	decodedSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(decodedSig, s.signature(encoded)) {
+		return nil, ErrInvalidQuoteToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidQuoteToken
+	}
+	var claims QuoteClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidQuoteToken
+	}
+
+	if !s.now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, ErrExpiredQuoteToken
+	}
+	return &claims, nil
+}
+
+func (s *QuoteSigner) signature(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// Quote runs the validation, stock check and pricing of NewOrder without inserting or reserving anything.
+// the returned token lets NewOrder place the order at the quoted prices until it expires. the discounts
+// of a coupon code and the tax of the shipping address are quoted too, NewOrder works both out again
+// when the order is placed. the token is issued to the customer and quotes the requested units of measure
+func (u *OrderUsecase) Quote(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.Quote, error) {
+
+	if _, _, err := reservationOptions(request); err != nil {
+		return nil, err
+	}
+
+	var promo *model.Promotion
+	if request.CouponCode != nil {
+		var err error
+		if promo, err = u.redeemablePromotion(ctx, *request.CouponCode); err != nil {
+			return nil, err
+		}
+	}
+
+	stockStatus, err := u.checkStock(ctx, toInventoryItems(request.OrderItems))
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &model.Quote{
+		Currency: orderCurrency,
+		Items:    []model.QuoteItem{},
+	}
+	claims := QuoteClaims{UserId: customer.UserId, Currency: orderCurrency}
+	total := fixed.NewF(0)
+
+	requestedUom := map[string]string{}
+	for _, item := range request.OrderItems {
+		requestedUom[item.Sku] = item.Uom
+	}
+
+	for _, i := range stockStatus.Items {
+		item := model.QuoteItem{
+			Sku:               i.Sku,
+			QuantityPerUom:    fixed.NewF(i.RequestedQuantity),
+			PricePerUom:       fixed.NewF(i.SkuPrice),
+			UomCode:           i.SkuUom,
+			AvailableQuantity: i.AvailableQuantity,
+			InStock:           i.AvailableQuantity >= i.RequestedQuantity,
+		}
+		item.Amount = item.QuantityPerUom.Mul(item.PricePerUom)
+		quote.Items = append(quote.Items, item)
+		total = total.Add(item.Amount)
+
+		claims.Items = append(claims.Items, QuotedItem{
+			Sku:            item.Sku,
+			QuantityPerUom: item.QuantityPerUom,
+			Uom:            requestedUom[item.Sku],
+			PricePerUom:    item.PricePerUom,
+		})
+	}
+
+	var (
+		items     []model.ItemOrder
+		discounts []model.OrderDiscount
+	)
+	for _, i := range quote.Items {
+		items = append(items, model.ItemOrder{Sku: i.Sku, QuantityPerUom: i.QuantityPerUom, PricePerUom: i.PricePerUom})
+	}
+	if promo != nil {
+		discounts, err = orderDiscounts(promo, items, stockCategories(stockStatus))
+		if err != nil {
+			if errors.Is(err, promotion.ErrNotApplicable) {
+				return nil, couponNotApplicable(err)
+			}
+			u.logger.Errorf("failed to apply promotion", "error", err.Error())
+			return nil, errlib.ErrInternalServer(err)
+		}
+
+		quote.CouponCode = promo.Code
+		for _, d := range discounts {
+			quote.Discounts = append(quote.Discounts, model.QuoteDiscount{Sku: d.Sku, Description: d.Description, Amount: d.Amount})
+		}
+		total = total.Sub(discountTotal(discounts))
+	}
+
+	priced := model.Order{TotalAmount: total, ShippingAddress: shippingAddress(request)}
+	taxes, err := u.taxOrder(ctx, &priced, items, discounts, stockTaxCategories(stockStatus.Items))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range taxes {
+		quote.Taxes = append(quote.Taxes, model.QuoteTax{
+			Sku:          t.Sku,
+			Jurisdiction: t.Jurisdiction,
+			TaxCategory:  t.TaxCategory,
+			Rate:         t.Rate,
+			TaxAmount:    t.TaxAmount,
+		})
+	}
+	quote.TaxAmount = priced.TaxAmount
+	quote.PricesIncludeTax = priced.PricesIncludeTax
+	quote.TotalAmount = priced.TotalAmount
+
+	quote.Token, quote.ExpiresAt, err = u.quoteSigner.Sign(claims)
+	if err != nil {
+		u.logger.Errorf("failed sign quote token", "error", err.Error())
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	return quote, nil
+}
+
+// claims of the quote token of the order request, the token must be valid, issued to the customer
+// and quote the same items in the same units of measure
+func (u *OrderUsecase) verifyQuote(customer model.Customer, request types.OrderRequest) (*QuoteClaims, error) {
+
+	claims, err := u.quoteSigner.Verify(*request.QuoteToken)
+	if errors.Is(err, ErrExpiredQuoteToken) {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"quote_token": "has expired, request a new quote"},
+		})
+	}
+	if err != nil {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"quote_token": "is invalid"},
+		})
+	}
+
+	if claims.UserId == "" || claims.UserId != customer.UserId {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"quote_token": "was issued to another user"},
+		})
+	}
+
+	quoted := map[string]QuotedItem{}
+	for _, item := range claims.Items {
+		quoted[item.Sku] = item
+	}
+	matches := len(quoted) == len(request.OrderItems)
+	for _, item := range request.OrderItems {
+		q, ok := quoted[item.Sku]
+		if !ok || q.Uom != item.Uom || !q.QuantityPerUom.Equal(fixed.NewF(item.QuantityPerUom)) {
+			matches = false
+			break
+		}
+	}
+	if !matches {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"quote_token": "was issued for different order items"},
+		})
+	}
+
+	return claims, nil
+}
+
+func (c *QuoteClaims) priceOf(sku string) (fixed.Fixed, bool) {
+	for _, item := range c.Items {
+		if item.Sku == sku {
+			return item.PricePerUom, true
+		}
+	}
+	return fixed.ZERO, false
+}
+
+// quoted lines whose current price differs, or that are no longer sold
+func quotePriceChanges(claims *QuoteClaims, stockStatus *inventoryv1.InventoryStatusResponse) []model.QuotePriceChange {
+
+	current := map[string]fixed.Fixed{}
+	for _, i := range stockStatus.Items {
+		current[i.Sku] = fixed.NewF(i.SkuPrice)
+	}
+
+	var changes []model.QuotePriceChange
+	for _, item := range claims.Items {
+		price, ok := current[item.Sku]
+		if ok && price.Equal(item.PricePerUom) {
+			continue
+		}
+
+		change := model.QuotePriceChange{Sku: item.Sku, QuotedPrice: item.PricePerUom}
+		if ok {
+			change.CurrentPrice = &price
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+	inventoryv1 "pb_schemas/inventory/v1"
+)
+
+func TestOrderUsecase_Quote(t *testing.T) {
+	partialPolicy := types.PARTIAL
+	allowBackorder := true
+
+	request := types.OrderRequest{
+		OrderItems: []types.StockItemRequest{
+			{
+				Sku:            "OLIVE-OIL-1L",
+				QuantityPerUom: 0.5,
+				Uom:            "L",
+			},
+			{
+				Sku:            "TSHIRT-M-WHITE",
+				QuantityPerUom: 2,
+				Uom:            "EA",
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		Request         types.OrderRequest
+		Mock            func(dep *usecaseDeps)
+		ExpectedErr     bool
+		ExpectedTotal   string
+		ExpectedInStock []bool
+	}{
+		{
+			Name:    "successful quote with prices and availability",
+			Request: request,
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(&inventoryv1.InventoryStatusResponse{
+						Items: []*inventoryv1.InventoryStatus{
+							{Sku: "OLIVE-OIL-1L", RequestedQuantity: 0.5, AvailableQuantity: 10, SkuPrice: 50, SkuUom: "L"},
+							{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, AvailableQuantity: 1, SkuPrice: 25, SkuUom: "EA"},
+						},
+					}, nil)
+			},
+			ExpectedErr:     false,
+			ExpectedTotal:   "75",
+			ExpectedInStock: []bool{true, false},
+		},
+		{
+			Name: "backorder with a partial policy is rejected",
+			Request: types.OrderRequest{
+				OrderItems:        request.OrderItems,
+				AllowBackorder:    &allowBackorder,
+				ReservationPolicy: &partialPolicy,
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+		},
+		{
+			Name:    "inventory service error",
+			Request: request,
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(nil, errors.New("inventory service error"))
+				dep.logger.EXPECT().Errorf("failed check stock to inventory service", mock.Anything)
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.Quote(context.Background(), mockCustomer, tc.Request)
+
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "USD", result.Currency)
+			assert.True(t, result.TotalAmount.Equal(fixed.NewS(tc.ExpectedTotal)))
+			assert.Len(t, result.Items, len(tc.ExpectedInStock))
+			for i, inStock := range tc.ExpectedInStock {
+				assert.Equal(t, inStock, result.Items[i].InStock)
+			}
+
+			// the token carries the quoted prices
+			claims, err := mockQuoteSigner.Verify(result.Token)
+			assert.NoError(t, err)
+			assert.Equal(t, result.ExpiresAt.Unix(), claims.ExpiresAt)
+			assert.Equal(t, mockUserId, claims.UserId)
+			assert.Len(t, claims.Items, len(result.Items))
+			for i, item := range claims.Items {
+				assert.Equal(t, result.Items[i].Sku, item.Sku)
+				assert.Equal(t, tc.Request.OrderItems[i].Uom, item.Uom)
+				assert.True(t, result.Items[i].PricePerUom.Equal(item.PricePerUom))
+			}
+		})
+	}
+}
+
+func TestQuoteSigner_Verify(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	signer := NewQuoteSigner("secret", 15*time.Minute)
+	signer.now = func() time.Time { return now }
+
+	token, expiresAt, err := signer.Sign(QuoteClaims{
+		Currency: "USD",
+		Items:    []QuotedItem{{Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("0.5"), Uom: "L", PricePerUom: fixed.NewS("50")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(15*time.Minute), expiresAt)
+
+	otherSigner := NewQuoteSigner("other-secret", 15*time.Minute)
+	otherSigner.now = signer.now
+
+	testCases := []struct {
+		Name        string
+		Signer      *QuoteSigner
+		Token       string
+		At          time.Time
+		ExpectedErr error
+	}{
+		{
+			Name:   "valid token",
+			Signer: signer,
+			Token:  token,
+			At:     now.Add(14 * time.Minute),
+		},
+		{
+			Name:        "expired token",
+			Signer:      signer,
+			Token:       token,
+			At:          now.Add(15 * time.Minute),
+			ExpectedErr: ErrExpiredQuoteToken,
+		},
+		{
+			Name:        "token signed with another secret",
+			Signer:      otherSigner,
+			Token:       token,
+			At:          now,
+			ExpectedErr: ErrInvalidQuoteToken,
+		},
+		{
+			Name:        "malformed token",
+			Signer:      signer,
+			Token:       "not-a-token",
+			At:          now,
+			ExpectedErr: ErrInvalidQuoteToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Signer.now = func() time.Time { return tc.At }
+
+			claims, err := tc.Signer.Verify(tc.Token)
+
+			if tc.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tc.ExpectedErr)
+				assert.Nil(t, claims)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, claims.Items, 1)
+			assert.True(t, claims.Items[0].PricePerUom.Equal(fixed.NewS("50")))
+		})
+	}
+}
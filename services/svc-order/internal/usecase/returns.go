@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"fmt"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"strings"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestReturn opens a REQUESTED return for lines of a FULFILLED order. a line can be returned up to its
+// shipped quantity minus what other returns that were not rejected hold. the refund amount is priced at
+// the price_per_uom of the order items less the share of their discounts, plus the share of their tax when
+// it was added to the order total. only the customer who placed the order can return its lines
+func (u *OrderUsecase) RequestReturn(ctx context.Context, orderId uuid.UUID, request types.ReturnRequest, customer model.Customer) (*model.OrderReturn, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil || !placedBy(order, customer) {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if order.Status != model.ORDER_STATUS_FULFILLED {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderNotReturnable)
+	}
+
+	returned, err := u.repoSQL.GetReturnedQuantities(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetReturnedQuantities", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	ordered := map[string]model.ItemOrder{}
+	for _, item := range items {
+		ordered[item.Sku] = item
+	}
+
+	discounted := map[uuid.UUID]fixed.Fixed{}
+	if order.PromotionId != nil {
+		discounts, err := u.repoSQL.GetOrderDiscounts(ctx, orderId)
+		if err != nil {
+			u.logger.Errorf("failed in GetOrderDiscounts", "error", err.Error())
+			return nil, errlib.ErrDBQuery()
+		}
+		for _, d := range discounts {
+			discounted[d.OrderItemId] = discounted[d.OrderItemId].Add(d.Amount)
+		}
+	}
+	taxed := map[uuid.UUID]fixed.Fixed{}
+	if order.ShippingAddress != nil {
+		taxes, err := u.repoSQL.GetOrderTaxes(ctx, orderId)
+		if err != nil {
+			u.logger.Errorf("failed in GetOrderTaxes", "error", err.Error())
+			return nil, errlib.ErrDBQuery()
+		}
+		for _, t := range taxes {
+			taxed[t.OrderItemId] = taxed[t.OrderItemId].Add(t.TaxAmount)
+		}
+	}
+
+	ret := &model.OrderReturn{
+		Id:           uuid.New(),
+		OrderId:      orderId,
+		Status:       model.RETURN_STATUS_REQUESTED,
+		RequestedBy:  customer.Email,
+		RefundAmount: fixed.NewF(0),
+		Currency:     order.Currency,
+	}
+	if request.Reason != nil {
+		ret.Reason = strings.TrimSpace(*request.Reason)
+	}
+
+	for _, req := range request.Items {
+		item, ok := ordered[req.Sku]
+		if !ok {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"sku": req.Sku + " is not on the order"},
+			})
+		}
+
+		// short lines only shipped their confirmed part
+		quantity := fixed.NewF(req.Quantity)
+		returnable := reservedQuantity(item).Sub(returned[item.Id])
+		if quantity.GreaterThan(returnable) {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"quantity": fmt.Sprintf("%s can be returned up to %s", req.Sku, returnable.String())},
+			})
+		}
+
+		discount := returnedShare(item, discounted[item.Id], returned[item.Id], quantity)
+		itemTax := returnedShare(item, taxed[item.Id], returned[item.Id], quantity)
+		ret.Items = append(ret.Items, model.ReturnItem{
+			Id:             uuid.New(),
+			ReturnId:       ret.Id,
+			OrderItemId:    item.Id,
+			Sku:            item.Sku,
+			Quantity:       quantity,
+			PricePerUom:    item.PricePerUom,
+			UomCode:        item.UomCode,
+			DiscountAmount: discount,
+			TaxAmount:      itemTax,
+		})
+		ret.RefundAmount = ret.RefundAmount.Add(quantity.Mul(item.PricePerUom)).Sub(discount)
+		if !order.PricesIncludeTax {
+			ret.RefundAmount = ret.RefundAmount.Add(itemTax)
+		}
+	}
+
+	ret.History = []model.ReturnHistory{{
+		ToStatus: model.RETURN_STATUS_REQUESTED,
+		Actor:    customer.Email,
+		Note:     ret.Reason,
+	}}
+
+	// the order version keeps two requests from returning the same quantity
+	inserted, err := u.repoSQL.InsertReturn(ctx, order, model.ORDER_STATUS_FULFILLED, ret)
+	if err != nil {
+		u.logger.Errorf("failed in InsertReturn", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !inserted {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+
+	return ret, nil
+}
+
+// GetOrderReturns lists the returns of an order with their items and history to the customer who placed it,
+// admins can read the returns of every order
+func (u *OrderUsecase) GetOrderReturns(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.OrderReturn, error) {
+
+	order, err := u.repoSQL.GetOrderById(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderById", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil || (!admin && !placedBy(order, customer)) {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	returns, err := u.repoSQL.GetOrderReturns(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderReturns", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return returns, nil
+}
+
+// ApproveReturn accepts a REQUESTED return, the customer can send the goods back
+func (u *OrderUsecase) ApproveReturn(ctx context.Context, returnId uuid.UUID, actor, note string) (*model.OrderReturn, error) {
+	return u.decideReturn(ctx, returnId, model.RETURN_STATUS_APPROVED, actor, note)
+}
+
+// RejectReturn refuses a REQUESTED return, its quantities can be requested again
+func (u *OrderUsecase) RejectReturn(ctx context.Context, returnId uuid.UUID, actor, note string) (*model.OrderReturn, error) {
+	return u.decideReturn(ctx, returnId, model.RETURN_STATUS_REJECTED, actor, note)
+}
+
+func (u *OrderUsecase) decideReturn(ctx context.Context, returnId uuid.UUID, to, actor, note string) (*model.OrderReturn, error) {
+
+	ret, err := u.getReturn(ctx, returnId)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Status != model.RETURN_STATUS_REQUESTED {
+		return nil, errlib.NewAppError(errlib.ErrCodeReturnStatus)
+	}
+
+	ret.Status = to
+	if err := u.transitionReturn(ctx, ret, model.RETURN_STATUS_REQUESTED, actor, note); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// ReceiveReturn books the goods of an APPROVED return back into stock through the inventory service, the
+// return is then RECEIVED, and refunds its amount on the captured payment of the order, the return is then
+// REFUNDED. a RECEIVED return whose refund failed is refunded again, the return id keeps the refund single
+func (u *OrderUsecase) ReceiveReturn(ctx context.Context, returnId uuid.UUID, actor string) (*model.OrderReturn, error) {
+
+	ret, err := u.getReturn(ctx, returnId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ret.Status {
+	case model.RETURN_STATUS_APPROVED:
+		if err := u.restockReturn(ctx, ret); err != nil {
+			return nil, err
+		}
+
+		ret.Status = model.RETURN_STATUS_RECEIVED
+		if err := u.transitionReturn(ctx, ret, model.RETURN_STATUS_APPROVED, actor, "goods restocked"); err != nil {
+			return nil, err
+		}
+	case model.RETURN_STATUS_RECEIVED:
+		// the refund failed after the goods were restocked
+	default:
+		return nil, errlib.NewAppError(errlib.ErrCodeReturnStatus)
+	}
+
+	if err := u.refundReturn(ctx, ret, actor); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (u *OrderUsecase) getReturn(ctx context.Context, returnId uuid.UUID) (*model.OrderReturn, error) {
+
+	ret, err := u.repoSQL.GetReturn(ctx, returnId)
+	if err != nil {
+		u.logger.Errorf("failed in GetReturn", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if ret == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return ret, nil
+}
+
+// moves ret from status from to ret.Status, RETURN_STATUS_CONFLICT when another call moved it first
+func (u *OrderUsecase) transitionReturn(ctx context.Context, ret *model.OrderReturn, from, actor, note string) error {
+
+	moved, err := u.repoSQL.TransitionReturn(ctx, ret, from, model.ReturnHistory{
+		FromStatus: from,
+		ToStatus:   ret.Status,
+		Actor:      actor,
+		Note:       note,
+	})
+	if err != nil {
+		u.logger.Errorf("failed in TransitionReturn", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+	if !moved {
+		return errlib.NewAppError(errlib.ErrCodeReturnStatus)
+	}
+
+	return nil
+}
+
+// restocks the returned quantities, the inventory service restocks a return id once so a retry is safe
+func (u *OrderUsecase) restockReturn(ctx context.Context, ret *model.OrderReturn) error {
+
+	items := make([]*inventoryv1.InventoryItem, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		items = append(items, &inventoryv1.InventoryItem{
+			Sku:          item.Sku,
+			ReqQtyPerUom: item.Quantity.Float(),
+			Uom:          item.UomCode,
+		})
+	}
+
+	_, err := u.inventoryGrpcClient.RestockReturn(ctx, &inventoryv1.RestockReturnRequest{
+		ReturnId: ret.Id.String(),
+		OrderId:  ret.OrderId.String(),
+		Items:    items,
+	})
+	if err != nil {
+		// an sku removed from the catalog since the order, the message tells which
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return errlib.ErrValidationError([]map[string]interface{}{
+				{"items": st.Message()},
+			})
+		}
+
+		u.logger.Errorf("failed restock return to inventory service", "error", err.Error())
+		return errlib.ErrInternalServer(err)
+	}
+
+	return nil
+}
+
+// refunds the amount of a RECEIVED return on the captured payment of its order and marks it REFUNDED
+func (u *OrderUsecase) refundReturn(ctx context.Context, ret *model.OrderReturn, actor string) error {
+
+	attempt, err := u.repoSQL.GetOrderPayment(ctx, ret.OrderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderPayment", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+	if attempt == nil || attempt.CaptureRef == "" {
+		u.logger.Errorf("return of order without a captured payment", "return_id", ret.Id.String())
+		return errlib.ErrInternalServer(fmt.Errorf("order %s has no captured payment to refund", ret.OrderId))
+	}
+
+	// the return id makes a retried refund return the first one
+	tx, err := u.paymentProvider.Refund(ctx, attempt.CaptureRef, ret.RefundAmount, ret.Id.String())
+	if err != nil {
+		return u.paymentError(err)
+	}
+
+	ret.Status = model.RETURN_STATUS_REFUNDED
+	ret.RefundRef = tx.Reference
+	entry := model.ReturnHistory{
+		FromStatus: model.RETURN_STATUS_RECEIVED,
+		ToStatus:   model.RETURN_STATUS_REFUNDED,
+		Actor:      actor,
+		Note:       "refunded " + tx.Amount.String() + " " + ret.Currency,
+	}
+
+	moved, err := u.repoSQL.RefundReturn(ctx, ret, entry, attempt.Id, tx.Amount)
+	if err != nil {
+		// the provider refunded it, a retry gets the same refund back and records it
+		u.logger.Errorf("failed to record refund "+tx.Reference+" of return "+ret.Id.String(), "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+	if !moved {
+		return errlib.NewAppError(errlib.ErrCodeReturnStatus)
+	}
+
+	return nil
+}
@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"net/http"
+	"testing"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+func TestOrderUsecase_RequestReturn(t *testing.T) {
+	fulfilledOrder := func() *model.Order {
+		order := mockOrder
+		order.Status = model.ORDER_STATUS_FULFILLED
+		return &order
+	}
+	reason := "arrived damaged"
+
+	testCases := []struct {
+		Name           string
+		Request        types.ReturnRequest
+		Mock           func(dep *usecaseDeps)
+		ExpectedErr    string
+		ExpectedRefund string
+	}{
+		{
+			Name: "returns part of a line at its order price",
+			Request: types.ReturnRequest{
+				Items:  []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+				Reason: &reason,
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(fulfilledOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.repoSQL.EXPECT().InsertReturn(mock.Anything, mock.Anything, model.ORDER_STATUS_FULFILLED, mock.MatchedBy(func(r *model.OrderReturn) bool {
+					return r.Status == model.RETURN_STATUS_REQUESTED && r.Reason == reason && len(r.Items) == 1 &&
+						r.Items[0].OrderItemId == mockItems[1].Id && len(r.History) == 1 && r.RequestedBy == mockUserEmail
+				})).
+					Return(true, nil)
+			},
+			ExpectedRefund: "25",
+		},
+		{
+			Name: "order of another customer is not found",
+			Request: types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				order := fulfilledOrder()
+				order.UserId = "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f"
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(order, mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+		{
+			Name: "order that is not fulfilled cannot be returned",
+			Request: types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotReturnable,
+		},
+		{
+			Name: "sku not on the order",
+			Request: types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "UNKNOWN-SKU", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(fulfilledOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "quantity held by an earlier return cannot be returned again",
+			Request: types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(fulfilledOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("1.5")}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "order modified by a concurrent request",
+			Request: types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "OLIVE-OIL-1L", Quantity: 0.5}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(fulfilledOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.repoSQL.EXPECT().InsertReturn(mock.Anything, mock.Anything, model.ORDER_STATUS_FULFILLED, mock.Anything).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderModified,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.RequestReturn(context.Background(), mockOrderId, tc.Request, mockCustomer)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.RETURN_STATUS_REQUESTED, result.Status)
+			assert.Equal(t, mockUserEmail, result.RequestedBy)
+			assert.True(t, fixed.NewS(tc.ExpectedRefund).Equal(result.RefundAmount))
+		})
+	}
+}
+
+func TestOrderUsecase_GetOrderReturns(t *testing.T) {
+	otherCustomer := model.Customer{UserId: "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f", Email: "other@email.com"}
+	returns := []model.OrderReturn{{Id: uuid.New(), OrderId: mockOrderId, Status: model.RETURN_STATUS_REQUESTED, RequestedBy: mockUserEmail}}
+
+	testCases := []struct {
+		Name        string
+		Customer    model.Customer
+		Admin       bool
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name:     "customer lists the returns of their order",
+			Customer: mockCustomer,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+				dep.repoSQL.EXPECT().GetOrderReturns(mock.Anything, mockOrderId).
+					Return(returns, nil)
+			},
+		},
+		{
+			Name:     "returns of another customer are not found",
+			Customer: otherCustomer,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+		{
+			Name:     "admins list the returns of every order",
+			Customer: otherCustomer,
+			Admin:    true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+				dep.repoSQL.EXPECT().GetOrderReturns(mock.Anything, mockOrderId).
+					Return(returns, nil)
+			},
+		},
+		{
+			Name:     "order not found",
+			Customer: mockCustomer,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.GetOrderReturns(context.Background(), mockOrderId, tc.Customer, tc.Admin)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Equal(t, http.StatusNotFound, appErr.Status)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, returns, result)
+		})
+	}
+}
+
+func TestOrderUsecase_DecideReturn(t *testing.T) {
+	returnId := uuid.New()
+	returnWithStatus := func(status string) *model.OrderReturn {
+		return &model.OrderReturn{Id: returnId, OrderId: mockOrderId, Status: status}
+	}
+
+	testCases := []struct {
+		Name        string
+		Approve     bool
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+		Expected    string
+	}{
+		{
+			Name:    "approves a requested return",
+			Approve: true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, returnId).
+					Return(returnWithStatus(model.RETURN_STATUS_REQUESTED), nil)
+				dep.repoSQL.EXPECT().TransitionReturn(mock.Anything, mock.Anything, model.RETURN_STATUS_REQUESTED, mock.MatchedBy(func(entry model.ReturnHistory) bool {
+					return entry.ToStatus == model.RETURN_STATUS_APPROVED && entry.Actor == "admin@email.com"
+				})).
+					Return(true, nil)
+			},
+			Expected: model.RETURN_STATUS_APPROVED,
+		},
+		{
+			Name: "rejects a requested return",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, returnId).
+					Return(returnWithStatus(model.RETURN_STATUS_REQUESTED), nil)
+				dep.repoSQL.EXPECT().TransitionReturn(mock.Anything, mock.Anything, model.RETURN_STATUS_REQUESTED, mock.Anything).
+					Return(true, nil)
+			},
+			Expected: model.RETURN_STATUS_REJECTED,
+		},
+		{
+			Name:    "approved return cannot be decided again",
+			Approve: true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, returnId).
+					Return(returnWithStatus(model.RETURN_STATUS_APPROVED), nil)
+			},
+			ExpectedErr: errlib.ErrCodeReturnStatus,
+		},
+		{
+			Name:    "decided by a concurrent call",
+			Approve: true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, returnId).
+					Return(returnWithStatus(model.RETURN_STATUS_REQUESTED), nil)
+				dep.repoSQL.EXPECT().TransitionReturn(mock.Anything, mock.Anything, model.RETURN_STATUS_REQUESTED, mock.Anything).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeReturnStatus,
+		},
+		{
+			Name:    "return not found",
+			Approve: true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, returnId).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			decide := usecase.RejectReturn
+			if tc.Approve {
+				decide = usecase.ApproveReturn
+			}
+			result, err := decide(context.Background(), returnId, "admin@email.com", "checked")
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, result.Status)
+		})
+	}
+}
+
+func TestOrderUsecase_ReceiveReturn(t *testing.T) {
+	// every case captures its own payment on the fake provider
+	capturedPayment := func(key string) *model.Payment {
+		auth, _ := mockPaymentProvider.Authorize(context.Background(), payment.AuthorizeRequest{
+			OrderId:        mockOrderId,
+			Amount:         fixed.NewS("100"),
+			Currency:       "USD",
+			IdempotencyKey: key,
+		})
+		capture, _ := mockPaymentProvider.Capture(context.Background(), auth.Reference, fixed.NewS("100"))
+		return &model.Payment{
+			Id:               uuid.New(),
+			OrderId:          mockOrderId,
+			Status:           model.PAYMENT_STATUS_CAPTURED,
+			Amount:           fixed.NewS("100"),
+			CapturedAmount:   fixed.NewS("100"),
+			Currency:         "USD",
+			AuthorizationRef: auth.Reference,
+			CaptureRef:       capture.Reference,
+		}
+	}
+	returnWithStatus := func(status string) *model.OrderReturn {
+		return &model.OrderReturn{
+			Id:           uuid.New(),
+			OrderId:      mockOrderId,
+			Status:       status,
+			RefundAmount: fixed.NewS("25"),
+			Currency:     "USD",
+			Items: []model.ReturnItem{
+				{Sku: "TSHIRT-M-WHITE", Quantity: fixed.NewS("1"), PricePerUom: fixed.NewS("25"), UomCode: "EA"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Return      *model.OrderReturn
+		Mock        func(dep *usecaseDeps, orderReturn *model.OrderReturn)
+		ExpectedErr string
+	}{
+		{
+			Name:   "restocks the goods and refunds the return",
+			Return: returnWithStatus(model.RETURN_STATUS_APPROVED),
+			Mock: func(dep *usecaseDeps, orderReturn *model.OrderReturn) {
+				captured := capturedPayment("receive-approved")
+
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, orderReturn.Id).
+					Return(orderReturn, nil)
+				dep.inventoryGrpcClient.EXPECT().RestockReturn(mock.Anything, mock.MatchedBy(func(req *inventoryv1.RestockReturnRequest) bool {
+					return req.ReturnId == orderReturn.Id.String() && len(req.Items) == 1 && req.Items[0].ReqQtyPerUom == 1
+				})).
+					Return(&inventoryv1.RestockReturnResponse{ReturnId: orderReturn.Id.String()}, nil)
+				dep.repoSQL.EXPECT().TransitionReturn(mock.Anything, orderReturn, model.RETURN_STATUS_APPROVED, mock.Anything).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(captured, nil)
+				dep.repoSQL.EXPECT().RefundReturn(mock.Anything, orderReturn, mock.Anything, captured.Id, mock.MatchedBy(func(amount fixed.Fixed) bool {
+					return amount.Equal(fixed.NewS("25"))
+				})).
+					Return(true, nil)
+			},
+		},
+		{
+			Name:   "received return whose refund failed is refunded without restocking",
+			Return: returnWithStatus(model.RETURN_STATUS_RECEIVED),
+			Mock: func(dep *usecaseDeps, orderReturn *model.OrderReturn) {
+				captured := capturedPayment("receive-retried")
+
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, orderReturn.Id).
+					Return(orderReturn, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(captured, nil)
+				dep.repoSQL.EXPECT().RefundReturn(mock.Anything, orderReturn, mock.Anything, captured.Id, mock.Anything).
+					Return(true, nil)
+			},
+		},
+		{
+			Name:   "requested return is not received",
+			Return: returnWithStatus(model.RETURN_STATUS_REQUESTED),
+			Mock: func(dep *usecaseDeps, orderReturn *model.OrderReturn) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, orderReturn.Id).
+					Return(orderReturn, nil)
+			},
+			ExpectedErr: errlib.ErrCodeReturnStatus,
+		},
+		{
+			Name:   "sku rejected by the inventory service",
+			Return: returnWithStatus(model.RETURN_STATUS_APPROVED),
+			Mock: func(dep *usecaseDeps, orderReturn *model.OrderReturn) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, orderReturn.Id).
+					Return(orderReturn, nil)
+				dep.inventoryGrpcClient.EXPECT().RestockReturn(mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "sku TSHIRT-M-WHITE not found"))
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name:   "unreachable inventory service",
+			Return: returnWithStatus(model.RETURN_STATUS_APPROVED),
+			Mock: func(dep *usecaseDeps, orderReturn *model.OrderReturn) {
+				dep.repoSQL.EXPECT().GetReturn(mock.Anything, orderReturn.Id).
+					Return(orderReturn, nil)
+				dep.inventoryGrpcClient.EXPECT().RestockReturn(mock.Anything, mock.Anything).
+					Return(nil, errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed restock return to inventory service", mock.Anything, mock.Anything)
+			},
+			ExpectedErr: errlib.ErrCodeInternalServer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps, tc.Return)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.ReceiveReturn(context.Background(), tc.Return.Id, "admin@email.com")
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.RETURN_STATUS_REFUNDED, result.Status)
+			assert.Equal(t, "fake_ref_"+tc.Return.Id.String(), result.RefundRef)
+		})
+	}
+}
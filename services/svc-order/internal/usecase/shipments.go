@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"fmt"
+	inventoryv1 "pb_schemas/inventory/v1"
+	notificationv1 "pb_schemas/notification/v1"
+	"strings"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateShipment packs lines of a CONFIRMED order into a PACKED shipment. a line can be packed up to its
+// reserved quantity minus what other shipments of the order hold
+func (u *OrderUsecase) CreateShipment(ctx context.Context, orderId uuid.UUID, request types.ShipmentRequest) (*model.Shipment, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if order.Status != model.ORDER_STATUS_CONFIRMED {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderNotShippable)
+	}
+
+	packed, err := u.repoSQL.GetShipmentQuantities(ctx, orderId, shipmentStatuses)
+	if err != nil {
+		u.logger.Errorf("failed in GetShipmentQuantities", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	ordered := map[string]model.ItemOrder{}
+	for _, item := range items {
+		ordered[item.Sku] = item
+	}
+
+	shipment := &model.Shipment{
+		Id:      uuid.New(),
+		OrderId: orderId,
+		Status:  model.SHIPMENT_STATUS_PACKED,
+		Carrier: strings.TrimSpace(request.Carrier),
+	}
+	if request.TrackingNumber != nil {
+		shipment.TrackingNumber = strings.TrimSpace(*request.TrackingNumber)
+	}
+
+	for _, req := range request.Items {
+		item, ok := ordered[req.Sku]
+		if !ok {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"sku": req.Sku + " is not on the order"},
+			})
+		}
+
+		// short lines only ship their reserved part
+		quantity := fixed.NewF(req.Quantity)
+		packable := reservedQuantity(item).Sub(packed[item.Id])
+		if quantity.GreaterThan(packable) {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"quantity": fmt.Sprintf("%s can be packed up to %s", req.Sku, packable.String())},
+			})
+		}
+		packed[item.Id] = packed[item.Id].Add(quantity)
+
+		shipment.Items = append(shipment.Items, model.ShipmentItem{
+			Id:          uuid.New(),
+			ShipmentId:  shipment.Id,
+			OrderItemId: item.Id,
+			Sku:         item.Sku,
+			Quantity:    quantity,
+			UomCode:     item.UomCode,
+		})
+	}
+
+	// the order version keeps two requests from packing the same quantity
+	inserted, err := u.repoSQL.InsertShipment(ctx, order, shipment)
+	if err != nil {
+		u.logger.Errorf("failed in InsertShipment", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !inserted {
+		return nil, errlib.NewAppError(errlib.ErrCodeOrderModified)
+	}
+
+	u.notifyShipment(ctx, order, shipment)
+	return shipment, nil
+}
+
+// GetOrderShipments lists the shipments of an order with their items to the customer who placed it, admins
+// can read the shipments of every order
+func (u *OrderUsecase) GetOrderShipments(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.Shipment, error) {
+
+	order, err := u.repoSQL.GetOrderById(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderById", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil || (!admin && !placedBy(order, customer)) {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	shipments, err := u.repoSQL.GetOrderShipments(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderShipments", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return shipments, nil
+}
+
+// ShipShipment hands a PACKED shipment to its carrier: the inventory service consumes the reserved stock of
+// its items and the shipment is SHIPPED. once every line of the order shipped the payment is captured and the
+// order is FULFILLED, fulfilled tells whether that happened. a failed capture leaves the order CONFIRMED
+// for FulfilOrder to retry, the shipment stays SHIPPED
+func (u *OrderUsecase) ShipShipment(ctx context.Context, shipmentId uuid.UUID, request types.ShipShipmentRequest) (shipment *model.Shipment, fulfilled bool, err error) {
+
+	shipment, err = u.getShipment(ctx, shipmentId)
+	if err != nil {
+		return nil, false, err
+	}
+	if shipment.Status != model.SHIPMENT_STATUS_PACKED {
+		return nil, false, errlib.NewAppError(errlib.ErrCodeShipmentStatus)
+	}
+
+	if request.Carrier != nil && strings.TrimSpace(*request.Carrier) != "" {
+		shipment.Carrier = strings.TrimSpace(*request.Carrier)
+	}
+	if request.TrackingNumber != nil {
+		shipment.TrackingNumber = strings.TrimSpace(*request.TrackingNumber)
+	}
+	if shipment.TrackingNumber == "" {
+		return nil, false, errlib.ErrValidationError([]map[string]interface{}{
+			{"tracking_number": "a shipment needs a tracking number to ship"},
+		})
+	}
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, shipment.OrderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, false, errlib.ErrDBQuery()
+	}
+	if order == nil {
+		return nil, false, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+	if order.Status != model.ORDER_STATUS_CONFIRMED {
+		return nil, false, errlib.NewAppError(errlib.ErrCodeOrderNotShippable)
+	}
+
+	// the shipment id commits its items once, a retry after a failed transition is safe
+	commit := make([]*inventoryv1.CommitItem, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		commit = append(commit, &inventoryv1.CommitItem{
+			Sku:      item.Sku,
+			Quantity: item.Quantity.Float(),
+		})
+	}
+	if err := u.commitReservation(ctx, order.Id, shipment.Id, commit); err != nil {
+		return nil, false, err
+	}
+
+	now := time.Now()
+	shipment.Status = model.SHIPMENT_STATUS_SHIPPED
+	shipment.ShippedAt = &now
+	if err := u.transitionShipment(ctx, shipment, model.SHIPMENT_STATUS_PACKED); err != nil {
+		return nil, false, err
+	}
+	u.notifyShipment(ctx, order, shipment)
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_SHIPMENT_SHIPPED, order, order.Status, map[string]interface{}{
+		"shipment": shipment,
+	})
+
+	shipped, err := u.repoSQL.GetShipmentQuantities(ctx, order.Id, []string{model.SHIPMENT_STATUS_SHIPPED, model.SHIPMENT_STATUS_DELIVERED})
+	if err != nil {
+		u.logger.Errorf("failed in GetShipmentQuantities", "error", err.Error())
+		return shipment, false, nil
+	}
+	for _, item := range items {
+		if shipped[item.Id].LessThan(reservedQuantity(item)) {
+			return shipment, false, nil
+		}
+	}
+
+	if _, err := u.fulfil(ctx, order); err != nil {
+		u.logger.Errorf("failed to fulfil shipped order "+order.Id.String(), "error", err.Error())
+		return shipment, false, nil
+	}
+
+	return shipment, true, nil
+}
+
+// DeliverShipment marks a SHIPPED shipment DELIVERED
+func (u *OrderUsecase) DeliverShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error) {
+
+	shipment, err := u.getShipment(ctx, shipmentId)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Status != model.SHIPMENT_STATUS_SHIPPED {
+		return nil, errlib.NewAppError(errlib.ErrCodeShipmentStatus)
+	}
+
+	now := time.Now()
+	shipment.Status = model.SHIPMENT_STATUS_DELIVERED
+	shipment.DeliveredAt = &now
+	if err := u.transitionShipment(ctx, shipment, model.SHIPMENT_STATUS_SHIPPED); err != nil {
+		return nil, err
+	}
+
+	order, err := u.repoSQL.GetOrderById(ctx, shipment.OrderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderById", "error", err.Error())
+	} else if order != nil {
+		u.notifyShipment(ctx, order, shipment)
+		u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_SHIPMENT_DELIVERED, order, order.Status, map[string]interface{}{
+			"shipment": shipment,
+		})
+	}
+
+	return shipment, nil
+}
+
+// every status of a shipment, packed quantities count until the order is fulfilled
+var shipmentStatuses = []string{model.SHIPMENT_STATUS_PACKED, model.SHIPMENT_STATUS_SHIPPED, model.SHIPMENT_STATUS_DELIVERED}
+
+func (u *OrderUsecase) getShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error) {
+
+	shipment, err := u.repoSQL.GetShipment(ctx, shipmentId)
+	if err != nil {
+		u.logger.Errorf("failed in GetShipment", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if shipment == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return shipment, nil
+}
+
+// moves shipment from status from to shipment.Status, SHIPMENT_STATUS_CONFLICT when another call moved it first
+func (u *OrderUsecase) transitionShipment(ctx context.Context, shipment *model.Shipment, from string) error {
+
+	moved, err := u.repoSQL.TransitionShipment(ctx, shipment, from)
+	if err != nil {
+		u.logger.Errorf("failed in TransitionShipment", "error", err.Error())
+		return errlib.ErrDBQuery()
+	}
+	if !moved {
+		return errlib.NewAppError(errlib.ErrCodeShipmentStatus)
+	}
+
+	return nil
+}
+
+// consumes reserved stock of an order through the inventory service, all it still holds when items is empty.
+// the inventory service commits a commit id once so a retry is safe
+func (u *OrderUsecase) commitReservation(ctx context.Context, orderId, commitId uuid.UUID, items []*inventoryv1.CommitItem) error {
+
+	_, err := u.inventoryGrpcClient.CommitReservation(ctx, &inventoryv1.CommitReservationRequest{
+		OrderId:  orderId.String(),
+		CommitId: commitId.String(),
+		Items:    items,
+	})
+	if err != nil {
+		// more than the order still holds, the message tells which sku
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return errlib.ErrValidationError([]map[string]interface{}{
+				{"items": st.Message()},
+			})
+		}
+
+		u.logger.Errorf("failed commit reservation to inventory service", "error", err.Error())
+		return errlib.ErrInternalServer(err)
+	}
+
+	return nil
+}
+
+// emails the customer about a shipment event, best effort, nothing is sent without a notification service
+func (u *OrderUsecase) notifyShipment(ctx context.Context, order *model.Order, shipment *model.Shipment) {
+	if u.notificationGrpcClient == nil || order.UserEmail == "" {
+		return
+	}
+
+	ref := order.Id.String()[:8]
+	var subject, intro string
+	switch shipment.Status {
+	case model.SHIPMENT_STATUS_PACKED:
+		subject = fmt.Sprintf("Your order %s is packed", ref)
+		intro = "We packed part of your order, it leaves the warehouse soon."
+	case model.SHIPMENT_STATUS_SHIPPED:
+		subject = fmt.Sprintf("Your order %s is on its way", ref)
+		intro = fmt.Sprintf("Your parcel was handed to %s, tracking number %s.", shipment.Carrier, shipment.TrackingNumber)
+	case model.SHIPMENT_STATUS_DELIVERED:
+		subject = fmt.Sprintf("Your order %s was delivered", ref)
+		intro = fmt.Sprintf("%s delivered your parcel, tracking number %s.", shipment.Carrier, shipment.TrackingNumber)
+	default:
+		return
+	}
+
+	var body strings.Builder
+	body.WriteString(intro + "\n\n")
+	for _, item := range shipment.Items {
+		fmt.Fprintf(&body, "- %s x %s %s\n", item.Sku, item.Quantity.String(), item.UomCode)
+	}
+
+	resp, err := u.notificationGrpcClient.SendEmail(ctx, &notificationv1.SendEmailRequest{
+		To:      order.UserEmail,
+		Subject: subject,
+		Body:    body.String(),
+	})
+	if err != nil {
+		u.logger.Errorf("failed to send shipment email", "shipment_id", shipment.Id, "error", err.Error())
+		return
+	}
+	if !resp.GetSuccess() {
+		u.logger.Errorf("failed to send shipment email", "shipment_id", shipment.Id, "error", resp.GetMessage())
+	}
+}
@@ -0,0 +1,508 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"strings"
+	"testing"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+	notificationv1 "pb_schemas/notification/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+func confirmedShipmentOrder() *model.Order {
+	order := mockOrder
+	order.Status = model.ORDER_STATUS_CONFIRMED
+	return &order
+}
+
+// email to mockUserEmail whose subject contains words
+func sentShipmentEmail(words string) interface{} {
+	return mock.MatchedBy(func(req *notificationv1.SendEmailRequest) bool {
+		return req.To == mockUserEmail && strings.Contains(req.Subject, words)
+	})
+}
+
+func TestOrderUsecase_CreateShipment(t *testing.T) {
+	tracking := "1Z999AA10123456784"
+
+	testCases := []struct {
+		Name        string
+		Request     types.ShipmentRequest
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name: "packs part of the order and emails the customer",
+			Request: types.ShipmentRequest{
+				Carrier:        "UPS",
+				TrackingNumber: &tracking,
+				Items:          []types.ShipmentItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.repoSQL.EXPECT().InsertShipment(mock.Anything, mock.Anything, mock.MatchedBy(func(s *model.Shipment) bool {
+					return s.Status == model.SHIPMENT_STATUS_PACKED && s.Carrier == "UPS" && s.TrackingNumber == tracking &&
+						len(s.Items) == 1 && s.Items[0].OrderItemId == mockItems[1].Id && s.Items[0].UomCode == "EA"
+				})).
+					Return(true, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentShipmentEmail("packed")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+		},
+		{
+			Name: "order that is not confirmed cannot be shipped",
+			Request: types.ShipmentRequest{
+				Carrier: "UPS",
+				Items:   []types.ShipmentItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderNotShippable,
+		},
+		{
+			Name: "sku not on the order",
+			Request: types.ShipmentRequest{
+				Carrier: "UPS",
+				Items:   []types.ShipmentItemRequest{{Sku: "UNKNOWN-SKU", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "quantity held by an earlier shipment cannot be packed again",
+			Request: types.ShipmentRequest{
+				Carrier: "UPS",
+				Items:   []types.ShipmentItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("1.5")}, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "order modified by a concurrent request",
+			Request: types.ShipmentRequest{
+				Carrier: "UPS",
+				Items:   []types.ShipmentItemRequest{{Sku: "OLIVE-OIL-1L", Quantity: 0.5}},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, shipmentStatuses).
+					Return(map[uuid.UUID]fixed.Fixed{}, nil)
+				dep.repoSQL.EXPECT().InsertShipment(mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeOrderModified,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                 loggerMocks.NewMockLogger(t),
+				repoSQL:                mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:    grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient:  grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:    grpcMocks.NewMockBackorderClient(t),
+				notificationGrpcClient: grpcMocks.NewMockNotificationClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, deps.notificationGrpcClient, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.CreateShipment(context.Background(), mockOrderId, tc.Request)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.SHIPMENT_STATUS_PACKED, result.Status)
+		})
+	}
+}
+
+func TestOrderUsecase_ShipShipment(t *testing.T) {
+	shipmentId := uuid.New()
+	packedShipment := func(tracking string) *model.Shipment {
+		return &model.Shipment{
+			Id:             shipmentId,
+			OrderId:        mockOrderId,
+			Status:         model.SHIPMENT_STATUS_PACKED,
+			Carrier:        "UPS",
+			TrackingNumber: tracking,
+			Items: []model.ShipmentItem{
+				{Id: uuid.New(), ShipmentId: shipmentId, OrderItemId: mockItems[1].Id, Sku: "TSHIRT-M-WHITE", Quantity: fixed.NewS("2"), UomCode: "EA"},
+			},
+		}
+	}
+	// the shipment id commits exactly its items
+	commitsShipment := mock.MatchedBy(func(req *inventoryv1.CommitReservationRequest) bool {
+		return req.OrderId == mockOrderId.String() && req.CommitId == shipmentId.String() &&
+			len(req.Items) == 1 && req.Items[0].Sku == "TSHIRT-M-WHITE" && req.Items[0].Quantity == 2
+	})
+	tracking := "1Z999AA10123456784"
+
+	testCases := []struct {
+		Name              string
+		Request           types.ShipShipmentRequest
+		Mock              func(dep *usecaseDeps)
+		ExpectedErr       string
+		ExpectedFulfilled bool
+	}{
+		{
+			Name:    "ships part of the order, the order stays confirmed",
+			Request: types.ShipShipmentRequest{TrackingNumber: &tracking},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+					Return(packedShipment(""), nil)
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, commitsShipment).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().TransitionShipment(mock.Anything, mock.MatchedBy(func(s *model.Shipment) bool {
+					return s.Status == model.SHIPMENT_STATUS_SHIPPED && s.TrackingNumber == tracking && s.ShippedAt != nil
+				}), model.SHIPMENT_STATUS_PACKED).
+					Return(true, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentShipmentEmail("on its way")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_SHIPPED, model.SHIPMENT_STATUS_DELIVERED}).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("2")}, nil)
+			},
+		},
+		{
+			Name: "shipping the last line captures the payment and fulfils the order",
+			Mock: func(dep *usecaseDeps) {
+				tx, _ := mockPaymentProvider.Authorize(context.Background(), payment.AuthorizeRequest{
+					OrderId:        mockOrderId,
+					Amount:         fixed.NewS("100"),
+					Currency:       "USD",
+					IdempotencyKey: "ship-last-line",
+				})
+
+				dep.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+					Return(packedShipment(tracking), nil)
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, commitsShipment).
+					Return(&inventoryv1.CommitReservationResponse{}, nil)
+				dep.repoSQL.EXPECT().TransitionShipment(mock.Anything, mock.Anything, model.SHIPMENT_STATUS_PACKED).
+					Return(true, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentShipmentEmail("on its way")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+				dep.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, mockOrderId, []string{model.SHIPMENT_STATUS_SHIPPED, model.SHIPMENT_STATUS_DELIVERED}).
+					Return(map[uuid.UUID]fixed.Fixed{mockItems[0].Id: fixed.NewS("0.5"), mockItems[1].Id: fixed.NewS("2")}, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(&model.Payment{Status: model.PAYMENT_STATUS_AUTHORIZED, AuthorizationRef: tx.Reference}, nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Status == model.PAYMENT_STATUS_CAPTURED
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_CONFIRMED, model.ORDER_STATUS_FULFILLED).
+					Return(true, nil)
+			},
+			ExpectedFulfilled: true,
+		},
+		{
+			Name: "shipment without a tracking number cannot ship",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+					Return(packedShipment(""), nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "shipment that already shipped",
+			Mock: func(dep *usecaseDeps) {
+				shipped := packedShipment(tracking)
+				shipped.Status = model.SHIPMENT_STATUS_SHIPPED
+				dep.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+					Return(shipped, nil)
+			},
+			ExpectedErr: errlib.ErrCodeShipmentStatus,
+		},
+		{
+			Name: "shipment shipped by a concurrent request",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+					Return(packedShipment(tracking), nil)
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(confirmedShipmentOrder(), mockItems, nil)
+				dep.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, commitsShipment).
+					Return(&inventoryv1.CommitReservationResponse{AlreadyCommitted: true}, nil)
+				dep.repoSQL.EXPECT().TransitionShipment(mock.Anything, mock.Anything, model.SHIPMENT_STATUS_PACKED).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeShipmentStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                 loggerMocks.NewMockLogger(t),
+				repoSQL:                mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:    grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient:  grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:    grpcMocks.NewMockBackorderClient(t),
+				notificationGrpcClient: grpcMocks.NewMockNotificationClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, deps.notificationGrpcClient, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, fulfilled, err := usecase.ShipShipment(context.Background(), shipmentId, tc.Request)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.SHIPMENT_STATUS_SHIPPED, result.Status)
+			assert.Equal(t, tc.ExpectedFulfilled, fulfilled)
+		})
+	}
+}
+
+func TestOrderUsecase_ShipmentEmailsCustomer(t *testing.T) {
+	customer := model.Customer{UserId: "3f2b8c4e-6d1a-4e9b-8c7f-0a1b2c3d4e5f", Email: "jane.doe@example.com"}
+	tracking := "1Z999AA10123456784"
+
+	deps := usecaseDeps{
+		logger:                 loggerMocks.NewMockLogger(t),
+		repoSQL:                mocks.NewMockIOrderSQLRepository(t),
+		inventoryGrpcClient:    grpcMocks.NewMockInvClient(t),
+		backInStockGrpcClient:  grpcMocks.NewMockBackInStockClient(t),
+		backorderGrpcClient:    grpcMocks.NewMockBackorderClient(t),
+		notificationGrpcClient: grpcMocks.NewMockNotificationClient(t),
+	}
+
+	// the order is placed by the customer and read back by the shipment
+	var placed model.Order
+	var placedItems []model.ItemOrder
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(mockStockResponse, nil)
+	deps.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, order *model.Order, items []model.ItemOrder, _ []model.OrderDiscount, _ []model.OrderTax) error {
+			placed, placedItems = *order, items
+			return nil
+		})
+	deps.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+		Return(mockReserveSuccessResponse, nil)
+	deps.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.Anything).
+		Return(nil)
+	deps.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+		Return(nil)
+	deps.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+		Return(nil)
+
+	usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, deps.notificationGrpcClient, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	_, _, err := usecase.NewOrder(context.Background(), customer, types.OrderRequest{
+		OrderItems: []types.StockItemRequest{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, customer.Email, placed.UserEmail)
+
+	shipmentId := uuid.New()
+	placed.Status = model.ORDER_STATUS_CONFIRMED
+	deps.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+		Return(&model.Shipment{
+			Id:             shipmentId,
+			OrderId:        placed.Id,
+			Status:         model.SHIPMENT_STATUS_PACKED,
+			Carrier:        "UPS",
+			TrackingNumber: tracking,
+			Items: []model.ShipmentItem{
+				{Id: uuid.New(), ShipmentId: shipmentId, OrderItemId: placedItems[1].Id, Sku: placedItems[1].Sku, Quantity: placedItems[1].QuantityPerUom, UomCode: placedItems[1].UomCode},
+			},
+		}, nil)
+	deps.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, placed.Id).
+		Return(&placed, placedItems, nil)
+	deps.inventoryGrpcClient.EXPECT().CommitReservation(mock.Anything, mock.Anything).
+		Return(&inventoryv1.CommitReservationResponse{}, nil)
+	deps.repoSQL.EXPECT().TransitionShipment(mock.Anything, mock.Anything, model.SHIPMENT_STATUS_PACKED).
+		Return(true, nil)
+	deps.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, mock.MatchedBy(func(req *notificationv1.SendEmailRequest) bool {
+		return req.To == customer.Email && strings.Contains(req.Subject, "on its way")
+	})).
+		Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+	deps.repoSQL.EXPECT().GetShipmentQuantities(mock.Anything, placed.Id, []string{model.SHIPMENT_STATUS_SHIPPED, model.SHIPMENT_STATUS_DELIVERED}).
+		Return(map[uuid.UUID]fixed.Fixed{placedItems[1].Id: placedItems[1].QuantityPerUom}, nil)
+
+	_, fulfilled, err := usecase.ShipShipment(context.Background(), shipmentId, types.ShipShipmentRequest{})
+	assert.NoError(t, err)
+	assert.False(t, fulfilled)
+}
+
+func TestOrderUsecase_GetOrderShipments(t *testing.T) {
+	otherCustomer := model.Customer{UserId: "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f", Email: "other@email.com"}
+	shipments := []model.Shipment{{Id: uuid.New(), OrderId: mockOrderId, Status: model.SHIPMENT_STATUS_PACKED}}
+
+	testCases := []struct {
+		Name        string
+		Customer    model.Customer
+		Admin       bool
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name:     "customer lists the shipments of their order",
+			Customer: mockCustomer,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+				dep.repoSQL.EXPECT().GetOrderShipments(mock.Anything, mockOrderId).
+					Return(shipments, nil)
+			},
+		},
+		{
+			Name:     "shipments of another customer are not found",
+			Customer: otherCustomer,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+		{
+			Name:     "admins list the shipments of every order",
+			Customer: otherCustomer,
+			Admin:    true,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+				dep.repoSQL.EXPECT().GetOrderShipments(mock.Anything, mockOrderId).
+					Return(shipments, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.GetOrderShipments(context.Background(), mockOrderId, tc.Customer, tc.Admin)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, shipments, result)
+		})
+	}
+}
+
+func TestOrderUsecase_DeliverShipment(t *testing.T) {
+	shipmentId := uuid.New()
+
+	testCases := []struct {
+		Name        string
+		Status      string
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name:   "delivers a shipped shipment and emails the customer",
+			Status: model.SHIPMENT_STATUS_SHIPPED,
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().TransitionShipment(mock.Anything, mock.MatchedBy(func(s *model.Shipment) bool {
+					return s.Status == model.SHIPMENT_STATUS_DELIVERED && s.DeliveredAt != nil
+				}), model.SHIPMENT_STATUS_SHIPPED).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().GetOrderById(mock.Anything, mockOrderId).
+					Return(&mockOrder, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentShipmentEmail("delivered")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+		},
+		{
+			Name:        "packed shipment cannot be delivered",
+			Status:      model.SHIPMENT_STATUS_PACKED,
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: errlib.ErrCodeShipmentStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                 loggerMocks.NewMockLogger(t),
+				repoSQL:                mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:    grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient:  grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:    grpcMocks.NewMockBackorderClient(t),
+				notificationGrpcClient: grpcMocks.NewMockNotificationClient(t),
+			}
+
+			deps.repoSQL.EXPECT().GetShipment(mock.Anything, shipmentId).
+				Return(&model.Shipment{Id: shipmentId, OrderId: mockOrderId, Status: tc.Status, Carrier: "UPS", TrackingNumber: "1Z999AA10123456784"}, nil)
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, deps.notificationGrpcClient, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.DeliverShipment(context.Background(), shipmentId)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.SHIPMENT_STATUS_DELIVERED, result.Status)
+		})
+	}
+}
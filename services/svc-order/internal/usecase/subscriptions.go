@@ -0,0 +1,426 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"fmt"
+	notificationv1 "pb_schemas/notification/v1"
+	"strings"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/repository"
+	"ops-monorepo/services/svc-order/internal/schedule"
+	grpc "ops-monorepo/shared-libs/grpc/client"
+	"ops-monorepo/shared-libs/logger"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+const (
+	// most subscriptions a scheduler run claims, the others are left to the next run or another replica
+	subscriptionClaimLimit = 50
+	// shortest time allowed between two runs of a subscription
+	subscriptionMinInterval = time.Hour
+	// runs checked against subscriptionMinInterval when a subscription is created
+	subscriptionIntervalChecks = 10
+	// runs shown with a subscription
+	subscriptionRunsShown = 20
+)
+
+type (
+	ISubscriptionUsecase interface {
+		CreateSubscription(ctx context.Context, customer model.Customer, request types.SubscriptionRequest) (*model.Subscription, error)
+		GetSubscriptions(ctx context.Context, customer model.Customer) ([]model.Subscription, error)
+		GetSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.SubscriptionDetail, error)
+		PauseSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.Subscription, error)
+		ResumeSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.Subscription, error)
+		RunDueSubscriptions(ctx context.Context) (int, error)
+	}
+
+	// SubscriptionUsecase keeps the recurring orders of users, the order of each run is placed through the order
+	// usecase
+	SubscriptionUsecase struct {
+		logger                 logger.Logger
+		repoSQL                repository.IOrderSQLRepository
+		orders                 IOrderUsecase
+		notificationGrpcClient grpc.NotificationClient
+		now                    func() time.Time
+	}
+)
+
+func NewSubscriptionUsecase(sql repository.IOrderSQLRepository, log logger.Logger, orders IOrderUsecase, notificationClient grpc.NotificationClient) ISubscriptionUsecase {
+	return &SubscriptionUsecase{
+		logger:                 log,
+		repoSQL:                sql,
+		orders:                 orders,
+		notificationGrpcClient: notificationClient,
+		now:                    time.Now,
+	}
+}
+
+// CreateSubscription starts a subscription of the customer, its first run is the first time of the schedule from
+// now. the order of every run is placed for the customer
+func (u *SubscriptionUsecase) CreateSubscription(ctx context.Context, customer model.Customer, request types.SubscriptionRequest) (*model.Subscription, error) {
+
+	timezone := "UTC"
+	if request.Timezone != nil && strings.TrimSpace(*request.Timezone) != "" {
+		timezone = strings.TrimSpace(*request.Timezone)
+	}
+	sched, err := parseSchedule(request.Schedule, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	nextRunAt := sched.Next(now)
+	// runs closer than the minimum interval would place overlapping orders
+	for i, runAt := 0, nextRunAt; i < subscriptionIntervalChecks; i++ {
+		following := sched.Next(runAt)
+		if following.IsZero() {
+			break
+		}
+		if following.Sub(runAt) < subscriptionMinInterval {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"schedule": "runs of the schedule must be at least " + subscriptionMinInterval.String() + " apart"},
+			})
+		}
+		runAt = following
+	}
+
+	policy := model.SUBSCRIPTION_SHORTAGE_SKIP
+	if request.ShortagePolicy != nil {
+		policy = string(*request.ShortagePolicy)
+	}
+
+	subscription := &model.Subscription{
+		UserId:          customer.UserId,
+		UserEmail:       customer.Email,
+		Status:          model.SUBSCRIPTION_STATUS_ACTIVE,
+		Schedule:        strings.TrimSpace(request.Schedule),
+		Timezone:        timezone,
+		ShortagePolicy:  policy,
+		PaymentMethod:   request.PaymentMethod,
+		ShippingAddress: shippingAddress(types.OrderRequest{ShippingAddress: request.ShippingAddress}),
+		NextRunAt:       &nextRunAt,
+	}
+	for _, item := range request.Items {
+		subscription.Items = append(subscription.Items, model.SubscriptionItem{
+			Sku:            item.Sku,
+			Uom:            item.Uom,
+			QuantityPerUom: fixed.NewF(item.QuantityPerUom),
+		})
+	}
+
+	if err := u.repoSQL.InsertSubscription(ctx, subscription); err != nil {
+		u.logger.Errorf("failed in InsertSubscription", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return subscription, nil
+}
+
+// GetSubscriptions lists the subscriptions of the user
+func (u *SubscriptionUsecase) GetSubscriptions(ctx context.Context, customer model.Customer) ([]model.Subscription, error) {
+
+	subscriptions, err := u.repoSQL.GetUserSubscriptions(ctx, customer.UserId)
+	if err != nil {
+		u.logger.Errorf("failed in GetUserSubscriptions", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return subscriptions, nil
+}
+
+// GetSubscription returns a subscription of the user with its latest runs
+func (u *SubscriptionUsecase) GetSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.SubscriptionDetail, error) {
+
+	subscription, err := u.getSubscription(ctx, customer, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := u.repoSQL.GetSubscriptionRuns(ctx, subscriptionId, subscriptionRunsShown)
+	if err != nil {
+		u.logger.Errorf("failed in GetSubscriptionRuns", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return &model.SubscriptionDetail{Subscription: *subscription, Runs: runs}, nil
+}
+
+// PauseSubscription stops the runs of a subscription until it is resumed, pausing a paused one changes nothing
+func (u *SubscriptionUsecase) PauseSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.Subscription, error) {
+
+	subscription, err := u.getSubscription(ctx, customer, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Status == model.SUBSCRIPTION_STATUS_PAUSED {
+		return subscription, nil
+	}
+
+	return u.setStatus(ctx, subscription, model.SUBSCRIPTION_STATUS_PAUSED, nil)
+}
+
+// ResumeSubscription restarts a paused subscription at the first time of its schedule from now, the runs missed
+// while it was paused are not made up for. resuming an active one changes nothing
+func (u *SubscriptionUsecase) ResumeSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.Subscription, error) {
+
+	subscription, err := u.getSubscription(ctx, customer, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Status == model.SUBSCRIPTION_STATUS_ACTIVE {
+		return subscription, nil
+	}
+
+	now := u.now()
+	nextRunAt := u.nextRunAt(subscription, now, now)
+	if nextRunAt == nil {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"schedule": "the schedule has no next run"},
+		})
+	}
+
+	return u.setStatus(ctx, subscription, model.SUBSCRIPTION_STATUS_ACTIVE, nextRunAt)
+}
+
+// RunDueSubscriptions places the order of every subscription whose next run is due and returns how many ran.
+// a subscription is claimed by a single replica and each scheduled time runs at most once, runs missed while
+// the scheduler was down are collapsed into one
+func (u *SubscriptionUsecase) RunDueSubscriptions(ctx context.Context) (int, error) {
+
+	now := u.now()
+	due, err := u.repoSQL.ClaimDueSubscriptions(ctx, subscriptionClaimLimit, now, func(subscription *model.Subscription) *time.Time {
+		return u.nextRunAt(subscription, *subscription.NextRunAt, now)
+	})
+	if err != nil {
+		u.logger.Errorf("failed in ClaimDueSubscriptions", "error", err.Error())
+		return 0, errlib.ErrDBQuery()
+	}
+
+	for i := range due {
+		u.runSubscription(ctx, &due[i].Subscription, &due[i].Run)
+	}
+
+	return len(due), nil
+}
+
+// places the order of a run, the shortage policy of the subscription tells what happens to short items
+func (u *SubscriptionUsecase) runSubscription(ctx context.Context, subscription *model.Subscription, run *model.SubscriptionRun) {
+
+	request := types.OrderRequest{
+		PaymentMethod:   subscription.PaymentMethod,
+		ShippingAddress: addressRequest(subscription.ShippingAddress),
+	}
+	for _, item := range subscription.Items {
+		request.OrderItems = append(request.OrderItems, types.StockItemRequest{
+			Sku:            item.Sku,
+			Uom:            item.Uom,
+			QuantityPerUom: item.QuantityPerUom.Float(),
+		})
+	}
+	switch subscription.ShortagePolicy {
+	case model.SUBSCRIPTION_SHORTAGE_PARTIAL:
+		policy := types.PARTIAL
+		request.ReservationPolicy = &policy
+	case model.SUBSCRIPTION_SHORTAGE_BACKORDER:
+		allowBackorder := true
+		request.AllowBackorder = &allowBackorder
+	}
+
+	order, failed, err := u.orders.NewOrder(ctx, model.Customer{UserId: subscription.UserId, Email: subscription.UserEmail}, request)
+	if order != nil {
+		orderId := order.Order.Id
+		run.OrderId = &orderId
+	}
+	switch {
+	case err != nil:
+		run.Status = model.SUBSCRIPTION_RUN_FAILED
+		run.Error = err.Error()
+	case len(failed) > 0:
+		run.Status = model.SUBSCRIPTION_RUN_SKIPPED
+		skus := make([]string, 0, len(failed))
+		for _, f := range failed {
+			skus = append(skus, f.Sku)
+		}
+		run.Error = "out of stock: " + strings.Join(skus, ", ")
+	case order.Order.Status == model.ORDER_STATUS_BACKORDERED:
+		run.Status = model.SUBSCRIPTION_RUN_BACKORDERED
+	case hasShortItems(order.Items):
+		run.Status = model.SUBSCRIPTION_RUN_PARTIAL
+	default:
+		run.Status = model.SUBSCRIPTION_RUN_PLACED
+	}
+
+	if err := u.repoSQL.FinishSubscriptionRun(ctx, run); err != nil {
+		u.logger.Errorf("failed in FinishSubscriptionRun", "error", err.Error())
+	}
+
+	if run.Status == model.SUBSCRIPTION_RUN_SKIPPED && subscription.ShortagePolicy == model.SUBSCRIPTION_SHORTAGE_PAUSE {
+		if _, err := u.repoSQL.SetSubscriptionStatus(ctx, subscription, model.SUBSCRIPTION_STATUS_PAUSED, nil); err != nil {
+			u.logger.Errorf("failed in SetSubscriptionStatus", "error", err.Error())
+		}
+	}
+
+	u.notifySubscriptionRun(ctx, subscription, run, order)
+}
+
+// first run of the schedule of subscription after after that is later than now, nil when the schedule has none
+func (u *SubscriptionUsecase) nextRunAt(subscription *model.Subscription, after, now time.Time) *time.Time {
+
+	sched, err := parseSchedule(subscription.Schedule, subscription.Timezone)
+	if err != nil {
+		u.logger.Errorf("invalid schedule of subscription "+subscription.Id.String(), "error", err.Error())
+		return nil
+	}
+
+	next := sched.Next(after)
+	if !next.IsZero() && !next.After(now) {
+		next = sched.Next(now)
+	}
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+func (u *SubscriptionUsecase) getSubscription(ctx context.Context, customer model.Customer, subscriptionId uuid.UUID) (*model.Subscription, error) {
+
+	subscription, err := u.repoSQL.GetSubscription(ctx, subscriptionId)
+	if err != nil {
+		u.logger.Errorf("failed in GetSubscription", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	// the subscriptions of other users are not told apart from missing ones
+	if subscription == nil || customer.UserId == "" || subscription.UserId != customer.UserId {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return subscription, nil
+}
+
+func (u *SubscriptionUsecase) setStatus(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time) (*model.Subscription, error) {
+
+	ok, err := u.repoSQL.SetSubscriptionStatus(ctx, subscription, status, nextRunAt)
+	if err != nil {
+		u.logger.Errorf("failed in SetSubscriptionStatus", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !ok {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return subscription, nil
+}
+
+// emails the customer the outcome of a run, a failed email is only logged
+func (u *SubscriptionUsecase) notifySubscriptionRun(ctx context.Context, subscription *model.Subscription, run *model.SubscriptionRun, order *model.OrderWithItems) {
+	if u.notificationGrpcClient == nil || subscription.UserEmail == "" {
+		return
+	}
+
+	var subject, intro string
+	switch run.Status {
+	case model.SUBSCRIPTION_RUN_PLACED:
+		subject = "Your subscription order was placed"
+		intro = "We placed the order of your subscription."
+	case model.SUBSCRIPTION_RUN_PARTIAL:
+		subject = "Your subscription order was placed in part"
+		intro = "Some items of your subscription are short, we placed the order for the items in stock."
+	case model.SUBSCRIPTION_RUN_BACKORDERED:
+		subject = "Your subscription order is backordered"
+		intro = "Some items of your subscription are short, the order ships once they are back in stock."
+	case model.SUBSCRIPTION_RUN_SKIPPED:
+		subject = "Your subscription order was skipped"
+		intro = "Items of your subscription are short, no order was placed this time (" + run.Error + ")."
+		if subscription.Status == model.SUBSCRIPTION_STATUS_PAUSED {
+			intro += " Your subscription is paused until you resume it."
+		}
+	default:
+		subject = "Your subscription order could not be placed"
+		intro = "We could not place the order of your subscription (" + run.Error + ")."
+	}
+
+	var body strings.Builder
+	body.WriteString(intro + "\n\n")
+	if order != nil && run.Status != model.SUBSCRIPTION_RUN_SKIPPED {
+		fmt.Fprintf(&body, "Order %s\n", order.Order.Id.String()[:8])
+	}
+	for _, item := range subscription.Items {
+		fmt.Fprintf(&body, "- %s x %s %s\n", item.Sku, item.QuantityPerUom.String(), item.Uom)
+	}
+	if subscription.NextRunAt != nil {
+		loc, err := time.LoadLocation(subscription.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		fmt.Fprintf(&body, "\nThe next order is placed on %s.\n", subscription.NextRunAt.In(loc).Format("Mon, 02 Jan 2006 15:04 MST"))
+	}
+
+	resp, err := u.notificationGrpcClient.SendEmail(ctx, &notificationv1.SendEmailRequest{
+		To:      subscription.UserEmail,
+		Subject: subject,
+		Body:    body.String(),
+	})
+	if err != nil {
+		u.logger.Errorf("failed to send subscription email", "subscription_id", subscription.Id, "error", err.Error())
+		return
+	}
+	if !resp.GetSuccess() {
+		u.logger.Errorf("failed to send subscription email", "subscription_id", subscription.Id, "error", resp.GetMessage())
+	}
+}
+
+// schedule of a subscription read in its time zone, errors are validation errors
+func parseSchedule(spec, timezone string) (schedule.Schedule, error) {
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "Local" {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"timezone": "timezone must be an IANA time zone such as Europe/Berlin"},
+		})
+	}
+	sched, err := schedule.Parse(spec, loc)
+	if err != nil {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"schedule": err.Error()},
+		})
+	}
+
+	return sched, nil
+}
+
+// order request address of a stored address
+func addressRequest(a *model.Address) *types.AddressRequest {
+	if a == nil {
+		return nil
+	}
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	return &types.AddressRequest{
+		CountryCode: a.CountryCode,
+		Region:      optional(a.Region),
+		City:        optional(a.City),
+		PostalCode:  optional(a.PostalCode),
+		Line1:       optional(a.Line1),
+		Line2:       optional(a.Line2),
+	}
+}
+
+// lines of a per line reservation that were not fully reserved
+func hasShortItems(items []model.ItemOrder) bool {
+	for _, item := range items {
+		if item.ShortQuantity != nil && item.ShortQuantity.GreaterThan(fixed.ZERO) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	notificationv1 "pb_schemas/notification/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+var (
+	// a wednesday
+	mockSubscriptionNow = time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)
+	mockSubscriptionId  = uuid.MustParse("3f6b1c2a-8d4e-4f7a-9b2c-1e5d7a9c3b8f")
+)
+
+type subscriptionDeps struct {
+	logger                 *loggerMocks.MockLogger
+	repoSQL                *mocks.MockIOrderSQLRepository
+	orders                 *mocks.MockIOrderUsecase
+	notificationGrpcClient *grpcMocks.MockNotificationClient
+}
+
+func newSubscriptionDeps(t *testing.T) (*subscriptionDeps, *SubscriptionUsecase) {
+	deps := &subscriptionDeps{
+		logger:                 loggerMocks.NewMockLogger(t),
+		repoSQL:                mocks.NewMockIOrderSQLRepository(t),
+		orders:                 mocks.NewMockIOrderUsecase(t),
+		notificationGrpcClient: grpcMocks.NewMockNotificationClient(t),
+	}
+	usecase := &SubscriptionUsecase{
+		logger:                 deps.logger,
+		repoSQL:                deps.repoSQL,
+		orders:                 deps.orders,
+		notificationGrpcClient: deps.notificationGrpcClient,
+		now:                    func() time.Time { return mockSubscriptionNow },
+	}
+	return deps, usecase
+}
+
+func mockSubscription(status, policy string) *model.Subscription {
+	// the monday of the week before, the run of the monday after it was missed too
+	nextRunAt := time.Date(2023, 12, 25, 8, 0, 0, 0, time.UTC)
+	s := &model.Subscription{
+		Id:             mockSubscriptionId,
+		UserId:         mockUserId,
+		UserEmail:      mockUserEmail,
+		Status:         status,
+		Schedule:       "0 8 * * MON",
+		Timezone:       "UTC",
+		ShortagePolicy: policy,
+		Items: []model.SubscriptionItem{
+			{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: fixed.NewF(10)},
+		},
+	}
+	if status == model.SUBSCRIPTION_STATUS_ACTIVE {
+		s.NextRunAt = &nextRunAt
+	}
+	return s
+}
+
+// email to mockUserEmail whose subject contains words
+func sentSubscriptionEmail(words string) interface{} {
+	return mock.MatchedBy(func(req *notificationv1.SendEmailRequest) bool {
+		return req.To == mockUserEmail && strings.Contains(req.Subject, words)
+	})
+}
+
+func TestSubscriptionUsecase_CreateSubscription(t *testing.T) {
+	berlin := "Europe/Berlin"
+	backorder := types.SubscriptionRequestShortagePolicyBACKORDER
+
+	testCases := []struct {
+		Name        string
+		Request     types.SubscriptionRequest
+		Mock        func(dep *subscriptionDeps)
+		ExpectedErr string
+		ExpectedRun time.Time
+	}{
+		{
+			Name: "first run is the next time of the schedule",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "0 8 * * MON",
+			},
+			Mock: func(dep *subscriptionDeps) {
+				dep.repoSQL.EXPECT().InsertSubscription(mock.Anything, mock.MatchedBy(func(s *model.Subscription) bool {
+					return s.UserId == mockUserId && s.UserEmail == mockUserEmail && s.Status == model.SUBSCRIPTION_STATUS_ACTIVE && s.Timezone == "UTC" &&
+						s.ShortagePolicy == model.SUBSCRIPTION_SHORTAGE_SKIP && len(s.Items) == 1 && s.Items[0].QuantityPerUom.Equal(fixed.NewF(10))
+				})).
+					Return(nil)
+			},
+			ExpectedRun: time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			Name: "schedule in the time zone of the customer",
+			Request: types.SubscriptionRequest{
+				Items:          []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule:       "@daily",
+				Timezone:       &berlin,
+				ShortagePolicy: &backorder,
+			},
+			Mock: func(dep *subscriptionDeps) {
+				dep.repoSQL.EXPECT().InsertSubscription(mock.Anything, mock.MatchedBy(func(s *model.Subscription) bool {
+					return s.Timezone == berlin && s.ShortagePolicy == model.SUBSCRIPTION_SHORTAGE_BACKORDER
+				})).
+					Return(nil)
+			},
+			ExpectedRun: time.Date(2024, 1, 3, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			Name: "invalid schedule",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "every monday",
+			},
+			Mock:        func(dep *subscriptionDeps) {},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "unknown time zone",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "@weekly",
+				Timezone: func() *string { s := "Mars/Olympus"; return &s }(),
+			},
+			Mock:        func(dep *subscriptionDeps) {},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "runs less than an hour apart",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "0,30 8 * * *",
+			},
+			Mock:        func(dep *subscriptionDeps) {},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "interval less than an hour",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "@every 15m",
+			},
+			Mock:        func(dep *subscriptionDeps) {},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+		{
+			Name: "database error",
+			Request: types.SubscriptionRequest{
+				Items:    []types.StockItemRequest{{Sku: "RICE-5KG", Uom: "EA", QuantityPerUom: 10}},
+				Schedule: "@weekly",
+			},
+			Mock: func(dep *subscriptionDeps) {
+				dep.repoSQL.EXPECT().InsertSubscription(mock.Anything, mock.Anything).
+					Return(errors.New("connection refused"))
+				dep.logger.EXPECT().Errorf("failed in InsertSubscription", mock.Anything)
+			},
+			ExpectedErr: errlib.ErrCodeDBQuery,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps, usecase := newSubscriptionDeps(t)
+			tc.Mock(deps)
+
+			result, err := usecase.CreateSubscription(context.Background(), mockCustomer, tc.Request)
+			if tc.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tc.ExpectedErr, err.(*errlib.AppError).Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.ExpectedRun.Equal(*result.NextRunAt), result.NextRunAt.String())
+		})
+	}
+}
+
+func TestSubscriptionUsecase_PauseResume(t *testing.T) {
+	t.Run("pause clears the next run", func(t *testing.T) {
+		deps, usecase := newSubscriptionDeps(t)
+		deps.repoSQL.EXPECT().GetSubscription(mock.Anything, mockSubscriptionId).
+			Return(mockSubscription(model.SUBSCRIPTION_STATUS_ACTIVE, model.SUBSCRIPTION_SHORTAGE_SKIP), nil)
+		deps.repoSQL.EXPECT().SetSubscriptionStatus(mock.Anything, mock.Anything, model.SUBSCRIPTION_STATUS_PAUSED, (*time.Time)(nil)).
+			Return(true, nil)
+
+		_, err := usecase.PauseSubscription(context.Background(), mockCustomer, mockSubscriptionId)
+		assert.NoError(t, err)
+	})
+
+	t.Run("resume starts from now", func(t *testing.T) {
+		deps, usecase := newSubscriptionDeps(t)
+		deps.repoSQL.EXPECT().GetSubscription(mock.Anything, mockSubscriptionId).
+			Return(mockSubscription(model.SUBSCRIPTION_STATUS_PAUSED, model.SUBSCRIPTION_SHORTAGE_SKIP), nil)
+		deps.repoSQL.EXPECT().SetSubscriptionStatus(mock.Anything, mock.Anything, model.SUBSCRIPTION_STATUS_ACTIVE, mock.MatchedBy(func(next *time.Time) bool {
+			return next != nil && next.Equal(time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC))
+		})).
+			Return(true, nil)
+
+		_, err := usecase.ResumeSubscription(context.Background(), mockCustomer, mockSubscriptionId)
+		assert.NoError(t, err)
+	})
+
+	t.Run("resuming an active subscription changes nothing", func(t *testing.T) {
+		deps, usecase := newSubscriptionDeps(t)
+		deps.repoSQL.EXPECT().GetSubscription(mock.Anything, mockSubscriptionId).
+			Return(mockSubscription(model.SUBSCRIPTION_STATUS_ACTIVE, model.SUBSCRIPTION_SHORTAGE_SKIP), nil)
+
+		result, err := usecase.ResumeSubscription(context.Background(), mockCustomer, mockSubscriptionId)
+		assert.NoError(t, err)
+		assert.Equal(t, model.SUBSCRIPTION_STATUS_ACTIVE, result.Status)
+	})
+
+	t.Run("subscription of another user", func(t *testing.T) {
+		deps, usecase := newSubscriptionDeps(t)
+		deps.repoSQL.EXPECT().GetSubscription(mock.Anything, mockSubscriptionId).
+			Return(mockSubscription(model.SUBSCRIPTION_STATUS_ACTIVE, model.SUBSCRIPTION_SHORTAGE_SKIP), nil)
+
+		// the same email does not make it theirs
+		other := model.Customer{UserId: "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f", Email: mockUserEmail}
+		_, err := usecase.PauseSubscription(context.Background(), other, mockSubscriptionId)
+		assert.Error(t, err)
+		assert.Equal(t, errlib.ErrCodeDataNotFound, err.(*errlib.AppError).Code)
+	})
+
+	t.Run("subscription is kept when the user changed their email", func(t *testing.T) {
+		deps, usecase := newSubscriptionDeps(t)
+		deps.repoSQL.EXPECT().GetSubscription(mock.Anything, mockSubscriptionId).
+			Return(mockSubscription(model.SUBSCRIPTION_STATUS_ACTIVE, model.SUBSCRIPTION_SHORTAGE_SKIP), nil)
+		deps.repoSQL.EXPECT().GetSubscriptionRuns(mock.Anything, mockSubscriptionId, subscriptionRunsShown).
+			Return([]model.SubscriptionRun{}, nil)
+
+		renamed := model.Customer{UserId: mockUserId, Email: "new@email.com"}
+		result, err := usecase.GetSubscription(context.Background(), renamed, mockSubscriptionId)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSubscriptionId, result.Id)
+	})
+}
+
+func TestSubscriptionUsecase_RunDueSubscriptions(t *testing.T) {
+	short := fixed.NewF(4)
+	placed := &model.OrderWithItems{Order: model.Order{Id: mockOrderId, Status: model.ORDER_STATUS_CONFIRMED}}
+	partial := &model.OrderWithItems{
+		Order: model.Order{Id: mockOrderId, Status: model.ORDER_STATUS_CONFIRMED},
+		Items: []model.ItemOrder{{Sku: "RICE-5KG", ShortQuantity: &short}},
+	}
+	backordered := &model.OrderWithItems{Order: model.Order{Id: mockOrderId, Status: model.ORDER_STATUS_BACKORDERED}}
+	failed := &model.OrderWithItems{Order: model.Order{Id: mockOrderId, Status: model.ORDER_STATUS_FAILED_RESERVATION}}
+
+	testCases := []struct {
+		Name           string
+		Policy         string
+		Mock           func(dep *subscriptionDeps)
+		ExpectedStatus string
+	}{
+		{
+			Name:   "order placed for the subscriber",
+			Policy: model.SUBSCRIPTION_SHORTAGE_SKIP,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mockCustomer, mock.MatchedBy(func(r types.OrderRequest) bool {
+					return r.ReservationPolicy == nil && r.AllowBackorder == nil && len(r.OrderItems) == 1 && r.OrderItems[0].QuantityPerUom == 10
+				})).
+					Return(placed, nil, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentSubscriptionEmail("was placed")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_PLACED,
+		},
+		{
+			Name:   "short items are skipped",
+			Policy: model.SUBSCRIPTION_SHORTAGE_SKIP,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).
+					Return(failed, []*model.OrderedItemStockStatus{{Sku: "RICE-5KG"}}, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentSubscriptionEmail("skipped")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_SKIPPED,
+		},
+		{
+			Name:   "short items pause the subscription",
+			Policy: model.SUBSCRIPTION_SHORTAGE_PAUSE,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).
+					Return(failed, []*model.OrderedItemStockStatus{{Sku: "RICE-5KG"}}, nil)
+				dep.repoSQL.EXPECT().SetSubscriptionStatus(mock.Anything, mock.Anything, model.SUBSCRIPTION_STATUS_PAUSED, (*time.Time)(nil)).
+					RunAndReturn(func(_ context.Context, s *model.Subscription, status string, next *time.Time) (bool, error) {
+						s.Status, s.NextRunAt = status, next
+						return true, nil
+					})
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, mock.MatchedBy(func(req *notificationv1.SendEmailRequest) bool {
+					return strings.Contains(req.Body, "paused")
+				})).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_SKIPPED,
+		},
+		{
+			Name:   "items in stock are ordered",
+			Policy: model.SUBSCRIPTION_SHORTAGE_PARTIAL,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.MatchedBy(func(r types.OrderRequest) bool {
+					return r.ReservationPolicy != nil && *r.ReservationPolicy == types.PARTIAL
+				})).
+					Return(partial, nil, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentSubscriptionEmail("in part")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_PARTIAL,
+		},
+		{
+			Name:   "short items are backordered",
+			Policy: model.SUBSCRIPTION_SHORTAGE_BACKORDER,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.MatchedBy(func(r types.OrderRequest) bool {
+					return r.AllowBackorder != nil && *r.AllowBackorder
+				})).
+					Return(backordered, nil, nil)
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentSubscriptionEmail("backordered")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_BACKORDERED,
+		},
+		{
+			Name:   "order error",
+			Policy: model.SUBSCRIPTION_SHORTAGE_SKIP,
+			Mock: func(dep *subscriptionDeps) {
+				dep.orders.EXPECT().NewOrder(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil, errlib.ErrPaymentDeclined(nil))
+				dep.notificationGrpcClient.EXPECT().SendEmail(mock.Anything, sentSubscriptionEmail("could not be placed")).
+					Return(&notificationv1.SendEmailResponse{Success: true}, nil)
+			},
+			ExpectedStatus: model.SUBSCRIPTION_RUN_FAILED,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps, usecase := newSubscriptionDeps(t)
+			tc.Mock(deps)
+
+			subscription := mockSubscription(model.SUBSCRIPTION_STATUS_ACTIVE, tc.Policy)
+			var run *model.SubscriptionRun
+			deps.repoSQL.EXPECT().ClaimDueSubscriptions(mock.Anything, subscriptionClaimLimit, mockSubscriptionNow, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ int, _ time.Time, next func(*model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error) {
+					scheduledFor := *subscription.NextRunAt
+					subscription.NextRunAt = next(subscription)
+					return []model.DueSubscriptionRun{{
+						Run:          model.SubscriptionRun{Id: uuid.New(), SubscriptionId: subscription.Id, ScheduledFor: scheduledFor, Status: model.SUBSCRIPTION_RUN_PENDING},
+						Subscription: *subscription,
+					}}, nil
+				})
+			deps.repoSQL.EXPECT().FinishSubscriptionRun(mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, r *model.SubscriptionRun) error {
+					run = r
+					return nil
+				})
+
+			ran, err := usecase.RunDueSubscriptions(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, 1, ran)
+			assert.Equal(t, tc.ExpectedStatus, run.Status)
+			// missed runs are collapsed into the claimed one
+			assert.True(t, time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC).Equal(*subscription.NextRunAt), subscription.NextRunAt.String())
+		})
+	}
+}
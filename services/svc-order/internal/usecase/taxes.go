@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	inventoryv1 "pb_schemas/inventory/v1"
+	"strings"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/tax"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+)
+
+// shipping address of the order request, nil when omitted
+func shippingAddress(request types.OrderRequest) *model.Address {
+	a := request.ShippingAddress
+	if a == nil {
+		return nil
+	}
+
+	address := &model.Address{CountryCode: strings.ToUpper(strings.TrimSpace(a.CountryCode))}
+	if a.Region != nil {
+		address.Region = strings.ToUpper(strings.TrimSpace(*a.Region))
+	}
+	if a.City != nil {
+		address.City = *a.City
+	}
+	if a.PostalCode != nil {
+		address.PostalCode = *a.PostalCode
+	}
+	if a.Line1 != nil {
+		address.Line1 = *a.Line1
+	}
+	if a.Line2 != nil {
+		address.Line2 = *a.Line2
+	}
+	return address
+}
+
+// tax category of every sku of the stock status
+func stockTaxCategories(statuses []*inventoryv1.InventoryStatus) map[string]string {
+	categories := map[string]string{}
+	for _, s := range statuses {
+		categories[s.Sku] = s.TaxCategory
+	}
+	return categories
+}
+
+// taxOrder works out the tax of the charged quantity of items, net of their discounts, for the shipping
+// address of order and sets its tax amount. order.TotalAmount is the amount net of the discounts, the tax
+// is added to it unless the prices of the jurisdiction include it. an order without a shipping address
+// is not taxed
+func (u *OrderUsecase) taxOrder(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, categories map[string]string) ([]model.OrderTax, error) {
+
+	order.TaxAmount = fixed.NewF(0)
+	order.PricesIncludeTax = false
+	if order.ShippingAddress == nil {
+		return nil, nil
+	}
+
+	discounted := map[string]fixed.Fixed{}
+	for _, d := range discounts {
+		discounted[d.Sku] = discounted[d.Sku].Add(d.Amount)
+	}
+
+	lines := make([]tax.Line, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, tax.Line{
+			Sku:         item.Sku,
+			TaxCategory: categories[item.Sku],
+			Amount:      reservedQuantity(item).Mul(item.PricePerUom).Sub(discounted[item.Sku]),
+		})
+	}
+
+	result, err := u.taxCalculator.Calculate(ctx, tax.Address{
+		CountryCode: order.ShippingAddress.CountryCode,
+		Region:      order.ShippingAddress.Region,
+	}, lines)
+	if errors.Is(err, tax.ErrNoJurisdiction) {
+		return nil, errlib.ErrValidationError([]map[string]interface{}{
+			{"shipping_address": "no tax rules for country " + order.ShippingAddress.CountryCode},
+		})
+	}
+	if err != nil {
+		u.logger.Errorf("failed to calculate tax", "error", err.Error())
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	taxes := make([]model.OrderTax, 0, len(result.Lines))
+	for i, l := range result.Lines {
+		taxes = append(taxes, model.OrderTax{
+			Id:            uuid.New(),
+			OrderId:       order.Id,
+			OrderItemId:   items[i].Id,
+			Jurisdiction:  result.Jurisdiction,
+			Sku:           l.Sku,
+			TaxCategory:   l.TaxCategory,
+			Rate:          l.Rate,
+			TaxableAmount: l.TaxableAmount,
+			TaxAmount:     l.TaxAmount,
+		})
+	}
+
+	order.TaxAmount = result.TaxAmount
+	order.PricesIncludeTax = result.PricesIncludeTax
+	if !result.PricesIncludeTax {
+		order.TotalAmount = order.TotalAmount.Add(result.TaxAmount)
+	}
+	return taxes, nil
+}
@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"testing"
+
+	inventoryv1 "pb_schemas/inventory/v1"
+
+	"github.com/google/uuid"
+	"github.com/robaho/fixed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+func TestOrderUsecase_NewOrderWithShippingAddress(t *testing.T) {
+	orderItems := []types.StockItemRequest{
+		{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+		{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+	}
+	taxedStock := &inventoryv1.InventoryStatusResponse{
+		Items: []*inventoryv1.InventoryStatus{
+			{Sku: "OLIVE-OIL-1L", RequestedQuantity: 0.5, SkuPrice: 50, SkuUom: "L", TaxCategory: "REDUCED"},
+			{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, SkuPrice: 25, SkuUom: "EA", TaxCategory: "STANDARD"},
+		},
+	}
+	california := "ca"
+
+	testCases := []struct {
+		Name                     string
+		Address                  types.AddressRequest
+		Mock                     func(dep *usecaseDeps)
+		ExpectedErr              string
+		ExpectedTotal            string
+		ExpectedTax              string
+		ExpectedPricesIncludeTax bool
+		ExpectedTaxes            map[string]string
+	}{
+		{
+			Name:    "tax is added to prices without it",
+			Address: types.AddressRequest{CountryCode: "us", Region: &california},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(taxedStock, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.MatchedBy(func(order *model.Order) bool {
+					return order.ShippingAddress.CountryCode == "US" && order.ShippingAddress.Region == "CA"
+				}), mock.Anything, mock.Anything, mock.MatchedBy(func(taxes []model.OrderTax) bool {
+					return len(taxes) == 2 && taxes[0].Jurisdiction == "US-CA" && taxes[0].OrderItemId != uuid.Nil
+				})).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Amount.Equal(fixed.NewS("78.63"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+			},
+			// 7.25% of 50, food is not taxed in California
+			ExpectedTotal: "78.63",
+			ExpectedTax:   "3.63",
+			ExpectedTaxes: map[string]string{"OLIVE-OIL-1L": "0", "TSHIRT-M-WHITE": "3.63"},
+		},
+		{
+			Name:    "prices including tax keep their total",
+			Address: types.AddressRequest{CountryCode: "DE"},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(taxedStock, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Amount.Equal(fixed.NewS("75"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.Anything).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.Anything, model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+			},
+			// 7% of 25 and 19% of 50 included in the prices
+			ExpectedTotal:            "75",
+			ExpectedTax:              "9.62",
+			ExpectedPricesIncludeTax: true,
+			ExpectedTaxes:            map[string]string{"OLIVE-OIL-1L": "1.64", "TSHIRT-M-WHITE": "7.98"},
+		},
+		{
+			Name:    "country without tax rules",
+			Address: types.AddressRequest{CountryCode: "XX"},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(taxedStock, nil)
+			},
+			ExpectedErr: errlib.ErrCodeValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, _, err := usecase.NewOrder(context.Background(), mockCustomer, types.OrderRequest{OrderItems: orderItems, ShippingAddress: &tc.Address})
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, fixed.NewS(tc.ExpectedTotal).Equal(result.TotalAmount), "total is %s", result.TotalAmount)
+			assert.True(t, fixed.NewS(tc.ExpectedTax).Equal(result.TaxAmount), "tax is %s", result.TaxAmount)
+			assert.Equal(t, tc.ExpectedPricesIncludeTax, result.PricesIncludeTax)
+			assert.Len(t, result.Taxes, len(tc.ExpectedTaxes))
+			for _, tax := range result.Taxes {
+				assert.True(t, fixed.NewS(tc.ExpectedTaxes[tax.Sku]).Equal(tax.TaxAmount), "%s tax is %s", tax.Sku, tax.TaxAmount)
+			}
+		})
+	}
+}
+
+func TestOrderUsecase_QuoteWithShippingAddress(t *testing.T) {
+	deps := usecaseDeps{
+		logger:                loggerMocks.NewMockLogger(t),
+		repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+		inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+		backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+		backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+	}
+	deps.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+		Return(mockStockResponse, nil)
+
+	region := "NY"
+	usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	quote, err := usecase.Quote(context.Background(), mockCustomer, types.OrderRequest{
+		OrderItems: []types.StockItemRequest{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: 0.5, Uom: "L"},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: 2, Uom: "EA"},
+		},
+		ShippingAddress: &types.AddressRequest{CountryCode: "US", Region: &region},
+	})
+
+	// 4% of 75
+	assert.NoError(t, err)
+	assert.Len(t, quote.Taxes, 2)
+	assert.Equal(t, "US-NY", quote.Taxes[0].Jurisdiction)
+	assert.True(t, fixed.NewS("3").Equal(quote.TaxAmount))
+	assert.True(t, fixed.NewS("78").Equal(quote.TotalAmount))
+}
+
+func TestOrderUsecase_RequestReturnWithTax(t *testing.T) {
+	taxes := []model.OrderTax{
+		{OrderItemId: mockItems[1].Id, Jurisdiction: "US-CA", Sku: "TSHIRT-M-WHITE", TaxAmount: fixed.NewS("3.63")},
+	}
+
+	testCases := []struct {
+		Name             string
+		PricesIncludeTax bool
+		Returned         map[uuid.UUID]fixed.Fixed
+		ExpectedTax      string
+		ExpectedRefund   string
+	}{
+		{
+			Name:           "share of the tax is refunded",
+			Returned:       map[uuid.UUID]fixed.Fixed{},
+			ExpectedTax:    "1.82",
+			ExpectedRefund: "26.82",
+		},
+		{
+			Name:           "last unit takes what earlier returns left",
+			Returned:       map[uuid.UUID]fixed.Fixed{mockItems[1].Id: fixed.NewS("1")},
+			ExpectedTax:    "1.81",
+			ExpectedRefund: "26.81",
+		},
+		{
+			Name:             "tax included in the price is not refunded again",
+			PricesIncludeTax: true,
+			Returned:         map[uuid.UUID]fixed.Fixed{},
+			ExpectedTax:      "1.82",
+			ExpectedRefund:   "25",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			order := mockOrder
+			order.Status = model.ORDER_STATUS_FULFILLED
+			order.ShippingAddress = &model.Address{CountryCode: "US", Region: "CA"}
+			order.PricesIncludeTax = tc.PricesIncludeTax
+
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+			deps.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+				Return(&order, mockItems, nil)
+			deps.repoSQL.EXPECT().GetReturnedQuantities(mock.Anything, mockOrderId).
+				Return(tc.Returned, nil)
+			deps.repoSQL.EXPECT().GetOrderTaxes(mock.Anything, mockOrderId).
+				Return(taxes, nil)
+			deps.repoSQL.EXPECT().InsertReturn(mock.Anything, mock.Anything, model.ORDER_STATUS_FULFILLED, mock.Anything).
+				Return(true, nil)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.RequestReturn(context.Background(), mockOrderId, types.ReturnRequest{
+				Items: []types.ReturnItemRequest{{Sku: "TSHIRT-M-WHITE", Quantity: 1}},
+			}, mockCustomer)
+
+			assert.NoError(t, err)
+			assert.True(t, fixed.NewS(tc.ExpectedTax).Equal(result.Items[0].TaxAmount), "tax is %s", result.Items[0].TaxAmount)
+			assert.True(t, fixed.NewS(tc.ExpectedRefund).Equal(result.RefundAmount), "refund is %s", result.RefundAmount)
+		})
+	}
+}
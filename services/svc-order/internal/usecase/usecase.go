@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errlib"
+	"errors"
 	inventoryv1 "pb_schemas/inventory/v1"
 
 	// "internal/runtime/math"
@@ -12,83 +13,159 @@ import (
 
 	"ops-monorepo/services/svc-order/internal/delivery/types"
 	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/internal/promotion"
 	"ops-monorepo/services/svc-order/internal/repository"
+	"ops-monorepo/services/svc-order/internal/tax"
+	"ops-monorepo/services/svc-order/internal/webhook"
 	grpc "ops-monorepo/shared-libs/grpc/client"
 	"ops-monorepo/shared-libs/logger"
 
 	"github.com/google/uuid"
 	"github.com/robaho/fixed"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type (
 	IOrderUsecase interface {
-		NewOrder(ctx context.Context, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)
+		NewOrder(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)
+		Quote(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.Quote, error)
+		AmendOrderItems(ctx context.Context, customer model.Customer, orderId uuid.UUID, request types.AmendOrderItemsRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)
+		FulfilOrder(ctx context.Context, orderId uuid.UUID) (*model.OrderWithItems, error)
+		RequestReturn(ctx context.Context, orderId uuid.UUID, request types.ReturnRequest, customer model.Customer) (*model.OrderReturn, error)
+		GetOrderReturns(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.OrderReturn, error)
+		ApproveReturn(ctx context.Context, returnId uuid.UUID, actor, note string) (*model.OrderReturn, error)
+		RejectReturn(ctx context.Context, returnId uuid.UUID, actor, note string) (*model.OrderReturn, error)
+		ReceiveReturn(ctx context.Context, returnId uuid.UUID, actor string) (*model.OrderReturn, error)
+		CreateShipment(ctx context.Context, orderId uuid.UUID, request types.ShipmentRequest) (*model.Shipment, error)
+		GetOrderShipments(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.Shipment, error)
+		ShipShipment(ctx context.Context, shipmentId uuid.UUID, request types.ShipShipmentRequest) (*model.Shipment, bool, error)
+		DeliverShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error)
+		GetOrderDetail(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) (*model.OrderDetail, error)
+		SubscribeBackInStock(ctx context.Context, sku, email string) (*model.BackInStockSubscription, error)
+		DescribeOutOfStock(ctx context.Context, failed []*model.OrderedItemStockStatus) []model.OutOfStockItem
+		ConfirmAllocatedBackorders(ctx context.Context) (int, error)
+		CreatePromotion(ctx context.Context, request types.PromotionRequest) (*model.Promotion, error)
+		ListPromotions(ctx context.Context) ([]model.Promotion, error)
+		CreateWebhookEndpoint(ctx context.Context, request types.WebhookEndpointRequest) (*model.WebhookEndpoint, error)
+		ListWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error)
+		UpdateWebhookEndpoint(ctx context.Context, endpointId uuid.UUID, request types.UpdateWebhookEndpointRequest) (*model.WebhookEndpoint, error)
+		ListWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+		GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)
+		ReplayWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)
+		DispatchWebhooks(ctx context.Context) (int, error)
+		SearchOrders(ctx context.Context, filter model.OrderSearchFilter, actor string) (*model.OrderSearchResult, error)
+		ExportOrders(ctx context.Context, filter model.OrderSearchFilter, actor string, fn func(order *model.OrderWithItems) error) error
+		ForceOrderStatus(ctx context.Context, orderId uuid.UUID, request types.ForceOrderStatusRequest, actor string) (*model.Order, error)
+		RetryReservation(ctx context.Context, orderId uuid.UUID, actor string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)
+		ListAdminAudit(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error)
 	}
 
 	OrderUsecase struct {
-		logger              logger.Logger
-		repoSQL             repository.IOrderSQLRepository
-		inventoryGrpcClient grpc.InvClient
+		logger                 logger.Logger
+		repoSQL                repository.IOrderSQLRepository
+		inventoryGrpcClient    grpc.InvClient
+		backInStockGrpcClient  grpc.BackInStockClient
+		backorderGrpcClient    grpc.BackorderClient
+		notificationGrpcClient grpc.NotificationClient
+		quoteSigner            *QuoteSigner
+		paymentProvider        payment.PaymentProvider
+		taxCalculator          tax.TaxCalculator
+		webhookSender          webhook.Sender
 	}
 )
 
-func NewOrderUsecase(sql repository.IOrderSQLRepository, log logger.Logger, invClient grpc.InvClient) IOrderUsecase {
+func NewOrderUsecase(sql repository.IOrderSQLRepository, log logger.Logger, invClient grpc.InvClient, backInStockClient grpc.BackInStockClient, backorderClient grpc.BackorderClient, notificationClient grpc.NotificationClient, quoteSigner *QuoteSigner, paymentProvider payment.PaymentProvider, taxCalculator tax.TaxCalculator, webhookSender webhook.Sender) IOrderUsecase {
 	return &OrderUsecase{
-		logger:              log,
-		repoSQL:             sql,
-		inventoryGrpcClient: invClient,
+		logger:                 log,
+		repoSQL:                sql,
+		inventoryGrpcClient:    invClient,
+		backInStockGrpcClient:  backInStockClient,
+		backorderGrpcClient:    backorderClient,
+		notificationGrpcClient: notificationClient,
+		quoteSigner:            quoteSigner,
+		paymentProvider:        paymentProvider,
+		taxCalculator:          taxCalculator,
+		webhookSender:          webhookSender,
 	}
 }
 
-func (u *OrderUsecase) NewOrder(ctx context.Context, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+// currency of orders and quotes
+const orderCurrency = "USD"
 
-	// check stock
-	var InventoryItems []*inventoryv1.InventoryItem
-	for _, item := range request.OrderItems {
-		invItem := &inventoryv1.InventoryItem{
-			Sku:          item.Sku,
-			ReqQtyPerUom: item.QuantityPerUom,
-			Uom:          item.Uom,
+// reservation policies of the order request, ALL_OR_NOTHING when omitted
+var reservationPolicies = map[types.OrderRequestReservationPolicy]inventoryv1.ReservationPolicy{
+	types.ALLORNOTHING:      inventoryv1.ReservationPolicy_ALL_OR_NOTHING,
+	types.PARTIAL:           inventoryv1.ReservationPolicy_PARTIAL,
+	types.FILLORKILLPERLINE: inventoryv1.ReservationPolicy_FILL_OR_KILL_PER_LINE,
+}
+
+func (u *OrderUsecase) NewOrder(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+
+	policy, allowBackorder, err := reservationOptions(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the order is placed at the quoted prices
+	var quoted *QuoteClaims
+	if request.QuoteToken != nil {
+		if quoted, err = u.verifyQuote(customer, request); err != nil {
+			return nil, nil, err
 		}
-		InventoryItems = append(InventoryItems, invItem)
 	}
 
-	stockStatus, err := u.inventoryGrpcClient.CheckStock(ctx, &inventoryv1.StandardInventoryRequest{
-		Items: InventoryItems,
-	})
-	// handle error sku not found here
+	// the coupon is checked before anything is reserved
+	var promo *model.Promotion
+	if request.CouponCode != nil {
+		if promo, err = u.redeemablePromotion(ctx, *request.CouponCode); err != nil {
+			return nil, nil, err
+		}
+	}
 
+	// check stock
+	InventoryItems := toInventoryItems(request.OrderItems)
+	stockStatus, err := u.checkStock(ctx, InventoryItems)
 	if err != nil {
+		return nil, nil, err
+	}
 
-		// handle error
-
-		u.logger.Errorf("failed check stock to inventory service", map[string]interface{}{"error": err})
-		return nil, nil, errlib.ErrInternalServer(err)
+	if quoted != nil {
+		if changes := quotePriceChanges(quoted, stockStatus); len(changes) > 0 {
+			return nil, nil, errlib.ErrQuotePriceChanged(changes)
+		}
 	}
 
 	// makesure quantity available
 
 	// prepare order data
-	useriddummy := "80212e88-5d6d-446b-981c-5dfdc426e867"
 	orderId := uuid.New()
 	order := model.Order{
 		Id:        orderId,
 		Status:    model.ORDER_STATUS_PENDING,
 		CreatedAt: time.Now(),
-		UserId:    useriddummy,
-		UserEmail: "dummy@email.com",
-		Currency:  "USD",
+		UserId:    customer.UserId,
+		UserEmail: customer.Email,
+		Currency:  orderCurrency,
+
+		ShippingAddress: shippingAddress(request),
 	}
 	var items []model.ItemOrder
 	total := fixed.NewF(0)
 
 	for _, i := range stockStatus.Items {
+		price := fixed.NewF(i.SkuPrice)
+		if quoted != nil {
+			price, _ = quoted.priceOf(i.Sku)
+		}
+
 		item := model.ItemOrder{
 			Id:             uuid.New(),
 			OrderId:        orderId,
 			Sku:            i.Sku,
 			QuantityPerUom: fixed.NewF(i.RequestedQuantity),
-			PricePerUom:    fixed.NewF(i.SkuPrice),
+			PricePerUom:    price,
 			UomCode:        i.SkuUom,
 		}
 		items = append(items, item)
@@ -96,22 +173,59 @@ func (u *OrderUsecase) NewOrder(ctx context.Context, request types.OrderRequest)
 		amount := item.QuantityPerUom.Mul(item.PricePerUom)
 		total = total.Add(amount)
 	}
+
+	// the total amount is net of the discounts of the coupon
+	var (
+		discounts     []model.OrderDiscount
+		categories    = stockCategories(stockStatus)
+		taxCategories = stockTaxCategories(stockStatus.Items)
+	)
+	if promo != nil {
+		if discounts, err = orderDiscounts(promo, items, categories); err != nil {
+			if errors.Is(err, promotion.ErrNotApplicable) {
+				return nil, nil, couponNotApplicable(err)
+			}
+			u.logger.Errorf("failed to apply promotion", "error", err.Error())
+			return nil, nil, errlib.ErrInternalServer(err)
+		}
+		order.PromotionId = &promo.Id
+		total = total.Sub(discountTotal(discounts))
+	}
 	order.TotalAmount = total
 
+	// the tax of the shipping address is checked before anything is reserved too
+	taxes, err := u.taxOrder(ctx, &order, items, discounts, taxCategories)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// insert order with pending status
-	err = u.repoSQL.InsertOrderWithItems(ctx, &order, items)
+	err = u.repoSQL.InsertOrderWithItems(ctx, &order, items, discounts, taxes)
+	if errors.Is(err, repository.ErrPromotionUsedUp) {
+		return nil, nil, errlib.ErrCouponNotApplicable(map[string]interface{}{"reason": "coupon usage limit reached"})
+	}
 	if err != nil {
 		u.logger.Errorf("failed in InsertOrderWithItems", "error", err)
 		return nil, nil, err
 	}
 
 	// reserve stock
-	reserveResp, errReserv := u.inventoryGrpcClient.ReserveStock(ctx, &inventoryv1.StandardInventoryRequest{})
+	reserveResp, errReserv := u.inventoryGrpcClient.ReserveStock(ctx, &inventoryv1.StandardInventoryRequest{
+		OrderId:           orderId.String(),
+		Items:             InventoryItems,
+		AllowBackorder:    allowBackorder,
+		ReservationPolicy: policy,
+	})
 	var failedReserveStockStatus []*model.OrderedItemStockStatus
 	if errReserv != nil && reserveResp != nil {
 		failedReserveStockStatus = reserveResp.FailedProcessedItems.GetItems()
 	}
 
+	// per line policies keep the order when anything was reserved, short lines are recorded on the items
+	if errReserv == nil && policy != inventoryv1.ReservationPolicy_ALL_OR_NOTHING && len(reserveResp.GetLines()) > 0 {
+		return u.applyReservedLines(ctx, order, items, promo, categories, taxCategories, reserveResp.GetLines(), paymentMethod(request))
+	}
+
 	// handle failed to reserve caused by insufficient, with no app error
 	if errReserv == nil && len(reserveResp.FailedProcessedItems.Items) > 0 {
 
@@ -120,8 +234,9 @@ func (u *OrderUsecase) NewOrder(ctx context.Context, request types.OrderRequest)
 			u.logger.Errorf("failed update order status to reserved", "error", err.Error())
 			return nil, reserveResp.FailedProcessedItems.Items, errlib.ErrDBQuery()
 		}
+		u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_RESERVATION_FAILED, &order, model.ORDER_STATUS_FAILED_RESERVATION, nil)
 
-		return &model.OrderWithItems{Order: order, Items: items}, reserveResp.FailedProcessedItems.Items, nil
+		return &model.OrderWithItems{Order: order, Items: items, Discounts: discounts, Taxes: taxes}, reserveResp.FailedProcessedItems.Items, nil
 	}
 	if errReserv != nil {
 		// update order to canceled
@@ -129,17 +244,391 @@ func (u *OrderUsecase) NewOrder(ctx context.Context, request types.OrderRequest)
 			u.logger.Errorf("failed update order status to reserved", "error", err.Error())
 			return nil, nil, errlib.ErrDBQuery()
 		}
+		u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_CANCELLED, &order, model.ORDER_STATUS_CANCELLED, nil)
 		return nil, nil, errlib.ErrReservationStock(errReserv)
 	}
 
+	// the stock is held, the order only goes ahead once its payment is authorized
+	authorized, err := u.authorizePayment(ctx, order, paymentMethod(request))
+	if err != nil {
+		u.releaseUnpaidOrder(ctx, orderId)
+		return nil, nil, err
+	}
+
+	// the shortfall waits for stock, the order is confirmed once all of it is allocated
+	if backorders := reserveResp.GetBackorders(); len(backorders) > 0 {
+		if err = u.repoSQL.UpdateOrderStatus(ctx, orderId, model.ORDER_STATUS_BACKORDERED); err != nil {
+			u.logger.Errorf("failed update order status to backordered", "error", err.Error())
+			return nil, nil, errlib.ErrDBQuery()
+		}
+
+		order.Status = model.ORDER_STATUS_BACKORDERED
+		u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_BACKORDERED, &order, order.Status, nil)
+		result := &model.OrderWithItems{Order: order, Items: items, Payment: authorized, Discounts: discounts, Taxes: taxes}
+		for _, b := range backorders {
+			result.Backorders = append(result.Backorders, model.Backorder{
+				Sku:       b.Sku,
+				Quantity:  b.Quantity,
+				Status:    b.Status,
+				CreatedAt: b.CreatedAt.AsTime(),
+			})
+		}
+		return result, nil, nil
+	}
+
 	// update order status to reserved
 	if err = u.repoSQL.UpdateOrderStatus(ctx, orderId, model.ORDER_STATUS_CONFIRMED); err != nil {
 		u.logger.Errorf("failed update order status to reserved", "error", err.Error())
 		return nil, nil, errlib.ErrDBQuery()
 	}
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_CONFIRMED, &order, model.ORDER_STATUS_CONFIRMED, nil)
 
 	return &model.OrderWithItems{
-		Order: order,
-		Items: items,
+		Order:     order,
+		Items:     items,
+		Payment:   authorized,
+		Discounts: discounts,
+		Taxes:     taxes,
 	}, failedReserveStockStatus, nil
 }
+
+// reservation policy and backorder flag of the order request
+func reservationOptions(request types.OrderRequest) (inventoryv1.ReservationPolicy, bool, error) {
+
+	policy := inventoryv1.ReservationPolicy_ALL_OR_NOTHING
+	if request.ReservationPolicy != nil {
+		p, ok := reservationPolicies[*request.ReservationPolicy]
+		if !ok {
+			return policy, false, errlib.ErrValidationError([]map[string]interface{}{
+				{"reservation_policy": "must be one of ALL_OR_NOTHING, PARTIAL, FILL_OR_KILL_PER_LINE"},
+			})
+		}
+		policy = p
+	}
+	allowBackorder := request.AllowBackorder != nil && *request.AllowBackorder
+	if allowBackorder && policy != inventoryv1.ReservationPolicy_ALL_OR_NOTHING {
+		return policy, false, errlib.ErrValidationError([]map[string]interface{}{
+			{"allow_backorder": "only supported with the ALL_OR_NOTHING reservation policy"},
+		})
+	}
+
+	return policy, allowBackorder, nil
+}
+
+// payment method of the order request, empty lets the provider use its default
+func paymentMethod(request types.OrderRequest) string {
+	if request.PaymentMethod == nil {
+		return ""
+	}
+	return *request.PaymentMethod
+}
+
+// whether the order was placed by the customer, an order of another customer is reported as not found
+func placedBy(order *model.Order, customer model.Customer) bool {
+	return customer.UserId != "" && order.UserId == customer.UserId
+}
+
+func toInventoryItems(orderItems []types.StockItemRequest) []*inventoryv1.InventoryItem {
+	var inventoryItems []*inventoryv1.InventoryItem
+	for _, item := range orderItems {
+		inventoryItems = append(inventoryItems, &inventoryv1.InventoryItem{
+			Sku:          item.Sku,
+			ReqQtyPerUom: item.QuantityPerUom,
+			Uom:          item.Uom,
+		})
+	}
+	return inventoryItems
+}
+
+// prices and availability of the items from the inventory service
+func (u *OrderUsecase) checkStock(ctx context.Context, inventoryItems []*inventoryv1.InventoryItem) (*inventoryv1.InventoryStatusResponse, error) {
+
+	stockStatus, err := u.inventoryGrpcClient.CheckStock(ctx, &inventoryv1.StandardInventoryRequest{
+		Items: inventoryItems,
+	})
+	// handle error sku not found here
+
+	if err != nil {
+
+		// handle error
+
+		u.logger.Errorf("failed check stock to inventory service", map[string]interface{}{"error": err})
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	return stockStatus, nil
+}
+
+// confirms the order with the quantities the inventory service reserved per line
+// and recomputes the total amount, the discounts of promo and the tax from the confirmed quantities,
+// the total is the amount authorized
+func (u *OrderUsecase) applyReservedLines(ctx context.Context, order model.Order, items []model.ItemOrder, promo *model.Promotion, categories, taxCategories map[string]string, lines []*inventoryv1.ReservedLine, method string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+
+	reserved := map[string]float64{}
+	for _, l := range lines {
+		reserved[l.Sku] = l.ReservedQuantity
+	}
+
+	total := fixed.NewF(0)
+	for i := range items {
+		confirmed := fixed.NewF(reserved[items[i].Sku])
+		short := items[i].QuantityPerUom.Sub(confirmed)
+		items[i].ConfirmedQuantity = &confirmed
+		items[i].ShortQuantity = &short
+
+		total = total.Add(confirmed.Mul(items[i].PricePerUom))
+	}
+
+	var discounts []model.OrderDiscount
+	if promo != nil {
+		var err error
+		if discounts, err = reappliedDiscounts(promo, items, categories); err != nil {
+			u.logger.Errorf("failed to apply promotion", "error", err.Error())
+			u.releaseUnpaidOrder(ctx, order.Id)
+			return nil, nil, errlib.ErrInternalServer(err)
+		}
+		total = total.Sub(discountTotal(discounts))
+	}
+	order.TotalAmount = total
+
+	taxes, err := u.taxOrder(ctx, &order, items, discounts, taxCategories)
+	if err != nil {
+		u.releaseUnpaidOrder(ctx, order.Id)
+		return nil, nil, err
+	}
+
+	authorized, err := u.authorizePayment(ctx, order, method)
+	if err != nil {
+		u.releaseUnpaidOrder(ctx, order.Id)
+		return nil, nil, err
+	}
+	order.Status = model.ORDER_STATUS_CONFIRMED
+
+	if err := u.repoSQL.UpdateOrderWithItems(ctx, &order, items, discounts, taxes); err != nil {
+		u.logger.Errorf("failed in UpdateOrderWithItems", "error", err.Error())
+		return nil, nil, errlib.ErrDBQuery()
+	}
+	u.publishOrderEvent(ctx, model.WEBHOOK_EVENT_ORDER_CONFIRMED, &order, order.Status, nil)
+
+	return &model.OrderWithItems{Order: order, Items: items, Payment: authorized, Discounts: discounts, Taxes: taxes}, nil, nil
+}
+
+// upper bound of reservation rows fetched for a single order detail
+const orderReservationsPageSize = 500
+
+// GetOrderDetail returns an order with its payment and reservations to the customer who placed it, admins can
+// read every order
+func (u *OrderUsecase) GetOrderDetail(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) (*model.OrderDetail, error) {
+
+	order, items, err := u.repoSQL.GetOrderWithItems(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderWithItems", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if order == nil || (!admin && !placedBy(order, customer)) {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	currentPayment, err := u.repoSQL.GetOrderPayment(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed in GetOrderPayment", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	detail := &model.OrderDetail{
+		OrderWithItems: model.OrderWithItems{Order: *order, Items: items, Payment: currentPayment},
+		Reservations:   []model.OrderReservation{},
+	}
+	if order.PromotionId != nil {
+		if detail.Discounts, err = u.repoSQL.GetOrderDiscounts(ctx, orderId); err != nil {
+			u.logger.Errorf("failed in GetOrderDiscounts", "error", err.Error())
+			return nil, errlib.ErrDBQuery()
+		}
+	}
+	if order.ShippingAddress != nil {
+		if detail.Taxes, err = u.repoSQL.GetOrderTaxes(ctx, orderId); err != nil {
+			u.logger.Errorf("failed in GetOrderTaxes", "error", err.Error())
+			return nil, errlib.ErrDBQuery()
+		}
+	}
+
+	// reservations are informational, the order is still returned when inventory is unreachable
+	resp, err := u.inventoryGrpcClient.ListReservations(ctx, &inventoryv1.ListReservationsRequest{
+		OrderId:  orderId.String(),
+		PageSize: orderReservationsPageSize,
+	})
+	if err != nil {
+		u.logger.Errorf("failed list reservations to inventory service", "error", err.Error())
+		return detail, nil
+	}
+
+	for _, r := range resp.GetItems() {
+		reservation := model.OrderReservation{
+			Sku:        r.Sku,
+			Quantity:   r.Quantity,
+			Uom:        r.Uom,
+			Status:     r.Status,
+			ReservedAt: r.ReservedAt.AsTime(),
+			LineType:   r.LineType,
+			BundleSku:  r.BundleSku,
+		}
+		if r.ReleasedAt != nil {
+			releasedAt := r.ReleasedAt.AsTime()
+			reservation.ReleasedAt = &releasedAt
+		}
+		detail.Reservations = append(detail.Reservations, reservation)
+	}
+
+	return detail, nil
+}
+
+// SubscribeBackInStock asks the inventory service to email the customer once an out of stock sku is available again
+func (u *OrderUsecase) SubscribeBackInStock(ctx context.Context, sku, email string) (*model.BackInStockSubscription, error) {
+
+	resp, err := u.backInStockGrpcClient.SubscribeBackInStock(ctx, &inventoryv1.SubscribeBackInStockRequest{
+		Sku:   sku,
+		Email: email,
+	})
+	if err != nil {
+		// unknown or in stock sku, the message tells which
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return nil, errlib.ErrValidationError([]map[string]interface{}{
+				{"sku": st.Message()},
+			})
+		}
+
+		u.logger.Errorf("failed subscribe back in stock to inventory service", "error", err.Error())
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	sub := resp.GetSubscription()
+	result := &model.BackInStockSubscription{
+		Id:        sub.GetId(),
+		Sku:       sub.GetSku(),
+		Email:     sub.GetEmail(),
+		Status:    sub.GetStatus(),
+		CreatedAt: sub.GetCreatedAt().AsTime(),
+		ExpiresAt: sub.GetExpiresAt().AsTime(),
+	}
+	if sub.GetNotifiedAt() != nil {
+		notifiedAt := sub.GetNotifiedAt().AsTime()
+		result.NotifiedAt = &notifiedAt
+	}
+
+	return result, nil
+}
+
+// upper bound of alternatives suggested per out of stock sku
+const outOfStockAlternativesLimit = 3
+
+// DescribeOutOfStock adds product names and in stock alternatives to the items that failed reservation.
+// suggestions are informational, the items are still returned without them when inventory is unreachable
+func (u *OrderUsecase) DescribeOutOfStock(ctx context.Context, failed []*model.OrderedItemStockStatus) []model.OutOfStockItem {
+
+	items := make([]model.OutOfStockItem, 0, len(failed))
+	request := &inventoryv1.SuggestAlternativesRequest{Limit: outOfStockAlternativesLimit}
+	for _, f := range failed {
+		items = append(items, model.OutOfStockItem{
+			Sku:               f.Sku,
+			RequestedQuantity: f.RequestedQuantity,
+			AvailableQuantity: f.AvailableQuantity,
+			Alternatives:      []model.AlternativeSku{},
+		})
+		request.Items = append(request.Items, &inventoryv1.InventoryItem{
+			Sku:          f.Sku,
+			ReqQtyPerUom: f.RequestedQuantity,
+			Uom:          f.SkuUom,
+		})
+	}
+	if len(items) == 0 {
+		return items
+	}
+
+	resp, err := u.inventoryGrpcClient.SuggestAlternatives(ctx, request)
+	if err != nil {
+		u.logger.Errorf("failed suggest alternatives to inventory service", "error", err.Error())
+		return items
+	}
+
+	suggested := map[string]*inventoryv1.SkuAlternatives{}
+	for _, s := range resp.GetItems() {
+		suggested[s.Sku] = s
+	}
+
+	for i := range items {
+		s, ok := suggested[items[i].Sku]
+		if !ok {
+			continue
+		}
+
+		items[i].ProductId = s.ProductId
+		items[i].ProductName = s.ProductName
+		for _, alt := range s.Alternatives {
+			items[i].Alternatives = append(items[i].Alternatives, model.AlternativeSku{
+				Sku:               alt.Sku,
+				ProductId:         alt.ProductId,
+				ProductName:       alt.ProductName,
+				AvailableQuantity: alt.AvailableQuantity,
+				Reason:            alt.Reason,
+			})
+		}
+	}
+
+	return items
+}
+
+// backorder events read per run, the inventory service caps it at 500
+const backorderEventsBatchSize = 100
+
+// ConfirmAllocatedBackorders confirms backordered orders whose stock the inventory service has allocated
+// and returns how many were confirmed. events can be delivered more than once, an order that is no longer
+// backordered is left as it is. events are acknowledged once handled, the rest are retried on the next run
+func (u *OrderUsecase) ConfirmAllocatedBackorders(ctx context.Context) (int, error) {
+
+	resp, err := u.backorderGrpcClient.ListBackorderEvents(ctx, &inventoryv1.ListBackorderEventsRequest{
+		Limit: backorderEventsBatchSize,
+	})
+	if err != nil {
+		u.logger.Errorf("failed list backorder events to inventory service", "error", err.Error())
+		return 0, errlib.ErrInternalServer(err)
+	}
+
+	var (
+		handled   []int64
+		confirmed int
+		errUpdate error
+	)
+	for _, event := range resp.GetEvents() {
+		orderId, err := uuid.Parse(event.OrderId)
+		if err != nil {
+			// not an order of this service, nothing to retry
+			u.logger.Warnf("skipping backorder event %d with invalid order id %q", event.Id, event.OrderId)
+			handled = append(handled, event.Id)
+			continue
+		}
+
+		moved, err := u.repoSQL.TransitionOrderStatus(ctx, orderId, model.ORDER_STATUS_BACKORDERED, model.ORDER_STATUS_CONFIRMED)
+		if err != nil {
+			u.logger.Errorf("failed in TransitionOrderStatus", "error", err.Error())
+			errUpdate = errlib.ErrDBQuery()
+			break
+		}
+		if moved {
+			confirmed++
+			u.publishOrderEventById(ctx, model.WEBHOOK_EVENT_ORDER_CONFIRMED, orderId)
+		}
+		handled = append(handled, event.Id)
+	}
+
+	if len(handled) > 0 {
+		_, err = u.backorderGrpcClient.AcknowledgeBackorderEvents(ctx, &inventoryv1.AcknowledgeBackorderEventsRequest{
+			Ids: handled,
+		})
+		if err != nil {
+			u.logger.Errorf("failed acknowledge backorder events to inventory service", "error", err.Error())
+			return confirmed, errlib.ErrInternalServer(err)
+		}
+	}
+
+	return confirmed, errUpdate
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errlib"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -10,9 +12,14 @@ import (
 	"github.com/robaho/fixed"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"ops-monorepo/services/svc-order/internal/delivery/types"
 	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/payment"
+	"ops-monorepo/services/svc-order/internal/tax"
 	"ops-monorepo/services/svc-order/mocks"
 	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
 	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
@@ -20,15 +27,20 @@ import (
 )
 
 type usecaseDeps struct {
-	logger              loggerMocks.MockLogger
-	repoSQL             mocks.MockIOrderSQLRepository
-	inventoryGrpcClient grpcMocks.MockInvClient
+	logger                *loggerMocks.MockLogger
+	repoSQL               *mocks.MockIOrderSQLRepository
+	inventoryGrpcClient   *grpcMocks.MockInvClient
+	backInStockGrpcClient *grpcMocks.MockBackInStockClient
+	backorderGrpcClient   *grpcMocks.MockBackorderClient
+
+	notificationGrpcClient *grpcMocks.MockNotificationClient
 }
 
 var (
 	mockOrderId   = uuid.MustParse("9680e493-843d-4069-9b38-7495e70d7621")
 	mockUserId    = "9ae74d58-7cb4-408d-bac0-8c5471a23062"
 	mockUserEmail = "user@email.com"
+	mockCustomer  = model.Customer{UserId: mockUserId, Email: mockUserEmail}
 	mockOrder     = model.Order{
 		Id:          mockOrderId,
 		UserId:      mockUserId,
@@ -72,6 +84,9 @@ var (
 			},
 		},
 	}
+	mockQuoteSigner            = NewQuoteSigner("secret", time.Minute)
+	mockPaymentProvider        = payment.NewFakeProvider()
+	mockTaxCalculator, _       = tax.NewTableCalculator(tax.DefaultJurisdictions)
 	mockReserveSuccessResponse = &inventoryv1.InventoryReservationResponse{
 		FailedProcessedItems: &inventoryv1.FailedProcessedItems{
 			Items: []*model.OrderedItemStockStatus{},
@@ -92,6 +107,39 @@ var (
 )
 
 func TestOrderUsecase_NewOrder(t *testing.T) {
+	allowBackorder := true
+	partialPolicy := types.PARTIAL
+
+	quoteToken, _, _ := mockQuoteSigner.Sign(QuoteClaims{
+		UserId:   mockUserId,
+		Currency: "USD",
+		Items: []QuotedItem{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("0.5"), Uom: "L", PricePerUom: fixed.NewS("50")},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: fixed.NewS("2"), Uom: "EA", PricePerUom: fixed.NewS("25")},
+		},
+	})
+	staleQuoteToken, _, _ := mockQuoteSigner.Sign(QuoteClaims{
+		UserId:   mockUserId,
+		Currency: "USD",
+		Items: []QuotedItem{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("0.5"), Uom: "L", PricePerUom: fixed.NewS("45")},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: fixed.NewS("2"), Uom: "EA", PricePerUom: fixed.NewS("25")},
+		},
+	})
+	otherUserQuoteToken, _, _ := mockQuoteSigner.Sign(QuoteClaims{
+		UserId:   "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f",
+		Currency: "USD",
+		Items: []QuotedItem{
+			{Sku: "OLIVE-OIL-1L", QuantityPerUom: fixed.NewS("0.5"), Uom: "L", PricePerUom: fixed.NewS("50")},
+			{Sku: "TSHIRT-M-WHITE", QuantityPerUom: fixed.NewS("2"), Uom: "EA", PricePerUom: fixed.NewS("25")},
+		},
+	})
+	forgedQuoteToken := quoteToken[:len(quoteToken)-2] + "AA"
+	declinedMethod := payment.FakeMethodDeclined
+	isAuthorized := func(p *model.Payment) bool {
+		return p.Status == model.PAYMENT_STATUS_AUTHORIZED && p.AuthorizationRef != ""
+	}
+
 	type args struct {
 		ctx     context.Context
 		request types.OrderRequest
@@ -126,10 +174,14 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 			Mock: func(dep *usecaseDeps) {
 				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
 					Return(mockStockResponse, nil)
-				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder")).
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
 					Return(nil)
 				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
 					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.AnythingOfType("*model.Payment")).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(isAuthorized)).
+					Return(nil)
 				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.AnythingOfType("uuid.UUID"), model.ORDER_STATUS_CONFIRMED).
 					Return(nil)
 			},
@@ -137,13 +189,151 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 			Expected: &model.OrderWithItems{
 				Order: model.Order{
 					Status:      model.ORDER_STATUS_PENDING,
-					UserId:      "80212e88-5d6d-446b-981c-5dfdc426e867",
-					UserEmail:   "dummy@email.com",
+					UserId:      mockUserId,
+					UserEmail:   mockUserEmail,
 					Currency:    "USD",
 					TotalAmount: fixed.NewS("75"),
 				},
 			},
 		},
+		{
+			Name: "order with backordered items",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					AllowBackorder: &allowBackorder,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mockStockResponse, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+					return req.AllowBackorder
+				})).
+					Return(&inventoryv1.InventoryReservationResponse{
+						FailedProcessedItems: &inventoryv1.FailedProcessedItems{},
+						Backorders: []*inventoryv1.Backorder{
+							{Sku: "TSHIRT-M-WHITE", Quantity: 1.5, Status: "PENDING", CreatedAt: timestamppb.Now()},
+						},
+					}, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.AnythingOfType("*model.Payment")).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(isAuthorized)).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.AnythingOfType("uuid.UUID"), model.ORDER_STATUS_BACKORDERED).
+					Return(nil)
+			},
+			ExpectedErr: false,
+			Expected: &model.OrderWithItems{
+				Order: model.Order{
+					Status:    model.ORDER_STATUS_BACKORDERED,
+					UserId:    mockUserId,
+					UserEmail: mockUserEmail,
+					Currency:  "USD",
+				},
+				Backorders: []model.Backorder{
+					{Sku: "TSHIRT-M-WHITE", Quantity: 1.5, Status: "PENDING"},
+				},
+			},
+		},
+		{
+			Name: "partial reservation confirms reserved quantities",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					ReservationPolicy: &partialPolicy,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mockStockResponse, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+					return req.ReservationPolicy == inventoryv1.ReservationPolicy_PARTIAL
+				})).
+					Return(&inventoryv1.InventoryReservationResponse{
+						FailedProcessedItems: &inventoryv1.FailedProcessedItems{
+							Items: []*model.OrderedItemStockStatus{{Sku: "TSHIRT-M-WHITE", AvailableQuantity: 0}},
+						},
+						Lines: []*inventoryv1.ReservedLine{
+							{Sku: "OLIVE-OIL-1L", RequestedQuantity: 0.5, ReservedQuantity: 0.5},
+							{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, ReservedQuantity: 1},
+						},
+					}, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					// only the confirmed quantities are paid
+					return p.Amount.Equal(fixed.NewS("50"))
+				})).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(isAuthorized)).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderWithItems(mock.Anything, mock.MatchedBy(func(o *model.Order) bool {
+					// 0.5 * 50 + 1 * 25
+					return o.Status == model.ORDER_STATUS_CONFIRMED && o.TotalAmount.Equal(fixed.NewS("50"))
+				}), mock.MatchedBy(func(items []model.ItemOrder) bool {
+					return len(items) == 2 &&
+						items[1].ConfirmedQuantity.Equal(fixed.NewS("1")) &&
+						items[1].ShortQuantity.Equal(fixed.NewS("1"))
+				}), mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			ExpectedErr: false,
+			Expected: &model.OrderWithItems{
+				Order: model.Order{
+					Status:    model.ORDER_STATUS_CONFIRMED,
+					UserId:    mockUserId,
+					UserEmail: mockUserEmail,
+					Currency:  "USD",
+				},
+			},
+		},
+		{
+			Name: "backorders need the all or nothing policy",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					AllowBackorder:    &allowBackorder,
+					ReservationPolicy: &partialPolicy,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+					},
+				},
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
 		{
 			Name: "failed stock check",
 			Args: args{
@@ -192,7 +382,7 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 							},
 						},
 					}, nil)
-				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder")).
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
 					Return(errors.New("database error"))
 				dep.logger.EXPECT().Errorf("failed in InsertOrderWithItems", mock.Anything)
 			},
@@ -225,7 +415,7 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 							},
 						},
 					}, nil)
-				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder")).
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
 					Return(nil)
 				// insufficient stock scenario
 				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
@@ -237,8 +427,8 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 			Expected: &model.OrderWithItems{
 				Order: model.Order{
 					Status:    model.ORDER_STATUS_PENDING,
-					UserId:    "80212e88-5d6d-446b-981c-5dfdc426e867",
-					UserEmail: "dummy@email.com",
+					UserId:    mockUserId,
+					UserEmail: mockUserEmail,
 					Currency:  "USD",
 				},
 			},
@@ -269,7 +459,7 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 							},
 						},
 					}, nil)
-				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder")).
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
 					Return(nil)
 				// service error during reservation
 				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
@@ -280,6 +470,215 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 			ExpectedErr: true,
 			Expected:    nil,
 		},
+		{
+			Name: "declined payment releases the reserved stock",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					PaymentMethod: &declinedMethod,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+					},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mockStockResponse, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.AnythingOfType("*model.Order"), mock.AnythingOfType("[]model.ItemOrder"), mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.AnythingOfType("*model.Payment")).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(func(p *model.Payment) bool {
+					return p.Status == model.PAYMENT_STATUS_DECLINED && p.FailureReason != ""
+				})).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReleaseStock(mock.Anything, mock.MatchedBy(func(req *inventoryv1.StandardInventoryRequest) bool {
+					return req.OrderId != "" && len(req.Items) == 0
+				})).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.AnythingOfType("uuid.UUID"), model.ORDER_STATUS_PAYMENT_FAILED).
+					Return(nil)
+			},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
+		{
+			Name: "order placed at the quoted prices",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &quoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mockStockResponse, nil)
+				dep.repoSQL.EXPECT().InsertOrderWithItems(mock.Anything, mock.MatchedBy(func(order *model.Order) bool {
+					return order.TotalAmount.Equal(fixed.NewS("75"))
+				}), mock.MatchedBy(func(items []model.ItemOrder) bool {
+					return items[0].PricePerUom.Equal(fixed.NewS("50")) && items[1].PricePerUom.Equal(fixed.NewS("25"))
+				}), mock.Anything, mock.Anything).
+					Return(nil)
+				dep.inventoryGrpcClient.EXPECT().ReserveStock(mock.Anything, mock.Anything).
+					Return(mockReserveSuccessResponse, nil)
+				dep.repoSQL.EXPECT().InsertPayment(mock.Anything, mock.AnythingOfType("*model.Payment")).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdatePayment(mock.Anything, mock.MatchedBy(isAuthorized)).
+					Return(nil)
+				dep.repoSQL.EXPECT().UpdateOrderStatus(mock.Anything, mock.AnythingOfType("uuid.UUID"), model.ORDER_STATUS_CONFIRMED).
+					Return(nil)
+			},
+			ExpectedErr: false,
+			Expected: &model.OrderWithItems{
+				Order: model.Order{
+					Status:    model.ORDER_STATUS_PENDING,
+					UserId:    mockUserId,
+					UserEmail: mockUserEmail,
+					Currency:  "USD",
+				},
+			},
+		},
+		{
+			Name: "order rejected when prices changed since the quote",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &staleQuoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().CheckStock(mock.Anything, mock.Anything).
+					Return(mockStockResponse, nil)
+			},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
+		{
+			Name: "order rejected with a forged quote token",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &forgedQuoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
+		{
+			Name: "order rejected when the quote was for different items",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &quoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 1,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
+		{
+			Name: "order rejected when the quote was for another unit of measure",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &quoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "ML",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
+		{
+			Name: "order rejected with a quote token issued to another user",
+			Args: args{
+				ctx: context.Background(),
+				request: types.OrderRequest{
+					QuoteToken: &otherUserQuoteToken,
+					OrderItems: []types.StockItemRequest{
+						{
+							Sku:            "OLIVE-OIL-1L",
+							QuantityPerUom: 0.5,
+							Uom:            "L",
+						},
+						{
+							Sku:            "TSHIRT-M-WHITE",
+							QuantityPerUom: 2,
+							Uom:            "EA",
+						},
+					},
+				},
+			},
+			Mock:        func(dep *usecaseDeps) {},
+			ExpectedErr: true,
+			Expected:    nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -287,17 +686,21 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 			mockRepo := mocks.NewMockIOrderSQLRepository(t)
 			mockLogger := loggerMocks.NewMockLogger(t)
 			mockInvClient := grpcMocks.NewMockInvClient(t)
+			mockBackInStockClient := grpcMocks.NewMockBackInStockClient(t)
+			mockBackorderClient := grpcMocks.NewMockBackorderClient(t)
 
 			deps := usecaseDeps{
-				logger:              *mockLogger,
-				repoSQL:             *mockRepo,
-				inventoryGrpcClient: *mockInvClient,
+				logger:                mockLogger,
+				repoSQL:               mockRepo,
+				inventoryGrpcClient:   mockInvClient,
+				backInStockGrpcClient: mockBackInStockClient,
+				backorderGrpcClient:   mockBackorderClient,
 			}
 
 			tc.Mock(&deps)
 
-			usecase := NewOrderUsecase(&deps.repoSQL, &deps.logger, &deps.inventoryGrpcClient)
-			result, failedItems, err := usecase.NewOrder(tc.Args.ctx, tc.Args.request)
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, failedItems, err := usecase.NewOrder(tc.Args.ctx, mockCustomer, tc.Args.request)
 
 			if tc.ExpectedErr {
 				assert.Error(t, err)
@@ -311,6 +714,12 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 					assert.Equal(t, tc.Expected.Order.UserEmail, result.Order.UserEmail)
 					assert.Equal(t, tc.Expected.Order.Currency, result.Order.Currency)
 
+					assert.Len(t, result.Backorders, len(tc.Expected.Backorders))
+					for i, b := range tc.Expected.Backorders {
+						assert.Equal(t, b.Sku, result.Backorders[i].Sku)
+						assert.Equal(t, b.Quantity, result.Backorders[i].Quantity)
+					}
+
 					// verify failed items for insufficient stock scenario
 					if tc.Name == "stock reservation failed - insufficient stock" {
 						assert.NotNil(t, failedItems)
@@ -321,3 +730,431 @@ func TestOrderUsecase_NewOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderUsecase_GetOrderDetail(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		orderId  uuid.UUID
+		customer model.Customer
+		admin    bool
+	}
+	otherCustomer := model.Customer{UserId: "5c1f0d2a-8e3b-4a7c-9f6d-1b2e3c4d5e6f", Email: "other@email.com"}
+
+	releasedAt := time.Now()
+	mockReservationsResponse := &inventoryv1.ListReservationsResponse{
+		Items: []*inventoryv1.ReservationHistory{
+			{
+				OrderId:    mockOrderId.String(),
+				Sku:        "OLIVE-OIL-1L",
+				Quantity:   0.5,
+				Uom:        "L",
+				Status:     "RESERVED",
+				ReservedAt: timestamppb.New(time.Now()),
+			},
+			{
+				OrderId:    mockOrderId.String(),
+				Sku:        "TSHIRT-M-WHITE",
+				Quantity:   2,
+				Uom:        "EA",
+				Status:     "RELEASED",
+				ReservedAt: timestamppb.New(time.Now()),
+				ReleasedAt: timestamppb.New(releasedAt),
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name                 string
+		Args                 args
+		Mock                 func(dep *usecaseDeps)
+		ExpectedErr          bool
+		ExpectedReservations int
+	}{
+		{
+			Name: "order with reservations",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: mockCustomer},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(nil, nil)
+				dep.inventoryGrpcClient.EXPECT().ListReservations(mock.Anything, mock.MatchedBy(func(req *inventoryv1.ListReservationsRequest) bool {
+					return req.OrderId == mockOrderId.String()
+				})).Return(mockReservationsResponse, nil)
+			},
+			ExpectedErr:          false,
+			ExpectedReservations: 2,
+		},
+		{
+			Name: "order not found",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: mockCustomer},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(nil, nil, nil)
+			},
+			ExpectedErr: true,
+		},
+		{
+			Name: "order of another customer is not found",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: otherCustomer},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+			},
+			ExpectedErr: true,
+		},
+		{
+			Name: "admins read the orders of every customer",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: otherCustomer, admin: true},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(nil, nil)
+				dep.inventoryGrpcClient.EXPECT().ListReservations(mock.Anything, mock.Anything).
+					Return(mockReservationsResponse, nil)
+			},
+			ExpectedReservations: 2,
+		},
+		{
+			Name: "failed to get order",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: mockCustomer},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(nil, nil, errors.New("database error"))
+				dep.logger.EXPECT().Errorf("failed in GetOrderWithItems", mock.Anything, mock.Anything)
+			},
+			ExpectedErr: true,
+		},
+		{
+			Name: "inventory service unavailable still returns order",
+			Args: args{ctx: context.Background(), orderId: mockOrderId, customer: mockCustomer},
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetOrderWithItems(mock.Anything, mockOrderId).
+					Return(&mockOrder, mockItems, nil)
+				dep.repoSQL.EXPECT().GetOrderPayment(mock.Anything, mockOrderId).
+					Return(nil, nil)
+				dep.inventoryGrpcClient.EXPECT().ListReservations(mock.Anything, mock.Anything).
+					Return(nil, errors.New("inventory service error"))
+				dep.logger.EXPECT().Errorf("failed list reservations to inventory service", mock.Anything, mock.Anything)
+			},
+			ExpectedErr:          false,
+			ExpectedReservations: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIOrderSQLRepository(t)
+			mockLogger := loggerMocks.NewMockLogger(t)
+			mockInvClient := grpcMocks.NewMockInvClient(t)
+			mockBackInStockClient := grpcMocks.NewMockBackInStockClient(t)
+			mockBackorderClient := grpcMocks.NewMockBackorderClient(t)
+
+			deps := usecaseDeps{
+				logger:                mockLogger,
+				repoSQL:               mockRepo,
+				inventoryGrpcClient:   mockInvClient,
+				backInStockGrpcClient: mockBackInStockClient,
+				backorderGrpcClient:   mockBackorderClient,
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.GetOrderDetail(tc.Args.ctx, tc.Args.orderId, tc.Args.customer, tc.Args.admin)
+
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, mockOrderId, result.Order.Id)
+			assert.Len(t, result.Items, len(mockItems))
+			assert.Len(t, result.Reservations, tc.ExpectedReservations)
+			if tc.ExpectedReservations == 2 {
+				assert.Nil(t, result.Reservations[0].ReleasedAt)
+				assert.NotNil(t, result.Reservations[1].ReleasedAt)
+			}
+		})
+	}
+}
+
+func TestOrderUsecase_SubscribeBackInStock(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		sku   string
+		email string
+	}
+
+	testCases := []struct {
+		Name           string
+		Args           args
+		Mock           func(dep *usecaseDeps)
+		ExpectedErr    bool
+		ExpectedStatus int
+	}{
+		{
+			Name: "successful subscription",
+			Args: args{ctx: context.Background(), sku: "OLIVE-OIL-1L", email: mockUserEmail},
+			Mock: func(dep *usecaseDeps) {
+				dep.backInStockGrpcClient.EXPECT().SubscribeBackInStock(mock.Anything, &inventoryv1.SubscribeBackInStockRequest{
+					Sku:   "OLIVE-OIL-1L",
+					Email: mockUserEmail,
+				}).Return(&inventoryv1.BackInStockSubscriptionResponse{
+					Subscription: &inventoryv1.BackInStockSubscription{
+						Id:        "1d0f8a43-5a1b-4c34-9d0e-0c7b5d1e2f3a",
+						Sku:       "OLIVE-OIL-1L",
+						Email:     mockUserEmail,
+						Status:    "PENDING",
+						CreatedAt: timestamppb.Now(),
+						ExpiresAt: timestamppb.New(time.Now().Add(30 * 24 * time.Hour)),
+					},
+				}, nil)
+			},
+			ExpectedErr: false,
+		},
+		{
+			Name: "sku is in stock",
+			Args: args{ctx: context.Background(), sku: "OLIVE-OIL-1L", email: mockUserEmail},
+			Mock: func(dep *usecaseDeps) {
+				dep.backInStockGrpcClient.EXPECT().SubscribeBackInStock(mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "sku is in stock"))
+			},
+			ExpectedErr:    true,
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name: "inventory service unavailable",
+			Args: args{ctx: context.Background(), sku: "OLIVE-OIL-1L", email: mockUserEmail},
+			Mock: func(dep *usecaseDeps) {
+				dep.backInStockGrpcClient.EXPECT().SubscribeBackInStock(mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.Unavailable, "connection refused"))
+				dep.logger.EXPECT().Errorf("failed subscribe back in stock to inventory service", mock.Anything, mock.Anything)
+			},
+			ExpectedErr:    true,
+			ExpectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIOrderSQLRepository(t)
+			mockLogger := loggerMocks.NewMockLogger(t)
+			mockInvClient := grpcMocks.NewMockInvClient(t)
+			mockBackInStockClient := grpcMocks.NewMockBackInStockClient(t)
+			mockBackorderClient := grpcMocks.NewMockBackorderClient(t)
+
+			deps := usecaseDeps{
+				logger:                mockLogger,
+				repoSQL:               mockRepo,
+				inventoryGrpcClient:   mockInvClient,
+				backInStockGrpcClient: mockBackInStockClient,
+				backorderGrpcClient:   mockBackorderClient,
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.SubscribeBackInStock(tc.Args.ctx, tc.Args.sku, tc.Args.email)
+
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedStatus, appErr.Status)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, tc.Args.sku, result.Sku)
+			assert.Equal(t, "PENDING", result.Status)
+			assert.Nil(t, result.NotifiedAt)
+		})
+	}
+}
+
+func TestOrderUsecase_DescribeOutOfStock(t *testing.T) {
+	failed := []*model.OrderedItemStockStatus{
+		{Sku: "TSHIRT-M-WHITE", RequestedQuantity: 2, AvailableQuantity: 1, SkuUom: "EA"},
+	}
+
+	testCases := []struct {
+		Name                 string
+		Mock                 func(dep *usecaseDeps)
+		ExpectedProductName  string
+		ExpectedAlternatives []model.AlternativeSku
+	}{
+		{
+			Name: "alternatives suggested",
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().SuggestAlternatives(mock.Anything, &inventoryv1.SuggestAlternativesRequest{
+					Items: []*inventoryv1.InventoryItem{
+						{Sku: "TSHIRT-M-WHITE", ReqQtyPerUom: 2, Uom: "EA"},
+					},
+					Limit: outOfStockAlternativesLimit,
+				}).Return(&inventoryv1.SuggestAlternativesResponse{
+					Items: []*inventoryv1.SkuAlternatives{
+						{
+							Sku:               "TSHIRT-M-WHITE",
+							ProductId:         "7b0e7c1e-53a4-4a8e-9f0c-2d5b0f1c6a11",
+							ProductName:       "Basic T-Shirt",
+							RequestedQuantity: 2,
+							Alternatives: []*inventoryv1.AlternativeSku{
+								{
+									Sku:               "TSHIRT-M-BLACK",
+									ProductId:         "7b0e7c1e-53a4-4a8e-9f0c-2d5b0f1c6a11",
+									ProductName:       "Basic T-Shirt",
+									AvailableQuantity: 10,
+									Reason:            "SAME_PRODUCT",
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			ExpectedProductName: "Basic T-Shirt",
+			ExpectedAlternatives: []model.AlternativeSku{
+				{
+					Sku:               "TSHIRT-M-BLACK",
+					ProductId:         "7b0e7c1e-53a4-4a8e-9f0c-2d5b0f1c6a11",
+					ProductName:       "Basic T-Shirt",
+					AvailableQuantity: 10,
+					Reason:            "SAME_PRODUCT",
+				},
+			},
+		},
+		{
+			Name: "inventory service unavailable",
+			Mock: func(dep *usecaseDeps) {
+				dep.inventoryGrpcClient.EXPECT().SuggestAlternatives(mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.Unavailable, "connection refused"))
+				dep.logger.EXPECT().Errorf("failed suggest alternatives to inventory service", mock.Anything, mock.Anything)
+			},
+			ExpectedProductName:  "",
+			ExpectedAlternatives: []model.AlternativeSku{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mockRepo := mocks.NewMockIOrderSQLRepository(t)
+			mockLogger := loggerMocks.NewMockLogger(t)
+			mockInvClient := grpcMocks.NewMockInvClient(t)
+			mockBackInStockClient := grpcMocks.NewMockBackInStockClient(t)
+			mockBackorderClient := grpcMocks.NewMockBackorderClient(t)
+
+			deps := usecaseDeps{
+				logger:                mockLogger,
+				repoSQL:               mockRepo,
+				inventoryGrpcClient:   mockInvClient,
+				backInStockGrpcClient: mockBackInStockClient,
+				backorderGrpcClient:   mockBackorderClient,
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result := usecase.DescribeOutOfStock(context.Background(), failed)
+
+			assert.Len(t, result, 1)
+			assert.Equal(t, "TSHIRT-M-WHITE", result[0].Sku)
+			assert.Equal(t, float64(2), result[0].RequestedQuantity)
+			assert.Equal(t, float64(1), result[0].AvailableQuantity)
+			assert.Equal(t, tc.ExpectedProductName, result[0].ProductName)
+			assert.Equal(t, tc.ExpectedAlternatives, result[0].Alternatives)
+		})
+	}
+}
+
+func TestOrderUsecase_ConfirmAllocatedBackorders(t *testing.T) {
+	otherOrderId := uuid.MustParse("2b1b0d0e-4f57-4f43-9f0a-6a1c3ad2c7b5")
+	events := &inventoryv1.ListBackorderEventsResponse{
+		Events: []*inventoryv1.BackorderEvent{
+			{Id: 1, OrderId: mockOrderId.String()},
+			{Id: 2, OrderId: otherOrderId.String()},
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Mock              func(dep *usecaseDeps)
+		ExpectedConfirmed int
+		ExpectedErr       bool
+	}{
+		{
+			Name: "confirms backordered orders and acknowledges every event",
+			Mock: func(dep *usecaseDeps) {
+				dep.backorderGrpcClient.EXPECT().ListBackorderEvents(mock.Anything, mock.Anything).
+					Return(events, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_BACKORDERED, model.ORDER_STATUS_CONFIRMED).
+					Return(true, nil)
+				// already confirmed by an earlier delivery of the event
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, otherOrderId, model.ORDER_STATUS_BACKORDERED, model.ORDER_STATUS_CONFIRMED).
+					Return(false, nil)
+				dep.backorderGrpcClient.EXPECT().AcknowledgeBackorderEvents(mock.Anything, mock.MatchedBy(func(req *inventoryv1.AcknowledgeBackorderEventsRequest) bool {
+					return assert.ObjectsAreEqual([]int64{1, 2}, req.Ids)
+				})).
+					Return(&inventoryv1.AcknowledgeBackorderEventsResponse{Acknowledged: 2}, nil)
+			},
+			ExpectedConfirmed: 1,
+		},
+		{
+			Name: "database error leaves the remaining events for the next run",
+			Mock: func(dep *usecaseDeps) {
+				dep.backorderGrpcClient.EXPECT().ListBackorderEvents(mock.Anything, mock.Anything).
+					Return(events, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, mockOrderId, model.ORDER_STATUS_BACKORDERED, model.ORDER_STATUS_CONFIRMED).
+					Return(true, nil)
+				dep.repoSQL.EXPECT().TransitionOrderStatus(mock.Anything, otherOrderId, model.ORDER_STATUS_BACKORDERED, model.ORDER_STATUS_CONFIRMED).
+					Return(false, errors.New("connection reset"))
+				dep.logger.EXPECT().Errorf("failed in TransitionOrderStatus", mock.Anything, mock.Anything)
+				dep.backorderGrpcClient.EXPECT().AcknowledgeBackorderEvents(mock.Anything, mock.MatchedBy(func(req *inventoryv1.AcknowledgeBackorderEventsRequest) bool {
+					return assert.ObjectsAreEqual([]int64{1}, req.Ids)
+				})).
+					Return(&inventoryv1.AcknowledgeBackorderEventsResponse{Acknowledged: 1}, nil)
+			},
+			ExpectedConfirmed: 1,
+			ExpectedErr:       true,
+		},
+		{
+			Name: "inventory unreachable",
+			Mock: func(dep *usecaseDeps) {
+				dep.backorderGrpcClient.EXPECT().ListBackorderEvents(mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.Unavailable, "connection refused"))
+				dep.logger.EXPECT().Errorf("failed list backorder events to inventory service", mock.Anything, mock.Anything)
+			},
+			ExpectedConfirmed: 0,
+			ExpectedErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			confirmed, err := usecase.ConfirmAllocatedBackorders(context.Background())
+
+			assert.Equal(t, tc.ExpectedConfirmed, confirmed)
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errlib"
+	"strings"
+	"time"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/webhook"
+
+	"github.com/google/uuid"
+)
+
+// deliveries sent per run of the webhook job. a claimed delivery is held for webhookClaimLease, it has to
+// cover sending the whole batch or another replica sends the delivery again
+const (
+	webhookBatchSize  = 10
+	webhookClaimLease = 5 * time.Minute
+)
+
+// size of a delivery log page
+const (
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 500
+)
+
+// CreateWebhookEndpoint registers a partner url for the event types it wants, the generated signing secret is
+// only returned here
+func (u *OrderUsecase) CreateWebhookEndpoint(ctx context.Context, request types.WebhookEndpointRequest) (*model.WebhookEndpoint, error) {
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, errlib.ErrInternalServer(err)
+	}
+
+	endpoint := &model.WebhookEndpoint{
+		Id:         uuid.New(),
+		Url:        strings.TrimSpace(request.Url),
+		Secret:     secret,
+		EventTypes: request.EventTypes,
+		Active:     true,
+	}
+	if request.Description != nil {
+		endpoint.Description = strings.TrimSpace(*request.Description)
+	}
+
+	if err := u.repoSQL.InsertWebhookEndpoint(ctx, endpoint); err != nil {
+		u.logger.Errorf("failed in InsertWebhookEndpoint", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return endpoint, nil
+}
+
+func (u *OrderUsecase) ListWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+
+	endpoints, err := u.repoSQL.GetWebhookEndpoints(ctx)
+	if err != nil {
+		u.logger.Errorf("failed in GetWebhookEndpoints", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return endpoints, nil
+}
+
+// UpdateWebhookEndpoint changes the given fields of an endpoint, an inactive endpoint gets no new deliveries
+// and its queued ones wait until it is active again
+func (u *OrderUsecase) UpdateWebhookEndpoint(ctx context.Context, endpointId uuid.UUID, request types.UpdateWebhookEndpointRequest) (*model.WebhookEndpoint, error) {
+
+	endpoint, err := u.repoSQL.GetWebhookEndpoint(ctx, endpointId)
+	if err != nil {
+		u.logger.Errorf("failed in GetWebhookEndpoint", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if endpoint == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	if request.Url != nil {
+		endpoint.Url = strings.TrimSpace(*request.Url)
+	}
+	if request.EventTypes != nil {
+		endpoint.EventTypes = *request.EventTypes
+	}
+	if request.Description != nil {
+		endpoint.Description = strings.TrimSpace(*request.Description)
+	}
+	if request.Active != nil {
+		endpoint.Active = *request.Active
+	}
+
+	updated, err := u.repoSQL.UpdateWebhookEndpoint(ctx, endpoint)
+	if err != nil {
+		u.logger.Errorf("failed in UpdateWebhookEndpoint", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !updated {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return endpoint, nil
+}
+
+// ListWebhookDeliveries returns the delivery log newest first, DEAD deliveries are the dead letter list
+func (u *OrderUsecase) ListWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultWebhookDeliveriesLimit
+	}
+	if filter.Limit > maxWebhookDeliveriesLimit {
+		filter.Limit = maxWebhookDeliveriesLimit
+	}
+
+	deliveries, err := u.repoSQL.GetWebhookDeliveries(ctx, filter)
+	if err != nil {
+		u.logger.Errorf("failed in GetWebhookDeliveries", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+
+	return deliveries, nil
+}
+
+// GetWebhookDelivery returns a delivery with every attempt to send it
+func (u *OrderUsecase) GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+
+	delivery, err := u.repoSQL.GetWebhookDelivery(ctx, deliveryId)
+	if err != nil {
+		u.logger.Errorf("failed in GetWebhookDelivery", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if delivery == nil {
+		return nil, errlib.NewAppError(errlib.ErrCodeDataNotFound)
+	}
+
+	return delivery, nil
+}
+
+// ReplayWebhookDelivery queues a DEAD or SUCCEEDED delivery again with a fresh set of attempts, the endpoint
+// gets the same event id and payload
+func (u *OrderUsecase) ReplayWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+
+	delivery, err := u.GetWebhookDelivery(ctx, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status == model.WEBHOOK_DELIVERY_PENDING {
+		return nil, errlib.NewAppError(errlib.ErrCodeWebhookDeliveryStatus)
+	}
+
+	replayed, err := u.repoSQL.ReplayWebhookDelivery(ctx, delivery)
+	if err != nil {
+		u.logger.Errorf("failed in ReplayWebhookDelivery", "error", err.Error())
+		return nil, errlib.ErrDBQuery()
+	}
+	if !replayed {
+		return nil, errlib.NewAppError(errlib.ErrCodeWebhookDeliveryStatus)
+	}
+
+	return delivery, nil
+}
+
+// DispatchWebhooks sends the deliveries that are due and returns how many the endpoints accepted. a failed
+// delivery is tried again after a growing wait and is DEAD once it failed webhook.MaxAttempts times
+func (u *OrderUsecase) DispatchWebhooks(ctx context.Context) (int, error) {
+	if u.webhookSender == nil {
+		return 0, nil
+	}
+
+	deliveries, err := u.repoSQL.ClaimWebhookDeliveries(ctx, webhookBatchSize, webhookClaimLease)
+	if err != nil {
+		u.logger.Errorf("failed in ClaimWebhookDeliveries", "error", err.Error())
+		return 0, errlib.ErrDBQuery()
+	}
+
+	delivered := 0
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		started := time.Now()
+		statusCode, sendErr := u.webhookSender.Send(ctx, *delivery)
+		finished := time.Now()
+
+		attempt := model.WebhookAttempt{
+			DeliveryId:  delivery.Id,
+			DurationMs:  finished.Sub(started).Milliseconds(),
+			AttemptedAt: started,
+		}
+		if statusCode != 0 {
+			attempt.StatusCode = &statusCode
+		}
+
+		delivery.Attempts++
+		delivery.LastStatusCode = attempt.StatusCode
+		switch {
+		case sendErr == nil:
+			delivery.Status = model.WEBHOOK_DELIVERY_SUCCEEDED
+			delivery.LastError = ""
+			delivery.DeliveredAt = &finished
+			delivered++
+		case delivery.Attempts >= webhook.MaxAttempts:
+			attempt.Error = sendErr.Error()
+			delivery.Status = model.WEBHOOK_DELIVERY_DEAD
+			delivery.LastError = attempt.Error
+		default:
+			attempt.Error = sendErr.Error()
+			delivery.LastError = attempt.Error
+			delivery.NextAttemptAt = finished.Add(webhook.Backoff(delivery.Attempts))
+		}
+
+		if err := u.repoSQL.RecordWebhookAttempt(ctx, delivery, attempt); err != nil {
+			// the delivery is sent again once its claim runs out
+			u.logger.Errorf("failed in RecordWebhookAttempt", "delivery_id", delivery.Id, "error", err.Error())
+			return delivered, errlib.ErrDBQuery()
+		}
+		if delivery.Status == model.WEBHOOK_DELIVERY_DEAD {
+			u.logger.Warnf("webhook delivery %s to %s is dead after %d attempts: %s", delivery.Id, delivery.Url, delivery.Attempts, delivery.LastError)
+		}
+	}
+
+	return delivered, nil
+}
+
+// queues an event about an order for the webhook endpoints subscribed to its type, best effort. status is
+// the status the order moved to, details are added to the order fields of the event
+func (u *OrderUsecase) publishOrderEvent(ctx context.Context, eventType string, order *model.Order, status string, details map[string]interface{}) {
+	if u.webhookSender == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"order_id":     order.Id,
+		"status":       status,
+		"total_amount": order.TotalAmount,
+		"currency":     order.Currency,
+	}
+	for key, value := range details {
+		data[key] = value
+	}
+	u.publishEvent(ctx, eventType, data)
+}
+
+// like publishOrderEvent for an order that is not loaded, it is read with its current status
+func (u *OrderUsecase) publishOrderEventById(ctx context.Context, eventType string, orderId uuid.UUID) {
+	if u.webhookSender == nil {
+		return
+	}
+
+	order, err := u.repoSQL.GetOrderById(ctx, orderId)
+	if err != nil {
+		u.logger.Errorf("failed to publish webhook event "+eventType, "order_id", orderId, "error", err.Error())
+		return
+	}
+	if order == nil {
+		return
+	}
+	u.publishOrderEvent(ctx, eventType, order, order.Status, nil)
+}
+
+// events are queued for the endpoints that are active when they happen, every endpoint gets the same payload
+func (u *OrderUsecase) publishEvent(ctx context.Context, eventType string, data map[string]interface{}) {
+	event := model.WebhookEvent{
+		Id:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		u.logger.Errorf("failed to encode webhook event "+eventType, "error", err.Error())
+		return
+	}
+
+	if _, err := u.repoSQL.InsertWebhookDeliveries(ctx, event, payload); err != nil {
+		u.logger.Errorf("failed in InsertWebhookDeliveries", "event_type", eventType, "error", err.Error())
+	}
+}
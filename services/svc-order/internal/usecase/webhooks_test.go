@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errlib"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ops-monorepo/services/svc-order/internal/delivery/types"
+	"ops-monorepo/services/svc-order/internal/model"
+	"ops-monorepo/services/svc-order/internal/webhook"
+	"ops-monorepo/services/svc-order/mocks"
+	grpcMocks "ops-monorepo/shared-libs/grpc/client/mocks"
+	loggerMocks "ops-monorepo/shared-libs/logger/mocks"
+)
+
+func TestOrderUsecase_DispatchWebhooks(t *testing.T) {
+	deliveryId := uuid.New()
+	claimed := func(attempts int) []model.WebhookDelivery {
+		return []model.WebhookDelivery{{
+			Id:        deliveryId,
+			EventType: model.WEBHOOK_EVENT_ORDER_CONFIRMED,
+			Payload:   []byte(`{"type":"order.confirmed"}`),
+			Status:    model.WEBHOOK_DELIVERY_PENDING,
+			Attempts:  attempts,
+			Url:       "https://partner.example.com/hooks",
+			Secret:    "whsec_test",
+		}}
+	}
+
+	testCases := []struct {
+		Name              string
+		Mock              func(dep *usecaseDeps, sender *mocks.MockSender)
+		ExpectedErr       string
+		ExpectedDelivered int
+	}{
+		{
+			Name: "accepted delivery succeeds",
+			Mock: func(dep *usecaseDeps, sender *mocks.MockSender) {
+				dep.repoSQL.EXPECT().ClaimWebhookDeliveries(mock.Anything, webhookBatchSize, webhookClaimLease).
+					Return(claimed(0), nil)
+				sender.EXPECT().Send(mock.Anything, mock.Anything).
+					Return(200, nil)
+				dep.repoSQL.EXPECT().RecordWebhookAttempt(mock.Anything, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+					return d.Status == model.WEBHOOK_DELIVERY_SUCCEEDED && d.Attempts == 1 && d.DeliveredAt != nil
+				}), mock.MatchedBy(func(a model.WebhookAttempt) bool {
+					return a.DeliveryId == deliveryId && *a.StatusCode == 200 && a.Error == ""
+				})).
+					Return(nil)
+			},
+			ExpectedDelivered: 1,
+		},
+		{
+			Name: "failed delivery is retried after a backoff",
+			Mock: func(dep *usecaseDeps, sender *mocks.MockSender) {
+				dep.repoSQL.EXPECT().ClaimWebhookDeliveries(mock.Anything, webhookBatchSize, webhookClaimLease).
+					Return(claimed(2), nil)
+				sender.EXPECT().Send(mock.Anything, mock.Anything).
+					Return(503, errors.New("endpoint answered 503"))
+				dep.repoSQL.EXPECT().RecordWebhookAttempt(mock.Anything, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+					wait := time.Until(d.NextAttemptAt)
+					return d.Status == model.WEBHOOK_DELIVERY_PENDING && d.Attempts == 3 && d.LastError == "endpoint answered 503" &&
+						wait > webhook.Backoff(3)-time.Minute && wait <= webhook.Backoff(3)
+				}), mock.MatchedBy(func(a model.WebhookAttempt) bool {
+					return *a.StatusCode == 503 && a.Error == "endpoint answered 503"
+				})).
+					Return(nil)
+			},
+		},
+		{
+			Name: "delivery is dead after the last attempt",
+			Mock: func(dep *usecaseDeps, sender *mocks.MockSender) {
+				dep.repoSQL.EXPECT().ClaimWebhookDeliveries(mock.Anything, webhookBatchSize, webhookClaimLease).
+					Return(claimed(webhook.MaxAttempts-1), nil)
+				sender.EXPECT().Send(mock.Anything, mock.Anything).
+					Return(0, errors.New("connection refused"))
+				dep.repoSQL.EXPECT().RecordWebhookAttempt(mock.Anything, mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+					return d.Status == model.WEBHOOK_DELIVERY_DEAD && d.Attempts == webhook.MaxAttempts && d.LastStatusCode == nil
+				}), mock.MatchedBy(func(a model.WebhookAttempt) bool {
+					return a.StatusCode == nil && a.Error == "connection refused"
+				})).
+					Return(nil)
+				dep.logger.EXPECT().Warnf("webhook delivery %s to %s is dead after %d attempts: %s", mock.Anything)
+			},
+		},
+		{
+			Name: "attempt that cannot be recorded stops the run",
+			Mock: func(dep *usecaseDeps, sender *mocks.MockSender) {
+				dep.repoSQL.EXPECT().ClaimWebhookDeliveries(mock.Anything, webhookBatchSize, webhookClaimLease).
+					Return(claimed(0), nil)
+				sender.EXPECT().Send(mock.Anything, mock.Anything).
+					Return(200, nil)
+				dep.repoSQL.EXPECT().RecordWebhookAttempt(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("db down"))
+				dep.logger.EXPECT().Errorf("failed in RecordWebhookAttempt", mock.Anything)
+			},
+			ExpectedErr:       errlib.ErrCodeDBQuery,
+			ExpectedDelivered: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:                loggerMocks.NewMockLogger(t),
+				repoSQL:               mocks.NewMockIOrderSQLRepository(t),
+				inventoryGrpcClient:   grpcMocks.NewMockInvClient(t),
+				backInStockGrpcClient: grpcMocks.NewMockBackInStockClient(t),
+				backorderGrpcClient:   grpcMocks.NewMockBackorderClient(t),
+			}
+			sender := mocks.NewMockSender(t)
+
+			tc.Mock(&deps, sender)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, deps.inventoryGrpcClient, deps.backInStockGrpcClient, deps.backorderGrpcClient, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, sender)
+			delivered, err := usecase.DispatchWebhooks(context.Background())
+
+			assert.Equal(t, tc.ExpectedDelivered, delivered)
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrderUsecase_DispatchWebhooks_NoSender(t *testing.T) {
+	// without a sender nothing is claimed, the repository mock fails on any call
+	usecase := NewOrderUsecase(mocks.NewMockIOrderSQLRepository(t), loggerMocks.NewMockLogger(t), nil, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	delivered, err := usecase.DispatchWebhooks(context.Background())
+
+	assert.NoError(t, err)
+	assert.Zero(t, delivered)
+}
+
+func TestOrderUsecase_ReplayWebhookDelivery(t *testing.T) {
+	deliveryId := uuid.New()
+	withStatus := func(status string) *model.WebhookDelivery {
+		return &model.WebhookDelivery{Id: deliveryId, Status: status, Attempts: webhook.MaxAttempts}
+	}
+
+	testCases := []struct {
+		Name        string
+		Mock        func(dep *usecaseDeps)
+		ExpectedErr string
+	}{
+		{
+			Name: "dead delivery is queued again",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetWebhookDelivery(mock.Anything, deliveryId).
+					Return(withStatus(model.WEBHOOK_DELIVERY_DEAD), nil)
+				dep.repoSQL.EXPECT().ReplayWebhookDelivery(mock.Anything, mock.Anything).
+					Return(true, nil)
+			},
+		},
+		{
+			Name: "pending delivery is already queued",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetWebhookDelivery(mock.Anything, deliveryId).
+					Return(withStatus(model.WEBHOOK_DELIVERY_PENDING), nil)
+			},
+			ExpectedErr: errlib.ErrCodeWebhookDeliveryStatus,
+		},
+		{
+			Name: "delivery sent concurrently is not replayed",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetWebhookDelivery(mock.Anything, deliveryId).
+					Return(withStatus(model.WEBHOOK_DELIVERY_SUCCEEDED), nil)
+				dep.repoSQL.EXPECT().ReplayWebhookDelivery(mock.Anything, mock.Anything).
+					Return(false, nil)
+			},
+			ExpectedErr: errlib.ErrCodeWebhookDeliveryStatus,
+		},
+		{
+			Name: "unknown delivery",
+			Mock: func(dep *usecaseDeps) {
+				dep.repoSQL.EXPECT().GetWebhookDelivery(mock.Anything, deliveryId).
+					Return(nil, nil)
+			},
+			ExpectedErr: errlib.ErrCodeDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			deps := usecaseDeps{
+				logger:  loggerMocks.NewMockLogger(t),
+				repoSQL: mocks.NewMockIOrderSQLRepository(t),
+			}
+
+			tc.Mock(&deps)
+
+			usecase := NewOrderUsecase(deps.repoSQL, deps.logger, nil, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+			result, err := usecase.ReplayWebhookDelivery(context.Background(), deliveryId)
+
+			if tc.ExpectedErr != "" {
+				appErr, ok := err.(*errlib.AppError)
+				assert.True(t, ok)
+				assert.Equal(t, tc.ExpectedErr, appErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, deliveryId, result.Id)
+		})
+	}
+}
+
+func TestOrderUsecase_CreateWebhookEndpoint(t *testing.T) {
+	repo := mocks.NewMockIOrderSQLRepository(t)
+	repo.EXPECT().InsertWebhookEndpoint(mock.Anything, mock.MatchedBy(func(e *model.WebhookEndpoint) bool {
+		return e.Url == "https://partner.example.com/hooks" && e.Active && len(e.Secret) > len("whsec_")
+	})).
+		Return(nil)
+
+	usecase := NewOrderUsecase(repo, loggerMocks.NewMockLogger(t), nil, nil, nil, nil, mockQuoteSigner, mockPaymentProvider, mockTaxCalculator, nil)
+	result, err := usecase.CreateWebhookEndpoint(context.Background(), types.WebhookEndpointRequest{
+		Url:        " https://partner.example.com/hooks ",
+		EventTypes: []string{model.WEBHOOK_EVENT_ORDER_CONFIRMED},
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.Secret)
+	assert.Equal(t, []string{model.WEBHOOK_EVENT_ORDER_CONFIRMED}, result.EventTypes)
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// headers of a delivery, the signature covers the timestamp and the body so neither can be swapped
+const (
+	HeaderId        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// retries of a failed delivery, the wait doubles from baseBackoff up to maxBackoff. a delivery that
+// failed MaxAttempts times is dead
+const (
+	MaxAttempts = 10
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+)
+
+var (
+	ErrInvalidSignature = errors.New("webhook signature does not match")
+	ErrExpiredTimestamp = errors.New("webhook timestamp is outside the tolerance")
+)
+
+type (
+	// Sender posts deliveries to partner endpoints
+	Sender interface {
+		// statusCode is the answer of the endpoint, zero when it did not answer. any answer but 2xx is an error
+		Send(ctx context.Context, delivery model.WebhookDelivery) (statusCode int, err error)
+	}
+
+	HTTPSender struct {
+		client *http.Client
+		now    func() time.Time
+	}
+)
+
+// NewHTTPSender sends deliveries over http, an endpoint has timeout to answer
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, delivery model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	timestamp := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "svc-order-webhooks")
+	req.Header.Set(HeaderId, delivery.Id.String())
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// a short excerpt of the answer goes to the delivery log
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint answered %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign is the signature header of a payload sent at timestamp, HMAC-SHA256 of "<timestamp>.<payload>"
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery received at now. deliveries signed more than tolerance
+// before or after now are refused, so a captured request cannot be replayed later
+func Verify(secret string, header http.Header, payload []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrExpiredTimestamp
+	}
+
+	if !hmac.Equal([]byte(header.Get(HeaderSignature)), []byte(Sign(secret, timestamp, payload))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Backoff is the wait before the next try of a delivery that failed attempts times
+func Backoff(attempts int) time.Duration {
+	wait := baseBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
+
+// NewSecret generates the signing secret of a new endpoint
+func NewSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"ops-monorepo/services/svc-order/internal/model"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	ctx := context.Background()
+	secret := "whsec_test"
+	payload := []byte(`{"id":"0b5e1a4e-6c41-4d5a-9a63-2d3b1f6f0c11","type":"order.confirmed"}`)
+
+	testCases := []struct {
+		Name               string
+		Status             int
+		ExpectedStatusCode int
+		ExpectedErr        bool
+	}{
+		{
+			Name:               "accepted delivery",
+			Status:             http.StatusNoContent,
+			ExpectedStatusCode: http.StatusNoContent,
+		},
+		{
+			Name:               "endpoint refuses the delivery",
+			Status:             http.StatusInternalServerError,
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			delivery := model.WebhookDelivery{
+				Id:        uuid.New(),
+				EventType: model.WEBHOOK_EVENT_ORDER_CONFIRMED,
+				Payload:   payload,
+				Secret:    secret,
+			}
+
+			// partner receiver verifying the delivery the way the readme describes
+			var received http.Header
+			var verifyErr error
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received = r.Header.Clone()
+				verifyErr = Verify(secret, r.Header, body, 5*time.Minute, time.Now())
+				assert.Equal(t, payload, body)
+				w.WriteHeader(tc.Status)
+			}))
+			defer receiver.Close()
+			delivery.Url = receiver.URL
+
+			statusCode, err := NewHTTPSender(time.Second).Send(ctx, delivery)
+
+			assert.Equal(t, tc.ExpectedStatusCode, statusCode)
+			if tc.ExpectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, verifyErr)
+			assert.Equal(t, delivery.Id.String(), received.Get(HeaderId))
+			assert.Equal(t, model.WEBHOOK_EVENT_ORDER_CONFIRMED, received.Get(HeaderEvent))
+			assert.Equal(t, "application/json", received.Get("Content-Type"))
+		})
+	}
+}
+
+func TestHTTPSender_Send_Unreachable(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	statusCode, err := NewHTTPSender(time.Second).Send(context.Background(), model.WebhookDelivery{
+		Id:      uuid.New(),
+		Url:     url,
+		Payload: []byte(`{}`),
+		Secret:  "whsec_test",
+	})
+
+	assert.Equal(t, 0, statusCode)
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	secret := "whsec_test"
+	payload := []byte(`{"type":"order.fulfilled"}`)
+	now := time.Unix(1760000000, 0)
+
+	signed := func(timestamp time.Time, secret string, payload []byte) http.Header {
+		header := http.Header{}
+		header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+		header.Set(HeaderSignature, Sign(secret, timestamp.Unix(), payload))
+		return header
+	}
+
+	testCases := []struct {
+		Name        string
+		Header      http.Header
+		Payload     []byte
+		ExpectedErr error
+	}{
+		{
+			Name:    "valid signature",
+			Header:  signed(now.Add(-time.Minute), secret, payload),
+			Payload: payload,
+		},
+		{
+			Name:        "tampered body",
+			Header:      signed(now, secret, payload),
+			Payload:     []byte(`{"type":"order.cancelled"}`),
+			ExpectedErr: ErrInvalidSignature,
+		},
+		{
+			Name:        "other secret",
+			Header:      signed(now, "whsec_other", payload),
+			Payload:     payload,
+			ExpectedErr: ErrInvalidSignature,
+		},
+		{
+			Name:        "replayed after the tolerance",
+			Header:      signed(now.Add(-10*time.Minute), secret, payload),
+			Payload:     payload,
+			ExpectedErr: ErrExpiredTimestamp,
+		},
+		{
+			Name:        "missing timestamp",
+			Header:      http.Header{},
+			Payload:     payload,
+			ExpectedErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := Verify(secret, tc.Header, tc.Payload, 5*time.Minute, now)
+			assert.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 32*time.Minute, Backoff(7))
+	assert.Equal(t, time.Hour, Backoff(8))
+	assert.Equal(t, time.Hour, Backoff(MaxAttempts))
+}
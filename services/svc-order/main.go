@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"ops-monorepo/services/svc-order/config"
@@ -18,6 +19,9 @@ func main() {
 	// register routes
 	server.RegisterRoutes()
 
+	// background jobs
+	server.StartJobs(context.Background())
+
 	// start server
 	addr := fmt.Sprintf(":%s", config.Port)
 	log.Fatal(server.Start(addr))
@@ -36,6 +36,86 @@ func (_m *MockIOrder) EXPECT() *MockIOrder_Expecter {
 	return &MockIOrder_Expecter{mock: &_m.Mock}
 }
 
+// AmendOrderItems provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) AmendOrderItems(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_AmendOrderItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendOrderItems'
+type MockIOrder_AmendOrderItems_Call struct {
+	*mock.Call
+}
+
+// AmendOrderItems is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) AmendOrderItems(c interface{}) *MockIOrder_AmendOrderItems_Call {
+	return &MockIOrder_AmendOrderItems_Call{Call: _e.mock.On("AmendOrderItems", c)}
+}
+
+func (_c *MockIOrder_AmendOrderItems_Call) Run(run func(c *gin.Context)) *MockIOrder_AmendOrderItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_AmendOrderItems_Call) Return() *MockIOrder_AmendOrderItems_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_AmendOrderItems_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_AmendOrderItems_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ApproveReturn provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ApproveReturn(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ApproveReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveReturn'
+type MockIOrder_ApproveReturn_Call struct {
+	*mock.Call
+}
+
+// ApproveReturn is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ApproveReturn(c interface{}) *MockIOrder_ApproveReturn_Call {
+	return &MockIOrder_ApproveReturn_Call{Call: _e.mock.On("ApproveReturn", c)}
+}
+
+func (_c *MockIOrder_ApproveReturn_Call) Run(run func(c *gin.Context)) *MockIOrder_ApproveReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ApproveReturn_Call) Return() *MockIOrder_ApproveReturn_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ApproveReturn_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ApproveReturn_Call {
+	_c.Run(run)
+	return _c
+}
+
 // CreateOrder provides a mock function for the type MockIOrder
 func (_mock *MockIOrder) CreateOrder(c *gin.Context) {
 	_mock.Called(c)
@@ -75,3 +155,1003 @@ func (_c *MockIOrder_CreateOrder_Call) RunAndReturn(run func(c *gin.Context)) *M
 	_c.Run(run)
 	return _c
 }
+
+// CreatePromotion provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) CreatePromotion(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_CreatePromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromotion'
+type MockIOrder_CreatePromotion_Call struct {
+	*mock.Call
+}
+
+// CreatePromotion is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) CreatePromotion(c interface{}) *MockIOrder_CreatePromotion_Call {
+	return &MockIOrder_CreatePromotion_Call{Call: _e.mock.On("CreatePromotion", c)}
+}
+
+func (_c *MockIOrder_CreatePromotion_Call) Run(run func(c *gin.Context)) *MockIOrder_CreatePromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_CreatePromotion_Call) Return() *MockIOrder_CreatePromotion_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_CreatePromotion_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_CreatePromotion_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateQuote provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) CreateQuote(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_CreateQuote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuote'
+type MockIOrder_CreateQuote_Call struct {
+	*mock.Call
+}
+
+// CreateQuote is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) CreateQuote(c interface{}) *MockIOrder_CreateQuote_Call {
+	return &MockIOrder_CreateQuote_Call{Call: _e.mock.On("CreateQuote", c)}
+}
+
+func (_c *MockIOrder_CreateQuote_Call) Run(run func(c *gin.Context)) *MockIOrder_CreateQuote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_CreateQuote_Call) Return() *MockIOrder_CreateQuote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_CreateQuote_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_CreateQuote_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateShipment provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) CreateShipment(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_CreateShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShipment'
+type MockIOrder_CreateShipment_Call struct {
+	*mock.Call
+}
+
+// CreateShipment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) CreateShipment(c interface{}) *MockIOrder_CreateShipment_Call {
+	return &MockIOrder_CreateShipment_Call{Call: _e.mock.On("CreateShipment", c)}
+}
+
+func (_c *MockIOrder_CreateShipment_Call) Run(run func(c *gin.Context)) *MockIOrder_CreateShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_CreateShipment_Call) Return() *MockIOrder_CreateShipment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_CreateShipment_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_CreateShipment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// CreateWebhookEndpoint provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) CreateWebhookEndpoint(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_CreateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookEndpoint'
+type MockIOrder_CreateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookEndpoint is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) CreateWebhookEndpoint(c interface{}) *MockIOrder_CreateWebhookEndpoint_Call {
+	return &MockIOrder_CreateWebhookEndpoint_Call{Call: _e.mock.On("CreateWebhookEndpoint", c)}
+}
+
+func (_c *MockIOrder_CreateWebhookEndpoint_Call) Run(run func(c *gin.Context)) *MockIOrder_CreateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_CreateWebhookEndpoint_Call) Return() *MockIOrder_CreateWebhookEndpoint_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_CreateWebhookEndpoint_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_CreateWebhookEndpoint_Call {
+	_c.Run(run)
+	return _c
+}
+
+// DeliverShipment provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) DeliverShipment(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_DeliverShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverShipment'
+type MockIOrder_DeliverShipment_Call struct {
+	*mock.Call
+}
+
+// DeliverShipment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) DeliverShipment(c interface{}) *MockIOrder_DeliverShipment_Call {
+	return &MockIOrder_DeliverShipment_Call{Call: _e.mock.On("DeliverShipment", c)}
+}
+
+func (_c *MockIOrder_DeliverShipment_Call) Run(run func(c *gin.Context)) *MockIOrder_DeliverShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_DeliverShipment_Call) Return() *MockIOrder_DeliverShipment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_DeliverShipment_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_DeliverShipment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ExportAdminOrders provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ExportAdminOrders(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ExportAdminOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportAdminOrders'
+type MockIOrder_ExportAdminOrders_Call struct {
+	*mock.Call
+}
+
+// ExportAdminOrders is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ExportAdminOrders(c interface{}) *MockIOrder_ExportAdminOrders_Call {
+	return &MockIOrder_ExportAdminOrders_Call{Call: _e.mock.On("ExportAdminOrders", c)}
+}
+
+func (_c *MockIOrder_ExportAdminOrders_Call) Run(run func(c *gin.Context)) *MockIOrder_ExportAdminOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ExportAdminOrders_Call) Return() *MockIOrder_ExportAdminOrders_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ExportAdminOrders_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ExportAdminOrders_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ForceOrderStatus provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ForceOrderStatus(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ForceOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceOrderStatus'
+type MockIOrder_ForceOrderStatus_Call struct {
+	*mock.Call
+}
+
+// ForceOrderStatus is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ForceOrderStatus(c interface{}) *MockIOrder_ForceOrderStatus_Call {
+	return &MockIOrder_ForceOrderStatus_Call{Call: _e.mock.On("ForceOrderStatus", c)}
+}
+
+func (_c *MockIOrder_ForceOrderStatus_Call) Run(run func(c *gin.Context)) *MockIOrder_ForceOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ForceOrderStatus_Call) Return() *MockIOrder_ForceOrderStatus_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ForceOrderStatus_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ForceOrderStatus_Call {
+	_c.Run(run)
+	return _c
+}
+
+// FulfilOrder provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) FulfilOrder(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_FulfilOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfilOrder'
+type MockIOrder_FulfilOrder_Call struct {
+	*mock.Call
+}
+
+// FulfilOrder is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) FulfilOrder(c interface{}) *MockIOrder_FulfilOrder_Call {
+	return &MockIOrder_FulfilOrder_Call{Call: _e.mock.On("FulfilOrder", c)}
+}
+
+func (_c *MockIOrder_FulfilOrder_Call) Run(run func(c *gin.Context)) *MockIOrder_FulfilOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_FulfilOrder_Call) Return() *MockIOrder_FulfilOrder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_FulfilOrder_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_FulfilOrder_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetOrder provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) GetOrder(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type MockIOrder_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) GetOrder(c interface{}) *MockIOrder_GetOrder_Call {
+	return &MockIOrder_GetOrder_Call{Call: _e.mock.On("GetOrder", c)}
+}
+
+func (_c *MockIOrder_GetOrder_Call) Run(run func(c *gin.Context)) *MockIOrder_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_GetOrder_Call) Return() *MockIOrder_GetOrder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_GetOrder_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_GetOrder_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetOrderReturns provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) GetOrderReturns(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_GetOrderReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderReturns'
+type MockIOrder_GetOrderReturns_Call struct {
+	*mock.Call
+}
+
+// GetOrderReturns is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) GetOrderReturns(c interface{}) *MockIOrder_GetOrderReturns_Call {
+	return &MockIOrder_GetOrderReturns_Call{Call: _e.mock.On("GetOrderReturns", c)}
+}
+
+func (_c *MockIOrder_GetOrderReturns_Call) Run(run func(c *gin.Context)) *MockIOrder_GetOrderReturns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_GetOrderReturns_Call) Return() *MockIOrder_GetOrderReturns_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_GetOrderReturns_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_GetOrderReturns_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetOrderShipments provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) GetOrderShipments(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_GetOrderShipments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderShipments'
+type MockIOrder_GetOrderShipments_Call struct {
+	*mock.Call
+}
+
+// GetOrderShipments is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) GetOrderShipments(c interface{}) *MockIOrder_GetOrderShipments_Call {
+	return &MockIOrder_GetOrderShipments_Call{Call: _e.mock.On("GetOrderShipments", c)}
+}
+
+func (_c *MockIOrder_GetOrderShipments_Call) Run(run func(c *gin.Context)) *MockIOrder_GetOrderShipments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_GetOrderShipments_Call) Return() *MockIOrder_GetOrderShipments_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_GetOrderShipments_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_GetOrderShipments_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetWebhookDelivery provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) GetWebhookDelivery(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_GetWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDelivery'
+type MockIOrder_GetWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDelivery is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) GetWebhookDelivery(c interface{}) *MockIOrder_GetWebhookDelivery_Call {
+	return &MockIOrder_GetWebhookDelivery_Call{Call: _e.mock.On("GetWebhookDelivery", c)}
+}
+
+func (_c *MockIOrder_GetWebhookDelivery_Call) Run(run func(c *gin.Context)) *MockIOrder_GetWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_GetWebhookDelivery_Call) Return() *MockIOrder_GetWebhookDelivery_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_GetWebhookDelivery_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_GetWebhookDelivery_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListAdminAudit provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ListAdminAudit(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ListAdminAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAdminAudit'
+type MockIOrder_ListAdminAudit_Call struct {
+	*mock.Call
+}
+
+// ListAdminAudit is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ListAdminAudit(c interface{}) *MockIOrder_ListAdminAudit_Call {
+	return &MockIOrder_ListAdminAudit_Call{Call: _e.mock.On("ListAdminAudit", c)}
+}
+
+func (_c *MockIOrder_ListAdminAudit_Call) Run(run func(c *gin.Context)) *MockIOrder_ListAdminAudit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ListAdminAudit_Call) Return() *MockIOrder_ListAdminAudit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ListAdminAudit_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ListAdminAudit_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListPromotions provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ListPromotions(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ListPromotions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPromotions'
+type MockIOrder_ListPromotions_Call struct {
+	*mock.Call
+}
+
+// ListPromotions is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ListPromotions(c interface{}) *MockIOrder_ListPromotions_Call {
+	return &MockIOrder_ListPromotions_Call{Call: _e.mock.On("ListPromotions", c)}
+}
+
+func (_c *MockIOrder_ListPromotions_Call) Run(run func(c *gin.Context)) *MockIOrder_ListPromotions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ListPromotions_Call) Return() *MockIOrder_ListPromotions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ListPromotions_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ListPromotions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ListWebhookDeliveries(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type MockIOrder_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ListWebhookDeliveries(c interface{}) *MockIOrder_ListWebhookDeliveries_Call {
+	return &MockIOrder_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", c)}
+}
+
+func (_c *MockIOrder_ListWebhookDeliveries_Call) Run(run func(c *gin.Context)) *MockIOrder_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ListWebhookDeliveries_Call) Return() *MockIOrder_ListWebhookDeliveries_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ListWebhookDeliveries_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ListWebhookDeliveries_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListWebhookEndpoints provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ListWebhookEndpoints(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ListWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookEndpoints'
+type MockIOrder_ListWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListWebhookEndpoints is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ListWebhookEndpoints(c interface{}) *MockIOrder_ListWebhookEndpoints_Call {
+	return &MockIOrder_ListWebhookEndpoints_Call{Call: _e.mock.On("ListWebhookEndpoints", c)}
+}
+
+func (_c *MockIOrder_ListWebhookEndpoints_Call) Run(run func(c *gin.Context)) *MockIOrder_ListWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ListWebhookEndpoints_Call) Return() *MockIOrder_ListWebhookEndpoints_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ListWebhookEndpoints_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ListWebhookEndpoints_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ReceiveReturn provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ReceiveReturn(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ReceiveReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveReturn'
+type MockIOrder_ReceiveReturn_Call struct {
+	*mock.Call
+}
+
+// ReceiveReturn is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ReceiveReturn(c interface{}) *MockIOrder_ReceiveReturn_Call {
+	return &MockIOrder_ReceiveReturn_Call{Call: _e.mock.On("ReceiveReturn", c)}
+}
+
+func (_c *MockIOrder_ReceiveReturn_Call) Run(run func(c *gin.Context)) *MockIOrder_ReceiveReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ReceiveReturn_Call) Return() *MockIOrder_ReceiveReturn_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ReceiveReturn_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ReceiveReturn_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RejectReturn provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) RejectReturn(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_RejectReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectReturn'
+type MockIOrder_RejectReturn_Call struct {
+	*mock.Call
+}
+
+// RejectReturn is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) RejectReturn(c interface{}) *MockIOrder_RejectReturn_Call {
+	return &MockIOrder_RejectReturn_Call{Call: _e.mock.On("RejectReturn", c)}
+}
+
+func (_c *MockIOrder_RejectReturn_Call) Run(run func(c *gin.Context)) *MockIOrder_RejectReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_RejectReturn_Call) Return() *MockIOrder_RejectReturn_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_RejectReturn_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_RejectReturn_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ReplayWebhookDelivery provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ReplayWebhookDelivery(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ReplayWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayWebhookDelivery'
+type MockIOrder_ReplayWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// ReplayWebhookDelivery is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ReplayWebhookDelivery(c interface{}) *MockIOrder_ReplayWebhookDelivery_Call {
+	return &MockIOrder_ReplayWebhookDelivery_Call{Call: _e.mock.On("ReplayWebhookDelivery", c)}
+}
+
+func (_c *MockIOrder_ReplayWebhookDelivery_Call) Run(run func(c *gin.Context)) *MockIOrder_ReplayWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ReplayWebhookDelivery_Call) Return() *MockIOrder_ReplayWebhookDelivery_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ReplayWebhookDelivery_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ReplayWebhookDelivery_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RequestReturn provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) RequestReturn(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_RequestReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestReturn'
+type MockIOrder_RequestReturn_Call struct {
+	*mock.Call
+}
+
+// RequestReturn is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) RequestReturn(c interface{}) *MockIOrder_RequestReturn_Call {
+	return &MockIOrder_RequestReturn_Call{Call: _e.mock.On("RequestReturn", c)}
+}
+
+func (_c *MockIOrder_RequestReturn_Call) Run(run func(c *gin.Context)) *MockIOrder_RequestReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_RequestReturn_Call) Return() *MockIOrder_RequestReturn_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_RequestReturn_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_RequestReturn_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RetryReservation provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) RetryReservation(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_RetryReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryReservation'
+type MockIOrder_RetryReservation_Call struct {
+	*mock.Call
+}
+
+// RetryReservation is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) RetryReservation(c interface{}) *MockIOrder_RetryReservation_Call {
+	return &MockIOrder_RetryReservation_Call{Call: _e.mock.On("RetryReservation", c)}
+}
+
+func (_c *MockIOrder_RetryReservation_Call) Run(run func(c *gin.Context)) *MockIOrder_RetryReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_RetryReservation_Call) Return() *MockIOrder_RetryReservation_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_RetryReservation_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_RetryReservation_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SearchAdminOrders provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) SearchAdminOrders(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_SearchAdminOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAdminOrders'
+type MockIOrder_SearchAdminOrders_Call struct {
+	*mock.Call
+}
+
+// SearchAdminOrders is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) SearchAdminOrders(c interface{}) *MockIOrder_SearchAdminOrders_Call {
+	return &MockIOrder_SearchAdminOrders_Call{Call: _e.mock.On("SearchAdminOrders", c)}
+}
+
+func (_c *MockIOrder_SearchAdminOrders_Call) Run(run func(c *gin.Context)) *MockIOrder_SearchAdminOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_SearchAdminOrders_Call) Return() *MockIOrder_SearchAdminOrders_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_SearchAdminOrders_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_SearchAdminOrders_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ShipShipment provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) ShipShipment(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_ShipShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShipShipment'
+type MockIOrder_ShipShipment_Call struct {
+	*mock.Call
+}
+
+// ShipShipment is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) ShipShipment(c interface{}) *MockIOrder_ShipShipment_Call {
+	return &MockIOrder_ShipShipment_Call{Call: _e.mock.On("ShipShipment", c)}
+}
+
+func (_c *MockIOrder_ShipShipment_Call) Run(run func(c *gin.Context)) *MockIOrder_ShipShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_ShipShipment_Call) Return() *MockIOrder_ShipShipment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_ShipShipment_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_ShipShipment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SubscribeBackInStock provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) SubscribeBackInStock(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_SubscribeBackInStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeBackInStock'
+type MockIOrder_SubscribeBackInStock_Call struct {
+	*mock.Call
+}
+
+// SubscribeBackInStock is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) SubscribeBackInStock(c interface{}) *MockIOrder_SubscribeBackInStock_Call {
+	return &MockIOrder_SubscribeBackInStock_Call{Call: _e.mock.On("SubscribeBackInStock", c)}
+}
+
+func (_c *MockIOrder_SubscribeBackInStock_Call) Run(run func(c *gin.Context)) *MockIOrder_SubscribeBackInStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_SubscribeBackInStock_Call) Return() *MockIOrder_SubscribeBackInStock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_SubscribeBackInStock_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_SubscribeBackInStock_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateWebhookEndpoint provides a mock function for the type MockIOrder
+func (_mock *MockIOrder) UpdateWebhookEndpoint(c *gin.Context) {
+	_mock.Called(c)
+	return
+}
+
+// MockIOrder_UpdateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookEndpoint'
+type MockIOrder_UpdateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookEndpoint is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MockIOrder_Expecter) UpdateWebhookEndpoint(c interface{}) *MockIOrder_UpdateWebhookEndpoint_Call {
+	return &MockIOrder_UpdateWebhookEndpoint_Call{Call: _e.mock.On("UpdateWebhookEndpoint", c)}
+}
+
+func (_c *MockIOrder_UpdateWebhookEndpoint_Call) Run(run func(c *gin.Context)) *MockIOrder_UpdateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrder_UpdateWebhookEndpoint_Call) Return() *MockIOrder_UpdateWebhookEndpoint_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIOrder_UpdateWebhookEndpoint_Call) RunAndReturn(run func(c *gin.Context)) *MockIOrder_UpdateWebhookEndpoint_Call {
+	_c.Run(run)
+	return _c
+}
@@ -8,8 +8,10 @@ import (
 	"context"
 	"ops-monorepo/services/svc-order/internal/model"
 	"ops-monorepo/shared-libs/storage/postgres"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/robaho/fixed"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -40,6 +42,84 @@ func (_m *MockIOrderSQLRepository) EXPECT() *MockIOrderSQLRepository_Expecter {
 	return &MockIOrderSQLRepository_Expecter{mock: &_m.Mock}
 }
 
+// AmendOrderItems provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) AmendOrderItems(ctx context.Context, order *model.Order, statuses []string, amendment model.OrderAmendment) (bool, error) {
+	ret := _mock.Called(ctx, order, statuses, amendment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendOrderItems")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, []string, model.OrderAmendment) (bool, error)); ok {
+		return returnFunc(ctx, order, statuses, amendment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, []string, model.OrderAmendment) bool); ok {
+		r0 = returnFunc(ctx, order, statuses, amendment)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, []string, model.OrderAmendment) error); ok {
+		r1 = returnFunc(ctx, order, statuses, amendment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_AmendOrderItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendOrderItems'
+type MockIOrderSQLRepository_AmendOrderItems_Call struct {
+	*mock.Call
+}
+
+// AmendOrderItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - statuses []string
+//   - amendment model.OrderAmendment
+func (_e *MockIOrderSQLRepository_Expecter) AmendOrderItems(ctx interface{}, order interface{}, statuses interface{}, amendment interface{}) *MockIOrderSQLRepository_AmendOrderItems_Call {
+	return &MockIOrderSQLRepository_AmendOrderItems_Call{Call: _e.mock.On("AmendOrderItems", ctx, order, statuses, amendment)}
+}
+
+func (_c *MockIOrderSQLRepository_AmendOrderItems_Call) Run(run func(ctx context.Context, order *model.Order, statuses []string, amendment model.OrderAmendment)) *MockIOrderSQLRepository_AmendOrderItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 model.OrderAmendment
+		if args[3] != nil {
+			arg3 = args[3].(model.OrderAmendment)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_AmendOrderItems_Call) Return(b bool, err error) *MockIOrderSQLRepository_AmendOrderItems_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_AmendOrderItems_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, statuses []string, amendment model.OrderAmendment) (bool, error)) *MockIOrderSQLRepository_AmendOrderItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BeginTransaction provides a mock function for the type MockIOrderSQLRepository
 func (_mock *MockIOrderSQLRepository) BeginTransaction(ctx context.Context) (storage.PgxTx, error) {
 	ret := _mock.Called(ctx)
@@ -102,6 +182,160 @@ func (_c *MockIOrderSQLRepository_BeginTransaction_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ClaimDueSubscriptions provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) ClaimDueSubscriptions(ctx context.Context, limit int, now time.Time, next func(subscription *model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error) {
+	ret := _mock.Called(ctx, limit, now, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueSubscriptions")
+	}
+
+	var r0 []model.DueSubscriptionRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time, func(subscription *model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error)); ok {
+		return returnFunc(ctx, limit, now, next)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Time, func(subscription *model.Subscription) *time.Time) []model.DueSubscriptionRun); ok {
+		r0 = returnFunc(ctx, limit, now, next)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DueSubscriptionRun)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Time, func(subscription *model.Subscription) *time.Time) error); ok {
+		r1 = returnFunc(ctx, limit, now, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_ClaimDueSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueSubscriptions'
+type MockIOrderSQLRepository_ClaimDueSubscriptions_Call struct {
+	*mock.Call
+}
+
+// ClaimDueSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - now time.Time
+//   - next func(subscription *model.Subscription) *time.Time
+func (_e *MockIOrderSQLRepository_Expecter) ClaimDueSubscriptions(ctx interface{}, limit interface{}, now interface{}, next interface{}) *MockIOrderSQLRepository_ClaimDueSubscriptions_Call {
+	return &MockIOrderSQLRepository_ClaimDueSubscriptions_Call{Call: _e.mock.On("ClaimDueSubscriptions", ctx, limit, now, next)}
+}
+
+func (_c *MockIOrderSQLRepository_ClaimDueSubscriptions_Call) Run(run func(ctx context.Context, limit int, now time.Time, next func(subscription *model.Subscription) *time.Time)) *MockIOrderSQLRepository_ClaimDueSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 func(subscription *model.Subscription) *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(func(subscription *model.Subscription) *time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ClaimDueSubscriptions_Call) Return(dueSubscriptionRuns []model.DueSubscriptionRun, err error) *MockIOrderSQLRepository_ClaimDueSubscriptions_Call {
+	_c.Call.Return(dueSubscriptionRuns, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ClaimDueSubscriptions_Call) RunAndReturn(run func(ctx context.Context, limit int, now time.Time, next func(subscription *model.Subscription) *time.Time) ([]model.DueSubscriptionRun, error)) *MockIOrderSQLRepository_ClaimDueSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimWebhookDeliveries provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, limit, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimWebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, limit, lease)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, time.Duration) []model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, limit, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = returnFunc(ctx, limit, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_ClaimWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimWebhookDeliveries'
+type MockIOrderSQLRepository_ClaimWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - lease time.Duration
+func (_e *MockIOrderSQLRepository_Expecter) ClaimWebhookDeliveries(ctx interface{}, limit interface{}, lease interface{}) *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call {
+	return &MockIOrderSQLRepository_ClaimWebhookDeliveries_Call{Call: _e.mock.On("ClaimWebhookDeliveries", ctx, limit, lease)}
+}
+
+func (_c *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call) Run(run func(ctx context.Context, limit int, lease time.Duration)) *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call) Return(webhookDeliverys []model.WebhookDelivery, err error) *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error)) *MockIOrderSQLRepository_ClaimWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CommitTransaction provides a mock function for the type MockIOrderSQLRepository
 func (_mock *MockIOrderSQLRepository) CommitTransaction(ctx context.Context, tx storage.PgxTx) error {
 	ret := _mock.Called(ctx, tx)
@@ -159,55 +393,44 @@ func (_c *MockIOrderSQLRepository_CommitTransaction_Call) RunAndReturn(run func(
 	return _c
 }
 
-// GetOrderById provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) GetOrderById(ctx context.Context, orderId uuid.UUID) (*model.Order, error) {
-	ret := _mock.Called(ctx, orderId)
+// FinishSubscriptionRun provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) FinishSubscriptionRun(ctx context.Context, run *model.SubscriptionRun) error {
+	ret := _mock.Called(ctx, run)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrderById")
+		panic("no return value specified for FinishSubscriptionRun")
 	}
 
-	var r0 *model.Order
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, error)); ok {
-		return returnFunc(ctx, orderId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
-		r0 = returnFunc(ctx, orderId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Order)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, orderId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SubscriptionRun) error); ok {
+		r0 = returnFunc(ctx, run)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIOrderSQLRepository_GetOrderById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderById'
-type MockIOrderSQLRepository_GetOrderById_Call struct {
+// MockIOrderSQLRepository_FinishSubscriptionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishSubscriptionRun'
+type MockIOrderSQLRepository_FinishSubscriptionRun_Call struct {
 	*mock.Call
 }
 
-// GetOrderById is a helper method to define mock.On call
+// FinishSubscriptionRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orderId uuid.UUID
-func (_e *MockIOrderSQLRepository_Expecter) GetOrderById(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderById_Call {
-	return &MockIOrderSQLRepository_GetOrderById_Call{Call: _e.mock.On("GetOrderById", ctx, orderId)}
+//   - run *model.SubscriptionRun
+func (_e *MockIOrderSQLRepository_Expecter) FinishSubscriptionRun(ctx interface{}, run interface{}) *MockIOrderSQLRepository_FinishSubscriptionRun_Call {
+	return &MockIOrderSQLRepository_FinishSubscriptionRun_Call{Call: _e.mock.On("FinishSubscriptionRun", ctx, run)}
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderById_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderById_Call {
+func (_c *MockIOrderSQLRepository_FinishSubscriptionRun_Call) Run(run func(ctx context.Context, run *model.SubscriptionRun)) *MockIOrderSQLRepository_FinishSubscriptionRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 *model.SubscriptionRun
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(*model.SubscriptionRun)
 		}
 		run(
 			arg0,
@@ -217,141 +440,695 @@ func (_c *MockIOrderSQLRepository_GetOrderById_Call) Run(run func(ctx context.Co
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderById_Call) Return(order *model.Order, err error) *MockIOrderSQLRepository_GetOrderById_Call {
-	_c.Call.Return(order, err)
+func (_c *MockIOrderSQLRepository_FinishSubscriptionRun_Call) Return(err error) *MockIOrderSQLRepository_FinishSubscriptionRun_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderById_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.Order, error)) *MockIOrderSQLRepository_GetOrderById_Call {
+func (_c *MockIOrderSQLRepository_FinishSubscriptionRun_Call) RunAndReturn(run func(ctx context.Context, run *model.SubscriptionRun) error) *MockIOrderSQLRepository_FinishSubscriptionRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrderItemsByOrderId provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) GetOrderItemsByOrderId(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error) {
-	ret := _mock.Called(ctx, orderId)
+// ForceOrderStatus provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) ForceOrderStatus(ctx context.Context, order *model.Order, to string, entry *model.AdminAuditEntry) (bool, error) {
+	ret := _mock.Called(ctx, order, to, entry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrderItemsByOrderId")
+		panic("no return value specified for ForceOrderStatus")
 	}
 
-	var r0 []model.ItemOrder
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.ItemOrder, error)); ok {
-		return returnFunc(ctx, orderId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, string, *model.AdminAuditEntry) (bool, error)); ok {
+		return returnFunc(ctx, order, to, entry)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.ItemOrder); ok {
-		r0 = returnFunc(ctx, orderId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, string, *model.AdminAuditEntry) bool); ok {
+		r0 = returnFunc(ctx, order, to, entry)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.ItemOrder)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, orderId)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, string, *model.AdminAuditEntry) error); ok {
+		r1 = returnFunc(ctx, order, to, entry)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIOrderSQLRepository_GetOrderItemsByOrderId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderItemsByOrderId'
-type MockIOrderSQLRepository_GetOrderItemsByOrderId_Call struct {
+// MockIOrderSQLRepository_ForceOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceOrderStatus'
+type MockIOrderSQLRepository_ForceOrderStatus_Call struct {
 	*mock.Call
 }
 
-// GetOrderItemsByOrderId is a helper method to define mock.On call
+// ForceOrderStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orderId uuid.UUID
-func (_e *MockIOrderSQLRepository_Expecter) GetOrderItemsByOrderId(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
-	return &MockIOrderSQLRepository_GetOrderItemsByOrderId_Call{Call: _e.mock.On("GetOrderItemsByOrderId", ctx, orderId)}
+//   - order *model.Order
+//   - to string
+//   - entry *model.AdminAuditEntry
+func (_e *MockIOrderSQLRepository_Expecter) ForceOrderStatus(ctx interface{}, order interface{}, to interface{}, entry interface{}) *MockIOrderSQLRepository_ForceOrderStatus_Call {
+	return &MockIOrderSQLRepository_ForceOrderStatus_Call{Call: _e.mock.On("ForceOrderStatus", ctx, order, to, entry)}
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+func (_c *MockIOrderSQLRepository_ForceOrderStatus_Call) Run(run func(ctx context.Context, order *model.Order, to string, entry *model.AdminAuditEntry)) *MockIOrderSQLRepository_ForceOrderStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 *model.Order
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *model.AdminAuditEntry
+		if args[3] != nil {
+			arg3 = args[3].(*model.AdminAuditEntry)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) Return(itemOrders []model.ItemOrder, err error) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
-	_c.Call.Return(itemOrders, err)
+func (_c *MockIOrderSQLRepository_ForceOrderStatus_Call) Return(b bool, err error) *MockIOrderSQLRepository_ForceOrderStatus_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error)) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+func (_c *MockIOrderSQLRepository_ForceOrderStatus_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, to string, entry *model.AdminAuditEntry) (bool, error)) *MockIOrderSQLRepository_ForceOrderStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrderWithItems provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) GetOrderWithItems(ctx context.Context, orderId uuid.UUID) (*model.Order, []model.ItemOrder, error) {
-	ret := _mock.Called(ctx, orderId)
+// GetAdminAuditLog provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetAdminAuditLog(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrderWithItems")
+		panic("no return value specified for GetAdminAuditLog")
 	}
 
-	var r0 *model.Order
-	var r1 []model.ItemOrder
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, []model.ItemOrder, error)); ok {
-		return returnFunc(ctx, orderId)
+	var r0 []model.AdminAuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AdminAuditFilter) ([]model.AdminAuditEntry, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
-		r0 = returnFunc(ctx, orderId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AdminAuditFilter) []model.AdminAuditEntry); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Order)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) []model.ItemOrder); ok {
-		r1 = returnFunc(ctx, orderId)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).([]model.ItemOrder)
+			r0 = ret.Get(0).([]model.AdminAuditEntry)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
-		r2 = returnFunc(ctx, orderId)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.AdminAuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockIOrderSQLRepository_GetOrderWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderWithItems'
-type MockIOrderSQLRepository_GetOrderWithItems_Call struct {
+// MockIOrderSQLRepository_GetAdminAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdminAuditLog'
+type MockIOrderSQLRepository_GetAdminAuditLog_Call struct {
 	*mock.Call
 }
 
-// GetOrderWithItems is a helper method to define mock.On call
+// GetAdminAuditLog is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orderId uuid.UUID
-func (_e *MockIOrderSQLRepository_Expecter) GetOrderWithItems(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderWithItems_Call {
-	return &MockIOrderSQLRepository_GetOrderWithItems_Call{Call: _e.mock.On("GetOrderWithItems", ctx, orderId)}
+//   - filter model.AdminAuditFilter
+func (_e *MockIOrderSQLRepository_Expecter) GetAdminAuditLog(ctx interface{}, filter interface{}) *MockIOrderSQLRepository_GetAdminAuditLog_Call {
+	return &MockIOrderSQLRepository_GetAdminAuditLog_Call{Call: _e.mock.On("GetAdminAuditLog", ctx, filter)}
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderWithItems_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderWithItems_Call {
+func (_c *MockIOrderSQLRepository_GetAdminAuditLog_Call) Run(run func(ctx context.Context, filter model.AdminAuditFilter)) *MockIOrderSQLRepository_GetAdminAuditLog_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 model.AdminAuditFilter
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(model.AdminAuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetAdminAuditLog_Call) Return(adminAuditEntrys []model.AdminAuditEntry, err error) *MockIOrderSQLRepository_GetAdminAuditLog_Call {
+	_c.Call.Return(adminAuditEntrys, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetAdminAuditLog_Call) RunAndReturn(run func(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error)) *MockIOrderSQLRepository_GetAdminAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderById provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderById(ctx context.Context, orderId uuid.UUID) (*model.Order, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderById")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderById'
+type MockIOrderSQLRepository_GetOrderById_Call struct {
+	*mock.Call
+}
+
+// GetOrderById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderById(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderById_Call {
+	return &MockIOrderSQLRepository_GetOrderById_Call{Call: _e.mock.On("GetOrderById", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderById_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderById_Call) Return(order *model.Order, err error) *MockIOrderSQLRepository_GetOrderById_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderById_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.Order, error)) *MockIOrderSQLRepository_GetOrderById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderDiscounts provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderDiscounts(ctx context.Context, orderId uuid.UUID) ([]model.OrderDiscount, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderDiscounts")
+	}
+
+	var r0 []model.OrderDiscount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.OrderDiscount, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.OrderDiscount); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderDiscount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderDiscounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderDiscounts'
+type MockIOrderSQLRepository_GetOrderDiscounts_Call struct {
+	*mock.Call
+}
+
+// GetOrderDiscounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderDiscounts(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderDiscounts_Call {
+	return &MockIOrderSQLRepository_GetOrderDiscounts_Call{Call: _e.mock.On("GetOrderDiscounts", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderDiscounts_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderDiscounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderDiscounts_Call) Return(orderDiscounts []model.OrderDiscount, err error) *MockIOrderSQLRepository_GetOrderDiscounts_Call {
+	_c.Call.Return(orderDiscounts, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderDiscounts_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.OrderDiscount, error)) *MockIOrderSQLRepository_GetOrderDiscounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderItemsByOrderId provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderItemsByOrderId(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderItemsByOrderId")
+	}
+
+	var r0 []model.ItemOrder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.ItemOrder, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.ItemOrder); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ItemOrder)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderItemsByOrderId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderItemsByOrderId'
+type MockIOrderSQLRepository_GetOrderItemsByOrderId_Call struct {
+	*mock.Call
+}
+
+// GetOrderItemsByOrderId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderItemsByOrderId(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+	return &MockIOrderSQLRepository_GetOrderItemsByOrderId_Call{Call: _e.mock.On("GetOrderItemsByOrderId", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) Return(itemOrders []model.ItemOrder, err error) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+	_c.Call.Return(itemOrders, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.ItemOrder, error)) *MockIOrderSQLRepository_GetOrderItemsByOrderId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderPayment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderPayment(ctx context.Context, orderId uuid.UUID) (*model.Payment, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderPayment")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Payment, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Payment); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderPayment'
+type MockIOrderSQLRepository_GetOrderPayment_Call struct {
+	*mock.Call
+}
+
+// GetOrderPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderPayment(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderPayment_Call {
+	return &MockIOrderSQLRepository_GetOrderPayment_Call{Call: _e.mock.On("GetOrderPayment", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderPayment_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderPayment_Call) Return(payment *model.Payment, err error) *MockIOrderSQLRepository_GetOrderPayment_Call {
+	_c.Call.Return(payment, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderPayment_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.Payment, error)) *MockIOrderSQLRepository_GetOrderPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderReturns provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderReturns(ctx context.Context, orderId uuid.UUID) ([]model.OrderReturn, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderReturns")
+	}
+
+	var r0 []model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.OrderReturn, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.OrderReturn); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderReturns'
+type MockIOrderSQLRepository_GetOrderReturns_Call struct {
+	*mock.Call
+}
+
+// GetOrderReturns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderReturns(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderReturns_Call {
+	return &MockIOrderSQLRepository_GetOrderReturns_Call{Call: _e.mock.On("GetOrderReturns", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderReturns_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderReturns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderReturns_Call) Return(orderReturns []model.OrderReturn, err error) *MockIOrderSQLRepository_GetOrderReturns_Call {
+	_c.Call.Return(orderReturns, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderReturns_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.OrderReturn, error)) *MockIOrderSQLRepository_GetOrderReturns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderShipments provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderShipments(ctx context.Context, orderId uuid.UUID) ([]model.Shipment, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderShipments")
+	}
+
+	var r0 []model.Shipment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.Shipment, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.Shipment); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderShipments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderShipments'
+type MockIOrderSQLRepository_GetOrderShipments_Call struct {
+	*mock.Call
+}
+
+// GetOrderShipments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderShipments(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderShipments_Call {
+	return &MockIOrderSQLRepository_GetOrderShipments_Call{Call: _e.mock.On("GetOrderShipments", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderShipments_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderShipments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderShipments_Call) Return(shipments []model.Shipment, err error) *MockIOrderSQLRepository_GetOrderShipments_Call {
+	_c.Call.Return(shipments, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderShipments_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.Shipment, error)) *MockIOrderSQLRepository_GetOrderShipments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderTaxes provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderTaxes(ctx context.Context, orderId uuid.UUID) ([]model.OrderTax, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderTaxes")
+	}
+
+	var r0 []model.OrderTax
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.OrderTax, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.OrderTax); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderTax)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetOrderTaxes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderTaxes'
+type MockIOrderSQLRepository_GetOrderTaxes_Call struct {
+	*mock.Call
+}
+
+// GetOrderTaxes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderTaxes(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderTaxes_Call {
+	return &MockIOrderSQLRepository_GetOrderTaxes_Call{Call: _e.mock.On("GetOrderTaxes", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderTaxes_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderTaxes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderTaxes_Call) Return(orderTaxs []model.OrderTax, err error) *MockIOrderSQLRepository_GetOrderTaxes_Call {
+	_c.Call.Return(orderTaxs, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderTaxes_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) ([]model.OrderTax, error)) *MockIOrderSQLRepository_GetOrderTaxes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderWithItems provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetOrderWithItems(ctx context.Context, orderId uuid.UUID) (*model.Order, []model.ItemOrder, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderWithItems")
+	}
+
+	var r0 *model.Order
+	var r1 []model.ItemOrder
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Order, []model.ItemOrder, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Order); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) []model.ItemOrder); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]model.ItemOrder)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, orderId)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOrderSQLRepository_GetOrderWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderWithItems'
+type MockIOrderSQLRepository_GetOrderWithItems_Call struct {
+	*mock.Call
+}
+
+// GetOrderWithItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetOrderWithItems(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetOrderWithItems_Call {
+	return &MockIOrderSQLRepository_GetOrderWithItems_Call{Call: _e.mock.On("GetOrderWithItems", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetOrderWithItems_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetOrderWithItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -366,54 +1143,2136 @@ func (_c *MockIOrderSQLRepository_GetOrderWithItems_Call) Return(order *model.Or
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_GetOrderWithItems_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.Order, []model.ItemOrder, error)) *MockIOrderSQLRepository_GetOrderWithItems_Call {
+func (_c *MockIOrderSQLRepository_GetOrderWithItems_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.Order, []model.ItemOrder, error)) *MockIOrderSQLRepository_GetOrderWithItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromotionByCode provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetPromotionByCode(ctx context.Context, code string) (*model.Promotion, error) {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotionByCode")
+	}
+
+	var r0 *model.Promotion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Promotion, error)); ok {
+		return returnFunc(ctx, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Promotion); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Promotion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetPromotionByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotionByCode'
+type MockIOrderSQLRepository_GetPromotionByCode_Call struct {
+	*mock.Call
+}
+
+// GetPromotionByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockIOrderSQLRepository_Expecter) GetPromotionByCode(ctx interface{}, code interface{}) *MockIOrderSQLRepository_GetPromotionByCode_Call {
+	return &MockIOrderSQLRepository_GetPromotionByCode_Call{Call: _e.mock.On("GetPromotionByCode", ctx, code)}
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionByCode_Call) Run(run func(ctx context.Context, code string)) *MockIOrderSQLRepository_GetPromotionByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionByCode_Call) Return(promotion *model.Promotion, err error) *MockIOrderSQLRepository_GetPromotionByCode_Call {
+	_c.Call.Return(promotion, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionByCode_Call) RunAndReturn(run func(ctx context.Context, code string) (*model.Promotion, error)) *MockIOrderSQLRepository_GetPromotionByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromotionById provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetPromotionById(ctx context.Context, promotionId uuid.UUID) (*model.Promotion, error) {
+	ret := _mock.Called(ctx, promotionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotionById")
+	}
+
+	var r0 *model.Promotion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Promotion, error)); ok {
+		return returnFunc(ctx, promotionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Promotion); ok {
+		r0 = returnFunc(ctx, promotionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Promotion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, promotionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetPromotionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotionById'
+type MockIOrderSQLRepository_GetPromotionById_Call struct {
+	*mock.Call
+}
+
+// GetPromotionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promotionId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetPromotionById(ctx interface{}, promotionId interface{}) *MockIOrderSQLRepository_GetPromotionById_Call {
+	return &MockIOrderSQLRepository_GetPromotionById_Call{Call: _e.mock.On("GetPromotionById", ctx, promotionId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionById_Call) Run(run func(ctx context.Context, promotionId uuid.UUID)) *MockIOrderSQLRepository_GetPromotionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionById_Call) Return(promotion *model.Promotion, err error) *MockIOrderSQLRepository_GetPromotionById_Call {
+	_c.Call.Return(promotion, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotionById_Call) RunAndReturn(run func(ctx context.Context, promotionId uuid.UUID) (*model.Promotion, error)) *MockIOrderSQLRepository_GetPromotionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPromotions provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetPromotions(ctx context.Context) ([]model.Promotion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromotions")
+	}
+
+	var r0 []model.Promotion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]model.Promotion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []model.Promotion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Promotion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetPromotions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromotions'
+type MockIOrderSQLRepository_GetPromotions_Call struct {
+	*mock.Call
+}
+
+// GetPromotions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderSQLRepository_Expecter) GetPromotions(ctx interface{}) *MockIOrderSQLRepository_GetPromotions_Call {
+	return &MockIOrderSQLRepository_GetPromotions_Call{Call: _e.mock.On("GetPromotions", ctx)}
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotions_Call) Run(run func(ctx context.Context)) *MockIOrderSQLRepository_GetPromotions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotions_Call) Return(promotions []model.Promotion, err error) *MockIOrderSQLRepository_GetPromotions_Call {
+	_c.Call.Return(promotions, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetPromotions_Call) RunAndReturn(run func(ctx context.Context) ([]model.Promotion, error)) *MockIOrderSQLRepository_GetPromotions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReturn provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetReturn(ctx context.Context, returnId uuid.UUID) (*model.OrderReturn, error) {
+	ret := _mock.Called(ctx, returnId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturn")
+	}
+
+	var r0 *model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.OrderReturn, error)); ok {
+		return returnFunc(ctx, returnId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.OrderReturn); ok {
+		r0 = returnFunc(ctx, returnId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, returnId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReturn'
+type MockIOrderSQLRepository_GetReturn_Call struct {
+	*mock.Call
+}
+
+// GetReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - returnId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetReturn(ctx interface{}, returnId interface{}) *MockIOrderSQLRepository_GetReturn_Call {
+	return &MockIOrderSQLRepository_GetReturn_Call{Call: _e.mock.On("GetReturn", ctx, returnId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetReturn_Call) Run(run func(ctx context.Context, returnId uuid.UUID)) *MockIOrderSQLRepository_GetReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetReturn_Call) Return(orderReturn *model.OrderReturn, err error) *MockIOrderSQLRepository_GetReturn_Call {
+	_c.Call.Return(orderReturn, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetReturn_Call) RunAndReturn(run func(ctx context.Context, returnId uuid.UUID) (*model.OrderReturn, error)) *MockIOrderSQLRepository_GetReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReturnedQuantities provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetReturnedQuantities(ctx context.Context, orderId uuid.UUID) (map[uuid.UUID]fixed.Fixed, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnedQuantities")
+	}
+
+	var r0 map[uuid.UUID]fixed.Fixed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (map[uuid.UUID]fixed.Fixed, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) map[uuid.UUID]fixed.Fixed); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]fixed.Fixed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetReturnedQuantities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReturnedQuantities'
+type MockIOrderSQLRepository_GetReturnedQuantities_Call struct {
+	*mock.Call
+}
+
+// GetReturnedQuantities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetReturnedQuantities(ctx interface{}, orderId interface{}) *MockIOrderSQLRepository_GetReturnedQuantities_Call {
+	return &MockIOrderSQLRepository_GetReturnedQuantities_Call{Call: _e.mock.On("GetReturnedQuantities", ctx, orderId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetReturnedQuantities_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderSQLRepository_GetReturnedQuantities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetReturnedQuantities_Call) Return(uUIDToFixed map[uuid.UUID]fixed.Fixed, err error) *MockIOrderSQLRepository_GetReturnedQuantities_Call {
+	_c.Call.Return(uUIDToFixed, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetReturnedQuantities_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (map[uuid.UUID]fixed.Fixed, error)) *MockIOrderSQLRepository_GetReturnedQuantities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShipment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error) {
+	ret := _mock.Called(ctx, shipmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipment")
+	}
+
+	var r0 *model.Shipment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Shipment, error)); ok {
+		return returnFunc(ctx, shipmentId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Shipment); ok {
+		r0 = returnFunc(ctx, shipmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, shipmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShipment'
+type MockIOrderSQLRepository_GetShipment_Call struct {
+	*mock.Call
+}
+
+// GetShipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shipmentId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetShipment(ctx interface{}, shipmentId interface{}) *MockIOrderSQLRepository_GetShipment_Call {
+	return &MockIOrderSQLRepository_GetShipment_Call{Call: _e.mock.On("GetShipment", ctx, shipmentId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetShipment_Call) Run(run func(ctx context.Context, shipmentId uuid.UUID)) *MockIOrderSQLRepository_GetShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetShipment_Call) Return(shipment *model.Shipment, err error) *MockIOrderSQLRepository_GetShipment_Call {
+	_c.Call.Return(shipment, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetShipment_Call) RunAndReturn(run func(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error)) *MockIOrderSQLRepository_GetShipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShipmentQuantities provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetShipmentQuantities(ctx context.Context, orderId uuid.UUID, statuses []string) (map[uuid.UUID]fixed.Fixed, error) {
+	ret := _mock.Called(ctx, orderId, statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipmentQuantities")
+	}
+
+	var r0 map[uuid.UUID]fixed.Fixed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) (map[uuid.UUID]fixed.Fixed, error)); ok {
+		return returnFunc(ctx, orderId, statuses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) map[uuid.UUID]fixed.Fixed); ok {
+		r0 = returnFunc(ctx, orderId, statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]fixed.Fixed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string) error); ok {
+		r1 = returnFunc(ctx, orderId, statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetShipmentQuantities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShipmentQuantities'
+type MockIOrderSQLRepository_GetShipmentQuantities_Call struct {
+	*mock.Call
+}
+
+// GetShipmentQuantities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - statuses []string
+func (_e *MockIOrderSQLRepository_Expecter) GetShipmentQuantities(ctx interface{}, orderId interface{}, statuses interface{}) *MockIOrderSQLRepository_GetShipmentQuantities_Call {
+	return &MockIOrderSQLRepository_GetShipmentQuantities_Call{Call: _e.mock.On("GetShipmentQuantities", ctx, orderId, statuses)}
+}
+
+func (_c *MockIOrderSQLRepository_GetShipmentQuantities_Call) Run(run func(ctx context.Context, orderId uuid.UUID, statuses []string)) *MockIOrderSQLRepository_GetShipmentQuantities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetShipmentQuantities_Call) Return(uUIDToFixed map[uuid.UUID]fixed.Fixed, err error) *MockIOrderSQLRepository_GetShipmentQuantities_Call {
+	_c.Call.Return(uUIDToFixed, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetShipmentQuantities_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, statuses []string) (map[uuid.UUID]fixed.Fixed, error)) *MockIOrderSQLRepository_GetShipmentQuantities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscription provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetSubscription(ctx context.Context, subscriptionId uuid.UUID) (*model.Subscription, error) {
+	ret := _mock.Called(ctx, subscriptionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *model.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Subscription, error)); ok {
+		return returnFunc(ctx, subscriptionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Subscription); ok {
+		r0 = returnFunc(ctx, subscriptionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, subscriptionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscription'
+type MockIOrderSQLRepository_GetSubscription_Call struct {
+	*mock.Call
+}
+
+// GetSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetSubscription(ctx interface{}, subscriptionId interface{}) *MockIOrderSQLRepository_GetSubscription_Call {
+	return &MockIOrderSQLRepository_GetSubscription_Call{Call: _e.mock.On("GetSubscription", ctx, subscriptionId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscription_Call) Run(run func(ctx context.Context, subscriptionId uuid.UUID)) *MockIOrderSQLRepository_GetSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscription_Call) Return(subscription *model.Subscription, err error) *MockIOrderSQLRepository_GetSubscription_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscription_Call) RunAndReturn(run func(ctx context.Context, subscriptionId uuid.UUID) (*model.Subscription, error)) *MockIOrderSQLRepository_GetSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscriptionRuns provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetSubscriptionRuns(ctx context.Context, subscriptionId uuid.UUID, limit int) ([]model.SubscriptionRun, error) {
+	ret := _mock.Called(ctx, subscriptionId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscriptionRuns")
+	}
+
+	var r0 []model.SubscriptionRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]model.SubscriptionRun, error)); ok {
+		return returnFunc(ctx, subscriptionId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []model.SubscriptionRun); ok {
+		r0 = returnFunc(ctx, subscriptionId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SubscriptionRun)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, subscriptionId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetSubscriptionRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscriptionRuns'
+type MockIOrderSQLRepository_GetSubscriptionRuns_Call struct {
+	*mock.Call
+}
+
+// GetSubscriptionRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscriptionId uuid.UUID
+//   - limit int
+func (_e *MockIOrderSQLRepository_Expecter) GetSubscriptionRuns(ctx interface{}, subscriptionId interface{}, limit interface{}) *MockIOrderSQLRepository_GetSubscriptionRuns_Call {
+	return &MockIOrderSQLRepository_GetSubscriptionRuns_Call{Call: _e.mock.On("GetSubscriptionRuns", ctx, subscriptionId, limit)}
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscriptionRuns_Call) Run(run func(ctx context.Context, subscriptionId uuid.UUID, limit int)) *MockIOrderSQLRepository_GetSubscriptionRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscriptionRuns_Call) Return(subscriptionRuns []model.SubscriptionRun, err error) *MockIOrderSQLRepository_GetSubscriptionRuns_Call {
+	_c.Call.Return(subscriptionRuns, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetSubscriptionRuns_Call) RunAndReturn(run func(ctx context.Context, subscriptionId uuid.UUID, limit int) ([]model.SubscriptionRun, error)) *MockIOrderSQLRepository_GetSubscriptionRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSubscriptions provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetUserSubscriptions(ctx context.Context, userId string) ([]model.Subscription, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSubscriptions")
+	}
+
+	var r0 []model.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]model.Subscription, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []model.Subscription); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetUserSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSubscriptions'
+type MockIOrderSQLRepository_GetUserSubscriptions_Call struct {
+	*mock.Call
+}
+
+// GetUserSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockIOrderSQLRepository_Expecter) GetUserSubscriptions(ctx interface{}, userId interface{}) *MockIOrderSQLRepository_GetUserSubscriptions_Call {
+	return &MockIOrderSQLRepository_GetUserSubscriptions_Call{Call: _e.mock.On("GetUserSubscriptions", ctx, userId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetUserSubscriptions_Call) Run(run func(ctx context.Context, userId string)) *MockIOrderSQLRepository_GetUserSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetUserSubscriptions_Call) Return(subscriptions []model.Subscription, err error) *MockIOrderSQLRepository_GetUserSubscriptions_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetUserSubscriptions_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]model.Subscription, error)) *MockIOrderSQLRepository_GetUserSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) []model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookDeliveryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockIOrderSQLRepository_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.WebhookDeliveryFilter
+func (_e *MockIOrderSQLRepository_Expecter) GetWebhookDeliveries(ctx interface{}, filter interface{}) *MockIOrderSQLRepository_GetWebhookDeliveries_Call {
+	return &MockIOrderSQLRepository_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, filter)}
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, filter model.WebhookDeliveryFilter)) *MockIOrderSQLRepository_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDeliveries_Call) Return(webhookDeliverys []model.WebhookDelivery, err error) *MockIOrderSQLRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)) *MockIOrderSQLRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDelivery provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, deliveryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDelivery")
+	}
+
+	var r0 *model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, deliveryId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDelivery'
+type MockIOrderSQLRepository_GetWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetWebhookDelivery(ctx interface{}, deliveryId interface{}) *MockIOrderSQLRepository_GetWebhookDelivery_Call {
+	return &MockIOrderSQLRepository_GetWebhookDelivery_Call{Call: _e.mock.On("GetWebhookDelivery", ctx, deliveryId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDelivery_Call) Run(run func(ctx context.Context, deliveryId uuid.UUID)) *MockIOrderSQLRepository_GetWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDelivery_Call) Return(webhookDelivery *model.WebhookDelivery, err error) *MockIOrderSQLRepository_GetWebhookDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)) *MockIOrderSQLRepository_GetWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookEndpoint provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetWebhookEndpoint(ctx context.Context, endpointId uuid.UUID) (*model.WebhookEndpoint, error) {
+	ret := _mock.Called(ctx, endpointId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoint")
+	}
+
+	var r0 *model.WebhookEndpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.WebhookEndpoint, error)); ok {
+		return returnFunc(ctx, endpointId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.WebhookEndpoint); ok {
+		r0 = returnFunc(ctx, endpointId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookEndpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, endpointId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookEndpoint'
+type MockIOrderSQLRepository_GetWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointId uuid.UUID
+func (_e *MockIOrderSQLRepository_Expecter) GetWebhookEndpoint(ctx interface{}, endpointId interface{}) *MockIOrderSQLRepository_GetWebhookEndpoint_Call {
+	return &MockIOrderSQLRepository_GetWebhookEndpoint_Call{Call: _e.mock.On("GetWebhookEndpoint", ctx, endpointId)}
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoint_Call) Run(run func(ctx context.Context, endpointId uuid.UUID)) *MockIOrderSQLRepository_GetWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoint_Call) Return(webhookEndpoint *model.WebhookEndpoint, err error) *MockIOrderSQLRepository_GetWebhookEndpoint_Call {
+	_c.Call.Return(webhookEndpoint, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoint_Call) RunAndReturn(run func(ctx context.Context, endpointId uuid.UUID) (*model.WebhookEndpoint, error)) *MockIOrderSQLRepository_GetWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookEndpoints provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) GetWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoints")
+	}
+
+	var r0 []model.WebhookEndpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]model.WebhookEndpoint, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []model.WebhookEndpoint); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookEndpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_GetWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookEndpoints'
+type MockIOrderSQLRepository_GetWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// GetWebhookEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderSQLRepository_Expecter) GetWebhookEndpoints(ctx interface{}) *MockIOrderSQLRepository_GetWebhookEndpoints_Call {
+	return &MockIOrderSQLRepository_GetWebhookEndpoints_Call{Call: _e.mock.On("GetWebhookEndpoints", ctx)}
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoints_Call) Run(run func(ctx context.Context)) *MockIOrderSQLRepository_GetWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoints_Call) Return(webhookEndpoints []model.WebhookEndpoint, err error) *MockIOrderSQLRepository_GetWebhookEndpoints_Call {
+	_c.Call.Return(webhookEndpoints, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_GetWebhookEndpoints_Call) RunAndReturn(run func(ctx context.Context) ([]model.WebhookEndpoint, error)) *MockIOrderSQLRepository_GetWebhookEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertAdminAuditEntry provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertAdminAuditEntry(ctx context.Context, entry *model.AdminAuditEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertAdminAuditEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AdminAuditEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertAdminAuditEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertAdminAuditEntry'
+type MockIOrderSQLRepository_InsertAdminAuditEntry_Call struct {
+	*mock.Call
+}
+
+// InsertAdminAuditEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *model.AdminAuditEntry
+func (_e *MockIOrderSQLRepository_Expecter) InsertAdminAuditEntry(ctx interface{}, entry interface{}) *MockIOrderSQLRepository_InsertAdminAuditEntry_Call {
+	return &MockIOrderSQLRepository_InsertAdminAuditEntry_Call{Call: _e.mock.On("InsertAdminAuditEntry", ctx, entry)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertAdminAuditEntry_Call) Run(run func(ctx context.Context, entry *model.AdminAuditEntry)) *MockIOrderSQLRepository_InsertAdminAuditEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.AdminAuditEntry
+		if args[1] != nil {
+			arg1 = args[1].(*model.AdminAuditEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertAdminAuditEntry_Call) Return(err error) *MockIOrderSQLRepository_InsertAdminAuditEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertAdminAuditEntry_Call) RunAndReturn(run func(ctx context.Context, entry *model.AdminAuditEntry) error) *MockIOrderSQLRepository_InsertAdminAuditEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertItemOrderWithTx provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertItemOrderWithTx(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder) error {
+	ret := _mock.Called(ctx, tx, itemOrder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertItemOrderWithTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx, model.ItemOrder) error); ok {
+		r0 = returnFunc(ctx, tx, itemOrder)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertItemOrderWithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertItemOrderWithTx'
+type MockIOrderSQLRepository_InsertItemOrderWithTx_Call struct {
+	*mock.Call
+}
+
+// InsertItemOrderWithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx storage.PgxTx
+//   - itemOrder model.ItemOrder
+func (_e *MockIOrderSQLRepository_Expecter) InsertItemOrderWithTx(ctx interface{}, tx interface{}, itemOrder interface{}) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+	return &MockIOrderSQLRepository_InsertItemOrderWithTx_Call{Call: _e.mock.On("InsertItemOrderWithTx", ctx, tx, itemOrder)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) Run(run func(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder)) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 storage.PgxTx
+		if args[1] != nil {
+			arg1 = args[1].(storage.PgxTx)
+		}
+		var arg2 model.ItemOrder
+		if args[2] != nil {
+			arg2 = args[2].(model.ItemOrder)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) Return(err error) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder) error) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertOrderWithItems provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error {
+	ret := _mock.Called(ctx, order, items, discounts, taxes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertOrderWithItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, []model.ItemOrder, []model.OrderDiscount, []model.OrderTax) error); ok {
+		r0 = returnFunc(ctx, order, items, discounts, taxes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertOrderWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrderWithItems'
+type MockIOrderSQLRepository_InsertOrderWithItems_Call struct {
+	*mock.Call
+}
+
+// InsertOrderWithItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - items []model.ItemOrder
+//   - discounts []model.OrderDiscount
+//   - taxes []model.OrderTax
+func (_e *MockIOrderSQLRepository_Expecter) InsertOrderWithItems(ctx interface{}, order interface{}, items interface{}, discounts interface{}, taxes interface{}) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+	return &MockIOrderSQLRepository_InsertOrderWithItems_Call{Call: _e.mock.On("InsertOrderWithItems", ctx, order, items, discounts, taxes)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) Run(run func(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax)) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 []model.ItemOrder
+		if args[2] != nil {
+			arg2 = args[2].([]model.ItemOrder)
+		}
+		var arg3 []model.OrderDiscount
+		if args[3] != nil {
+			arg3 = args[3].([]model.OrderDiscount)
+		}
+		var arg4 []model.OrderTax
+		if args[4] != nil {
+			arg4 = args[4].([]model.OrderTax)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) Return(err error) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertOrderWithTx provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertOrderWithTx(ctx context.Context, tx storage.PgxTx, order *model.Order) error {
+	ret := _mock.Called(ctx, tx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertOrderWithTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx, *model.Order) error); ok {
+		r0 = returnFunc(ctx, tx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertOrderWithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrderWithTx'
+type MockIOrderSQLRepository_InsertOrderWithTx_Call struct {
+	*mock.Call
+}
+
+// InsertOrderWithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx storage.PgxTx
+//   - order *model.Order
+func (_e *MockIOrderSQLRepository_Expecter) InsertOrderWithTx(ctx interface{}, tx interface{}, order interface{}) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+	return &MockIOrderSQLRepository_InsertOrderWithTx_Call{Call: _e.mock.On("InsertOrderWithTx", ctx, tx, order)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) Run(run func(ctx context.Context, tx storage.PgxTx, order *model.Order)) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 storage.PgxTx
+		if args[1] != nil {
+			arg1 = args[1].(storage.PgxTx)
+		}
+		var arg2 *model.Order
+		if args[2] != nil {
+			arg2 = args[2].(*model.Order)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) Return(err error) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx, order *model.Order) error) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertPayment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertPayment(ctx context.Context, payment *model.Payment) error {
+	ret := _mock.Called(ctx, payment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPayment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Payment) error); ok {
+		r0 = returnFunc(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertPayment'
+type MockIOrderSQLRepository_InsertPayment_Call struct {
+	*mock.Call
+}
+
+// InsertPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Payment
+func (_e *MockIOrderSQLRepository_Expecter) InsertPayment(ctx interface{}, payment interface{}) *MockIOrderSQLRepository_InsertPayment_Call {
+	return &MockIOrderSQLRepository_InsertPayment_Call{Call: _e.mock.On("InsertPayment", ctx, payment)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertPayment_Call) Run(run func(ctx context.Context, payment *model.Payment)) *MockIOrderSQLRepository_InsertPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Payment
+		if args[1] != nil {
+			arg1 = args[1].(*model.Payment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertPayment_Call) Return(err error) *MockIOrderSQLRepository_InsertPayment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertPayment_Call) RunAndReturn(run func(ctx context.Context, payment *model.Payment) error) *MockIOrderSQLRepository_InsertPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertPromotion provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertPromotion(ctx context.Context, promotion *model.Promotion) error {
+	ret := _mock.Called(ctx, promotion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertPromotion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Promotion) error); ok {
+		r0 = returnFunc(ctx, promotion)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertPromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertPromotion'
+type MockIOrderSQLRepository_InsertPromotion_Call struct {
+	*mock.Call
+}
+
+// InsertPromotion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - promotion *model.Promotion
+func (_e *MockIOrderSQLRepository_Expecter) InsertPromotion(ctx interface{}, promotion interface{}) *MockIOrderSQLRepository_InsertPromotion_Call {
+	return &MockIOrderSQLRepository_InsertPromotion_Call{Call: _e.mock.On("InsertPromotion", ctx, promotion)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertPromotion_Call) Run(run func(ctx context.Context, promotion *model.Promotion)) *MockIOrderSQLRepository_InsertPromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Promotion
+		if args[1] != nil {
+			arg1 = args[1].(*model.Promotion)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertPromotion_Call) Return(err error) *MockIOrderSQLRepository_InsertPromotion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertPromotion_Call) RunAndReturn(run func(ctx context.Context, promotion *model.Promotion) error) *MockIOrderSQLRepository_InsertPromotion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertReturn provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertReturn(ctx context.Context, order *model.Order, status string, orderReturn *model.OrderReturn) (bool, error) {
+	ret := _mock.Called(ctx, order, status, orderReturn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertReturn")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, string, *model.OrderReturn) (bool, error)); ok {
+		return returnFunc(ctx, order, status, orderReturn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, string, *model.OrderReturn) bool); ok {
+		r0 = returnFunc(ctx, order, status, orderReturn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, string, *model.OrderReturn) error); ok {
+		r1 = returnFunc(ctx, order, status, orderReturn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_InsertReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertReturn'
+type MockIOrderSQLRepository_InsertReturn_Call struct {
+	*mock.Call
+}
+
+// InsertReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - status string
+//   - orderReturn *model.OrderReturn
+func (_e *MockIOrderSQLRepository_Expecter) InsertReturn(ctx interface{}, order interface{}, status interface{}, orderReturn interface{}) *MockIOrderSQLRepository_InsertReturn_Call {
+	return &MockIOrderSQLRepository_InsertReturn_Call{Call: _e.mock.On("InsertReturn", ctx, order, status, orderReturn)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertReturn_Call) Run(run func(ctx context.Context, order *model.Order, status string, orderReturn *model.OrderReturn)) *MockIOrderSQLRepository_InsertReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *model.OrderReturn
+		if args[3] != nil {
+			arg3 = args[3].(*model.OrderReturn)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertReturn_Call) Return(b bool, err error) *MockIOrderSQLRepository_InsertReturn_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertReturn_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, status string, orderReturn *model.OrderReturn) (bool, error)) *MockIOrderSQLRepository_InsertReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertShipment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertShipment(ctx context.Context, order *model.Order, shipment *model.Shipment) (bool, error) {
+	ret := _mock.Called(ctx, order, shipment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertShipment")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, *model.Shipment) (bool, error)); ok {
+		return returnFunc(ctx, order, shipment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, *model.Shipment) bool); ok {
+		r0 = returnFunc(ctx, order, shipment)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Order, *model.Shipment) error); ok {
+		r1 = returnFunc(ctx, order, shipment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_InsertShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertShipment'
+type MockIOrderSQLRepository_InsertShipment_Call struct {
+	*mock.Call
+}
+
+// InsertShipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - shipment *model.Shipment
+func (_e *MockIOrderSQLRepository_Expecter) InsertShipment(ctx interface{}, order interface{}, shipment interface{}) *MockIOrderSQLRepository_InsertShipment_Call {
+	return &MockIOrderSQLRepository_InsertShipment_Call{Call: _e.mock.On("InsertShipment", ctx, order, shipment)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertShipment_Call) Run(run func(ctx context.Context, order *model.Order, shipment *model.Shipment)) *MockIOrderSQLRepository_InsertShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 *model.Shipment
+		if args[2] != nil {
+			arg2 = args[2].(*model.Shipment)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertShipment_Call) Return(b bool, err error) *MockIOrderSQLRepository_InsertShipment_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertShipment_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, shipment *model.Shipment) (bool, error)) *MockIOrderSQLRepository_InsertShipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertSubscription provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertSubscription(ctx context.Context, subscription *model.Subscription) error {
+	ret := _mock.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSubscription")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Subscription) error); ok {
+		r0 = returnFunc(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSubscription'
+type MockIOrderSQLRepository_InsertSubscription_Call struct {
+	*mock.Call
+}
+
+// InsertSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *model.Subscription
+func (_e *MockIOrderSQLRepository_Expecter) InsertSubscription(ctx interface{}, subscription interface{}) *MockIOrderSQLRepository_InsertSubscription_Call {
+	return &MockIOrderSQLRepository_InsertSubscription_Call{Call: _e.mock.On("InsertSubscription", ctx, subscription)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertSubscription_Call) Run(run func(ctx context.Context, subscription *model.Subscription)) *MockIOrderSQLRepository_InsertSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(*model.Subscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertSubscription_Call) Return(err error) *MockIOrderSQLRepository_InsertSubscription_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertSubscription_Call) RunAndReturn(run func(ctx context.Context, subscription *model.Subscription) error) *MockIOrderSQLRepository_InsertSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertWebhookDeliveries provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertWebhookDeliveries(ctx context.Context, event model.WebhookEvent, payload []byte) (int, error) {
+	ret := _mock.Called(ctx, event, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertWebhookDeliveries")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookEvent, []byte) (int, error)); ok {
+		return returnFunc(ctx, event, payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookEvent, []byte) int); ok {
+		r0 = returnFunc(ctx, event, payload)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookEvent, []byte) error); ok {
+		r1 = returnFunc(ctx, event, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_InsertWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertWebhookDeliveries'
+type MockIOrderSQLRepository_InsertWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// InsertWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.WebhookEvent
+//   - payload []byte
+func (_e *MockIOrderSQLRepository_Expecter) InsertWebhookDeliveries(ctx interface{}, event interface{}, payload interface{}) *MockIOrderSQLRepository_InsertWebhookDeliveries_Call {
+	return &MockIOrderSQLRepository_InsertWebhookDeliveries_Call{Call: _e.mock.On("InsertWebhookDeliveries", ctx, event, payload)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookDeliveries_Call) Run(run func(ctx context.Context, event model.WebhookEvent, payload []byte)) *MockIOrderSQLRepository_InsertWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookEvent
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookEvent)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookDeliveries_Call) Return(n int, err error) *MockIOrderSQLRepository_InsertWebhookDeliveries_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, event model.WebhookEvent, payload []byte) (int, error)) *MockIOrderSQLRepository_InsertWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertWebhookEndpoint provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) InsertWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) error {
+	ret := _mock.Called(ctx, endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertWebhookEndpoint")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) error); ok {
+		r0 = returnFunc(ctx, endpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_InsertWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertWebhookEndpoint'
+type MockIOrderSQLRepository_InsertWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// InsertWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpoint *model.WebhookEndpoint
+func (_e *MockIOrderSQLRepository_Expecter) InsertWebhookEndpoint(ctx interface{}, endpoint interface{}) *MockIOrderSQLRepository_InsertWebhookEndpoint_Call {
+	return &MockIOrderSQLRepository_InsertWebhookEndpoint_Call{Call: _e.mock.On("InsertWebhookEndpoint", ctx, endpoint)}
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookEndpoint_Call) Run(run func(ctx context.Context, endpoint *model.WebhookEndpoint)) *MockIOrderSQLRepository_InsertWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.WebhookEndpoint
+		if args[1] != nil {
+			arg1 = args[1].(*model.WebhookEndpoint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookEndpoint_Call) Return(err error) *MockIOrderSQLRepository_InsertWebhookEndpoint_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_InsertWebhookEndpoint_Call) RunAndReturn(run func(ctx context.Context, endpoint *model.WebhookEndpoint) error) *MockIOrderSQLRepository_InsertWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordWebhookAttempt provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) RecordWebhookAttempt(ctx context.Context, delivery *model.WebhookDelivery, attempt model.WebhookAttempt) error {
+	ret := _mock.Called(ctx, delivery, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWebhookAttempt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery, model.WebhookAttempt) error); ok {
+		r0 = returnFunc(ctx, delivery, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_RecordWebhookAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWebhookAttempt'
+type MockIOrderSQLRepository_RecordWebhookAttempt_Call struct {
+	*mock.Call
+}
+
+// RecordWebhookAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *model.WebhookDelivery
+//   - attempt model.WebhookAttempt
+func (_e *MockIOrderSQLRepository_Expecter) RecordWebhookAttempt(ctx interface{}, delivery interface{}, attempt interface{}) *MockIOrderSQLRepository_RecordWebhookAttempt_Call {
+	return &MockIOrderSQLRepository_RecordWebhookAttempt_Call{Call: _e.mock.On("RecordWebhookAttempt", ctx, delivery, attempt)}
+}
+
+func (_c *MockIOrderSQLRepository_RecordWebhookAttempt_Call) Run(run func(ctx context.Context, delivery *model.WebhookDelivery, attempt model.WebhookAttempt)) *MockIOrderSQLRepository_RecordWebhookAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*model.WebhookDelivery)
+		}
+		var arg2 model.WebhookAttempt
+		if args[2] != nil {
+			arg2 = args[2].(model.WebhookAttempt)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RecordWebhookAttempt_Call) Return(err error) *MockIOrderSQLRepository_RecordWebhookAttempt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RecordWebhookAttempt_Call) RunAndReturn(run func(ctx context.Context, delivery *model.WebhookDelivery, attempt model.WebhookAttempt) error) *MockIOrderSQLRepository_RecordWebhookAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefundReturn provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) RefundReturn(ctx context.Context, orderReturn *model.OrderReturn, entry model.ReturnHistory, paymentId uuid.UUID, amount fixed.Fixed) (bool, error) {
+	ret := _mock.Called(ctx, orderReturn, entry, paymentId, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundReturn")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.OrderReturn, model.ReturnHistory, uuid.UUID, fixed.Fixed) (bool, error)); ok {
+		return returnFunc(ctx, orderReturn, entry, paymentId, amount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.OrderReturn, model.ReturnHistory, uuid.UUID, fixed.Fixed) bool); ok {
+		r0 = returnFunc(ctx, orderReturn, entry, paymentId, amount)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.OrderReturn, model.ReturnHistory, uuid.UUID, fixed.Fixed) error); ok {
+		r1 = returnFunc(ctx, orderReturn, entry, paymentId, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_RefundReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundReturn'
+type MockIOrderSQLRepository_RefundReturn_Call struct {
+	*mock.Call
+}
+
+// RefundReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderReturn *model.OrderReturn
+//   - entry model.ReturnHistory
+//   - paymentId uuid.UUID
+//   - amount fixed.Fixed
+func (_e *MockIOrderSQLRepository_Expecter) RefundReturn(ctx interface{}, orderReturn interface{}, entry interface{}, paymentId interface{}, amount interface{}) *MockIOrderSQLRepository_RefundReturn_Call {
+	return &MockIOrderSQLRepository_RefundReturn_Call{Call: _e.mock.On("RefundReturn", ctx, orderReturn, entry, paymentId, amount)}
+}
+
+func (_c *MockIOrderSQLRepository_RefundReturn_Call) Run(run func(ctx context.Context, orderReturn *model.OrderReturn, entry model.ReturnHistory, paymentId uuid.UUID, amount fixed.Fixed)) *MockIOrderSQLRepository_RefundReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.OrderReturn
+		if args[1] != nil {
+			arg1 = args[1].(*model.OrderReturn)
+		}
+		var arg2 model.ReturnHistory
+		if args[2] != nil {
+			arg2 = args[2].(model.ReturnHistory)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		var arg4 fixed.Fixed
+		if args[4] != nil {
+			arg4 = args[4].(fixed.Fixed)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RefundReturn_Call) Return(b bool, err error) *MockIOrderSQLRepository_RefundReturn_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RefundReturn_Call) RunAndReturn(run func(ctx context.Context, orderReturn *model.OrderReturn, entry model.ReturnHistory, paymentId uuid.UUID, amount fixed.Fixed) (bool, error)) *MockIOrderSQLRepository_RefundReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayWebhookDelivery provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) ReplayWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (bool, error) {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayWebhookDelivery")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) (bool, error)); ok {
+		return returnFunc(ctx, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookDelivery) bool); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.WebhookDelivery) error); ok {
+		r1 = returnFunc(ctx, delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_ReplayWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayWebhookDelivery'
+type MockIOrderSQLRepository_ReplayWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// ReplayWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *model.WebhookDelivery
+func (_e *MockIOrderSQLRepository_Expecter) ReplayWebhookDelivery(ctx interface{}, delivery interface{}) *MockIOrderSQLRepository_ReplayWebhookDelivery_Call {
+	return &MockIOrderSQLRepository_ReplayWebhookDelivery_Call{Call: _e.mock.On("ReplayWebhookDelivery", ctx, delivery)}
+}
+
+func (_c *MockIOrderSQLRepository_ReplayWebhookDelivery_Call) Run(run func(ctx context.Context, delivery *model.WebhookDelivery)) *MockIOrderSQLRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*model.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ReplayWebhookDelivery_Call) Return(b bool, err error) *MockIOrderSQLRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_ReplayWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, delivery *model.WebhookDelivery) (bool, error)) *MockIOrderSQLRepository_ReplayWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackTransaction provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) RollbackTransaction(ctx context.Context, tx storage.PgxTx) error {
+	ret := _mock.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackTransaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx) error); ok {
+		r0 = returnFunc(ctx, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_RollbackTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackTransaction'
+type MockIOrderSQLRepository_RollbackTransaction_Call struct {
+	*mock.Call
+}
+
+// RollbackTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx storage.PgxTx
+func (_e *MockIOrderSQLRepository_Expecter) RollbackTransaction(ctx interface{}, tx interface{}) *MockIOrderSQLRepository_RollbackTransaction_Call {
+	return &MockIOrderSQLRepository_RollbackTransaction_Call{Call: _e.mock.On("RollbackTransaction", ctx, tx)}
+}
+
+func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) Run(run func(ctx context.Context, tx storage.PgxTx)) *MockIOrderSQLRepository_RollbackTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 storage.PgxTx
+		if args[1] != nil {
+			arg1 = args[1].(storage.PgxTx)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) Return(err error) *MockIOrderSQLRepository_RollbackTransaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx) error) *MockIOrderSQLRepository_RollbackTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchOrders provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) SearchOrders(ctx context.Context, filter model.OrderSearchFilter) ([]model.Order, int, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchOrders")
+	}
+
+	var r0 []model.Order
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter) ([]model.Order, int, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter) []model.Order); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrderSearchFilter) int); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, model.OrderSearchFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOrderSQLRepository_SearchOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchOrders'
+type MockIOrderSQLRepository_SearchOrders_Call struct {
+	*mock.Call
+}
+
+// SearchOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrderSearchFilter
+func (_e *MockIOrderSQLRepository_Expecter) SearchOrders(ctx interface{}, filter interface{}) *MockIOrderSQLRepository_SearchOrders_Call {
+	return &MockIOrderSQLRepository_SearchOrders_Call{Call: _e.mock.On("SearchOrders", ctx, filter)}
+}
+
+func (_c *MockIOrderSQLRepository_SearchOrders_Call) Run(run func(ctx context.Context, filter model.OrderSearchFilter)) *MockIOrderSQLRepository_SearchOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderSearchFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderSearchFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_SearchOrders_Call) Return(orders []model.Order, n int, err error) *MockIOrderSQLRepository_SearchOrders_Call {
+	_c.Call.Return(orders, n, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_SearchOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderSearchFilter) ([]model.Order, int, error)) *MockIOrderSQLRepository_SearchOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSubscriptionStatus provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) SetSubscriptionStatus(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time) (bool, error) {
+	ret := _mock.Called(ctx, subscription, status, nextRunAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSubscriptionStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Subscription, string, *time.Time) (bool, error)); ok {
+		return returnFunc(ctx, subscription, status, nextRunAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Subscription, string, *time.Time) bool); ok {
+		r0 = returnFunc(ctx, subscription, status, nextRunAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Subscription, string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, subscription, status, nextRunAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_SetSubscriptionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSubscriptionStatus'
+type MockIOrderSQLRepository_SetSubscriptionStatus_Call struct {
+	*mock.Call
+}
+
+// SetSubscriptionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *model.Subscription
+//   - status string
+//   - nextRunAt *time.Time
+func (_e *MockIOrderSQLRepository_Expecter) SetSubscriptionStatus(ctx interface{}, subscription interface{}, status interface{}, nextRunAt interface{}) *MockIOrderSQLRepository_SetSubscriptionStatus_Call {
+	return &MockIOrderSQLRepository_SetSubscriptionStatus_Call{Call: _e.mock.On("SetSubscriptionStatus", ctx, subscription, status, nextRunAt)}
+}
+
+func (_c *MockIOrderSQLRepository_SetSubscriptionStatus_Call) Run(run func(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time)) *MockIOrderSQLRepository_SetSubscriptionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Subscription
+		if args[1] != nil {
+			arg1 = args[1].(*model.Subscription)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_SetSubscriptionStatus_Call) Return(b bool, err error) *MockIOrderSQLRepository_SetSubscriptionStatus_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_SetSubscriptionStatus_Call) RunAndReturn(run func(ctx context.Context, subscription *model.Subscription, status string, nextRunAt *time.Time) (bool, error)) *MockIOrderSQLRepository_SetSubscriptionStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertItemOrderWithTx provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) InsertItemOrderWithTx(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder) error {
-	ret := _mock.Called(ctx, tx, itemOrder)
+// StreamOrders provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) StreamOrders(ctx context.Context, filter model.OrderSearchFilter, fn func(order *model.OrderWithItems) error) error {
+	ret := _mock.Called(ctx, filter, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertItemOrderWithTx")
+		panic("no return value specified for StreamOrders")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx, model.ItemOrder) error); ok {
-		r0 = returnFunc(ctx, tx, itemOrder)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter, func(order *model.OrderWithItems) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIOrderSQLRepository_InsertItemOrderWithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertItemOrderWithTx'
-type MockIOrderSQLRepository_InsertItemOrderWithTx_Call struct {
+// MockIOrderSQLRepository_StreamOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamOrders'
+type MockIOrderSQLRepository_StreamOrders_Call struct {
 	*mock.Call
 }
 
-// InsertItemOrderWithTx is a helper method to define mock.On call
+// StreamOrders is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx storage.PgxTx
-//   - itemOrder model.ItemOrder
-func (_e *MockIOrderSQLRepository_Expecter) InsertItemOrderWithTx(ctx interface{}, tx interface{}, itemOrder interface{}) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
-	return &MockIOrderSQLRepository_InsertItemOrderWithTx_Call{Call: _e.mock.On("InsertItemOrderWithTx", ctx, tx, itemOrder)}
+//   - filter model.OrderSearchFilter
+//   - fn func(order *model.OrderWithItems) error
+func (_e *MockIOrderSQLRepository_Expecter) StreamOrders(ctx interface{}, filter interface{}, fn interface{}) *MockIOrderSQLRepository_StreamOrders_Call {
+	return &MockIOrderSQLRepository_StreamOrders_Call{Call: _e.mock.On("StreamOrders", ctx, filter, fn)}
 }
 
-func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) Run(run func(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder)) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+func (_c *MockIOrderSQLRepository_StreamOrders_Call) Run(run func(ctx context.Context, filter model.OrderSearchFilter, fn func(order *model.OrderWithItems) error)) *MockIOrderSQLRepository_StreamOrders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 storage.PgxTx
+		var arg1 model.OrderSearchFilter
 		if args[1] != nil {
-			arg1 = args[1].(storage.PgxTx)
+			arg1 = args[1].(model.OrderSearchFilter)
 		}
-		var arg2 model.ItemOrder
+		var arg2 func(order *model.OrderWithItems) error
 		if args[2] != nil {
-			arg2 = args[2].(model.ItemOrder)
+			arg2 = args[2].(func(order *model.OrderWithItems) error)
 		}
 		run(
 			arg0,
@@ -424,195 +3283,240 @@ func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) Return(err error) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+func (_c *MockIOrderSQLRepository_StreamOrders_Call) Return(err error) *MockIOrderSQLRepository_StreamOrders_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertItemOrderWithTx_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx, itemOrder model.ItemOrder) error) *MockIOrderSQLRepository_InsertItemOrderWithTx_Call {
+func (_c *MockIOrderSQLRepository_StreamOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderSearchFilter, fn func(order *model.OrderWithItems) error) error) *MockIOrderSQLRepository_StreamOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertOrderWithItems provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) InsertOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder) error {
-	ret := _mock.Called(ctx, order, items)
+// TransitionOrderStatus provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) TransitionOrderStatus(ctx context.Context, orderId uuid.UUID, from string, to string) (bool, error) {
+	ret := _mock.Called(ctx, orderId, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertOrderWithItems")
+		panic("no return value specified for TransitionOrderStatus")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, []model.ItemOrder) error); ok {
-		r0 = returnFunc(ctx, order, items)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (bool, error)); ok {
+		return returnFunc(ctx, orderId, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) bool); ok {
+		r0 = returnFunc(ctx, orderId, from, to)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, orderId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIOrderSQLRepository_InsertOrderWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrderWithItems'
-type MockIOrderSQLRepository_InsertOrderWithItems_Call struct {
+// MockIOrderSQLRepository_TransitionOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionOrderStatus'
+type MockIOrderSQLRepository_TransitionOrderStatus_Call struct {
 	*mock.Call
 }
 
-// InsertOrderWithItems is a helper method to define mock.On call
+// TransitionOrderStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - order *model.Order
-//   - items []model.ItemOrder
-func (_e *MockIOrderSQLRepository_Expecter) InsertOrderWithItems(ctx interface{}, order interface{}, items interface{}) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
-	return &MockIOrderSQLRepository_InsertOrderWithItems_Call{Call: _e.mock.On("InsertOrderWithItems", ctx, order, items)}
+//   - orderId uuid.UUID
+//   - from string
+//   - to string
+func (_e *MockIOrderSQLRepository_Expecter) TransitionOrderStatus(ctx interface{}, orderId interface{}, from interface{}, to interface{}) *MockIOrderSQLRepository_TransitionOrderStatus_Call {
+	return &MockIOrderSQLRepository_TransitionOrderStatus_Call{Call: _e.mock.On("TransitionOrderStatus", ctx, orderId, from, to)}
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) Run(run func(ctx context.Context, order *model.Order, items []model.ItemOrder)) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+func (_c *MockIOrderSQLRepository_TransitionOrderStatus_Call) Run(run func(ctx context.Context, orderId uuid.UUID, from string, to string)) *MockIOrderSQLRepository_TransitionOrderStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.Order
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(*model.Order)
+			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 []model.ItemOrder
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].([]model.ItemOrder)
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) Return(err error) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
-	_c.Call.Return(err)
+func (_c *MockIOrderSQLRepository_TransitionOrderStatus_Call) Return(b bool, err error) *MockIOrderSQLRepository_TransitionOrderStatus_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithItems_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, items []model.ItemOrder) error) *MockIOrderSQLRepository_InsertOrderWithItems_Call {
+func (_c *MockIOrderSQLRepository_TransitionOrderStatus_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, from string, to string) (bool, error)) *MockIOrderSQLRepository_TransitionOrderStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InsertOrderWithTx provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) InsertOrderWithTx(ctx context.Context, tx storage.PgxTx, order *model.Order) error {
-	ret := _mock.Called(ctx, tx, order)
+// TransitionReturn provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) TransitionReturn(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory) (bool, error) {
+	ret := _mock.Called(ctx, orderReturn, from, entry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InsertOrderWithTx")
+		panic("no return value specified for TransitionReturn")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx, *model.Order) error); ok {
-		r0 = returnFunc(ctx, tx, order)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.OrderReturn, string, model.ReturnHistory) (bool, error)); ok {
+		return returnFunc(ctx, orderReturn, from, entry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.OrderReturn, string, model.ReturnHistory) bool); ok {
+		r0 = returnFunc(ctx, orderReturn, from, entry)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.OrderReturn, string, model.ReturnHistory) error); ok {
+		r1 = returnFunc(ctx, orderReturn, from, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIOrderSQLRepository_InsertOrderWithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertOrderWithTx'
-type MockIOrderSQLRepository_InsertOrderWithTx_Call struct {
+// MockIOrderSQLRepository_TransitionReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionReturn'
+type MockIOrderSQLRepository_TransitionReturn_Call struct {
 	*mock.Call
 }
 
-// InsertOrderWithTx is a helper method to define mock.On call
+// TransitionReturn is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx storage.PgxTx
-//   - order *model.Order
-func (_e *MockIOrderSQLRepository_Expecter) InsertOrderWithTx(ctx interface{}, tx interface{}, order interface{}) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
-	return &MockIOrderSQLRepository_InsertOrderWithTx_Call{Call: _e.mock.On("InsertOrderWithTx", ctx, tx, order)}
+//   - orderReturn *model.OrderReturn
+//   - from string
+//   - entry model.ReturnHistory
+func (_e *MockIOrderSQLRepository_Expecter) TransitionReturn(ctx interface{}, orderReturn interface{}, from interface{}, entry interface{}) *MockIOrderSQLRepository_TransitionReturn_Call {
+	return &MockIOrderSQLRepository_TransitionReturn_Call{Call: _e.mock.On("TransitionReturn", ctx, orderReturn, from, entry)}
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) Run(run func(ctx context.Context, tx storage.PgxTx, order *model.Order)) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+func (_c *MockIOrderSQLRepository_TransitionReturn_Call) Run(run func(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory)) *MockIOrderSQLRepository_TransitionReturn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 storage.PgxTx
+		var arg1 *model.OrderReturn
 		if args[1] != nil {
-			arg1 = args[1].(storage.PgxTx)
+			arg1 = args[1].(*model.OrderReturn)
 		}
-		var arg2 *model.Order
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(*model.Order)
+			arg2 = args[2].(string)
+		}
+		var arg3 model.ReturnHistory
+		if args[3] != nil {
+			arg3 = args[3].(model.ReturnHistory)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) Return(err error) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
-	_c.Call.Return(err)
+func (_c *MockIOrderSQLRepository_TransitionReturn_Call) Return(b bool, err error) *MockIOrderSQLRepository_TransitionReturn_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_InsertOrderWithTx_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx, order *model.Order) error) *MockIOrderSQLRepository_InsertOrderWithTx_Call {
+func (_c *MockIOrderSQLRepository_TransitionReturn_Call) RunAndReturn(run func(ctx context.Context, orderReturn *model.OrderReturn, from string, entry model.ReturnHistory) (bool, error)) *MockIOrderSQLRepository_TransitionReturn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RollbackTransaction provides a mock function for the type MockIOrderSQLRepository
-func (_mock *MockIOrderSQLRepository) RollbackTransaction(ctx context.Context, tx storage.PgxTx) error {
-	ret := _mock.Called(ctx, tx)
+// TransitionShipment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) TransitionShipment(ctx context.Context, shipment *model.Shipment, from string) (bool, error) {
+	ret := _mock.Called(ctx, shipment, from)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RollbackTransaction")
+		panic("no return value specified for TransitionShipment")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, storage.PgxTx) error); ok {
-		r0 = returnFunc(ctx, tx)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Shipment, string) (bool, error)); ok {
+		return returnFunc(ctx, shipment, from)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Shipment, string) bool); ok {
+		r0 = returnFunc(ctx, shipment, from)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Shipment, string) error); ok {
+		r1 = returnFunc(ctx, shipment, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIOrderSQLRepository_RollbackTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackTransaction'
-type MockIOrderSQLRepository_RollbackTransaction_Call struct {
+// MockIOrderSQLRepository_TransitionShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionShipment'
+type MockIOrderSQLRepository_TransitionShipment_Call struct {
 	*mock.Call
 }
 
-// RollbackTransaction is a helper method to define mock.On call
+// TransitionShipment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tx storage.PgxTx
-func (_e *MockIOrderSQLRepository_Expecter) RollbackTransaction(ctx interface{}, tx interface{}) *MockIOrderSQLRepository_RollbackTransaction_Call {
-	return &MockIOrderSQLRepository_RollbackTransaction_Call{Call: _e.mock.On("RollbackTransaction", ctx, tx)}
+//   - shipment *model.Shipment
+//   - from string
+func (_e *MockIOrderSQLRepository_Expecter) TransitionShipment(ctx interface{}, shipment interface{}, from interface{}) *MockIOrderSQLRepository_TransitionShipment_Call {
+	return &MockIOrderSQLRepository_TransitionShipment_Call{Call: _e.mock.On("TransitionShipment", ctx, shipment, from)}
 }
 
-func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) Run(run func(ctx context.Context, tx storage.PgxTx)) *MockIOrderSQLRepository_RollbackTransaction_Call {
+func (_c *MockIOrderSQLRepository_TransitionShipment_Call) Run(run func(ctx context.Context, shipment *model.Shipment, from string)) *MockIOrderSQLRepository_TransitionShipment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 storage.PgxTx
+		var arg1 *model.Shipment
 		if args[1] != nil {
-			arg1 = args[1].(storage.PgxTx)
+			arg1 = args[1].(*model.Shipment)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) Return(err error) *MockIOrderSQLRepository_RollbackTransaction_Call {
-	_c.Call.Return(err)
+func (_c *MockIOrderSQLRepository_TransitionShipment_Call) Return(b bool, err error) *MockIOrderSQLRepository_TransitionShipment_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockIOrderSQLRepository_RollbackTransaction_Call) RunAndReturn(run func(ctx context.Context, tx storage.PgxTx) error) *MockIOrderSQLRepository_RollbackTransaction_Call {
+func (_c *MockIOrderSQLRepository_TransitionShipment_Call) RunAndReturn(run func(ctx context.Context, shipment *model.Shipment, from string) (bool, error)) *MockIOrderSQLRepository_TransitionShipment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -812,6 +3716,81 @@ func (_c *MockIOrderSQLRepository_UpdateOrderStatusWithTx_Call) RunAndReturn(run
 	return _c
 }
 
+// UpdateOrderWithItems provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) UpdateOrderWithItems(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error {
+	ret := _mock.Called(ctx, order, items, discounts, taxes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderWithItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Order, []model.ItemOrder, []model.OrderDiscount, []model.OrderTax) error); ok {
+		r0 = returnFunc(ctx, order, items, discounts, taxes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_UpdateOrderWithItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrderWithItems'
+type MockIOrderSQLRepository_UpdateOrderWithItems_Call struct {
+	*mock.Call
+}
+
+// UpdateOrderWithItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.Order
+//   - items []model.ItemOrder
+//   - discounts []model.OrderDiscount
+//   - taxes []model.OrderTax
+func (_e *MockIOrderSQLRepository_Expecter) UpdateOrderWithItems(ctx interface{}, order interface{}, items interface{}, discounts interface{}, taxes interface{}) *MockIOrderSQLRepository_UpdateOrderWithItems_Call {
+	return &MockIOrderSQLRepository_UpdateOrderWithItems_Call{Call: _e.mock.On("UpdateOrderWithItems", ctx, order, items, discounts, taxes)}
+}
+
+func (_c *MockIOrderSQLRepository_UpdateOrderWithItems_Call) Run(run func(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax)) *MockIOrderSQLRepository_UpdateOrderWithItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Order
+		if args[1] != nil {
+			arg1 = args[1].(*model.Order)
+		}
+		var arg2 []model.ItemOrder
+		if args[2] != nil {
+			arg2 = args[2].([]model.ItemOrder)
+		}
+		var arg3 []model.OrderDiscount
+		if args[3] != nil {
+			arg3 = args[3].([]model.OrderDiscount)
+		}
+		var arg4 []model.OrderTax
+		if args[4] != nil {
+			arg4 = args[4].([]model.OrderTax)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdateOrderWithItems_Call) Return(err error) *MockIOrderSQLRepository_UpdateOrderWithItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdateOrderWithItems_Call) RunAndReturn(run func(ctx context.Context, order *model.Order, items []model.ItemOrder, discounts []model.OrderDiscount, taxes []model.OrderTax) error) *MockIOrderSQLRepository_UpdateOrderWithItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateOrderWithTx provides a mock function for the type MockIOrderSQLRepository
 func (_mock *MockIOrderSQLRepository) UpdateOrderWithTx(ctx context.Context, tx storage.PgxTx, order *model.Order) error {
 	ret := _mock.Called(ctx, tx, order)
@@ -874,3 +3853,126 @@ func (_c *MockIOrderSQLRepository_UpdateOrderWithTx_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdatePayment provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) UpdatePayment(ctx context.Context, payment *model.Payment) error {
+	ret := _mock.Called(ctx, payment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Payment) error); ok {
+		r0 = returnFunc(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderSQLRepository_UpdatePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayment'
+type MockIOrderSQLRepository_UpdatePayment_Call struct {
+	*mock.Call
+}
+
+// UpdatePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment *model.Payment
+func (_e *MockIOrderSQLRepository_Expecter) UpdatePayment(ctx interface{}, payment interface{}) *MockIOrderSQLRepository_UpdatePayment_Call {
+	return &MockIOrderSQLRepository_UpdatePayment_Call{Call: _e.mock.On("UpdatePayment", ctx, payment)}
+}
+
+func (_c *MockIOrderSQLRepository_UpdatePayment_Call) Run(run func(ctx context.Context, payment *model.Payment)) *MockIOrderSQLRepository_UpdatePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Payment
+		if args[1] != nil {
+			arg1 = args[1].(*model.Payment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdatePayment_Call) Return(err error) *MockIOrderSQLRepository_UpdatePayment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdatePayment_Call) RunAndReturn(run func(ctx context.Context, payment *model.Payment) error) *MockIOrderSQLRepository_UpdatePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookEndpoint provides a mock function for the type MockIOrderSQLRepository
+func (_mock *MockIOrderSQLRepository) UpdateWebhookEndpoint(ctx context.Context, endpoint *model.WebhookEndpoint) (bool, error) {
+	ret := _mock.Called(ctx, endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEndpoint")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) (bool, error)); ok {
+		return returnFunc(ctx, endpoint)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.WebhookEndpoint) bool); ok {
+		r0 = returnFunc(ctx, endpoint)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.WebhookEndpoint) error); ok {
+		r1 = returnFunc(ctx, endpoint)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderSQLRepository_UpdateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookEndpoint'
+type MockIOrderSQLRepository_UpdateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpoint *model.WebhookEndpoint
+func (_e *MockIOrderSQLRepository_Expecter) UpdateWebhookEndpoint(ctx interface{}, endpoint interface{}) *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call {
+	return &MockIOrderSQLRepository_UpdateWebhookEndpoint_Call{Call: _e.mock.On("UpdateWebhookEndpoint", ctx, endpoint)}
+}
+
+func (_c *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call) Run(run func(ctx context.Context, endpoint *model.WebhookEndpoint)) *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.WebhookEndpoint
+		if args[1] != nil {
+			arg1 = args[1].(*model.WebhookEndpoint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call) Return(b bool, err error) *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call) RunAndReturn(run func(ctx context.Context, endpoint *model.WebhookEndpoint) (bool, error)) *MockIOrderSQLRepository_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
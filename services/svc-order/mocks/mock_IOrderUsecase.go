@@ -9,6 +9,7 @@ import (
 	"ops-monorepo/services/svc-order/internal/delivery/types"
 	"ops-monorepo/services/svc-order/internal/model"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,78 +40,2254 @@ func (_m *MockIOrderUsecase) EXPECT() *MockIOrderUsecase_Expecter {
 	return &MockIOrderUsecase_Expecter{mock: &_m.Mock}
 }
 
+// AmendOrderItems provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) AmendOrderItems(ctx context.Context, customer model.Customer, orderId uuid.UUID, request types.AmendOrderItemsRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+	ret := _mock.Called(ctx, customer, orderId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendOrderItems")
+	}
+
+	var r0 *model.OrderWithItems
+	var r1 []*model.OrderedItemStockStatus
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, uuid.UUID, types.AmendOrderItemsRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)); ok {
+		return returnFunc(ctx, customer, orderId, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, uuid.UUID, types.AmendOrderItemsRequest) *model.OrderWithItems); ok {
+		r0 = returnFunc(ctx, customer, orderId, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderWithItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Customer, uuid.UUID, types.AmendOrderItemsRequest) []*model.OrderedItemStockStatus); ok {
+		r1 = returnFunc(ctx, customer, orderId, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.OrderedItemStockStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, model.Customer, uuid.UUID, types.AmendOrderItemsRequest) error); ok {
+		r2 = returnFunc(ctx, customer, orderId, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOrderUsecase_AmendOrderItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendOrderItems'
+type MockIOrderUsecase_AmendOrderItems_Call struct {
+	*mock.Call
+}
+
+// AmendOrderItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customer model.Customer
+//   - orderId uuid.UUID
+//   - request types.AmendOrderItemsRequest
+func (_e *MockIOrderUsecase_Expecter) AmendOrderItems(ctx interface{}, customer interface{}, orderId interface{}, request interface{}) *MockIOrderUsecase_AmendOrderItems_Call {
+	return &MockIOrderUsecase_AmendOrderItems_Call{Call: _e.mock.On("AmendOrderItems", ctx, customer, orderId, request)}
+}
+
+func (_c *MockIOrderUsecase_AmendOrderItems_Call) Run(run func(ctx context.Context, customer model.Customer, orderId uuid.UUID, request types.AmendOrderItemsRequest)) *MockIOrderUsecase_AmendOrderItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Customer
+		if args[1] != nil {
+			arg1 = args[1].(model.Customer)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 types.AmendOrderItemsRequest
+		if args[3] != nil {
+			arg3 = args[3].(types.AmendOrderItemsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_AmendOrderItems_Call) Return(orderWithItems *model.OrderWithItems, vs []*model.OrderedItemStockStatus, err error) *MockIOrderUsecase_AmendOrderItems_Call {
+	_c.Call.Return(orderWithItems, vs, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_AmendOrderItems_Call) RunAndReturn(run func(ctx context.Context, customer model.Customer, orderId uuid.UUID, request types.AmendOrderItemsRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)) *MockIOrderUsecase_AmendOrderItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveReturn provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ApproveReturn(ctx context.Context, returnId uuid.UUID, actor string, note string) (*model.OrderReturn, error) {
+	ret := _mock.Called(ctx, returnId, actor, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveReturn")
+	}
+
+	var r0 *model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*model.OrderReturn, error)); ok {
+		return returnFunc(ctx, returnId, actor, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *model.OrderReturn); ok {
+		r0 = returnFunc(ctx, returnId, actor, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, returnId, actor, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ApproveReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveReturn'
+type MockIOrderUsecase_ApproveReturn_Call struct {
+	*mock.Call
+}
+
+// ApproveReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - returnId uuid.UUID
+//   - actor string
+//   - note string
+func (_e *MockIOrderUsecase_Expecter) ApproveReturn(ctx interface{}, returnId interface{}, actor interface{}, note interface{}) *MockIOrderUsecase_ApproveReturn_Call {
+	return &MockIOrderUsecase_ApproveReturn_Call{Call: _e.mock.On("ApproveReturn", ctx, returnId, actor, note)}
+}
+
+func (_c *MockIOrderUsecase_ApproveReturn_Call) Run(run func(ctx context.Context, returnId uuid.UUID, actor string, note string)) *MockIOrderUsecase_ApproveReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ApproveReturn_Call) Return(orderReturn *model.OrderReturn, err error) *MockIOrderUsecase_ApproveReturn_Call {
+	_c.Call.Return(orderReturn, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ApproveReturn_Call) RunAndReturn(run func(ctx context.Context, returnId uuid.UUID, actor string, note string) (*model.OrderReturn, error)) *MockIOrderUsecase_ApproveReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmAllocatedBackorders provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ConfirmAllocatedBackorders(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmAllocatedBackorders")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ConfirmAllocatedBackorders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmAllocatedBackorders'
+type MockIOrderUsecase_ConfirmAllocatedBackorders_Call struct {
+	*mock.Call
+}
+
+// ConfirmAllocatedBackorders is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderUsecase_Expecter) ConfirmAllocatedBackorders(ctx interface{}) *MockIOrderUsecase_ConfirmAllocatedBackorders_Call {
+	return &MockIOrderUsecase_ConfirmAllocatedBackorders_Call{Call: _e.mock.On("ConfirmAllocatedBackorders", ctx)}
+}
+
+func (_c *MockIOrderUsecase_ConfirmAllocatedBackorders_Call) Run(run func(ctx context.Context)) *MockIOrderUsecase_ConfirmAllocatedBackorders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ConfirmAllocatedBackorders_Call) Return(n int, err error) *MockIOrderUsecase_ConfirmAllocatedBackorders_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ConfirmAllocatedBackorders_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIOrderUsecase_ConfirmAllocatedBackorders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePromotion provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) CreatePromotion(ctx context.Context, request types.PromotionRequest) (*model.Promotion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePromotion")
+	}
+
+	var r0 *model.Promotion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.PromotionRequest) (*model.Promotion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.PromotionRequest) *model.Promotion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Promotion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, types.PromotionRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_CreatePromotion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePromotion'
+type MockIOrderUsecase_CreatePromotion_Call struct {
+	*mock.Call
+}
+
+// CreatePromotion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request types.PromotionRequest
+func (_e *MockIOrderUsecase_Expecter) CreatePromotion(ctx interface{}, request interface{}) *MockIOrderUsecase_CreatePromotion_Call {
+	return &MockIOrderUsecase_CreatePromotion_Call{Call: _e.mock.On("CreatePromotion", ctx, request)}
+}
+
+func (_c *MockIOrderUsecase_CreatePromotion_Call) Run(run func(ctx context.Context, request types.PromotionRequest)) *MockIOrderUsecase_CreatePromotion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 types.PromotionRequest
+		if args[1] != nil {
+			arg1 = args[1].(types.PromotionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreatePromotion_Call) Return(promotion *model.Promotion, err error) *MockIOrderUsecase_CreatePromotion_Call {
+	_c.Call.Return(promotion, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreatePromotion_Call) RunAndReturn(run func(ctx context.Context, request types.PromotionRequest) (*model.Promotion, error)) *MockIOrderUsecase_CreatePromotion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShipment provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) CreateShipment(ctx context.Context, orderId uuid.UUID, request types.ShipmentRequest) (*model.Shipment, error) {
+	ret := _mock.Called(ctx, orderId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShipment")
+	}
+
+	var r0 *model.Shipment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ShipmentRequest) (*model.Shipment, error)); ok {
+		return returnFunc(ctx, orderId, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ShipmentRequest) *model.Shipment); ok {
+		r0 = returnFunc(ctx, orderId, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, types.ShipmentRequest) error); ok {
+		r1 = returnFunc(ctx, orderId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_CreateShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShipment'
+type MockIOrderUsecase_CreateShipment_Call struct {
+	*mock.Call
+}
+
+// CreateShipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - request types.ShipmentRequest
+func (_e *MockIOrderUsecase_Expecter) CreateShipment(ctx interface{}, orderId interface{}, request interface{}) *MockIOrderUsecase_CreateShipment_Call {
+	return &MockIOrderUsecase_CreateShipment_Call{Call: _e.mock.On("CreateShipment", ctx, orderId, request)}
+}
+
+func (_c *MockIOrderUsecase_CreateShipment_Call) Run(run func(ctx context.Context, orderId uuid.UUID, request types.ShipmentRequest)) *MockIOrderUsecase_CreateShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 types.ShipmentRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.ShipmentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreateShipment_Call) Return(shipment *model.Shipment, err error) *MockIOrderUsecase_CreateShipment_Call {
+	_c.Call.Return(shipment, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreateShipment_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, request types.ShipmentRequest) (*model.Shipment, error)) *MockIOrderUsecase_CreateShipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookEndpoint provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) CreateWebhookEndpoint(ctx context.Context, request types.WebhookEndpointRequest) (*model.WebhookEndpoint, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookEndpoint")
+	}
+
+	var r0 *model.WebhookEndpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.WebhookEndpointRequest) (*model.WebhookEndpoint, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, types.WebhookEndpointRequest) *model.WebhookEndpoint); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookEndpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, types.WebhookEndpointRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_CreateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookEndpoint'
+type MockIOrderUsecase_CreateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request types.WebhookEndpointRequest
+func (_e *MockIOrderUsecase_Expecter) CreateWebhookEndpoint(ctx interface{}, request interface{}) *MockIOrderUsecase_CreateWebhookEndpoint_Call {
+	return &MockIOrderUsecase_CreateWebhookEndpoint_Call{Call: _e.mock.On("CreateWebhookEndpoint", ctx, request)}
+}
+
+func (_c *MockIOrderUsecase_CreateWebhookEndpoint_Call) Run(run func(ctx context.Context, request types.WebhookEndpointRequest)) *MockIOrderUsecase_CreateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 types.WebhookEndpointRequest
+		if args[1] != nil {
+			arg1 = args[1].(types.WebhookEndpointRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreateWebhookEndpoint_Call) Return(webhookEndpoint *model.WebhookEndpoint, err error) *MockIOrderUsecase_CreateWebhookEndpoint_Call {
+	_c.Call.Return(webhookEndpoint, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_CreateWebhookEndpoint_Call) RunAndReturn(run func(ctx context.Context, request types.WebhookEndpointRequest) (*model.WebhookEndpoint, error)) *MockIOrderUsecase_CreateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliverShipment provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) DeliverShipment(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error) {
+	ret := _mock.Called(ctx, shipmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverShipment")
+	}
+
+	var r0 *model.Shipment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.Shipment, error)); ok {
+		return returnFunc(ctx, shipmentId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.Shipment); ok {
+		r0 = returnFunc(ctx, shipmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, shipmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_DeliverShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliverShipment'
+type MockIOrderUsecase_DeliverShipment_Call struct {
+	*mock.Call
+}
+
+// DeliverShipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shipmentId uuid.UUID
+func (_e *MockIOrderUsecase_Expecter) DeliverShipment(ctx interface{}, shipmentId interface{}) *MockIOrderUsecase_DeliverShipment_Call {
+	return &MockIOrderUsecase_DeliverShipment_Call{Call: _e.mock.On("DeliverShipment", ctx, shipmentId)}
+}
+
+func (_c *MockIOrderUsecase_DeliverShipment_Call) Run(run func(ctx context.Context, shipmentId uuid.UUID)) *MockIOrderUsecase_DeliverShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DeliverShipment_Call) Return(shipment *model.Shipment, err error) *MockIOrderUsecase_DeliverShipment_Call {
+	_c.Call.Return(shipment, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DeliverShipment_Call) RunAndReturn(run func(ctx context.Context, shipmentId uuid.UUID) (*model.Shipment, error)) *MockIOrderUsecase_DeliverShipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeOutOfStock provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) DescribeOutOfStock(ctx context.Context, failed []*model.OrderedItemStockStatus) []model.OutOfStockItem {
+	ret := _mock.Called(ctx, failed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeOutOfStock")
+	}
+
+	var r0 []model.OutOfStockItem
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.OrderedItemStockStatus) []model.OutOfStockItem); ok {
+		r0 = returnFunc(ctx, failed)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OutOfStockItem)
+		}
+	}
+	return r0
+}
+
+// MockIOrderUsecase_DescribeOutOfStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeOutOfStock'
+type MockIOrderUsecase_DescribeOutOfStock_Call struct {
+	*mock.Call
+}
+
+// DescribeOutOfStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - failed []*model.OrderedItemStockStatus
+func (_e *MockIOrderUsecase_Expecter) DescribeOutOfStock(ctx interface{}, failed interface{}) *MockIOrderUsecase_DescribeOutOfStock_Call {
+	return &MockIOrderUsecase_DescribeOutOfStock_Call{Call: _e.mock.On("DescribeOutOfStock", ctx, failed)}
+}
+
+func (_c *MockIOrderUsecase_DescribeOutOfStock_Call) Run(run func(ctx context.Context, failed []*model.OrderedItemStockStatus)) *MockIOrderUsecase_DescribeOutOfStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.OrderedItemStockStatus
+		if args[1] != nil {
+			arg1 = args[1].([]*model.OrderedItemStockStatus)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DescribeOutOfStock_Call) Return(outOfStockItems []model.OutOfStockItem) *MockIOrderUsecase_DescribeOutOfStock_Call {
+	_c.Call.Return(outOfStockItems)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DescribeOutOfStock_Call) RunAndReturn(run func(ctx context.Context, failed []*model.OrderedItemStockStatus) []model.OutOfStockItem) *MockIOrderUsecase_DescribeOutOfStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DispatchWebhooks provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) DispatchWebhooks(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispatchWebhooks")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_DispatchWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DispatchWebhooks'
+type MockIOrderUsecase_DispatchWebhooks_Call struct {
+	*mock.Call
+}
+
+// DispatchWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderUsecase_Expecter) DispatchWebhooks(ctx interface{}) *MockIOrderUsecase_DispatchWebhooks_Call {
+	return &MockIOrderUsecase_DispatchWebhooks_Call{Call: _e.mock.On("DispatchWebhooks", ctx)}
+}
+
+func (_c *MockIOrderUsecase_DispatchWebhooks_Call) Run(run func(ctx context.Context)) *MockIOrderUsecase_DispatchWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DispatchWebhooks_Call) Return(n int, err error) *MockIOrderUsecase_DispatchWebhooks_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_DispatchWebhooks_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIOrderUsecase_DispatchWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportOrders provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ExportOrders(ctx context.Context, filter model.OrderSearchFilter, actor string, fn func(order *model.OrderWithItems) error) error {
+	ret := _mock.Called(ctx, filter, actor, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportOrders")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter, string, func(order *model.OrderWithItems) error) error); ok {
+		r0 = returnFunc(ctx, filter, actor, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOrderUsecase_ExportOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportOrders'
+type MockIOrderUsecase_ExportOrders_Call struct {
+	*mock.Call
+}
+
+// ExportOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrderSearchFilter
+//   - actor string
+//   - fn func(order *model.OrderWithItems) error
+func (_e *MockIOrderUsecase_Expecter) ExportOrders(ctx interface{}, filter interface{}, actor interface{}, fn interface{}) *MockIOrderUsecase_ExportOrders_Call {
+	return &MockIOrderUsecase_ExportOrders_Call{Call: _e.mock.On("ExportOrders", ctx, filter, actor, fn)}
+}
+
+func (_c *MockIOrderUsecase_ExportOrders_Call) Run(run func(ctx context.Context, filter model.OrderSearchFilter, actor string, fn func(order *model.OrderWithItems) error)) *MockIOrderUsecase_ExportOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderSearchFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderSearchFilter)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 func(order *model.OrderWithItems) error
+		if args[3] != nil {
+			arg3 = args[3].(func(order *model.OrderWithItems) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ExportOrders_Call) Return(err error) *MockIOrderUsecase_ExportOrders_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ExportOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderSearchFilter, actor string, fn func(order *model.OrderWithItems) error) error) *MockIOrderUsecase_ExportOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceOrderStatus provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ForceOrderStatus(ctx context.Context, orderId uuid.UUID, request types.ForceOrderStatusRequest, actor string) (*model.Order, error) {
+	ret := _mock.Called(ctx, orderId, request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceOrderStatus")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ForceOrderStatusRequest, string) (*model.Order, error)); ok {
+		return returnFunc(ctx, orderId, request, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ForceOrderStatusRequest, string) *model.Order); ok {
+		r0 = returnFunc(ctx, orderId, request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, types.ForceOrderStatusRequest, string) error); ok {
+		r1 = returnFunc(ctx, orderId, request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ForceOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceOrderStatus'
+type MockIOrderUsecase_ForceOrderStatus_Call struct {
+	*mock.Call
+}
+
+// ForceOrderStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - request types.ForceOrderStatusRequest
+//   - actor string
+func (_e *MockIOrderUsecase_Expecter) ForceOrderStatus(ctx interface{}, orderId interface{}, request interface{}, actor interface{}) *MockIOrderUsecase_ForceOrderStatus_Call {
+	return &MockIOrderUsecase_ForceOrderStatus_Call{Call: _e.mock.On("ForceOrderStatus", ctx, orderId, request, actor)}
+}
+
+func (_c *MockIOrderUsecase_ForceOrderStatus_Call) Run(run func(ctx context.Context, orderId uuid.UUID, request types.ForceOrderStatusRequest, actor string)) *MockIOrderUsecase_ForceOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 types.ForceOrderStatusRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.ForceOrderStatusRequest)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ForceOrderStatus_Call) Return(order *model.Order, err error) *MockIOrderUsecase_ForceOrderStatus_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ForceOrderStatus_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, request types.ForceOrderStatusRequest, actor string) (*model.Order, error)) *MockIOrderUsecase_ForceOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FulfilOrder provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) FulfilOrder(ctx context.Context, orderId uuid.UUID) (*model.OrderWithItems, error) {
+	ret := _mock.Called(ctx, orderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FulfilOrder")
+	}
+
+	var r0 *model.OrderWithItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.OrderWithItems, error)); ok {
+		return returnFunc(ctx, orderId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.OrderWithItems); ok {
+		r0 = returnFunc(ctx, orderId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderWithItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_FulfilOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfilOrder'
+type MockIOrderUsecase_FulfilOrder_Call struct {
+	*mock.Call
+}
+
+// FulfilOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+func (_e *MockIOrderUsecase_Expecter) FulfilOrder(ctx interface{}, orderId interface{}) *MockIOrderUsecase_FulfilOrder_Call {
+	return &MockIOrderUsecase_FulfilOrder_Call{Call: _e.mock.On("FulfilOrder", ctx, orderId)}
+}
+
+func (_c *MockIOrderUsecase_FulfilOrder_Call) Run(run func(ctx context.Context, orderId uuid.UUID)) *MockIOrderUsecase_FulfilOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_FulfilOrder_Call) Return(orderWithItems *model.OrderWithItems, err error) *MockIOrderUsecase_FulfilOrder_Call {
+	_c.Call.Return(orderWithItems, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_FulfilOrder_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID) (*model.OrderWithItems, error)) *MockIOrderUsecase_FulfilOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderDetail provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) GetOrderDetail(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) (*model.OrderDetail, error) {
+	ret := _mock.Called(ctx, orderId, customer, admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderDetail")
+	}
+
+	var r0 *model.OrderDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) (*model.OrderDetail, error)); ok {
+		return returnFunc(ctx, orderId, customer, admin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) *model.OrderDetail); ok {
+		r0 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.Customer, bool) error); ok {
+		r1 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_GetOrderDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderDetail'
+type MockIOrderUsecase_GetOrderDetail_Call struct {
+	*mock.Call
+}
+
+// GetOrderDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - customer model.Customer
+//   - admin bool
+func (_e *MockIOrderUsecase_Expecter) GetOrderDetail(ctx interface{}, orderId interface{}, customer interface{}, admin interface{}) *MockIOrderUsecase_GetOrderDetail_Call {
+	return &MockIOrderUsecase_GetOrderDetail_Call{Call: _e.mock.On("GetOrderDetail", ctx, orderId, customer, admin)}
+}
+
+func (_c *MockIOrderUsecase_GetOrderDetail_Call) Run(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool)) *MockIOrderUsecase_GetOrderDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.Customer
+		if args[2] != nil {
+			arg2 = args[2].(model.Customer)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderDetail_Call) Return(orderDetail *model.OrderDetail, err error) *MockIOrderUsecase_GetOrderDetail_Call {
+	_c.Call.Return(orderDetail, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderDetail_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) (*model.OrderDetail, error)) *MockIOrderUsecase_GetOrderDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderReturns provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) GetOrderReturns(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.OrderReturn, error) {
+	ret := _mock.Called(ctx, orderId, customer, admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderReturns")
+	}
+
+	var r0 []model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) ([]model.OrderReturn, error)); ok {
+		return returnFunc(ctx, orderId, customer, admin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) []model.OrderReturn); ok {
+		r0 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.Customer, bool) error); ok {
+		r1 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_GetOrderReturns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderReturns'
+type MockIOrderUsecase_GetOrderReturns_Call struct {
+	*mock.Call
+}
+
+// GetOrderReturns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - customer model.Customer
+//   - admin bool
+func (_e *MockIOrderUsecase_Expecter) GetOrderReturns(ctx interface{}, orderId interface{}, customer interface{}, admin interface{}) *MockIOrderUsecase_GetOrderReturns_Call {
+	return &MockIOrderUsecase_GetOrderReturns_Call{Call: _e.mock.On("GetOrderReturns", ctx, orderId, customer, admin)}
+}
+
+func (_c *MockIOrderUsecase_GetOrderReturns_Call) Run(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool)) *MockIOrderUsecase_GetOrderReturns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.Customer
+		if args[2] != nil {
+			arg2 = args[2].(model.Customer)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderReturns_Call) Return(orderReturns []model.OrderReturn, err error) *MockIOrderUsecase_GetOrderReturns_Call {
+	_c.Call.Return(orderReturns, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderReturns_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.OrderReturn, error)) *MockIOrderUsecase_GetOrderReturns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderShipments provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) GetOrderShipments(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.Shipment, error) {
+	ret := _mock.Called(ctx, orderId, customer, admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderShipments")
+	}
+
+	var r0 []model.Shipment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) ([]model.Shipment, error)); ok {
+		return returnFunc(ctx, orderId, customer, admin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.Customer, bool) []model.Shipment); ok {
+		r0 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.Customer, bool) error); ok {
+		r1 = returnFunc(ctx, orderId, customer, admin)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_GetOrderShipments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderShipments'
+type MockIOrderUsecase_GetOrderShipments_Call struct {
+	*mock.Call
+}
+
+// GetOrderShipments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - customer model.Customer
+//   - admin bool
+func (_e *MockIOrderUsecase_Expecter) GetOrderShipments(ctx interface{}, orderId interface{}, customer interface{}, admin interface{}) *MockIOrderUsecase_GetOrderShipments_Call {
+	return &MockIOrderUsecase_GetOrderShipments_Call{Call: _e.mock.On("GetOrderShipments", ctx, orderId, customer, admin)}
+}
+
+func (_c *MockIOrderUsecase_GetOrderShipments_Call) Run(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool)) *MockIOrderUsecase_GetOrderShipments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.Customer
+		if args[2] != nil {
+			arg2 = args[2].(model.Customer)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderShipments_Call) Return(shipments []model.Shipment, err error) *MockIOrderUsecase_GetOrderShipments_Call {
+	_c.Call.Return(shipments, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetOrderShipments_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, customer model.Customer, admin bool) ([]model.Shipment, error)) *MockIOrderUsecase_GetOrderShipments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDelivery provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) GetWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, deliveryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDelivery")
+	}
+
+	var r0 *model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, deliveryId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_GetWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDelivery'
+type MockIOrderUsecase_GetWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryId uuid.UUID
+func (_e *MockIOrderUsecase_Expecter) GetWebhookDelivery(ctx interface{}, deliveryId interface{}) *MockIOrderUsecase_GetWebhookDelivery_Call {
+	return &MockIOrderUsecase_GetWebhookDelivery_Call{Call: _e.mock.On("GetWebhookDelivery", ctx, deliveryId)}
+}
+
+func (_c *MockIOrderUsecase_GetWebhookDelivery_Call) Run(run func(ctx context.Context, deliveryId uuid.UUID)) *MockIOrderUsecase_GetWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetWebhookDelivery_Call) Return(webhookDelivery *model.WebhookDelivery, err error) *MockIOrderUsecase_GetWebhookDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_GetWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)) *MockIOrderUsecase_GetWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAdminAudit provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ListAdminAudit(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdminAudit")
+	}
+
+	var r0 []model.AdminAuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AdminAuditFilter) ([]model.AdminAuditEntry, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.AdminAuditFilter) []model.AdminAuditEntry); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AdminAuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.AdminAuditFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ListAdminAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAdminAudit'
+type MockIOrderUsecase_ListAdminAudit_Call struct {
+	*mock.Call
+}
+
+// ListAdminAudit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.AdminAuditFilter
+func (_e *MockIOrderUsecase_Expecter) ListAdminAudit(ctx interface{}, filter interface{}) *MockIOrderUsecase_ListAdminAudit_Call {
+	return &MockIOrderUsecase_ListAdminAudit_Call{Call: _e.mock.On("ListAdminAudit", ctx, filter)}
+}
+
+func (_c *MockIOrderUsecase_ListAdminAudit_Call) Run(run func(ctx context.Context, filter model.AdminAuditFilter)) *MockIOrderUsecase_ListAdminAudit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.AdminAuditFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.AdminAuditFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListAdminAudit_Call) Return(adminAuditEntrys []model.AdminAuditEntry, err error) *MockIOrderUsecase_ListAdminAudit_Call {
+	_c.Call.Return(adminAuditEntrys, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListAdminAudit_Call) RunAndReturn(run func(ctx context.Context, filter model.AdminAuditFilter) ([]model.AdminAuditEntry, error)) *MockIOrderUsecase_ListAdminAudit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPromotions provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ListPromotions(ctx context.Context) ([]model.Promotion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPromotions")
+	}
+
+	var r0 []model.Promotion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]model.Promotion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []model.Promotion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Promotion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ListPromotions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPromotions'
+type MockIOrderUsecase_ListPromotions_Call struct {
+	*mock.Call
+}
+
+// ListPromotions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderUsecase_Expecter) ListPromotions(ctx interface{}) *MockIOrderUsecase_ListPromotions_Call {
+	return &MockIOrderUsecase_ListPromotions_Call{Call: _e.mock.On("ListPromotions", ctx)}
+}
+
+func (_c *MockIOrderUsecase_ListPromotions_Call) Run(run func(ctx context.Context)) *MockIOrderUsecase_ListPromotions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListPromotions_Call) Return(promotions []model.Promotion, err error) *MockIOrderUsecase_ListPromotions_Call {
+	_c.Call.Return(promotions, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListPromotions_Call) RunAndReturn(run func(ctx context.Context) ([]model.Promotion, error)) *MockIOrderUsecase_ListPromotions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookDeliveries provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ListWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookDeliveries")
+	}
+
+	var r0 []model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.WebhookDeliveryFilter) []model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.WebhookDeliveryFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ListWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookDeliveries'
+type MockIOrderUsecase_ListWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.WebhookDeliveryFilter
+func (_e *MockIOrderUsecase_Expecter) ListWebhookDeliveries(ctx interface{}, filter interface{}) *MockIOrderUsecase_ListWebhookDeliveries_Call {
+	return &MockIOrderUsecase_ListWebhookDeliveries_Call{Call: _e.mock.On("ListWebhookDeliveries", ctx, filter)}
+}
+
+func (_c *MockIOrderUsecase_ListWebhookDeliveries_Call) Run(run func(ctx context.Context, filter model.WebhookDeliveryFilter)) *MockIOrderUsecase_ListWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.WebhookDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListWebhookDeliveries_Call) Return(webhookDeliverys []model.WebhookDelivery, err error) *MockIOrderUsecase_ListWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, filter model.WebhookDeliveryFilter) ([]model.WebhookDelivery, error)) *MockIOrderUsecase_ListWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhookEndpoints provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ListWebhookEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookEndpoints")
+	}
+
+	var r0 []model.WebhookEndpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]model.WebhookEndpoint, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []model.WebhookEndpoint); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookEndpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ListWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhookEndpoints'
+type MockIOrderUsecase_ListWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// ListWebhookEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIOrderUsecase_Expecter) ListWebhookEndpoints(ctx interface{}) *MockIOrderUsecase_ListWebhookEndpoints_Call {
+	return &MockIOrderUsecase_ListWebhookEndpoints_Call{Call: _e.mock.On("ListWebhookEndpoints", ctx)}
+}
+
+func (_c *MockIOrderUsecase_ListWebhookEndpoints_Call) Run(run func(ctx context.Context)) *MockIOrderUsecase_ListWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListWebhookEndpoints_Call) Return(webhookEndpoints []model.WebhookEndpoint, err error) *MockIOrderUsecase_ListWebhookEndpoints_Call {
+	_c.Call.Return(webhookEndpoints, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ListWebhookEndpoints_Call) RunAndReturn(run func(ctx context.Context) ([]model.WebhookEndpoint, error)) *MockIOrderUsecase_ListWebhookEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrder provides a mock function for the type MockIOrderUsecase
-func (_mock *MockIOrderUsecase) NewOrder(ctx context.Context, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
-	ret := _mock.Called(ctx, request)
+func (_mock *MockIOrderUsecase) NewOrder(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+	ret := _mock.Called(ctx, customer, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewOrder")
+	}
+
+	var r0 *model.OrderWithItems
+	var r1 []*model.OrderedItemStockStatus
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)); ok {
+		return returnFunc(ctx, customer, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, types.OrderRequest) *model.OrderWithItems); ok {
+		r0 = returnFunc(ctx, customer, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderWithItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Customer, types.OrderRequest) []*model.OrderedItemStockStatus); ok {
+		r1 = returnFunc(ctx, customer, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.OrderedItemStockStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, model.Customer, types.OrderRequest) error); ok {
+		r2 = returnFunc(ctx, customer, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOrderUsecase_NewOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewOrder'
+type MockIOrderUsecase_NewOrder_Call struct {
+	*mock.Call
+}
+
+// NewOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customer model.Customer
+//   - request types.OrderRequest
+func (_e *MockIOrderUsecase_Expecter) NewOrder(ctx interface{}, customer interface{}, request interface{}) *MockIOrderUsecase_NewOrder_Call {
+	return &MockIOrderUsecase_NewOrder_Call{Call: _e.mock.On("NewOrder", ctx, customer, request)}
+}
+
+func (_c *MockIOrderUsecase_NewOrder_Call) Run(run func(ctx context.Context, customer model.Customer, request types.OrderRequest)) *MockIOrderUsecase_NewOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Customer
+		if args[1] != nil {
+			arg1 = args[1].(model.Customer)
+		}
+		var arg2 types.OrderRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.OrderRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_NewOrder_Call) Return(orderWithItems *model.OrderWithItems, vs []*model.OrderedItemStockStatus, err error) *MockIOrderUsecase_NewOrder_Call {
+	_c.Call.Return(orderWithItems, vs, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_NewOrder_Call) RunAndReturn(run func(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)) *MockIOrderUsecase_NewOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Quote provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) Quote(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.Quote, error) {
+	ret := _mock.Called(ctx, customer, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quote")
+	}
+
+	var r0 *model.Quote
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, types.OrderRequest) (*model.Quote, error)); ok {
+		return returnFunc(ctx, customer, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.Customer, types.OrderRequest) *model.Quote); ok {
+		r0 = returnFunc(ctx, customer, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Quote)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.Customer, types.OrderRequest) error); ok {
+		r1 = returnFunc(ctx, customer, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_Quote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Quote'
+type MockIOrderUsecase_Quote_Call struct {
+	*mock.Call
+}
+
+// Quote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - customer model.Customer
+//   - request types.OrderRequest
+func (_e *MockIOrderUsecase_Expecter) Quote(ctx interface{}, customer interface{}, request interface{}) *MockIOrderUsecase_Quote_Call {
+	return &MockIOrderUsecase_Quote_Call{Call: _e.mock.On("Quote", ctx, customer, request)}
+}
+
+func (_c *MockIOrderUsecase_Quote_Call) Run(run func(ctx context.Context, customer model.Customer, request types.OrderRequest)) *MockIOrderUsecase_Quote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.Customer
+		if args[1] != nil {
+			arg1 = args[1].(model.Customer)
+		}
+		var arg2 types.OrderRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.OrderRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_Quote_Call) Return(quote *model.Quote, err error) *MockIOrderUsecase_Quote_Call {
+	_c.Call.Return(quote, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_Quote_Call) RunAndReturn(run func(ctx context.Context, customer model.Customer, request types.OrderRequest) (*model.Quote, error)) *MockIOrderUsecase_Quote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveReturn provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ReceiveReturn(ctx context.Context, returnId uuid.UUID, actor string) (*model.OrderReturn, error) {
+	ret := _mock.Called(ctx, returnId, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveReturn")
+	}
+
+	var r0 *model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*model.OrderReturn, error)); ok {
+		return returnFunc(ctx, returnId, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *model.OrderReturn); ok {
+		r0 = returnFunc(ctx, returnId, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, returnId, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ReceiveReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveReturn'
+type MockIOrderUsecase_ReceiveReturn_Call struct {
+	*mock.Call
+}
+
+// ReceiveReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - returnId uuid.UUID
+//   - actor string
+func (_e *MockIOrderUsecase_Expecter) ReceiveReturn(ctx interface{}, returnId interface{}, actor interface{}) *MockIOrderUsecase_ReceiveReturn_Call {
+	return &MockIOrderUsecase_ReceiveReturn_Call{Call: _e.mock.On("ReceiveReturn", ctx, returnId, actor)}
+}
+
+func (_c *MockIOrderUsecase_ReceiveReturn_Call) Run(run func(ctx context.Context, returnId uuid.UUID, actor string)) *MockIOrderUsecase_ReceiveReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ReceiveReturn_Call) Return(orderReturn *model.OrderReturn, err error) *MockIOrderUsecase_ReceiveReturn_Call {
+	_c.Call.Return(orderReturn, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ReceiveReturn_Call) RunAndReturn(run func(ctx context.Context, returnId uuid.UUID, actor string) (*model.OrderReturn, error)) *MockIOrderUsecase_ReceiveReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectReturn provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) RejectReturn(ctx context.Context, returnId uuid.UUID, actor string, note string) (*model.OrderReturn, error) {
+	ret := _mock.Called(ctx, returnId, actor, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectReturn")
+	}
+
+	var r0 *model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*model.OrderReturn, error)); ok {
+		return returnFunc(ctx, returnId, actor, note)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *model.OrderReturn); ok {
+		r0 = returnFunc(ctx, returnId, actor, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, returnId, actor, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_RejectReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectReturn'
+type MockIOrderUsecase_RejectReturn_Call struct {
+	*mock.Call
+}
+
+// RejectReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - returnId uuid.UUID
+//   - actor string
+//   - note string
+func (_e *MockIOrderUsecase_Expecter) RejectReturn(ctx interface{}, returnId interface{}, actor interface{}, note interface{}) *MockIOrderUsecase_RejectReturn_Call {
+	return &MockIOrderUsecase_RejectReturn_Call{Call: _e.mock.On("RejectReturn", ctx, returnId, actor, note)}
+}
+
+func (_c *MockIOrderUsecase_RejectReturn_Call) Run(run func(ctx context.Context, returnId uuid.UUID, actor string, note string)) *MockIOrderUsecase_RejectReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_RejectReturn_Call) Return(orderReturn *model.OrderReturn, err error) *MockIOrderUsecase_RejectReturn_Call {
+	_c.Call.Return(orderReturn, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_RejectReturn_Call) RunAndReturn(run func(ctx context.Context, returnId uuid.UUID, actor string, note string) (*model.OrderReturn, error)) *MockIOrderUsecase_RejectReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayWebhookDelivery provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ReplayWebhookDelivery(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, deliveryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayWebhookDelivery")
+	}
+
+	var r0 *model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, deliveryId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, deliveryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_ReplayWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayWebhookDelivery'
+type MockIOrderUsecase_ReplayWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// ReplayWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryId uuid.UUID
+func (_e *MockIOrderUsecase_Expecter) ReplayWebhookDelivery(ctx interface{}, deliveryId interface{}) *MockIOrderUsecase_ReplayWebhookDelivery_Call {
+	return &MockIOrderUsecase_ReplayWebhookDelivery_Call{Call: _e.mock.On("ReplayWebhookDelivery", ctx, deliveryId)}
+}
+
+func (_c *MockIOrderUsecase_ReplayWebhookDelivery_Call) Run(run func(ctx context.Context, deliveryId uuid.UUID)) *MockIOrderUsecase_ReplayWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ReplayWebhookDelivery_Call) Return(webhookDelivery *model.WebhookDelivery, err error) *MockIOrderUsecase_ReplayWebhookDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ReplayWebhookDelivery_Call) RunAndReturn(run func(ctx context.Context, deliveryId uuid.UUID) (*model.WebhookDelivery, error)) *MockIOrderUsecase_ReplayWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestReturn provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) RequestReturn(ctx context.Context, orderId uuid.UUID, request types.ReturnRequest, customer model.Customer) (*model.OrderReturn, error) {
+	ret := _mock.Called(ctx, orderId, request, customer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for NewOrder")
+		panic("no return value specified for RequestReturn")
+	}
+
+	var r0 *model.OrderReturn
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ReturnRequest, model.Customer) (*model.OrderReturn, error)); ok {
+		return returnFunc(ctx, orderId, request, customer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ReturnRequest, model.Customer) *model.OrderReturn); ok {
+		r0 = returnFunc(ctx, orderId, request, customer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderReturn)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, types.ReturnRequest, model.Customer) error); ok {
+		r1 = returnFunc(ctx, orderId, request, customer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_RequestReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestReturn'
+type MockIOrderUsecase_RequestReturn_Call struct {
+	*mock.Call
+}
+
+// RequestReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderId uuid.UUID
+//   - request types.ReturnRequest
+//   - customer model.Customer
+func (_e *MockIOrderUsecase_Expecter) RequestReturn(ctx interface{}, orderId interface{}, request interface{}, customer interface{}) *MockIOrderUsecase_RequestReturn_Call {
+	return &MockIOrderUsecase_RequestReturn_Call{Call: _e.mock.On("RequestReturn", ctx, orderId, request, customer)}
+}
+
+func (_c *MockIOrderUsecase_RequestReturn_Call) Run(run func(ctx context.Context, orderId uuid.UUID, request types.ReturnRequest, customer model.Customer)) *MockIOrderUsecase_RequestReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 types.ReturnRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.ReturnRequest)
+		}
+		var arg3 model.Customer
+		if args[3] != nil {
+			arg3 = args[3].(model.Customer)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_RequestReturn_Call) Return(orderReturn *model.OrderReturn, err error) *MockIOrderUsecase_RequestReturn_Call {
+	_c.Call.Return(orderReturn, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_RequestReturn_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, request types.ReturnRequest, customer model.Customer) (*model.OrderReturn, error)) *MockIOrderUsecase_RequestReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryReservation provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) RetryReservation(ctx context.Context, orderId uuid.UUID, actor string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error) {
+	ret := _mock.Called(ctx, orderId, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryReservation")
 	}
 
 	var r0 *model.OrderWithItems
 	var r1 []*model.OrderedItemStockStatus
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)); ok {
-		return returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)); ok {
+		return returnFunc(ctx, orderId, actor)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, types.OrderRequest) *model.OrderWithItems); ok {
-		r0 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *model.OrderWithItems); ok {
+		r0 = returnFunc(ctx, orderId, actor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.OrderWithItems)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, types.OrderRequest) []*model.OrderedItemStockStatus); ok {
-		r1 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) []*model.OrderedItemStockStatus); ok {
+		r1 = returnFunc(ctx, orderId, actor)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]*model.OrderedItemStockStatus)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, types.OrderRequest) error); ok {
-		r2 = returnFunc(ctx, request)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
+		r2 = returnFunc(ctx, orderId, actor)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockIOrderUsecase_NewOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewOrder'
-type MockIOrderUsecase_NewOrder_Call struct {
+// MockIOrderUsecase_RetryReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryReservation'
+type MockIOrderUsecase_RetryReservation_Call struct {
 	*mock.Call
 }
 
-// NewOrder is a helper method to define mock.On call
+// RetryReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request types.OrderRequest
-func (_e *MockIOrderUsecase_Expecter) NewOrder(ctx interface{}, request interface{}) *MockIOrderUsecase_NewOrder_Call {
-	return &MockIOrderUsecase_NewOrder_Call{Call: _e.mock.On("NewOrder", ctx, request)}
+//   - orderId uuid.UUID
+//   - actor string
+func (_e *MockIOrderUsecase_Expecter) RetryReservation(ctx interface{}, orderId interface{}, actor interface{}) *MockIOrderUsecase_RetryReservation_Call {
+	return &MockIOrderUsecase_RetryReservation_Call{Call: _e.mock.On("RetryReservation", ctx, orderId, actor)}
 }
 
-func (_c *MockIOrderUsecase_NewOrder_Call) Run(run func(ctx context.Context, request types.OrderRequest)) *MockIOrderUsecase_NewOrder_Call {
+func (_c *MockIOrderUsecase_RetryReservation_Call) Run(run func(ctx context.Context, orderId uuid.UUID, actor string)) *MockIOrderUsecase_RetryReservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 types.OrderRequest
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(types.OrderRequest)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOrderUsecase_NewOrder_Call) Return(orderWithItems *model.OrderWithItems, vs []*model.OrderedItemStockStatus, err error) *MockIOrderUsecase_NewOrder_Call {
+func (_c *MockIOrderUsecase_RetryReservation_Call) Return(orderWithItems *model.OrderWithItems, vs []*model.OrderedItemStockStatus, err error) *MockIOrderUsecase_RetryReservation_Call {
 	_c.Call.Return(orderWithItems, vs, err)
 	return _c
 }
 
-func (_c *MockIOrderUsecase_NewOrder_Call) RunAndReturn(run func(ctx context.Context, request types.OrderRequest) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)) *MockIOrderUsecase_NewOrder_Call {
+func (_c *MockIOrderUsecase_RetryReservation_Call) RunAndReturn(run func(ctx context.Context, orderId uuid.UUID, actor string) (*model.OrderWithItems, []*model.OrderedItemStockStatus, error)) *MockIOrderUsecase_RetryReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchOrders provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) SearchOrders(ctx context.Context, filter model.OrderSearchFilter, actor string) (*model.OrderSearchResult, error) {
+	ret := _mock.Called(ctx, filter, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchOrders")
+	}
+
+	var r0 *model.OrderSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter, string) (*model.OrderSearchResult, error)); ok {
+		return returnFunc(ctx, filter, actor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, model.OrderSearchFilter, string) *model.OrderSearchResult); ok {
+		r0 = returnFunc(ctx, filter, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, model.OrderSearchFilter, string) error); ok {
+		r1 = returnFunc(ctx, filter, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_SearchOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchOrders'
+type MockIOrderUsecase_SearchOrders_Call struct {
+	*mock.Call
+}
+
+// SearchOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.OrderSearchFilter
+//   - actor string
+func (_e *MockIOrderUsecase_Expecter) SearchOrders(ctx interface{}, filter interface{}, actor interface{}) *MockIOrderUsecase_SearchOrders_Call {
+	return &MockIOrderUsecase_SearchOrders_Call{Call: _e.mock.On("SearchOrders", ctx, filter, actor)}
+}
+
+func (_c *MockIOrderUsecase_SearchOrders_Call) Run(run func(ctx context.Context, filter model.OrderSearchFilter, actor string)) *MockIOrderUsecase_SearchOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 model.OrderSearchFilter
+		if args[1] != nil {
+			arg1 = args[1].(model.OrderSearchFilter)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_SearchOrders_Call) Return(orderSearchResult *model.OrderSearchResult, err error) *MockIOrderUsecase_SearchOrders_Call {
+	_c.Call.Return(orderSearchResult, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_SearchOrders_Call) RunAndReturn(run func(ctx context.Context, filter model.OrderSearchFilter, actor string) (*model.OrderSearchResult, error)) *MockIOrderUsecase_SearchOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShipShipment provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) ShipShipment(ctx context.Context, shipmentId uuid.UUID, request types.ShipShipmentRequest) (*model.Shipment, bool, error) {
+	ret := _mock.Called(ctx, shipmentId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShipShipment")
+	}
+
+	var r0 *model.Shipment
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ShipShipmentRequest) (*model.Shipment, bool, error)); ok {
+		return returnFunc(ctx, shipmentId, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.ShipShipmentRequest) *model.Shipment); ok {
+		r0 = returnFunc(ctx, shipmentId, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shipment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, types.ShipShipmentRequest) bool); ok {
+		r1 = returnFunc(ctx, shipmentId, request)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, types.ShipShipmentRequest) error); ok {
+		r2 = returnFunc(ctx, shipmentId, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOrderUsecase_ShipShipment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShipShipment'
+type MockIOrderUsecase_ShipShipment_Call struct {
+	*mock.Call
+}
+
+// ShipShipment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shipmentId uuid.UUID
+//   - request types.ShipShipmentRequest
+func (_e *MockIOrderUsecase_Expecter) ShipShipment(ctx interface{}, shipmentId interface{}, request interface{}) *MockIOrderUsecase_ShipShipment_Call {
+	return &MockIOrderUsecase_ShipShipment_Call{Call: _e.mock.On("ShipShipment", ctx, shipmentId, request)}
+}
+
+func (_c *MockIOrderUsecase_ShipShipment_Call) Run(run func(ctx context.Context, shipmentId uuid.UUID, request types.ShipShipmentRequest)) *MockIOrderUsecase_ShipShipment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 types.ShipShipmentRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.ShipShipmentRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ShipShipment_Call) Return(shipment *model.Shipment, b bool, err error) *MockIOrderUsecase_ShipShipment_Call {
+	_c.Call.Return(shipment, b, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_ShipShipment_Call) RunAndReturn(run func(ctx context.Context, shipmentId uuid.UUID, request types.ShipShipmentRequest) (*model.Shipment, bool, error)) *MockIOrderUsecase_ShipShipment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeBackInStock provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) SubscribeBackInStock(ctx context.Context, sku string, email string) (*model.BackInStockSubscription, error) {
+	ret := _mock.Called(ctx, sku, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeBackInStock")
+	}
+
+	var r0 *model.BackInStockSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*model.BackInStockSubscription, error)); ok {
+		return returnFunc(ctx, sku, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *model.BackInStockSubscription); ok {
+		r0 = returnFunc(ctx, sku, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackInStockSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, sku, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_SubscribeBackInStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeBackInStock'
+type MockIOrderUsecase_SubscribeBackInStock_Call struct {
+	*mock.Call
+}
+
+// SubscribeBackInStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+//   - email string
+func (_e *MockIOrderUsecase_Expecter) SubscribeBackInStock(ctx interface{}, sku interface{}, email interface{}) *MockIOrderUsecase_SubscribeBackInStock_Call {
+	return &MockIOrderUsecase_SubscribeBackInStock_Call{Call: _e.mock.On("SubscribeBackInStock", ctx, sku, email)}
+}
+
+func (_c *MockIOrderUsecase_SubscribeBackInStock_Call) Run(run func(ctx context.Context, sku string, email string)) *MockIOrderUsecase_SubscribeBackInStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_SubscribeBackInStock_Call) Return(backInStockSubscription *model.BackInStockSubscription, err error) *MockIOrderUsecase_SubscribeBackInStock_Call {
+	_c.Call.Return(backInStockSubscription, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_SubscribeBackInStock_Call) RunAndReturn(run func(ctx context.Context, sku string, email string) (*model.BackInStockSubscription, error)) *MockIOrderUsecase_SubscribeBackInStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookEndpoint provides a mock function for the type MockIOrderUsecase
+func (_mock *MockIOrderUsecase) UpdateWebhookEndpoint(ctx context.Context, endpointId uuid.UUID, request types.UpdateWebhookEndpointRequest) (*model.WebhookEndpoint, error) {
+	ret := _mock.Called(ctx, endpointId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEndpoint")
+	}
+
+	var r0 *model.WebhookEndpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.UpdateWebhookEndpointRequest) (*model.WebhookEndpoint, error)); ok {
+		return returnFunc(ctx, endpointId, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, types.UpdateWebhookEndpointRequest) *model.WebhookEndpoint); ok {
+		r0 = returnFunc(ctx, endpointId, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WebhookEndpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, types.UpdateWebhookEndpointRequest) error); ok {
+		r1 = returnFunc(ctx, endpointId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOrderUsecase_UpdateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookEndpoint'
+type MockIOrderUsecase_UpdateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointId uuid.UUID
+//   - request types.UpdateWebhookEndpointRequest
+func (_e *MockIOrderUsecase_Expecter) UpdateWebhookEndpoint(ctx interface{}, endpointId interface{}, request interface{}) *MockIOrderUsecase_UpdateWebhookEndpoint_Call {
+	return &MockIOrderUsecase_UpdateWebhookEndpoint_Call{Call: _e.mock.On("UpdateWebhookEndpoint", ctx, endpointId, request)}
+}
+
+func (_c *MockIOrderUsecase_UpdateWebhookEndpoint_Call) Run(run func(ctx context.Context, endpointId uuid.UUID, request types.UpdateWebhookEndpointRequest)) *MockIOrderUsecase_UpdateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 types.UpdateWebhookEndpointRequest
+		if args[2] != nil {
+			arg2 = args[2].(types.UpdateWebhookEndpointRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOrderUsecase_UpdateWebhookEndpoint_Call) Return(webhookEndpoint *model.WebhookEndpoint, err error) *MockIOrderUsecase_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(webhookEndpoint, err)
+	return _c
+}
+
+func (_c *MockIOrderUsecase_UpdateWebhookEndpoint_Call) RunAndReturn(run func(ctx context.Context, endpointId uuid.UUID, request types.UpdateWebhookEndpointRequest) (*model.WebhookEndpoint, error)) *MockIOrderUsecase_UpdateWebhookEndpoint_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,15 +2,39 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
 CREATE SCHEMA IF NOT EXISTS order_service;
 
+-- discount rules redeemed with a coupon code, scope_values holds the skus or category ids of the scope
+CREATE TABLE IF NOT EXISTS order_service.promotions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(50) NOT NULL UNIQUE, -- stored upper case
+    name VARCHAR(100) NOT NULL,
+    discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('PERCENTAGE', 'FIXED', 'BUY_X_GET_Y')),
+    discount_value DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (discount_value >= 0),
+    buy_quantity INT NOT NULL DEFAULT 0 CHECK (buy_quantity >= 0),
+    get_quantity INT NOT NULL DEFAULT 0 CHECK (get_quantity >= 0),
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('ORDER', 'SKU', 'CATEGORY')),
+    scope_values TEXT[] NOT NULL DEFAULT '{}',
+    min_order_value DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    max_uses INT CHECK (max_uses > 0), -- unlimited when null
+    max_uses_per_user INT CHECK (max_uses_per_user > 0),
+    valid_from TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    valid_to TIMESTAMP WITH TIME ZONE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
 CREATE TABLE IF NOT EXISTS order_service.orders (
     id UUID PRIMARY KEY not null DEFAULT uuid_generate_v4(),
     user_id UUID NOT NULL,
     user_email VARCHAR(50) NOT NULL,
-    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'CONFIRMED', 'CANCELLED')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'CONFIRMED', 'FAILED_RESERVATION', 'BACKORDERED', 'CANCELLED', 'PAYMENT_FAILED', 'FULFILLED')),
     total_amount DECIMAL(10, 2) NOT NULL,
     currency VARCHAR(3) NOT NULL DEFAULT 'USD',
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    promotion_id UUID REFERENCES order_service.promotions(id), -- coupon the order was placed with
+    shipping_address JSONB, -- selects the tax rules, orders without one are not taxed
+    tax_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    prices_include_tax BOOLEAN NOT NULL DEFAULT FALSE -- tax_amount is part of total_amount, added to it otherwise
 );
 
 CREATE TABLE IF NOT EXISTS order_service.order_items (
@@ -20,11 +44,240 @@ CREATE TABLE IF NOT EXISTS order_service.order_items (
     quantity_per_uom DECIMAL(10, 2),
     price_per_uom DECIMAL(10, 2) NOT NULL,
     uom_code VARCHAR(20) NOT NULL, -- References inventory_service.units_of_measure(code)
+    confirmed_quantity DECIMAL(10, 2), -- reserved part, set with a per line reservation policy
+    short_quantity DECIMAL(10, 2), -- part that could not be reserved
     CONSTRAINT unique_order_sku UNIQUE (order_id, sku)
 );  
 
+-- part of the promotion of an order taken off each of its items, total_amount is net of them
+CREATE TABLE IF NOT EXISTS order_service.order_discounts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_service.order_items(id) ON DELETE CASCADE,
+    promotion_id UUID NOT NULL REFERENCES order_service.promotions(id),
+    code VARCHAR(50) NOT NULL,
+    sku VARCHAR(50) NOT NULL,
+    description VARCHAR(100) NOT NULL DEFAULT '',
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    CONSTRAINT unique_order_item_promotion UNIQUE (order_item_id, promotion_id)
+);
+
+-- tax of each order item in the jurisdiction of the shipping address, rounded per line or per order
+CREATE TABLE IF NOT EXISTS order_service.order_taxes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_service.order_items(id) ON DELETE CASCADE,
+    jurisdiction VARCHAR(20) NOT NULL,
+    sku VARCHAR(50) NOT NULL,
+    tax_category VARCHAR(20) NOT NULL,
+    rate DECIMAL(6, 3) NOT NULL CHECK (rate >= 0), -- percentage
+    taxable_amount DECIMAL(10, 2) NOT NULL,
+    tax_amount DECIMAL(10, 2) NOT NULL CHECK (tax_amount >= 0),
+    CONSTRAINT unique_order_item_tax UNIQUE (order_item_id)
+);
+
+-- changes made to an order after it was placed
+CREATE TABLE IF NOT EXISTS order_service.order_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    event VARCHAR(30) NOT NULL CHECK (event IN ('ITEMS_AMENDED')),
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- payment attempts of an order, the latest one is authorized, captured or refunded
+CREATE TABLE IF NOT EXISTS order_service.payments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    provider VARCHAR(30) NOT NULL,
+    payment_method VARCHAR(50) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'AUTHORIZED', 'DECLINED', 'FAILED', 'CAPTURED', 'VOIDED', 'REFUNDED')),
+    amount DECIMAL(10, 2) NOT NULL,
+    captured_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    refunded_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    authorization_ref VARCHAR(100),
+    capture_ref VARCHAR(100),
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- goods of a fulfilled order sent back by the customer, refund_amount is priced from order_items less their discounts
+CREATE TABLE IF NOT EXISTS order_service.returns (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('REQUESTED', 'APPROVED', 'REJECTED', 'RECEIVED', 'REFUNDED')),
+    reason TEXT NOT NULL DEFAULT '',
+    requested_by VARCHAR(50) NOT NULL DEFAULT '',
+    refund_amount DECIMAL(10, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    refund_ref VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS order_service.return_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    return_id UUID NOT NULL REFERENCES order_service.returns(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_service.order_items(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    quantity DECIMAL(10, 2) NOT NULL CHECK (quantity > 0),
+    price_per_uom DECIMAL(10, 2) NOT NULL,
+    uom_code VARCHAR(20) NOT NULL,
+    discount_amount DECIMAL(10, 2) NOT NULL DEFAULT 0, -- share of the order item discounts, not refunded
+    tax_amount DECIMAL(10, 2) NOT NULL DEFAULT 0, -- share of the order item tax, refunded when it was added to the order
+    CONSTRAINT unique_return_order_item UNIQUE (return_id, order_item_id)
+);
+
+-- every status change of a return, with who made it
+CREATE TABLE IF NOT EXISTS order_service.return_history (
+    id BIGSERIAL PRIMARY KEY,
+    return_id UUID NOT NULL REFERENCES order_service.returns(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(50) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- parcels of an order, PACKED shipments consume their reserved stock once they are SHIPPED
+CREATE TABLE IF NOT EXISTS order_service.shipments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    order_id UUID NOT NULL REFERENCES order_service.orders(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PACKED', 'SHIPPED', 'DELIVERED')),
+    carrier VARCHAR(50) NOT NULL,
+    tracking_number VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    shipped_at TIMESTAMP WITH TIME ZONE,
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TABLE IF NOT EXISTS order_service.shipment_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    shipment_id UUID NOT NULL REFERENCES order_service.shipments(id) ON DELETE CASCADE,
+    order_item_id UUID NOT NULL REFERENCES order_service.order_items(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    quantity DECIMAL(10, 2) NOT NULL CHECK (quantity > 0),
+    uom_code VARCHAR(20) NOT NULL,
+    CONSTRAINT unique_shipment_order_item UNIQUE (shipment_id, order_item_id)
+);
+
+-- partner urls notified about order events, event_types lists the events an endpoint receives
+CREATE TABLE IF NOT EXISTS order_service.webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    url TEXT NOT NULL,
+    secret VARCHAR(100) NOT NULL, -- signs the deliveries with HMAC-SHA256
+    event_types TEXT[] NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- event queued for an endpoint, failed deliveries are retried at next_attempt_at until they are DEAD
+CREATE TABLE IF NOT EXISTS order_service.webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    endpoint_id UUID NOT NULL REFERENCES order_service.webhook_endpoints(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_status_code INT,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT unique_webhook_delivery_event UNIQUE (endpoint_id, event_id)
+);
+
+-- every try to send a delivery, status_code is null when the endpoint did not answer
+CREATE TABLE IF NOT EXISTS order_service.webhook_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id UUID NOT NULL REFERENCES order_service.webhook_deliveries(id) ON DELETE CASCADE,
+    status_code INT,
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms BIGINT NOT NULL,
+    attempted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- actions of admins on orders, kept when the order is deleted so it has no foreign key
+CREATE TABLE IF NOT EXISTS order_service.admin_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(30) NOT NULL CHECK (action IN ('SEARCH_ORDERS', 'EXPORT_ORDERS', 'FORCE_STATUS', 'RETRY_RESERVATION')),
+    order_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- orders placed again on a schedule, next_run_at is null while the subscription is paused
+CREATE TABLE IF NOT EXISTS order_service.subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    user_email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('ACTIVE', 'PAUSED')),
+    schedule VARCHAR(100) NOT NULL, -- cron expression or @every <duration>
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    shortage_policy VARCHAR(20) NOT NULL CHECK (shortage_policy IN ('SKIP', 'PAUSE', 'PARTIAL', 'BACKORDER')),
+    payment_method VARCHAR(50),
+    shipping_address JSONB,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- the items ordered at every run of a subscription
+CREATE TABLE IF NOT EXISTS order_service.subscription_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL REFERENCES order_service.subscriptions(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    uom VARCHAR(20) NOT NULL,
+    quantity_per_uom DECIMAL(10, 2) NOT NULL CHECK (quantity_per_uom > 0),
+    CONSTRAINT unique_subscription_item_sku UNIQUE (subscription_id, sku)
+);
+
+-- one row per scheduled time of a subscription, the unique constraint keeps a time from being run twice
+CREATE TABLE IF NOT EXISTS order_service.subscription_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL REFERENCES order_service.subscriptions(id) ON DELETE CASCADE,
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('PENDING', 'PLACED', 'PARTIAL', 'BACKORDERED', 'SKIPPED', 'FAILED')),
+    order_id UUID REFERENCES order_service.orders(id),
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT unique_subscription_run UNIQUE (subscription_id, scheduled_for)
+);
+
 CREATE INDEX IF NOT EXISTS idx_order_user ON order_service.orders(user_id);
 CREATE INDEX IF NOT EXISTS idx_order_status ON order_service.orders(status);
 CREATE INDEX IF NOT EXISTS idx_order_created ON order_service.orders(created_at);
 CREATE INDEX IF NOT EXISTS idx_order_items_order ON order_service.order_items(order_id);
-CREATE INDEX IF NOT EXISTS idx_order_items_sku ON order_service.order_items(sku);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_order_items_sku ON order_service.order_items(sku);
+CREATE INDEX IF NOT EXISTS idx_order_promotion ON order_service.orders(promotion_id, user_id) WHERE promotion_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_order_discounts_order ON order_service.order_discounts(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_taxes_order ON order_service.order_taxes(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_history_order ON order_service.order_history(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payments_order ON order_service.payments(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_returns_order ON order_service.returns(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_return_items_return ON order_service.return_items(return_id);
+CREATE INDEX IF NOT EXISTS idx_return_items_order_item ON order_service.return_items(order_item_id);
+CREATE INDEX IF NOT EXISTS idx_return_history_return ON order_service.return_history(return_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_shipments_order ON order_service.shipments(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_shipment_items_shipment ON order_service.shipment_items(shipment_id);
+CREATE INDEX IF NOT EXISTS idx_shipment_items_order_item ON order_service.shipment_items(order_item_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON order_service.webhook_deliveries(next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created ON order_service.webhook_deliveries(created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_attempts_delivery ON order_service.webhook_attempts(delivery_id, attempted_at);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_order ON order_service.admin_audit_log(order_id, created_at) WHERE order_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_created ON order_service.admin_audit_log(created_at);
+CREATE INDEX IF NOT EXISTS idx_subscriptions_due ON order_service.subscriptions(next_run_at) WHERE status = 'ACTIVE';
+CREATE INDEX IF NOT EXISTS idx_subscriptions_user ON order_service.subscriptions(user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_subscription_items_subscription ON order_service.subscription_items(subscription_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_runs_subscription ON order_service.subscription_runs(subscription_id, scheduled_for);
\ No newline at end of file
@@ -49,5 +49,6 @@ func (h *userHandler) ValidateToken(ctx context.Context, req *userv1.ValidateTok
 		Valid:     true,
 		UserEmail: user.Email,
 		Roles:     user.Roles,
+		UserId:    user.ID,
 	}, nil
 }
\ No newline at end of file
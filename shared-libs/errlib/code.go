@@ -31,4 +31,36 @@ const (
 	// invetory
 	ErrCodeReservationStock string = "FAILED_RESERVE_STOCK"
 	ErrCodeReleaseStock     string = "FAILED_RELEASE_STOCK"
+
+	// order
+	ErrCodeQuotePriceChanged   string = "QUOTE_PRICE_CHANGED"
+	ErrCodeOrderNotAmendable   string = "ORDER_NOT_AMENDABLE"
+	ErrCodeOrderModified       string = "ORDER_MODIFIED"
+	ErrCodeOrderNotFulfillable string = "ORDER_NOT_FULFILLABLE"
+
+	// return
+	ErrCodeOrderNotReturnable string = "ORDER_NOT_RETURNABLE"
+	ErrCodeReturnStatus       string = "RETURN_STATUS_CONFLICT"
+
+	// shipment
+	ErrCodeOrderNotShippable string = "ORDER_NOT_SHIPPABLE"
+	ErrCodeShipmentStatus    string = "SHIPMENT_STATUS_CONFLICT"
+
+	// promotion
+	ErrCodeCouponNotApplicable string = "COUPON_NOT_APPLICABLE"
+
+	// webhook
+	ErrCodeWebhookDeliveryStatus string = "WEBHOOK_DELIVERY_STATUS_CONFLICT"
+
+	// admin
+	ErrCodeOrderNotReservable string = "ORDER_NOT_RESERVABLE"
+
+	// cart
+	ErrCodeCartEmpty              string = "CART_EMPTY"
+	ErrCodeCartFull               string = "CART_FULL"
+	ErrCodeCartCheckoutInProgress string = "CART_CHECKOUT_IN_PROGRESS"
+
+	// payment
+	ErrCodePaymentDeclined string = "PAYMENT_DECLINED"
+	ErrCodePaymentFailed   string = "PAYMENT_FAILED"
 )
@@ -86,3 +86,15 @@ func ErrJSONBinding(err error) *AppError {
 func ErrReservationStock(details interface{}) *AppError {
 	return NewAppErrorWithDetails(ErrCodeReservationStock, map[string]interface{}{"details": details})
 }
+
+func ErrQuotePriceChanged(details interface{}) *AppError {
+	return NewAppErrorWithDetails(ErrCodeQuotePriceChanged, map[string]interface{}{"details": details})
+}
+
+func ErrPaymentDeclined(details interface{}) *AppError {
+	return NewAppErrorWithDetails(ErrCodePaymentDeclined, map[string]interface{}{"details": details})
+}
+
+func ErrCouponNotApplicable(details interface{}) *AppError {
+	return NewAppErrorWithDetails(ErrCodeCouponNotApplicable, map[string]interface{}{"details": details})
+}
@@ -129,4 +129,100 @@ var errorRegistry = map[string]AppError{
 		Message: "Email already used",
 		Status:  http.StatusBadRequest,
 	},
+
+	// order errors
+	ErrCodeQuotePriceChanged: {
+		Code:    ErrCodeQuotePriceChanged,
+		Message: "Prices have changed since the quote was issued",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeOrderNotAmendable: {
+		Code:    ErrCodeOrderNotAmendable,
+		Message: "Order items can only be changed while the order is PENDING or CONFIRMED",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeOrderModified: {
+		Code:    ErrCodeOrderModified,
+		Message: "Order was modified concurrently, try again",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeOrderNotFulfillable: {
+		Code:    ErrCodeOrderNotFulfillable,
+		Message: "Only CONFIRMED orders with an authorized payment can be fulfilled",
+		Status:  http.StatusConflict,
+	},
+
+	// return errors
+	ErrCodeOrderNotReturnable: {
+		Code:    ErrCodeOrderNotReturnable,
+		Message: "Only FULFILLED orders can be returned",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeReturnStatus: {
+		Code:    ErrCodeReturnStatus,
+		Message: "The return status does not allow this action",
+		Status:  http.StatusConflict,
+	},
+
+	// shipment errors
+	ErrCodeOrderNotShippable: {
+		Code:    ErrCodeOrderNotShippable,
+		Message: "Only CONFIRMED orders can be shipped",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeShipmentStatus: {
+		Code:    ErrCodeShipmentStatus,
+		Message: "The shipment status does not allow this action",
+		Status:  http.StatusConflict,
+	},
+
+	// promotion errors
+	ErrCodeCouponNotApplicable: {
+		Code:    ErrCodeCouponNotApplicable,
+		Message: "The coupon cannot be applied to this order",
+		Status:  http.StatusUnprocessableEntity,
+	},
+
+	// webhook errors
+	ErrCodeWebhookDeliveryStatus: {
+		Code:    ErrCodeWebhookDeliveryStatus,
+		Message: "The webhook delivery is already queued",
+		Status:  http.StatusConflict,
+	},
+
+	// admin errors
+	ErrCodeOrderNotReservable: {
+		Code:    ErrCodeOrderNotReservable,
+		Message: "Only orders that failed reservation can be reserved again",
+		Status:  http.StatusConflict,
+	},
+
+	// cart errors
+	ErrCodeCartEmpty: {
+		Code:    ErrCodeCartEmpty,
+		Message: "The cart has no items",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeCartFull: {
+		Code:    ErrCodeCartFull,
+		Message: "The cart holds the most items it can",
+		Status:  http.StatusConflict,
+	},
+	ErrCodeCartCheckoutInProgress: {
+		Code:    ErrCodeCartCheckoutInProgress,
+		Message: "The cart is already being checked out",
+		Status:  http.StatusConflict,
+	},
+
+	// payment errors
+	ErrCodePaymentDeclined: {
+		Code:    ErrCodePaymentDeclined,
+		Message: "Payment was declined",
+		Status:  http.StatusPaymentRequired,
+	},
+	ErrCodePaymentFailed: {
+		Code:    ErrCodePaymentFailed,
+		Message: "Payment could not be processed, try again later",
+		Status:  http.StatusBadGateway,
+	},
 }
@@ -7,19 +7,20 @@ package grpc
 import (
 	"context"
 	"pb_schemas/inventory/v1"
+	"pb_schemas/notification/v1"
 	"pb_schemas/user/v1"
 
 	mock "github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
 )
 
-// NewMockInvClient creates a new instance of MockInvClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockBackInStockClient creates a new instance of MockBackInStockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockInvClient(t interface {
+func NewMockBackInStockClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockInvClient {
-	mock := &MockInvClient{}
+}) *MockBackInStockClient {
+	mock := &MockBackInStockClient{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -27,21 +28,21 @@ func NewMockInvClient(t interface {
 	return mock
 }
 
-// MockInvClient is an autogenerated mock type for the InvClient type
-type MockInvClient struct {
+// MockBackInStockClient is an autogenerated mock type for the BackInStockClient type
+type MockBackInStockClient struct {
 	mock.Mock
 }
 
-type MockInvClient_Expecter struct {
+type MockBackInStockClient_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockInvClient) EXPECT() *MockInvClient_Expecter {
-	return &MockInvClient_Expecter{mock: &_m.Mock}
+func (_m *MockBackInStockClient) EXPECT() *MockBackInStockClient_Expecter {
+	return &MockBackInStockClient_Expecter{mock: &_m.Mock}
 }
 
-// CheckStock provides a mock function for the type MockInvClient
-func (_mock *MockInvClient) CheckStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error) {
+// SubscribeBackInStock provides a mock function for the type MockBackInStockClient
+func (_mock *MockBackInStockClient) SubscribeBackInStock(ctx context.Context, in *inventoryv1.SubscribeBackInStockRequest, opts ...grpc.CallOption) (*inventoryv1.BackInStockSubscriptionResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -51,22 +52,22 @@ func (_mock *MockInvClient) CheckStock(ctx context.Context, in *inventoryv1.Stan
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for CheckStock")
+		panic("no return value specified for SubscribeBackInStock")
 	}
 
-	var r0 *inventoryv1.InventoryStatusResponse
+	var r0 *inventoryv1.BackInStockSubscriptionResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SubscribeBackInStockRequest, ...grpc.CallOption) (*inventoryv1.BackInStockSubscriptionResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryStatusResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SubscribeBackInStockRequest, ...grpc.CallOption) *inventoryv1.BackInStockSubscriptionResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventoryv1.InventoryStatusResponse)
+			r0 = ret.Get(0).(*inventoryv1.BackInStockSubscriptionResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.SubscribeBackInStockRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -74,29 +75,29 @@ func (_mock *MockInvClient) CheckStock(ctx context.Context, in *inventoryv1.Stan
 	return r0, r1
 }
 
-// MockInvClient_CheckStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckStock'
-type MockInvClient_CheckStock_Call struct {
+// MockBackInStockClient_SubscribeBackInStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeBackInStock'
+type MockBackInStockClient_SubscribeBackInStock_Call struct {
 	*mock.Call
 }
 
-// CheckStock is a helper method to define mock.On call
+// SubscribeBackInStock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *inventoryv1.StandardInventoryRequest
+//   - in *inventoryv1.SubscribeBackInStockRequest
 //   - opts ...grpc.CallOption
-func (_e *MockInvClient_Expecter) CheckStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_CheckStock_Call {
-	return &MockInvClient_CheckStock_Call{Call: _e.mock.On("CheckStock",
+func (_e *MockBackInStockClient_Expecter) SubscribeBackInStock(ctx interface{}, in interface{}, opts ...interface{}) *MockBackInStockClient_SubscribeBackInStock_Call {
+	return &MockBackInStockClient_SubscribeBackInStock_Call{Call: _e.mock.On("SubscribeBackInStock",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockInvClient_CheckStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_CheckStock_Call {
+func (_c *MockBackInStockClient_SubscribeBackInStock_Call) Run(run func(ctx context.Context, in *inventoryv1.SubscribeBackInStockRequest, opts ...grpc.CallOption)) *MockBackInStockClient_SubscribeBackInStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *inventoryv1.StandardInventoryRequest
+		var arg1 *inventoryv1.SubscribeBackInStockRequest
 		if args[1] != nil {
-			arg1 = args[1].(*inventoryv1.StandardInventoryRequest)
+			arg1 = args[1].(*inventoryv1.SubscribeBackInStockRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -113,18 +114,45 @@ func (_c *MockInvClient_CheckStock_Call) Run(run func(ctx context.Context, in *i
 	return _c
 }
 
-func (_c *MockInvClient_CheckStock_Call) Return(inventoryStatusResponse *inventoryv1.InventoryStatusResponse, err error) *MockInvClient_CheckStock_Call {
-	_c.Call.Return(inventoryStatusResponse, err)
+func (_c *MockBackInStockClient_SubscribeBackInStock_Call) Return(backInStockSubscriptionResponse *inventoryv1.BackInStockSubscriptionResponse, err error) *MockBackInStockClient_SubscribeBackInStock_Call {
+	_c.Call.Return(backInStockSubscriptionResponse, err)
 	return _c
 }
 
-func (_c *MockInvClient_CheckStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error)) *MockInvClient_CheckStock_Call {
+func (_c *MockBackInStockClient_SubscribeBackInStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.SubscribeBackInStockRequest, opts ...grpc.CallOption) (*inventoryv1.BackInStockSubscriptionResponse, error)) *MockBackInStockClient_SubscribeBackInStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReleaseStock provides a mock function for the type MockInvClient
-func (_mock *MockInvClient) ReleaseStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error) {
+// NewMockBackorderClient creates a new instance of MockBackorderClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackorderClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBackorderClient {
+	mock := &MockBackorderClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBackorderClient is an autogenerated mock type for the BackorderClient type
+type MockBackorderClient struct {
+	mock.Mock
+}
+
+type MockBackorderClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackorderClient) EXPECT() *MockBackorderClient_Expecter {
+	return &MockBackorderClient_Expecter{mock: &_m.Mock}
+}
+
+// AcknowledgeBackorderEvents provides a mock function for the type MockBackorderClient
+func (_mock *MockBackorderClient) AcknowledgeBackorderEvents(ctx context.Context, in *inventoryv1.AcknowledgeBackorderEventsRequest, opts ...grpc.CallOption) (*inventoryv1.AcknowledgeBackorderEventsResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -134,22 +162,215 @@ func (_mock *MockInvClient) ReleaseStock(ctx context.Context, in *inventoryv1.St
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReleaseStock")
+		panic("no return value specified for AcknowledgeBackorderEvents")
+	}
+
+	var r0 *inventoryv1.AcknowledgeBackorderEventsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.AcknowledgeBackorderEventsRequest, ...grpc.CallOption) (*inventoryv1.AcknowledgeBackorderEventsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.AcknowledgeBackorderEventsRequest, ...grpc.CallOption) *inventoryv1.AcknowledgeBackorderEventsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.AcknowledgeBackorderEventsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.AcknowledgeBackorderEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBackorderClient_AcknowledgeBackorderEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeBackorderEvents'
+type MockBackorderClient_AcknowledgeBackorderEvents_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeBackorderEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.AcknowledgeBackorderEventsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBackorderClient_Expecter) AcknowledgeBackorderEvents(ctx interface{}, in interface{}, opts ...interface{}) *MockBackorderClient_AcknowledgeBackorderEvents_Call {
+	return &MockBackorderClient_AcknowledgeBackorderEvents_Call{Call: _e.mock.On("AcknowledgeBackorderEvents",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBackorderClient_AcknowledgeBackorderEvents_Call) Run(run func(ctx context.Context, in *inventoryv1.AcknowledgeBackorderEventsRequest, opts ...grpc.CallOption)) *MockBackorderClient_AcknowledgeBackorderEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.AcknowledgeBackorderEventsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.AcknowledgeBackorderEventsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBackorderClient_AcknowledgeBackorderEvents_Call) Return(acknowledgeBackorderEventsResponse *inventoryv1.AcknowledgeBackorderEventsResponse, err error) *MockBackorderClient_AcknowledgeBackorderEvents_Call {
+	_c.Call.Return(acknowledgeBackorderEventsResponse, err)
+	return _c
+}
+
+func (_c *MockBackorderClient_AcknowledgeBackorderEvents_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.AcknowledgeBackorderEventsRequest, opts ...grpc.CallOption) (*inventoryv1.AcknowledgeBackorderEventsResponse, error)) *MockBackorderClient_AcknowledgeBackorderEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBackorderEvents provides a mock function for the type MockBackorderClient
+func (_mock *MockBackorderClient) ListBackorderEvents(ctx context.Context, in *inventoryv1.ListBackorderEventsRequest, opts ...grpc.CallOption) (*inventoryv1.ListBackorderEventsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackorderEvents")
+	}
+
+	var r0 *inventoryv1.ListBackorderEventsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.ListBackorderEventsRequest, ...grpc.CallOption) (*inventoryv1.ListBackorderEventsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.ListBackorderEventsRequest, ...grpc.CallOption) *inventoryv1.ListBackorderEventsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.ListBackorderEventsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.ListBackorderEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBackorderClient_ListBackorderEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackorderEvents'
+type MockBackorderClient_ListBackorderEvents_Call struct {
+	*mock.Call
+}
+
+// ListBackorderEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.ListBackorderEventsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBackorderClient_Expecter) ListBackorderEvents(ctx interface{}, in interface{}, opts ...interface{}) *MockBackorderClient_ListBackorderEvents_Call {
+	return &MockBackorderClient_ListBackorderEvents_Call{Call: _e.mock.On("ListBackorderEvents",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBackorderClient_ListBackorderEvents_Call) Run(run func(ctx context.Context, in *inventoryv1.ListBackorderEventsRequest, opts ...grpc.CallOption)) *MockBackorderClient_ListBackorderEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.ListBackorderEventsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.ListBackorderEventsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBackorderClient_ListBackorderEvents_Call) Return(listBackorderEventsResponse *inventoryv1.ListBackorderEventsResponse, err error) *MockBackorderClient_ListBackorderEvents_Call {
+	_c.Call.Return(listBackorderEventsResponse, err)
+	return _c
+}
+
+func (_c *MockBackorderClient_ListBackorderEvents_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.ListBackorderEventsRequest, opts ...grpc.CallOption) (*inventoryv1.ListBackorderEventsResponse, error)) *MockBackorderClient_ListBackorderEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInvClient creates a new instance of MockInvClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInvClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInvClient {
+	mock := &MockInvClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInvClient is an autogenerated mock type for the InvClient type
+type MockInvClient struct {
+	mock.Mock
+}
+
+type MockInvClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInvClient) EXPECT() *MockInvClient_Expecter {
+	return &MockInvClient_Expecter{mock: &_m.Mock}
+}
+
+// AmendReservation provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) AmendReservation(ctx context.Context, in *inventoryv1.AmendReservationRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendReservation")
 	}
 
 	var r0 *inventoryv1.InventoryReservationResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.AmendReservationRequest, ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryReservationResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.AmendReservationRequest, ...grpc.CallOption) *inventoryv1.InventoryReservationResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*inventoryv1.InventoryReservationResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.AmendReservationRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -157,29 +378,29 @@ func (_mock *MockInvClient) ReleaseStock(ctx context.Context, in *inventoryv1.St
 	return r0, r1
 }
 
-// MockInvClient_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
-type MockInvClient_ReleaseStock_Call struct {
+// MockInvClient_AmendReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendReservation'
+type MockInvClient_AmendReservation_Call struct {
 	*mock.Call
 }
 
-// ReleaseStock is a helper method to define mock.On call
+// AmendReservation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *inventoryv1.StandardInventoryRequest
+//   - in *inventoryv1.AmendReservationRequest
 //   - opts ...grpc.CallOption
-func (_e *MockInvClient_Expecter) ReleaseStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_ReleaseStock_Call {
-	return &MockInvClient_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock",
+func (_e *MockInvClient_Expecter) AmendReservation(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_AmendReservation_Call {
+	return &MockInvClient_AmendReservation_Call{Call: _e.mock.On("AmendReservation",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockInvClient_ReleaseStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_ReleaseStock_Call {
+func (_c *MockInvClient_AmendReservation_Call) Run(run func(ctx context.Context, in *inventoryv1.AmendReservationRequest, opts ...grpc.CallOption)) *MockInvClient_AmendReservation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *inventoryv1.StandardInventoryRequest
+		var arg1 *inventoryv1.AmendReservationRequest
 		if args[1] != nil {
-			arg1 = args[1].(*inventoryv1.StandardInventoryRequest)
+			arg1 = args[1].(*inventoryv1.AmendReservationRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -196,18 +417,18 @@ func (_c *MockInvClient_ReleaseStock_Call) Run(run func(ctx context.Context, in
 	return _c
 }
 
-func (_c *MockInvClient_ReleaseStock_Call) Return(inventoryReservationResponse *inventoryv1.InventoryReservationResponse, err error) *MockInvClient_ReleaseStock_Call {
+func (_c *MockInvClient_AmendReservation_Call) Return(inventoryReservationResponse *inventoryv1.InventoryReservationResponse, err error) *MockInvClient_AmendReservation_Call {
 	_c.Call.Return(inventoryReservationResponse, err)
 	return _c
 }
 
-func (_c *MockInvClient_ReleaseStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)) *MockInvClient_ReleaseStock_Call {
+func (_c *MockInvClient_AmendReservation_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.AmendReservationRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)) *MockInvClient_AmendReservation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReserveStock provides a mock function for the type MockInvClient
-func (_mock *MockInvClient) ReserveStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error) {
+// CheckStock provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) CheckStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -217,19 +438,19 @@ func (_mock *MockInvClient) ReserveStock(ctx context.Context, in *inventoryv1.St
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReserveStock")
+		panic("no return value specified for CheckStock")
 	}
 
-	var r0 *inventoryv1.InventoryReservationResponse
+	var r0 *inventoryv1.InventoryStatusResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryReservationResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryStatusResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*inventoryv1.InventoryReservationResponse)
+			r0 = ret.Get(0).(*inventoryv1.InventoryStatusResponse)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) error); ok {
@@ -240,21 +461,21 @@ func (_mock *MockInvClient) ReserveStock(ctx context.Context, in *inventoryv1.St
 	return r0, r1
 }
 
-// MockInvClient_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
-type MockInvClient_ReserveStock_Call struct {
+// MockInvClient_CheckStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckStock'
+type MockInvClient_CheckStock_Call struct {
 	*mock.Call
 }
 
-// ReserveStock is a helper method to define mock.On call
+// CheckStock is a helper method to define mock.On call
 //   - ctx context.Context
 //   - in *inventoryv1.StandardInventoryRequest
 //   - opts ...grpc.CallOption
-func (_e *MockInvClient_Expecter) ReserveStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_ReserveStock_Call {
-	return &MockInvClient_ReserveStock_Call{Call: _e.mock.On("ReserveStock",
+func (_e *MockInvClient_Expecter) CheckStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_CheckStock_Call {
+	return &MockInvClient_CheckStock_Call{Call: _e.mock.On("CheckStock",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockInvClient_ReserveStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_ReserveStock_Call {
+func (_c *MockInvClient_CheckStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_CheckStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -279,12 +500,952 @@ func (_c *MockInvClient_ReserveStock_Call) Run(run func(ctx context.Context, in
 	return _c
 }
 
-func (_c *MockInvClient_ReserveStock_Call) Return(inventoryReservationResponse *inventoryv1.InventoryReservationResponse, err error) *MockInvClient_ReserveStock_Call {
-	_c.Call.Return(inventoryReservationResponse, err)
+func (_c *MockInvClient_CheckStock_Call) Return(inventoryStatusResponse *inventoryv1.InventoryStatusResponse, err error) *MockInvClient_CheckStock_Call {
+	_c.Call.Return(inventoryStatusResponse, err)
 	return _c
 }
 
-func (_c *MockInvClient_ReserveStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)) *MockInvClient_ReserveStock_Call {
+func (_c *MockInvClient_CheckStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryStatusResponse, error)) *MockInvClient_CheckStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitReservation provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) CommitReservation(ctx context.Context, in *inventoryv1.CommitReservationRequest, opts ...grpc.CallOption) (*inventoryv1.CommitReservationResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 *inventoryv1.CommitReservationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.CommitReservationRequest, ...grpc.CallOption) (*inventoryv1.CommitReservationResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.CommitReservationRequest, ...grpc.CallOption) *inventoryv1.CommitReservationResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.CommitReservationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.CommitReservationRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_CommitReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitReservation'
+type MockInvClient_CommitReservation_Call struct {
+	*mock.Call
+}
+
+// CommitReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.CommitReservationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) CommitReservation(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_CommitReservation_Call {
+	return &MockInvClient_CommitReservation_Call{Call: _e.mock.On("CommitReservation",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_CommitReservation_Call) Run(run func(ctx context.Context, in *inventoryv1.CommitReservationRequest, opts ...grpc.CallOption)) *MockInvClient_CommitReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.CommitReservationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.CommitReservationRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_CommitReservation_Call) Return(commitReservationResponse *inventoryv1.CommitReservationResponse, err error) *MockInvClient_CommitReservation_Call {
+	_c.Call.Return(commitReservationResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_CommitReservation_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.CommitReservationRequest, opts ...grpc.CallOption) (*inventoryv1.CommitReservationResponse, error)) *MockInvClient_CommitReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DefineBundle provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) DefineBundle(ctx context.Context, in *inventoryv1.DefineBundleRequest, opts ...grpc.CallOption) (*inventoryv1.BundleResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DefineBundle")
+	}
+
+	var r0 *inventoryv1.BundleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.DefineBundleRequest, ...grpc.CallOption) (*inventoryv1.BundleResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.DefineBundleRequest, ...grpc.CallOption) *inventoryv1.BundleResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.BundleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.DefineBundleRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_DefineBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefineBundle'
+type MockInvClient_DefineBundle_Call struct {
+	*mock.Call
+}
+
+// DefineBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.DefineBundleRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) DefineBundle(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_DefineBundle_Call {
+	return &MockInvClient_DefineBundle_Call{Call: _e.mock.On("DefineBundle",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_DefineBundle_Call) Run(run func(ctx context.Context, in *inventoryv1.DefineBundleRequest, opts ...grpc.CallOption)) *MockInvClient_DefineBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.DefineBundleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.DefineBundleRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_DefineBundle_Call) Return(bundleResponse *inventoryv1.BundleResponse, err error) *MockInvClient_DefineBundle_Call {
+	_c.Call.Return(bundleResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_DefineBundle_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.DefineBundleRequest, opts ...grpc.CallOption) (*inventoryv1.BundleResponse, error)) *MockInvClient_DefineBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DefineSubstitutes provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) DefineSubstitutes(ctx context.Context, in *inventoryv1.DefineSubstitutesRequest, opts ...grpc.CallOption) (*inventoryv1.SubstitutesResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DefineSubstitutes")
+	}
+
+	var r0 *inventoryv1.SubstitutesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.DefineSubstitutesRequest, ...grpc.CallOption) (*inventoryv1.SubstitutesResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.DefineSubstitutesRequest, ...grpc.CallOption) *inventoryv1.SubstitutesResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.SubstitutesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.DefineSubstitutesRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_DefineSubstitutes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefineSubstitutes'
+type MockInvClient_DefineSubstitutes_Call struct {
+	*mock.Call
+}
+
+// DefineSubstitutes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.DefineSubstitutesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) DefineSubstitutes(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_DefineSubstitutes_Call {
+	return &MockInvClient_DefineSubstitutes_Call{Call: _e.mock.On("DefineSubstitutes",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_DefineSubstitutes_Call) Run(run func(ctx context.Context, in *inventoryv1.DefineSubstitutesRequest, opts ...grpc.CallOption)) *MockInvClient_DefineSubstitutes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.DefineSubstitutesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.DefineSubstitutesRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_DefineSubstitutes_Call) Return(substitutesResponse *inventoryv1.SubstitutesResponse, err error) *MockInvClient_DefineSubstitutes_Call {
+	_c.Call.Return(substitutesResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_DefineSubstitutes_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.DefineSubstitutesRequest, opts ...grpc.CallOption) (*inventoryv1.SubstitutesResponse, error)) *MockInvClient_DefineSubstitutes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStockAsOf provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) GetStockAsOf(ctx context.Context, in *inventoryv1.GetStockAsOfRequest, opts ...grpc.CallOption) (*inventoryv1.GetStockAsOfResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockAsOf")
+	}
+
+	var r0 *inventoryv1.GetStockAsOfResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetStockAsOfRequest, ...grpc.CallOption) (*inventoryv1.GetStockAsOfResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.GetStockAsOfRequest, ...grpc.CallOption) *inventoryv1.GetStockAsOfResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.GetStockAsOfResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.GetStockAsOfRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_GetStockAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStockAsOf'
+type MockInvClient_GetStockAsOf_Call struct {
+	*mock.Call
+}
+
+// GetStockAsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.GetStockAsOfRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) GetStockAsOf(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_GetStockAsOf_Call {
+	return &MockInvClient_GetStockAsOf_Call{Call: _e.mock.On("GetStockAsOf",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_GetStockAsOf_Call) Run(run func(ctx context.Context, in *inventoryv1.GetStockAsOfRequest, opts ...grpc.CallOption)) *MockInvClient_GetStockAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.GetStockAsOfRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.GetStockAsOfRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_GetStockAsOf_Call) Return(getStockAsOfResponse *inventoryv1.GetStockAsOfResponse, err error) *MockInvClient_GetStockAsOf_Call {
+	_c.Call.Return(getStockAsOfResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_GetStockAsOf_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.GetStockAsOfRequest, opts ...grpc.CallOption) (*inventoryv1.GetStockAsOfResponse, error)) *MockInvClient_GetStockAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReservations provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) ListReservations(ctx context.Context, in *inventoryv1.ListReservationsRequest, opts ...grpc.CallOption) (*inventoryv1.ListReservationsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReservations")
+	}
+
+	var r0 *inventoryv1.ListReservationsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.ListReservationsRequest, ...grpc.CallOption) (*inventoryv1.ListReservationsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.ListReservationsRequest, ...grpc.CallOption) *inventoryv1.ListReservationsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.ListReservationsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.ListReservationsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_ListReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReservations'
+type MockInvClient_ListReservations_Call struct {
+	*mock.Call
+}
+
+// ListReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.ListReservationsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) ListReservations(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_ListReservations_Call {
+	return &MockInvClient_ListReservations_Call{Call: _e.mock.On("ListReservations",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_ListReservations_Call) Run(run func(ctx context.Context, in *inventoryv1.ListReservationsRequest, opts ...grpc.CallOption)) *MockInvClient_ListReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.ListReservationsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.ListReservationsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_ListReservations_Call) Return(listReservationsResponse *inventoryv1.ListReservationsResponse, err error) *MockInvClient_ListReservations_Call {
+	_c.Call.Return(listReservationsResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_ListReservations_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.ListReservationsRequest, opts ...grpc.CallOption) (*inventoryv1.ListReservationsResponse, error)) *MockInvClient_ListReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStock provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) ReleaseStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 *inventoryv1.InventoryReservationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryReservationResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.InventoryReservationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type MockInvClient_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.StandardInventoryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) ReleaseStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_ReleaseStock_Call {
+	return &MockInvClient_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_ReleaseStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.StandardInventoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.StandardInventoryRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_ReleaseStock_Call) Return(inventoryReservationResponse *inventoryv1.InventoryReservationResponse, err error) *MockInvClient_ReleaseStock_Call {
+	_c.Call.Return(inventoryReservationResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_ReleaseStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)) *MockInvClient_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) ReserveStock(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 *inventoryv1.InventoryReservationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) *inventoryv1.InventoryReservationResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.InventoryReservationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.StandardInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockInvClient_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.StandardInventoryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) ReserveStock(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_ReserveStock_Call {
+	return &MockInvClient_ReserveStock_Call{Call: _e.mock.On("ReserveStock",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_ReserveStock_Call) Run(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption)) *MockInvClient_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.StandardInventoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.StandardInventoryRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_ReserveStock_Call) Return(inventoryReservationResponse *inventoryv1.InventoryReservationResponse, err error) *MockInvClient_ReserveStock_Call {
+	_c.Call.Return(inventoryReservationResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_ReserveStock_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.StandardInventoryRequest, opts ...grpc.CallOption) (*inventoryv1.InventoryReservationResponse, error)) *MockInvClient_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestockReturn provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) RestockReturn(ctx context.Context, in *inventoryv1.RestockReturnRequest, opts ...grpc.CallOption) (*inventoryv1.RestockReturnResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestockReturn")
+	}
+
+	var r0 *inventoryv1.RestockReturnResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.RestockReturnRequest, ...grpc.CallOption) (*inventoryv1.RestockReturnResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.RestockReturnRequest, ...grpc.CallOption) *inventoryv1.RestockReturnResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.RestockReturnResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.RestockReturnRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_RestockReturn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestockReturn'
+type MockInvClient_RestockReturn_Call struct {
+	*mock.Call
+}
+
+// RestockReturn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.RestockReturnRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) RestockReturn(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_RestockReturn_Call {
+	return &MockInvClient_RestockReturn_Call{Call: _e.mock.On("RestockReturn",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_RestockReturn_Call) Run(run func(ctx context.Context, in *inventoryv1.RestockReturnRequest, opts ...grpc.CallOption)) *MockInvClient_RestockReturn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.RestockReturnRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.RestockReturnRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_RestockReturn_Call) Return(restockReturnResponse *inventoryv1.RestockReturnResponse, err error) *MockInvClient_RestockReturn_Call {
+	_c.Call.Return(restockReturnResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_RestockReturn_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.RestockReturnRequest, opts ...grpc.CallOption) (*inventoryv1.RestockReturnResponse, error)) *MockInvClient_RestockReturn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSkus provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) SearchSkus(ctx context.Context, in *inventoryv1.SearchSkusRequest, opts ...grpc.CallOption) (*inventoryv1.SearchSkusResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchSkus")
+	}
+
+	var r0 *inventoryv1.SearchSkusResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SearchSkusRequest, ...grpc.CallOption) (*inventoryv1.SearchSkusResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SearchSkusRequest, ...grpc.CallOption) *inventoryv1.SearchSkusResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.SearchSkusResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.SearchSkusRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_SearchSkus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSkus'
+type MockInvClient_SearchSkus_Call struct {
+	*mock.Call
+}
+
+// SearchSkus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.SearchSkusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) SearchSkus(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_SearchSkus_Call {
+	return &MockInvClient_SearchSkus_Call{Call: _e.mock.On("SearchSkus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_SearchSkus_Call) Run(run func(ctx context.Context, in *inventoryv1.SearchSkusRequest, opts ...grpc.CallOption)) *MockInvClient_SearchSkus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.SearchSkusRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.SearchSkusRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_SearchSkus_Call) Return(searchSkusResponse *inventoryv1.SearchSkusResponse, err error) *MockInvClient_SearchSkus_Call {
+	_c.Call.Return(searchSkusResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_SearchSkus_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.SearchSkusRequest, opts ...grpc.CallOption) (*inventoryv1.SearchSkusResponse, error)) *MockInvClient_SearchSkus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestAlternatives provides a mock function for the type MockInvClient
+func (_mock *MockInvClient) SuggestAlternatives(ctx context.Context, in *inventoryv1.SuggestAlternativesRequest, opts ...grpc.CallOption) (*inventoryv1.SuggestAlternativesResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestAlternatives")
+	}
+
+	var r0 *inventoryv1.SuggestAlternativesResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SuggestAlternativesRequest, ...grpc.CallOption) (*inventoryv1.SuggestAlternativesResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *inventoryv1.SuggestAlternativesRequest, ...grpc.CallOption) *inventoryv1.SuggestAlternativesResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inventoryv1.SuggestAlternativesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *inventoryv1.SuggestAlternativesRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInvClient_SuggestAlternatives_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestAlternatives'
+type MockInvClient_SuggestAlternatives_Call struct {
+	*mock.Call
+}
+
+// SuggestAlternatives is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *inventoryv1.SuggestAlternativesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockInvClient_Expecter) SuggestAlternatives(ctx interface{}, in interface{}, opts ...interface{}) *MockInvClient_SuggestAlternatives_Call {
+	return &MockInvClient_SuggestAlternatives_Call{Call: _e.mock.On("SuggestAlternatives",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockInvClient_SuggestAlternatives_Call) Run(run func(ctx context.Context, in *inventoryv1.SuggestAlternativesRequest, opts ...grpc.CallOption)) *MockInvClient_SuggestAlternatives_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *inventoryv1.SuggestAlternativesRequest
+		if args[1] != nil {
+			arg1 = args[1].(*inventoryv1.SuggestAlternativesRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInvClient_SuggestAlternatives_Call) Return(suggestAlternativesResponse *inventoryv1.SuggestAlternativesResponse, err error) *MockInvClient_SuggestAlternatives_Call {
+	_c.Call.Return(suggestAlternativesResponse, err)
+	return _c
+}
+
+func (_c *MockInvClient_SuggestAlternatives_Call) RunAndReturn(run func(ctx context.Context, in *inventoryv1.SuggestAlternativesRequest, opts ...grpc.CallOption) (*inventoryv1.SuggestAlternativesResponse, error)) *MockInvClient_SuggestAlternatives_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationClient creates a new instance of MockNotificationClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationClient {
+	mock := &MockNotificationClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationClient is an autogenerated mock type for the NotificationClient type
+type MockNotificationClient struct {
+	mock.Mock
+}
+
+type MockNotificationClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationClient) EXPECT() *MockNotificationClient_Expecter {
+	return &MockNotificationClient_Expecter{mock: &_m.Mock}
+}
+
+// SendEmail provides a mock function for the type MockNotificationClient
+func (_mock *MockNotificationClient) SendEmail(ctx context.Context, in *notificationv1.SendEmailRequest, opts ...grpc.CallOption) (*notificationv1.SendEmailResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmail")
+	}
+
+	var r0 *notificationv1.SendEmailResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *notificationv1.SendEmailRequest, ...grpc.CallOption) (*notificationv1.SendEmailResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *notificationv1.SendEmailRequest, ...grpc.CallOption) *notificationv1.SendEmailResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*notificationv1.SendEmailResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *notificationv1.SendEmailRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationClient_SendEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendEmail'
+type MockNotificationClient_SendEmail_Call struct {
+	*mock.Call
+}
+
+// SendEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *notificationv1.SendEmailRequest
+//   - opts ...grpc.CallOption
+func (_e *MockNotificationClient_Expecter) SendEmail(ctx interface{}, in interface{}, opts ...interface{}) *MockNotificationClient_SendEmail_Call {
+	return &MockNotificationClient_SendEmail_Call{Call: _e.mock.On("SendEmail",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockNotificationClient_SendEmail_Call) Run(run func(ctx context.Context, in *notificationv1.SendEmailRequest, opts ...grpc.CallOption)) *MockNotificationClient_SendEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *notificationv1.SendEmailRequest
+		if args[1] != nil {
+			arg1 = args[1].(*notificationv1.SendEmailRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationClient_SendEmail_Call) Return(sendEmailResponse *notificationv1.SendEmailResponse, err error) *MockNotificationClient_SendEmail_Call {
+	_c.Call.Return(sendEmailResponse, err)
+	return _c
+}
+
+func (_c *MockNotificationClient_SendEmail_Call) RunAndReturn(run func(ctx context.Context, in *notificationv1.SendEmailRequest, opts ...grpc.CallOption) (*notificationv1.SendEmailResponse, error)) *MockNotificationClient_SendEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,13 +5,17 @@ import (
 
 	// pb_schemas generated interface
 	inventoryv1 "pb_schemas/inventory/v1"
+	notificationv1 "pb_schemas/notification/v1"
 	userv1 "pb_schemas/user/v1"
 )
 
 // aliases
 type (
-	InvClient  = inventoryv1.InventoryServiceClient
-	UserClient = userv1.UserServiceClient
+	InvClient          = inventoryv1.InventoryServiceClient
+	BackInStockClient  = inventoryv1.BackInStockServiceClient
+	BackorderClient    = inventoryv1.BackorderServiceClient
+	UserClient         = userv1.UserServiceClient
+	NotificationClient = notificationv1.NotificationServiceClient
 )
 
 type ServiceClients struct {
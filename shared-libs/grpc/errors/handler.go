@@ -57,6 +57,9 @@ func (h *GRPCErrorHandler) handleBusinessError(bizErr *AppError) error {
 	case InternalServerError:
 		return h.createStatusError(codes.Internal, "internal server error", nil)
 
+	case ConcurrencyConflict:
+		return h.createStatusError(codes.Aborted, bizErr.Message, bizErr.Details)
+
 	default:
 		return h.createStatusError(codes.Internal, "unknown error", nil)
 	}
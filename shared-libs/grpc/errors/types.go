@@ -10,4 +10,5 @@ const (
 	DbError
 	DbTransactionError
 	InternalServerError
+	ConcurrencyConflict
 )
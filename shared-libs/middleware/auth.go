@@ -21,8 +21,9 @@ type AuthConfig struct {
 
 // UserInfo contains authenticated user information
 type UserInfo struct {
-	Email string   `json:"email"`
-	Roles []string `json:"roles"`
+	UserId string   `json:"user_id"`
+	Email  string   `json:"email"`
+	Roles  []string `json:"roles"`
 }
 
 // JWTAuthMiddleware creates a Gin middleware for JWT authentication
@@ -73,6 +74,7 @@ func JWTAuthMiddleware(config AuthConfig) gin.HandlerFunc {
 
 		// Set user info in context for downstream handlers
 		c.Set("user", userInfo)
+		c.Set("user_id", userInfo.UserId)
 		c.Set("user_email", userInfo.Email)
 		c.Set("user_roles", userInfo.Roles)
 
@@ -81,6 +83,19 @@ func JWTAuthMiddleware(config AuthConfig) gin.HandlerFunc {
 	})
 }
 
+// OptionalJWTAuthMiddleware authenticates requests that carry an Authorization header like JWTAuthMiddleware
+// and lets the others through anonymously, without user info in the context
+func OptionalJWTAuthMiddleware(config AuthConfig) gin.HandlerFunc {
+	auth := JWTAuthMiddleware(config)
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		auth(c)
+	})
+}
+
 // validateTokenWithUserService validates JWT token by calling user service gRPC endpoint
 func validateTokenWithUserService(token string, config AuthConfig) (*UserInfo, error) {
 	// Set default timeout if not provided
@@ -117,8 +132,9 @@ func validateTokenWithUserService(token string, config AuthConfig) (*UserInfo, e
 	}
 
 	return &UserInfo{
-		Email: resp.UserEmail,
-		Roles: resp.Roles,
+		UserId: resp.UserId,
+		Email:  resp.UserEmail,
+		Roles:  resp.Roles,
 	}, nil
 }
 